@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"ticket-reservation/internal/config"
+	infraDB "ticket-reservation/internal/infra/db"
+	reservationRepo "ticket-reservation/internal/infra/db/repository/reservation"
+	seatRepo "ticket-reservation/internal/infra/db/repository/seat"
+	ticketRepo "ticket-reservation/internal/infra/db/repository/ticket"
+	webhookDeliveryRepo "ticket-reservation/internal/infra/db/repository/webhook_delivery"
+	zoneRepo "ticket-reservation/internal/infra/db/repository/zone"
+	eventGateway "ticket-reservation/internal/infra/gateway/event"
+	infraRedis "ticket-reservation/internal/infra/redis"
+	seatRedisRepo "ticket-reservation/internal/infra/redis/repository/seat"
+	seatEventRedisRepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+
+	redsyncLocker "github.com/kittipat1413/go-common/framework/lockmanager/redsync"
+	"github.com/spf13/cobra"
+)
+
+var cleanupExpiredCmd = &cobra.Command{
+	Use:   "cleanup-expired",
+	Short: "Expire stale pending reservations and free their seats.",
+	Long: `Run the expiry sweeper once.
+
+Pending reservations past their expires_at are marked as expired and pending seats
+whose locked_until has passed are set back to available, in batches of
+EXPIRY_SWEEP_BATCH_SIZE rows per transaction. Rows are claimed with
+FOR UPDATE SKIP LOCKED, so it is safe to run alongside the server's own sweeper.
+
+Example:
+	cleanup-expired
+`,
+
+	RunE: runCleanupExpiredCmd,
+}
+
+func runCleanupExpiredCmd(cmd *cobra.Command, args []string) error {
+	cfg := config.MustConfigure()
+
+	dbConn := infraDB.MustConnect(cfg)
+	defer dbConn.Close()
+	redisClient := infraRedis.NewClient(cfg)
+	defer redisClient.Close()
+
+	usecase := reservationUsecase.NewReservationUsecase(
+		cfg.App,
+		infraDB.NewSqlxTransactorFactory(dbConn),
+		zoneRepo.NewZoneRepository(dbConn),
+		seatRepo.NewSeatRepository(dbConn),
+		reservationRepo.NewReservationRepository(dbConn),
+		ticketRepo.NewTicketRepository(dbConn),
+		seatRedisRepo.NewSeatLockerRepository(redsyncLocker.NewRedsyncLockManager(redisClient)),
+		seatRedisRepo.NewSeatMapRepository(redisClient),
+		eventGateway.NewEventPublisher(
+			seatEventRedisRepo.NewSeatEventRepository(redisClient),
+			webhookDeliveryRepo.NewWebhookDeliveryRepository(dbConn),
+		),
+	)
+
+	result, err := usecase.CleanupExpiredReservations(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to cleanup expired reservations: %w", err)
+	}
+
+	log.Printf("Expired %d reservations and released %d seats.", result.ExpiredReservations, result.ReleasedSeats)
+	return nil
+}
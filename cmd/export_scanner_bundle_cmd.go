@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	infraDB "ticket-reservation/internal/infra/db"
+	concertRepo "ticket-reservation/internal/infra/db/repository/concert"
+	reservationRepo "ticket-reservation/internal/infra/db/repository/reservation"
+	ticketRepo "ticket-reservation/internal/infra/db/repository/ticket"
+	ticketCheckinRepo "ticket-reservation/internal/infra/db/repository/ticket_checkin"
+	ticketScanRepo "ticket-reservation/internal/infra/db/repository/ticket_scan"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/pkg/signedtoken"
+
+	"github.com/spf13/cobra"
+)
+
+var exportScannerBundleCmd = &cobra.Command{
+	Use:   "export-scanner-bundle",
+	Short: "Export the signed bundle of valid tickets loaded onto gate scanners.",
+	Long: `Export the valid tickets of a concert for gate scanners that validate tickets offline.
+
+The bundle lists the IDs of the tickets whose reservation is still confirmed and is
+signed with TICKET_SIGNING_KEY; the file also holds the public key that verifies the
+bundle and the ticket payloads. It is the same document as
+GET /admin/concerts/:id/scanner-bundle. Export it again after late sales or refunds.
+
+Example:
+	export-scanner-bundle --concert-id <uuid>
+	export-scanner-bundle --concert-id <uuid> --output bundle.json
+`,
+
+	RunE: runExportScannerBundleCmd,
+}
+
+// scannerBundleFile is the document written by the command, scanners read the same fields from the admin endpoint
+type scannerBundleFile struct {
+	ConcertID   string `json:"concert_id"`
+	GeneratedAt string `json:"generated_at"`
+	TicketCount int    `json:"ticket_count"`
+	PublicKey   string `json:"public_key"`
+	Bundle      string `json:"bundle"`
+}
+
+func runExportScannerBundleCmd(cmd *cobra.Command, args []string) error {
+	cfg := config.MustConfigure()
+
+	concertID, _ := cmd.Flags().GetString("concert-id")
+	output, _ := cmd.Flags().GetString("output")
+
+	privateKey, err := signedtoken.ParsePrivateKey(cfg.App.TicketSigningKey)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", config.TicketSigningKeyKey, err)
+	}
+
+	dbConn := infraDB.MustConnect(cfg)
+	defer dbConn.Close()
+
+	usecase := checkinUsecase.NewCheckinUsecase(
+		cfg.App,
+		concertRepo.NewConcertRepository(dbConn),
+		reservationRepo.NewReservationRepository(dbConn),
+		ticketRepo.NewTicketRepository(dbConn),
+		ticketCheckinRepo.NewTicketCheckinRepository(dbConn),
+		ticketScanRepo.NewTicketScanRepository(dbConn),
+		entity.NewTicketSigner(privateKey),
+	)
+
+	result, err := usecase.ExportScannerBundle(context.Background(), checkinUsecase.ExportScannerBundleInput{
+		ConcertID: concertID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export scanner bundle: %w", err)
+	}
+
+	loc, err := time.LoadLocation(cfg.App.Timezone)
+	if err != nil {
+		return fmt.Errorf("failed to load timezone: %w", err)
+	}
+	document, err := json.MarshalIndent(scannerBundleFile{
+		ConcertID:   result.Bundle.ConcertID.String(),
+		GeneratedAt: time.Unix(result.Bundle.GeneratedAt, 0).In(loc).Format(time.RFC3339),
+		TicketCount: len(result.Bundle.TicketIDs),
+		PublicKey:   signedtoken.EncodePublicKey(result.PublicKey),
+		Bundle:      result.Token,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode scanner bundle: %w", err)
+	}
+
+	if output == "" {
+		fmt.Println(string(document))
+		return nil
+	}
+	if err := os.WriteFile(output, append(document, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write scanner bundle: %w", err)
+	}
+	log.Printf("Exported %d tickets of concert %s to %s.", len(result.Bundle.TicketIDs), concertID, output)
+	return nil
+}
+
+func init() {
+	exportScannerBundleCmd.Flags().String("concert-id", "", "ID of the concert to export the tickets of")
+	exportScannerBundleCmd.Flags().String("output", "", "File to write the bundle to, printed when empty")
+	_ = exportScannerBundleCmd.MarkFlagRequired("concert-id")
+}
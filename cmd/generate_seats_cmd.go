@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	infraDB "ticket-reservation/internal/infra/db"
+	concertRepo "ticket-reservation/internal/infra/db/repository/concert"
+	reservationRepo "ticket-reservation/internal/infra/db/repository/reservation"
+	seatRepo "ticket-reservation/internal/infra/db/repository/seat"
+	zoneRepo "ticket-reservation/internal/infra/db/repository/zone"
+	infraRedis "ticket-reservation/internal/infra/redis"
+	seatRedisRepo "ticket-reservation/internal/infra/redis/repository/seat"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+
+	redsyncLocker "github.com/kittipat1413/go-common/framework/lockmanager/redsync"
+	"github.com/kittipat1413/go-common/util/pointer"
+	"github.com/spf13/cobra"
+)
+
+var generateSeatsCmd = &cobra.Command{
+	Use:   "generate-seats",
+	Short: "Bulk-generate the seats of a zone from a layout.",
+	Long: `Generate every seat of a zone from a row/column layout in a single transaction.
+
+Seat numbers are built from the row label followed by the seat number (e.g. A1, A2, ... B1).
+Numbers listed in --skip are never given to a seat (the numbering jumps over them),
+an aisle runs after every seat listed in --aisle-after counted from the left, and --direction
+controls whether numbering runs left_to_right (1 on the left) or right_to_left (1 on the right).
+
+If any of the generated seats already exist in the zone, nothing is created and
+a per-seat conflict report is printed instead.
+
+Example:
+	generate-seats --concert-id <uuid> --zone-id <uuid> --rows A,B,C --seats-per-row 20
+	generate-seats --concert-id <uuid> --zone-id <uuid> --rows A,B --seats-per-row 12 --skip 13 --direction right_to_left
+	generate-seats --concert-id <uuid> --zone-id <uuid> --rows A,B --seats-per-row 20 --aisle-after 5,15
+`,
+
+	RunE: runGenerateSeatsCmd,
+}
+
+func runGenerateSeatsCmd(cmd *cobra.Command, args []string) error {
+	cfg := config.MustConfigure()
+
+	concertID, _ := cmd.Flags().GetString("concert-id")
+	zoneID, _ := cmd.Flags().GetString("zone-id")
+	rows, _ := cmd.Flags().GetStringSlice("rows")
+	for i := range rows {
+		rows[i] = strings.TrimSpace(rows[i])
+	}
+	seatsPerRow, _ := cmd.Flags().GetInt("seats-per-row")
+	skippedNumbers, _ := cmd.Flags().GetIntSlice("skip")
+	aislesAfter, _ := cmd.Flags().GetIntSlice("aisle-after")
+	direction, _ := cmd.Flags().GetString("direction")
+
+	dbConn := infraDB.MustConnect(cfg)
+	defer dbConn.Close()
+	redisClient := infraRedis.NewClient(cfg)
+	defer redisClient.Close()
+
+	usecase := seatUsecase.NewSeatUsecase(
+		cfg.App,
+		concertRepo.NewConcertRepository(dbConn),
+		zoneRepo.NewZoneRepository(dbConn),
+		seatRepo.NewSeatRepository(dbConn),
+		reservationRepo.NewReservationRepository(dbConn),
+		infraDB.NewSqlxTransactorFactory(dbConn),
+		seatRedisRepo.NewSeatLockerRepository(redsyncLocker.NewRedsyncLockManager(redisClient)),
+		seatRedisRepo.NewSeatMapRepository(redisClient),
+		nil, // Generating seats neither streams seat status nor publishes events
+		nil,
+	)
+
+	seats, err := usecase.GenerateSeats(context.Background(), seatUsecase.GenerateSeatsInput{
+		ConcertID:          concertID,
+		ZoneID:             zoneID,
+		RowLabels:          rows,
+		SeatsPerRow:        seatsPerRow,
+		SkippedNumbers:     skippedNumbers,
+		AislesAfter:        aislesAfter,
+		NumberingDirection: direction,
+		Principal:          &entity.Principal{Role: entity.UserRoleAdmin}, // The command is run by an operator with access to the database
+	})
+	if err != nil {
+		var seatsExistErr *errs.SeatsAlreadyExistError
+		if errors.As(err, &seatsExistErr) {
+			printSeatConflicts(seatsExistErr.Conflicts)
+		}
+		return fmt.Errorf("failed to generate seats: %w", err)
+	}
+
+	log.Printf("Generated %d seats in zone %s.", len(pointer.GetValue(seats)), zoneID)
+	return nil
+}
+
+func printSeatConflicts(conflicts []errs.SeatConflict) {
+	log.Printf("%d seats conflict with existing seats:", len(conflicts))
+	for _, conflict := range conflicts {
+		fmt.Printf("  %-8s %s\n", conflict.SeatNumber, conflict.Reason)
+	}
+}
+
+func init() {
+	generateSeatsCmd.Flags().String("concert-id", "", "ID of the concert the zone belongs to")
+	generateSeatsCmd.Flags().String("zone-id", "", "ID of the zone to generate seats for")
+	generateSeatsCmd.Flags().StringSlice("rows", nil, "Comma-separated row labels (e.g. A,B,C)")
+	generateSeatsCmd.Flags().Int("seats-per-row", 0, "Number of seats in each row")
+	generateSeatsCmd.Flags().IntSlice("skip", nil, "Comma-separated seat numbers never given to a seat (e.g. 13)")
+	generateSeatsCmd.Flags().IntSlice("aisle-after", nil, "Comma-separated seats, counted from the left, followed by an aisle (e.g. 5,15)")
+	generateSeatsCmd.Flags().String("direction", entity.SeatNumberingLeftToRight.String(), "Numbering direction: left_to_right or right_to_left")
+	_ = generateSeatsCmd.MarkFlagRequired("concert-id")
+	_ = generateSeatsCmd.MarkFlagRequired("zone-id")
+	_ = generateSeatsCmd.MarkFlagRequired("rows")
+	_ = generateSeatsCmd.MarkFlagRequired("seats-per-row")
+}
@@ -28,5 +28,9 @@ func init() {
 		newMigrationCmd,
 		migrateCmd,
 		serveCmd,
+		generateSeatsCmd,
+		cleanupExpiredCmd,
+		exportScannerBundleCmd,
+		setUserRoleCmd,
 	)
 }
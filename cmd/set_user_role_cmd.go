@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	infraDB "ticket-reservation/internal/infra/db"
+	userRepo "ticket-reservation/internal/infra/db/repository/user"
+
+	"github.com/spf13/cobra"
+)
+
+var setUserRoleCmd = &cobra.Command{
+	Use:   "set-user-role",
+	Short: "Set the role of a registered user.",
+	Long: `Set the role of a user registered with POST /auth/register.
+
+Roles are admin, organizer, box_office and customer; every new account is a customer.
+Admins change roles with PUT /admin/users/:id/role, this command promotes the first
+admin. The new role is carried by the tokens issued from the next sign-in or refresh.
+
+Example:
+	set-user-role --email admin@example.com --role admin
+`,
+
+	RunE: runSetUserRoleCmd,
+}
+
+func runSetUserRoleCmd(cmd *cobra.Command, args []string) error {
+	cfg := config.MustConfigure()
+
+	email, _ := cmd.Flags().GetString("email")
+	roleFlag, _ := cmd.Flags().GetString("role")
+
+	role, err := new(entity.UserRole).Parse(roleFlag)
+	if err != nil {
+		return err
+	}
+
+	dbConn := infraDB.MustConnect(cfg)
+	defer dbConn.Close()
+
+	repository := userRepo.NewUserRepository(dbConn)
+	user, err := repository.FindOneByEmail(context.Background(), entity.NormalizeEmail(email))
+	if err != nil {
+		return fmt.Errorf("failed to find user %s: %w", email, err)
+	}
+	user, err = repository.UpdateRole(context.Background(), user.ID, role)
+	if err != nil {
+		return fmt.Errorf("failed to set the role of user %s: %w", email, err)
+	}
+
+	log.Printf("User %s (%s) is now %s.", user.Email, user.ID, user.Role)
+	return nil
+}
+
+func init() {
+	setUserRoleCmd.Flags().String("email", "", "Email the user registered with")
+	setUserRoleCmd.Flags().String("role", "", "Role to set: admin, organizer, box_office or customer")
+	_ = setUserRoleCmd.MarkFlagRequired("email")
+	_ = setUserRoleCmd.MarkFlagRequired("role")
+}
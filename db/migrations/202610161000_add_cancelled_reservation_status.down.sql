@@ -0,0 +1,6 @@
+-- 202610161000_add_cancelled_reservation_status.down.sql
+
+-- Cancelled reservations fall back to expired, which is the closest pre-existing state
+UPDATE reservations SET status = 'expired' WHERE status = 'cancelled';
+ALTER TABLE reservations DROP CONSTRAINT IF EXISTS reservations_status_check;
+ALTER TABLE reservations ADD CONSTRAINT reservations_status_check CHECK (status IN ('pending', 'confirmed', 'expired'));
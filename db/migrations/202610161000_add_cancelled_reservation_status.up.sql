@@ -0,0 +1,5 @@
+-- 202610161000_add_cancelled_reservation_status.up.sql
+
+-- Allow reservations to be cancelled by the session that holds them
+ALTER TABLE reservations DROP CONSTRAINT IF EXISTS reservations_status_check;
+ALTER TABLE reservations ADD CONSTRAINT reservations_status_check CHECK (status IN ('pending', 'confirmed', 'expired', 'cancelled'));
@@ -0,0 +1,3 @@
+-- 202610161100_add_reservation_group.down.sql
+DROP INDEX IF EXISTS reservations_group_id_idx;
+ALTER TABLE reservations DROP COLUMN IF EXISTS group_id;
@@ -0,0 +1,5 @@
+-- 202610161100_add_reservation_group.up.sql
+
+-- Reservations made together in a single cart share the same group_id
+ALTER TABLE reservations ADD COLUMN group_id UUID;
+CREATE INDEX reservations_group_id_idx ON reservations(group_id);
@@ -0,0 +1,6 @@
+-- 202610161200_add_payment_provider.down.sql
+DROP INDEX IF EXISTS payments_reservation_paid_key;
+DROP INDEX IF EXISTS payments_provider_reference_key;
+DROP INDEX IF EXISTS payments_reservation_id_idx;
+ALTER TABLE payments DROP COLUMN IF EXISTS provider_reference;
+ALTER TABLE payments DROP COLUMN IF EXISTS provider;
@@ -0,0 +1,9 @@
+-- 202610161200_add_payment_provider.up.sql
+
+-- Keep track of the payment gateway that processed a payment and its reference for the charge
+ALTER TABLE payments ADD COLUMN provider TEXT;
+ALTER TABLE payments ADD COLUMN provider_reference TEXT;
+CREATE INDEX payments_reservation_id_idx ON payments(reservation_id);
+CREATE UNIQUE INDEX payments_provider_reference_key ON payments(provider, provider_reference);
+-- A reservation can only be paid once
+CREATE UNIQUE INDEX payments_reservation_paid_key ON payments(reservation_id) WHERE status = 'paid';
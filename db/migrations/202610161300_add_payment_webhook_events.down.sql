@@ -0,0 +1,2 @@
+-- 202610161300_add_payment_webhook_events.down.sql
+DROP TABLE IF EXISTS payment_webhook_events;
@@ -0,0 +1,12 @@
+-- 202610161300_add_payment_webhook_events.up.sql
+
+-- Payment webhook events that were already processed, a provider may deliver the same event more than once
+CREATE TABLE payment_webhook_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    provider TEXT NOT NULL,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payment_id UUID REFERENCES payments(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(provider, event_id)
+);
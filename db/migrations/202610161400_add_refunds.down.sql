@@ -0,0 +1,7 @@
+-- 202610161400_add_refunds.down.sql
+DROP TABLE IF EXISTS refunds;
+
+-- Refunded payments fall back to paid, which is the closest pre-existing state
+UPDATE payments SET status = 'paid' WHERE status IN ('refunded', 'partially_refunded');
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check CHECK (status IN ('initiated', 'paid', 'failed'));
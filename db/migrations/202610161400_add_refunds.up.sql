@@ -0,0 +1,19 @@
+-- 202610161400_add_refunds.up.sql
+
+-- Payments can be given back in full or in part
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check CHECK (status IN ('initiated', 'paid', 'failed', 'refunded', 'partially_refunded'));
+
+-- Refunds Table
+CREATE TABLE refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'succeeded', 'failed')),
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+    reason TEXT,
+    provider_reference TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TRIGGER refunds_updated_at_modtime BEFORE UPDATE ON refunds FOR EACH ROW EXECUTE PROCEDURE update_updated_at_column();
+CREATE INDEX refunds_payment_id_idx ON refunds(payment_id);
@@ -0,0 +1,6 @@
+-- 202610161500_add_pricing.down.sql
+ALTER TABLE reservations DROP COLUMN IF EXISTS currency;
+ALTER TABLE reservations DROP COLUMN IF EXISTS price;
+ALTER TABLE seats DROP COLUMN IF EXISTS price;
+ALTER TABLE zones DROP COLUMN IF EXISTS currency;
+ALTER TABLE zones DROP COLUMN IF EXISTS price;
@@ -0,0 +1,10 @@
+-- 202610161500_add_pricing.up.sql
+
+-- Every seat of a zone is sold at the zone price unless the seat overrides it
+ALTER TABLE zones ADD COLUMN price DECIMAL(10, 2) CHECK (price >= 0);
+ALTER TABLE zones ADD COLUMN currency TEXT NOT NULL DEFAULT 'THB' CHECK (currency ~ '^[A-Z]{3}$');
+ALTER TABLE seats ADD COLUMN price DECIMAL(10, 2) CHECK (price >= 0);
+
+-- The price is captured when the seat is held, a later price change does not alter what the customer pays
+ALTER TABLE reservations ADD COLUMN price DECIMAL(10, 2);
+ALTER TABLE reservations ADD COLUMN currency TEXT;
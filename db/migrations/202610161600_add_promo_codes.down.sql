@@ -0,0 +1,5 @@
+-- 202610161600_add_promo_codes.down.sql
+DROP TABLE IF EXISTS promo_code_redemptions;
+ALTER TABLE payments DROP COLUMN IF EXISTS discount_amount;
+ALTER TABLE payments DROP COLUMN IF EXISTS promo_code_id;
+DROP TABLE IF EXISTS promo_codes;
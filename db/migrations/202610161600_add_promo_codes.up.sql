@@ -0,0 +1,35 @@
+-- 202610161600_add_promo_codes.up.sql
+
+-- Promo Codes Table
+CREATE TABLE promo_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code TEXT NOT NULL UNIQUE CHECK (code = UPPER(code)),
+    discount_type TEXT NOT NULL CHECK (discount_type IN ('percentage', 'fixed')),
+    discount_value DECIMAL(10, 2) NOT NULL CHECK (discount_value > 0),
+    max_redemptions INT CHECK (max_redemptions > 0),
+    max_redemptions_per_session INT CHECK (max_redemptions_per_session > 0),
+    valid_from TIMESTAMPTZ,
+    valid_until TIMESTAMPTZ,
+    concert_id UUID REFERENCES concerts(id) ON DELETE CASCADE,
+    zone_id UUID REFERENCES zones(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (discount_type <> 'percentage' OR discount_value < 100),
+    CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from < valid_until)
+);
+CREATE TRIGGER promo_codes_updated_at_modtime BEFORE UPDATE ON promo_codes FOR EACH ROW EXECUTE PROCEDURE update_updated_at_column();
+
+-- Payments record the promo code applied and the discount it gave
+ALTER TABLE payments ADD COLUMN promo_code_id UUID REFERENCES promo_codes(id) ON DELETE SET NULL;
+ALTER TABLE payments ADD COLUMN discount_amount DECIMAL(10, 2) CHECK (discount_amount >= 0);
+
+-- Promo Code Redemptions Table, one per payment that used a code
+CREATE TABLE promo_code_redemptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    promo_code_id UUID NOT NULL REFERENCES promo_codes(id) ON DELETE CASCADE,
+    payment_id UUID NOT NULL UNIQUE REFERENCES payments(id) ON DELETE CASCADE,
+    session_id TEXT NOT NULL,
+    discount_amount DECIMAL(10, 2) NOT NULL CHECK (discount_amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX promo_code_redemptions_promo_code_id_session_id_idx ON promo_code_redemptions(promo_code_id, session_id);
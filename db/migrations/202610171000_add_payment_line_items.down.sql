@@ -0,0 +1,2 @@
+-- 202610171000_add_payment_line_items.down.sql
+DROP TABLE IF EXISTS payment_line_items;
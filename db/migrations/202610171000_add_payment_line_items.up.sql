@@ -0,0 +1,13 @@
+-- 202610171000_add_payment_line_items.up.sql
+
+-- Payment Line Items Table, the breakdown of the amount charged for a payment
+CREATE TABLE payment_line_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    line_number INT NOT NULL CHECK (line_number > 0),
+    type TEXT NOT NULL CHECK (type IN ('ticket', 'discount', 'service_fee', 'payment_fee', 'vat')),
+    rate DECIMAL(5, 2) CHECK (rate >= 0),
+    amount DECIMAL(10, 2) NOT NULL CHECK (type = 'discount' OR amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (payment_id, line_number)
+);
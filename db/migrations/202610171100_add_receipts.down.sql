@@ -0,0 +1,3 @@
+-- 202610171100_add_receipts.down.sql
+DROP TABLE IF EXISTS receipts;
+DROP TABLE IF EXISTS receipt_number_sequences;
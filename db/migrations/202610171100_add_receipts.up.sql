@@ -0,0 +1,28 @@
+-- 202610171100_add_receipts.up.sql
+
+-- Receipt Number Sequences Table, the last receipt number issued per seller.
+-- Numbers are taken by incrementing the row inside the transaction that marks the payment paid, a rolled back
+-- payment gives its number back, so receipt numbers have no gaps unlike a Postgres SEQUENCE
+CREATE TABLE receipt_number_sequences (
+    seller_tax_id TEXT PRIMARY KEY,
+    last_number BIGINT NOT NULL CHECK (last_number > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TRIGGER receipt_number_sequences_updated_at_modtime BEFORE UPDATE ON receipt_number_sequences FOR EACH ROW EXECUTE PROCEDURE update_updated_at_column();
+
+-- Receipts Table, the tax invoice issued for a paid payment with the seller details at issue time
+CREATE TABLE receipts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL UNIQUE REFERENCES payments(id) ON DELETE RESTRICT,
+    seller_tax_id TEXT NOT NULL,
+    seller_name TEXT NOT NULL,
+    seller_address TEXT NOT NULL DEFAULT '',
+    receipt_number BIGINT NOT NULL CHECK (receipt_number > 0),
+    document_number TEXT NOT NULL,
+    description TEXT NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL CHECK (amount >= 0),
+    issued_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (seller_tax_id, receipt_number)
+);
@@ -0,0 +1,2 @@
+-- 202610171200_add_payment_qr_payload.down.sql
+ALTER TABLE payments DROP COLUMN IF EXISTS qr_payload;
@@ -0,0 +1,4 @@
+-- 202610171200_add_payment_qr_payload.up.sql
+
+-- The QR code payload the customer scans to pay, set for payment methods paid by scanning such as PromptPay
+ALTER TABLE payments ADD COLUMN qr_payload TEXT;
@@ -0,0 +1,2 @@
+-- 202610171300_add_tickets.down.sql
+DROP TABLE IF EXISTS tickets;
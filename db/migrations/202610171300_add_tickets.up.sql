@@ -0,0 +1,18 @@
+-- 202610171300_add_tickets.up.sql
+
+-- Tickets Table, the ticket issued when a reservation is confirmed.
+-- payload is the Ed25519 signed token printed as the QR code, gates verify it offline with the public key
+CREATE TABLE tickets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL UNIQUE REFERENCES reservations(id) ON DELETE RESTRICT,
+    concert_id UUID NOT NULL REFERENCES concerts(id) ON DELETE RESTRICT,
+    zone_id UUID NOT NULL REFERENCES zones(id) ON DELETE RESTRICT,
+    seat_id UUID NOT NULL REFERENCES seats(id) ON DELETE RESTRICT,
+    zone_name TEXT NOT NULL,
+    seat_number TEXT NOT NULL,
+    holder TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_tickets_concert_id ON tickets(concert_id);
@@ -0,0 +1,2 @@
+-- 202610171400_add_ticket_checkins.down.sql
+DROP TABLE IF EXISTS ticket_checkins;
@@ -0,0 +1,14 @@
+-- 202610171400_add_ticket_checkins.up.sql
+
+-- Ticket Checkins Table, the admission of a ticket at a gate.
+-- A ticket is admitted once, the unique ticket_id makes the first of two concurrent scans win
+CREATE TABLE ticket_checkins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id UUID NOT NULL UNIQUE REFERENCES tickets(id) ON DELETE RESTRICT,
+    concert_id UUID NOT NULL REFERENCES concerts(id) ON DELETE RESTRICT,
+    gate TEXT NOT NULL,
+    scanner_id TEXT NOT NULL,
+    admitted_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_ticket_checkins_concert_id ON ticket_checkins(concert_id);
@@ -0,0 +1,2 @@
+-- 202610171500_add_ticket_scans.down.sql
+DROP TABLE IF EXISTS ticket_scans;
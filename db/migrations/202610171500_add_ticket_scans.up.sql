@@ -0,0 +1,15 @@
+-- 202610171500_add_ticket_scans.up.sql
+
+-- Ticket Scans Table, the scan logs uploaded by gate scanners that validated tickets offline.
+-- A scan is identified by its ticket, scanner and time, so that a log uploaded twice is recorded once
+CREATE TABLE ticket_scans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id UUID NOT NULL REFERENCES tickets(id) ON DELETE RESTRICT,
+    concert_id UUID NOT NULL REFERENCES concerts(id) ON DELETE RESTRICT,
+    gate TEXT NOT NULL,
+    scanner_id TEXT NOT NULL,
+    scanned_at TIMESTAMPTZ NOT NULL,
+    uploaded_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (ticket_id, scanner_id, scanned_at)
+);
+CREATE INDEX idx_ticket_scans_concert_id ON ticket_scans(concert_id);
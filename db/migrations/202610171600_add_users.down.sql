@@ -0,0 +1,3 @@
+-- 202610171600_add_users.down.sql
+DROP TRIGGER IF EXISTS users_updated_at_modtime ON users;
+DROP TABLE IF EXISTS users;
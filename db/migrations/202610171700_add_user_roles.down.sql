@@ -0,0 +1,4 @@
+-- 202610171700_add_user_roles.down.sql
+DROP INDEX IF EXISTS concerts_organizer_id_idx;
+ALTER TABLE concerts DROP COLUMN IF EXISTS organizer_id;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
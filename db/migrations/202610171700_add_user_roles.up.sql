@@ -0,0 +1,8 @@
+-- 202610171700_add_user_roles.up.sql
+
+-- Every user has a single role, new accounts are customers until an admin promotes them
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'customer' CHECK (role IN ('admin', 'organizer', 'box_office', 'customer'));
+
+-- Concerts created by an organizer are owned by them, concerts created by an admin have no organizer
+ALTER TABLE concerts ADD COLUMN organizer_id UUID REFERENCES users(id);
+CREATE INDEX concerts_organizer_id_idx ON concerts(organizer_id);
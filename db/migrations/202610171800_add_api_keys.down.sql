@@ -0,0 +1,3 @@
+-- 202610171800_add_api_keys.down.sql
+DROP TRIGGER IF EXISTS api_keys_updated_at_modtime ON api_keys;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,19 @@
+-- 202610171800_add_api_keys.up.sql
+
+-- API Keys Table, the credentials of partner integrations.
+-- Only the prefix of a key is stored in clear, to look it up, and the key itself is kept as a SHA-256 hash.
+-- Scopes are space-separated, e.g. 'concerts:read reservations:write'
+CREATE TABLE api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL UNIQUE,
+    key_hash TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER api_keys_updated_at_modtime BEFORE UPDATE ON api_keys FOR EACH ROW EXECUTE PROCEDURE update_updated_at_column();
@@ -0,0 +1,2 @@
+-- 202610171900_add_concert_waiting_room.down.sql
+ALTER TABLE concerts DROP COLUMN IF EXISTS waiting_room_enabled;
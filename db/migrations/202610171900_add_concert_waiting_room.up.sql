@@ -0,0 +1,4 @@
+-- 202610171900_add_concert_waiting_room.up.sql
+
+-- Concerts expecting a rush put their buyers through a waiting room, the queue itself is kept in Redis
+ALTER TABLE concerts ADD COLUMN waiting_room_enabled BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,5 @@
+-- 202610172000_add_webhooks.down.sql
+DROP TRIGGER IF EXISTS webhook_deliveries_updated_at_modtime ON webhook_deliveries;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TRIGGER IF EXISTS webhook_subscriptions_updated_at_modtime ON webhook_subscriptions;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,43 @@
+-- 202610172000_add_webhooks.up.sql
+
+-- Webhook Subscriptions Table, the endpoints of partner systems (CRM, analytics) notified of lifecycle events.
+-- The secret is kept in clear as it keys the HMAC-SHA256 signature of every delivery.
+-- Event types are space-separated, e.g. 'reservation.created payment.succeeded'
+CREATE TABLE webhook_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER webhook_subscriptions_updated_at_modtime BEFORE UPDATE ON webhook_subscriptions FOR EACH ROW EXECUTE PROCEDURE update_updated_at_column();
+
+-- Webhook Deliveries Table, one row per event and subscription, written in the transaction of the change the event describes.
+-- The payload is kept as text so that every attempt posts the same bytes.
+-- Pending deliveries are sent once next_attempt_at has passed, a delivery still failing after the last attempt is dead-lettered
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead_lettered')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_attempt_at TIMESTAMPTZ,
+    last_response_status INT,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(subscription_id, event_id)
+);
+
+CREATE INDEX webhook_deliveries_due_idx ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_deliveries_subscription_id_idx ON webhook_deliveries(subscription_id, created_at);
+
+CREATE TRIGGER webhook_deliveries_updated_at_modtime BEFORE UPDATE ON webhook_deliveries FOR EACH ROW EXECUTE PROCEDURE update_updated_at_column();
@@ -0,0 +1,6 @@
+-- 202610172100_add_refund_required_payment_status.down.sql
+
+-- Payments owed back fall back to failed, which is the closest pre-existing state
+UPDATE payments SET status = 'failed' WHERE status = 'refund_required';
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check CHECK (status IN ('initiated', 'paid', 'failed', 'refunded', 'partially_refunded'));
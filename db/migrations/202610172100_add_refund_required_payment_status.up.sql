@@ -0,0 +1,5 @@
+-- 202610172100_add_refund_required_payment_status.up.sql
+
+-- Allow payments charged after their reservation could no longer be confirmed to be kept as owed back
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check CHECK (status IN ('initiated', 'paid', 'failed', 'refunded', 'partially_refunded', 'refund_required'));
@@ -0,0 +1,2 @@
+-- 202610172200_add_seat_position.down.sql
+ALTER TABLE seats DROP COLUMN IF EXISTS position;
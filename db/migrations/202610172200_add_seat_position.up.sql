@@ -0,0 +1,7 @@
+-- 202610172200_add_seat_position.up.sql
+
+-- The place of a seat in its row counted from the left, an aisle takes a place so that only seats side by side follow each other
+ALTER TABLE seats ADD COLUMN position INTEGER CHECK (position >= 1);
+
+-- Seats laid out before were numbered without gaps other than their aisles, their number is their place
+UPDATE seats SET position = substring(seat_number FROM '^[^0-9]*([0-9]+)$')::INTEGER;
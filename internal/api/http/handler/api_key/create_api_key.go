@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type createAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required" example:"Partner"`
+	Scopes    []string   `json:"scopes" binding:"required" example:"concerts:read,reservations:write"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2026-01-01T00:00:00+07:00"`
+}
+
+// @Summary		Create API Key
+// @Description	Issues an API key to a partner with the given scopes (concerts:read, reservations:write, admin) and optional expiry. The key is only returned in this response, it is sent as "Authorization: ApiKey <key>"
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			request	body		createAPIKeyRequest													true	"API key creation input"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=issuedAPIKeyResponse,metadata=nil}	"API key created"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}									"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - The user is not an admin"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal server error"
+// @Router			/admin/api-keys [post]
+func (h *apiKeyHandler) CreateAPIKey(c *gin.Context) {
+	var request createAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	issued, err := h.apiKeyUsecase.CreateAPIKey(c.Request.Context(), apiKeyUsecase.CreateAPIKeyInput{
+		Name:      request.Name,
+		Scopes:    request.Scopes,
+		ExpiresAt: request.ExpiresAt,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newIssuedAPIKeyResponse(issued))
+}
@@ -0,0 +1,163 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAPIKeyHandler_CreateAPIKey(t *testing.T) {
+	apiKeyID := uuid.New()
+	expiresAt := time.Date(2025, 12, 31, 17, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful api key creation",
+			requestBody: map[string]interface{}{
+				"name":       "Partner",
+				"scopes":     []string{"concerts:read", "reservations:write"},
+				"expires_at": "2026-01-01T00:00:00+07:00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input apiKeyUsecase.CreateAPIKeyInput) (*apiKeyUsecase.IssuedAPIKey, error) {
+						assert.Equal(t, "Partner", input.Name)
+						assert.Equal(t, []string{"concerts:read", "reservations:write"}, input.Scopes)
+						assert.Equal(t, expiresAt, input.ExpiresAt.UTC())
+						return &apiKeyUsecase.IssuedAPIKey{
+							APIKey: &entity.APIKey{
+								ID:        apiKeyID,
+								Name:      "Partner",
+								Prefix:    "a1b2c3d4e5f6",
+								Scopes:    []entity.APIKeyScope{entity.APIKeyScopeConcertsRead, entity.APIKeyScopeReservationsWrite},
+								ExpiresAt: &expiresAt,
+								CreatedAt: createdAt,
+							},
+							Key: "trk_a1b2c3d4e5f6_secret",
+						}, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":         apiKeyID.String(),
+					"name":       "Partner",
+					"prefix":     "a1b2c3d4e5f6",
+					"scopes":     []interface{}{"concerts:read", "reservations:write"},
+					"expires_at": "2026-01-01T00:00:00+07:00",
+					"created_at": "2025-01-01T10:00:00+07:00",
+					"key":        "trk_a1b2c3d4e5f6_secret",
+				},
+			},
+		},
+		{
+			name: "missing scopes",
+			requestBody: map[string]interface{}{
+				"name": "Partner",
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name: "invalid scope",
+			requestBody: map[string]interface{}{
+				"name":   "Partner",
+				"scopes": []string{"concerts:write"},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name: "usecase internal error",
+			requestBody: map[string]interface{}{
+				"name":   "Partner",
+				"scopes": []string{"admin"},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					CreateAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/admin/api-keys").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.apiKeyHandler.CreateAPIKey(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		List API Keys
+// @Description	Lists every API key, revoked and expired ones included, the most recent first. Only the prefix of each key is returned
+// @Tags			Admin
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Success		200	{object}	httpresponse.SuccessResponse{data=[]apiKeyResponse,metadata=nil}	"API keys found"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}							"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}							"Forbidden - The user is not an admin"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}							"Internal server error"
+// @Router			/admin/api-keys [get]
+func (h *apiKeyHandler) FindAllAPIKeys(c *gin.Context) {
+	apiKeys, err := h.apiKeyUsecase.FindAllAPIKeys(c.Request.Context())
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	response := make([]apiKeyResponse, 0, len(*apiKeys))
+	for _, apiKey := range *apiKeys {
+		response = append(response, h.newAPIKeyResponse(&apiKey))
+	}
+	httpresponse.Success(c, response)
+}
@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/pkg/testhelper"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAPIKeyHandler_FindAllAPIKeys(t *testing.T) {
+	apiKeyID := uuid.New()
+	lastUsedAt := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	revokedAt := time.Date(2025, 1, 3, 3, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful retrieval",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					FindAllAPIKeys(gomock.Any()).
+					Return(&entity.APIKeys{
+						{
+							ID:         apiKeyID,
+							Name:       "Partner",
+							Prefix:     "a1b2c3d4e5f6",
+							KeyHash:    "hash",
+							Scopes:     []entity.APIKeyScope{entity.APIKeyScopeAdmin},
+							LastUsedAt: &lastUsedAt,
+							RevokedAt:  &revokedAt,
+							CreatedAt:  createdAt,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{
+					map[string]interface{}{
+						"id":           apiKeyID.String(),
+						"name":         "Partner",
+						"prefix":       "a1b2c3d4e5f6",
+						"scopes":       []interface{}{"admin"},
+						"last_used_at": "2025-01-02T10:00:00+07:00",
+						"revoked_at":   "2025-01-03T10:00:00+07:00",
+						"created_at":   "2025-01-01T10:00:00+07:00",
+					},
+				},
+			},
+		},
+		{
+			name: "no api keys",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					FindAllAPIKeys(gomock.Any()).
+					Return(&entity.APIKeys{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{},
+			},
+		},
+		{
+			name: "usecase internal error",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					FindAllAPIKeys(gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/admin/api-keys").
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.apiKeyHandler.FindAllAPIKeys(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
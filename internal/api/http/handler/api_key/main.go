@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type APIKeyHandler interface {
+	CreateAPIKey(c *gin.Context)
+	FindAllAPIKeys(c *gin.Context)
+	RotateAPIKey(c *gin.Context)
+	RevokeAPIKey(c *gin.Context)
+}
+
+type apiKeyHandler struct {
+	appConfig     config.AppConfig
+	apiKeyUsecase apiKeyUsecase.APIKeyUsecase
+}
+
+func NewAPIKeyHandler(appConfig config.AppConfig, apiKeyUsecase apiKeyUsecase.APIKeyUsecase) APIKeyHandler {
+	return &apiKeyHandler{
+		appConfig:     appConfig,
+		apiKeyUsecase: apiKeyUsecase,
+	}
+}
+
+type apiKeyResponse struct {
+	ID         string   `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name       string   `json:"name" example:"Partner"`
+	Prefix     string   `json:"prefix" example:"a1b2c3d4e5f6"`
+	Scopes     []string `json:"scopes" example:"concerts:read,reservations:write"`
+	ExpiresAt  *string  `json:"expires_at,omitempty" example:"2026-01-01T00:00:00+07:00"`
+	LastUsedAt *string  `json:"last_used_at,omitempty" example:"2025-01-02T10:00:00+07:00"`
+	RevokedAt  *string  `json:"revoked_at,omitempty" example:"2025-01-03T10:00:00+07:00"`
+	CreatedAt  string   `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+// issuedAPIKeyResponse is returned when a key is created or rotated, it is the only time the key can be read
+type issuedAPIKeyResponse struct {
+	apiKeyResponse
+	Key string `json:"key" example:"trk_a1b2c3d4e5f6_Zm9vYmFyYmF6cXV4cXV1eGNvcmdlZ3JhdWx0Z2FycGx5"`
+}
+
+func (h *apiKeyHandler) newAPIKeyResponse(apiKey *entity.APIKey) apiKeyResponse {
+	if apiKey == nil {
+		return apiKeyResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := apiKeyResponse{
+		ID:        apiKey.ID.String(),
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Scopes:    make([]string, 0, len(apiKey.Scopes)),
+		CreatedAt: apiKey.CreatedAt.In(loc).Format(time.RFC3339),
+	}
+	for _, scope := range apiKey.Scopes {
+		response.Scopes = append(response.Scopes, scope.String())
+	}
+	if apiKey.ExpiresAt != nil {
+		response.ExpiresAt = pointer.ToPointer(apiKey.ExpiresAt.In(loc).Format(time.RFC3339))
+	}
+	if apiKey.LastUsedAt != nil {
+		response.LastUsedAt = pointer.ToPointer(apiKey.LastUsedAt.In(loc).Format(time.RFC3339))
+	}
+	if apiKey.RevokedAt != nil {
+		response.RevokedAt = pointer.ToPointer(apiKey.RevokedAt.In(loc).Format(time.RFC3339))
+	}
+	return response
+}
+
+func (h *apiKeyHandler) newIssuedAPIKeyResponse(issued *apiKeyUsecase.IssuedAPIKey) issuedAPIKeyResponse {
+	return issuedAPIKeyResponse{
+		apiKeyResponse: h.newAPIKeyResponse(issued.APIKey),
+		Key:            issued.Key,
+	}
+}
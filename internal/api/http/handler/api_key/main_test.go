@@ -0,0 +1,58 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/api_key"
+	"ticket-reservation/internal/config"
+	api_key_mocks "ticket-reservation/internal/usecase/api_key/mocks"
+)
+
+type testHelper struct {
+	ctrl              *gomock.Controller
+	appConfig         config.AppConfig
+	mockAPIKeyUsecase *api_key_mocks.MockAPIKeyUsecase
+	apiKeyHandler     handler.APIKeyHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+
+	mockAPIKeyUsecase := api_key_mocks.NewMockAPIKeyUsecase(ctrl)
+
+	apiKeyHandler := handler.NewAPIKeyHandler(appConfig, mockAPIKeyUsecase)
+
+	return &testHelper{
+		ctrl:              ctrl,
+		appConfig:         appConfig,
+		mockAPIKeyUsecase: mockAPIKeyUsecase,
+		apiKeyHandler:     apiKeyHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewAPIKeyHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+	mockAPIKeyUsecase := api_key_mocks.NewMockAPIKeyUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewAPIKeyHandler(appConfig, mockAPIKeyUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
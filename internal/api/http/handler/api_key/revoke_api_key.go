@@ -0,0 +1,33 @@
+package handler
+
+import (
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Revoke API Key
+// @Description	Stops an API key from authenticating for good, revoking a revoked key returns it unchanged
+// @Tags			Admin
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id	path		string															true	"API key ID"
+// @Success		200	{object}	httpresponse.SuccessResponse{data=apiKeyResponse,metadata=nil}	"API key revoked"
+// @Failure		400	{object}	httpresponse.ErrorResponse{data=nil}							"Bad request"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}							"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}							"Forbidden - The user is not an admin"
+// @Failure		404	{object}	httpresponse.ErrorResponse{data=nil}							"API key not found"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}							"Internal server error"
+// @Router			/admin/api-keys/{id} [delete]
+func (h *apiKeyHandler) RevokeAPIKey(c *gin.Context) {
+	apiKey, err := h.apiKeyUsecase.RevokeAPIKey(c.Request.Context(), apiKeyUsecase.RevokeAPIKeyInput{
+		ID: c.Param("id"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newAPIKeyResponse(apiKey))
+}
@@ -0,0 +1,131 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAPIKeyHandler_RevokeAPIKey(t *testing.T) {
+	apiKeyID := uuid.New()
+	revokedAt := time.Date(2025, 1, 3, 3, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		apiKeyID         string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:     "successful revocation",
+			apiKeyID: apiKeyID.String(),
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					RevokeAPIKey(gomock.Any(), apiKeyUsecase.RevokeAPIKeyInput{ID: apiKeyID.String()}).
+					Return(&entity.APIKey{
+						ID:        apiKeyID,
+						Name:      "Partner",
+						Prefix:    "a1b2c3d4e5f6",
+						Scopes:    []entity.APIKeyScope{entity.APIKeyScopeConcertsRead},
+						RevokedAt: &revokedAt,
+						CreatedAt: createdAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":         apiKeyID.String(),
+					"name":       "Partner",
+					"prefix":     "a1b2c3d4e5f6",
+					"scopes":     []interface{}{"concerts:read"},
+					"revoked_at": "2025-01-03T10:00:00+07:00",
+					"created_at": "2025-01-01T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name:     "invalid api key ID",
+			apiKeyID: "not-a-uuid",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					RevokeAPIKey(gomock.Any(), apiKeyUsecase.RevokeAPIKeyInput{ID: "not-a-uuid"}).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name:     "api key not found",
+			apiKeyID: apiKeyID.String(),
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					RevokeAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("api key not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "api key not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodDelete).
+				Path("/admin/api-keys/"+tt.apiKeyID).
+				Param("id", tt.apiKeyID).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.apiKeyHandler.RevokeAPIKey(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
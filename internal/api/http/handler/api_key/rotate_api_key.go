@@ -0,0 +1,34 @@
+package handler
+
+import (
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		Rotate API Key
+// @Description	Replaces an active API key with a new key that keeps its name, scopes and expiry. The previous key stops working at once, the new key is only returned in this response
+// @Tags			Admin
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id	path		string																true	"API key ID"
+// @Success		200	{object}	httpresponse.SuccessResponse{data=issuedAPIKeyResponse,metadata=nil}	"API key rotated"
+// @Failure		400	{object}	httpresponse.ErrorResponse{data=nil}									"Bad request"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - The user is not an admin"
+// @Failure		404	{object}	httpresponse.ErrorResponse{data=nil}									"API key not found"
+// @Failure		409	{object}	httpresponse.ErrorResponse{data=nil}									"Conflict - API key revoked or expired"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}									"Internal server error"
+// @Router			/admin/api-keys/{id}/rotate [post]
+func (h *apiKeyHandler) RotateAPIKey(c *gin.Context) {
+	issued, err := h.apiKeyUsecase.RotateAPIKey(c.Request.Context(), apiKeyUsecase.RotateAPIKeyInput{
+		ID: c.Param("id"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newIssuedAPIKeyResponse(issued))
+}
@@ -0,0 +1,128 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAPIKeyHandler_RotateAPIKey(t *testing.T) {
+	apiKeyID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful rotation",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					RotateAPIKey(gomock.Any(), apiKeyUsecase.RotateAPIKeyInput{ID: apiKeyID.String()}).
+					Return(&apiKeyUsecase.IssuedAPIKey{
+						APIKey: &entity.APIKey{
+							ID:        apiKeyID,
+							Name:      "Partner",
+							Prefix:    "f6e5d4c3b2a1",
+							Scopes:    []entity.APIKeyScope{entity.APIKeyScopeReservationsWrite},
+							CreatedAt: createdAt,
+						},
+						Key: "trk_f6e5d4c3b2a1_secret",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":         apiKeyID.String(),
+					"name":       "Partner",
+					"prefix":     "f6e5d4c3b2a1",
+					"scopes":     []interface{}{"reservations:write"},
+					"created_at": "2025-01-01T10:00:00+07:00",
+					"key":        "trk_f6e5d4c3b2a1_secret",
+				},
+			},
+		},
+		{
+			name: "api key not found",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					RotateAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("api key not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "api key not found",
+			},
+		},
+		{
+			name: "api key revoked",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyUsecase.EXPECT().
+					RotateAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("the api key has been revoked or has expired", nil))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "the api key has been revoked or has expired",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/admin/api-keys/"+apiKeyID.String()+"/rotate").
+				Param("id", apiKeyID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.apiKeyHandler.RotateAPIKey(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required" example:"fan@example.com"`
+	Password string `json:"password" binding:"required" example:"correct-horse-battery"`
+}
+
+// @Summary		Sign In
+// @Description	Signs a user in with its email and password. The access token is sent as "Authorization: Bearer <token>" on reservation endpoints, the refresh token exchanges for a new pair once the access token expires
+// @Tags			Auth
+// @Accept			json
+// @Produce		json
+// @Param			request	body		LoginRequest												true	"Credentials"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=AuthResponse,metadata=nil}	"Signed in"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}						"Bad Request - Invalid input"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}						"Unauthorized - Invalid email or password"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}					"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}						"Internal Server Error - Unexpected error occurred"
+// @Router			/auth/login [post]
+func (h *authHandler) Login(c *gin.Context) {
+	var request LoginRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	result, err := h.authUsecase.Login(c.Request.Context(), authUsecase.LoginInput{
+		Email:    request.Email,
+		Password: request.Password,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newAuthResponse(result))
+}
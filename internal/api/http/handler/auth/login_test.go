@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/auth"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAuthHandler_Login(t *testing.T) {
+	userID := uuid.New()
+
+	validRequest := handler.LoginRequest{
+		Email:    "fan@example.com",
+		Password: "correct-horse",
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful login",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					Login(gomock.Any(), authUsecase.LoginInput{
+						Email:    "fan@example.com",
+						Password: "correct-horse",
+					}).
+					Return(newTestAuthResult(userID), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": expectedAuthData(userID),
+			},
+		},
+		{
+			name:           "missing email",
+			requestBody:    map[string]interface{}{"password": "correct-horse"},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "invalid credentials",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					Login(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewUnauthorizedError("invalid email or password", nil))
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-901000",
+				"message": "invalid email or password",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					Login(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with request body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/auth/login").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.authHandler.Login(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
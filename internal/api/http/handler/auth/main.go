@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuthHandler interface {
+	Register(c *gin.Context)
+	Login(c *gin.Context)
+	Refresh(c *gin.Context)
+	UpdateUserRole(c *gin.Context)
+}
+
+type authHandler struct {
+	appConfig   config.AppConfig
+	authUsecase authUsecase.AuthUsecase
+}
+
+func NewAuthHandler(appConfig config.AppConfig, authUsecase authUsecase.AuthUsecase) AuthHandler {
+	return &authHandler{
+		appConfig:   appConfig,
+		authUsecase: authUsecase,
+	}
+}
@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/auth"
+	"ticket-reservation/internal/config"
+	auth_mocks "ticket-reservation/internal/usecase/auth/mocks"
+)
+
+type testHelper struct {
+	ctrl            *gomock.Controller
+	appConfig       config.AppConfig
+	mockAuthUsecase *auth_mocks.MockAuthUsecase
+	authHandler     handler.AuthHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockAuthUsecase := auth_mocks.NewMockAuthUsecase(ctrl)
+
+	authHandler := handler.NewAuthHandler(appConfig, mockAuthUsecase)
+
+	return &testHelper{
+		ctrl:            ctrl,
+		appConfig:       appConfig,
+		mockAuthUsecase: mockAuthUsecase,
+		authHandler:     authHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewAuthHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockAuthUsecase := auth_mocks.NewMockAuthUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewAuthHandler(appConfig, mockAuthUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
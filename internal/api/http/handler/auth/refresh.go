@@ -0,0 +1,44 @@
+package handler
+
+import (
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// @Summary		Refresh Tokens
+// @Description	Issues a new pair of access and refresh tokens in exchange of a refresh token that has not expired
+// @Tags			Auth
+// @Accept			json
+// @Produce		json
+// @Param			request	body		RefreshRequest												true	"Refresh token"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=AuthResponse,metadata=nil}	"Tokens refreshed"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}						"Bad Request - Invalid input"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}						"Unauthorized - Invalid or expired refresh token"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}					"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}						"Internal Server Error - Unexpected error occurred"
+// @Router			/auth/refresh [post]
+func (h *authHandler) Refresh(c *gin.Context) {
+	var request RefreshRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	result, err := h.authUsecase.Refresh(c.Request.Context(), authUsecase.RefreshInput{
+		RefreshToken: request.RefreshToken,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newAuthResponse(result))
+}
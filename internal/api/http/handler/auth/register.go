@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required" example:"fan@example.com"`
+	Password string `json:"password" binding:"required" example:"correct-horse-battery"`
+}
+
+type AuthResponse struct {
+	UserID                string `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Email                 string `json:"email" example:"fan@example.com"`
+	Role                  string `json:"role" example:"customer"`
+	TokenType             string `json:"token_type" example:"Bearer"`
+	AccessToken           string `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	AccessTokenExpiresAt  string `json:"access_token_expires_at" example:"2025-01-01T10:15:00+07:00"`
+	RefreshToken          string `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	RefreshTokenExpiresAt string `json:"refresh_token_expires_at" example:"2025-01-31T10:00:00+07:00"`
+}
+
+// @Summary		Register a User
+// @Description	Creates a user account and signs it in. The email is case-insensitive and registered only once, the password must be 8 to 72 characters long
+// @Tags			Auth
+// @Accept			json
+// @Produce		json
+// @Param			request	body		RegisterRequest												true	"Account to create"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=AuthResponse,metadata=nil}	"User registered and signed in"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}						"Bad Request - Invalid input"
+// @Failure		409		{object}	httpresponse.ErrorResponse{data=nil}						"Conflict - Email already registered"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}					"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}						"Internal Server Error - Unexpected error occurred"
+// @Router			/auth/register [post]
+func (h *authHandler) Register(c *gin.Context) {
+	var request RegisterRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	result, err := h.authUsecase.Register(c.Request.Context(), authUsecase.RegisterInput{
+		Email:    request.Email,
+		Password: request.Password,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newAuthResponse(result))
+}
+
+func (h *authHandler) newAuthResponse(result *authUsecase.AuthResult) AuthResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return AuthResponse{
+		UserID:                result.User.ID.String(),
+		Email:                 result.User.Email,
+		Role:                  result.User.Role.String(),
+		TokenType:             "Bearer",
+		AccessToken:           result.AccessToken,
+		AccessTokenExpiresAt:  result.AccessTokenExpiresAt.In(loc).Format(time.RFC3339),
+		RefreshToken:          result.RefreshToken,
+		RefreshTokenExpiresAt: result.RefreshTokenExpiresAt.In(loc).Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,160 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/auth"
+	"ticket-reservation/internal/domain/entity"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+// newTestAuthResult returns the tokens the mocked usecase issues to the user
+func newTestAuthResult(userID uuid.UUID) *authUsecase.AuthResult {
+	return &authUsecase.AuthResult{
+		User:                  &entity.User{ID: userID, Email: "fan@example.com", Role: entity.UserRoleCustomer},
+		AccessToken:           "access-token",
+		AccessTokenExpiresAt:  time.Date(2025, 1, 1, 3, 15, 0, 0, time.UTC),
+		RefreshToken:          "refresh-token",
+		RefreshTokenExpiresAt: time.Date(2025, 1, 31, 3, 0, 0, 0, time.UTC),
+	}
+}
+
+// expectedAuthData is the response data of newTestAuthResult
+func expectedAuthData(userID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":                  userID.String(),
+		"email":                    "fan@example.com",
+		"role":                     "customer",
+		"token_type":               "Bearer",
+		"access_token":             "access-token",
+		"access_token_expires_at":  "2025-01-01T10:15:00+07:00",
+		"refresh_token":            "refresh-token",
+		"refresh_token_expires_at": "2025-01-31T10:00:00+07:00",
+	}
+}
+
+func TestAuthHandler_Register(t *testing.T) {
+	userID := uuid.New()
+
+	validRequest := handler.RegisterRequest{
+		Email:    "fan@example.com",
+		Password: "correct-horse",
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful registration",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					Register(gomock.Any(), authUsecase.RegisterInput{
+						Email:    "fan@example.com",
+						Password: "correct-horse",
+					}).
+					Return(newTestAuthResult(userID), nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": expectedAuthData(userID),
+			},
+		},
+		{
+			name:           "missing password",
+			requestBody:    map[string]interface{}{"email": "fan@example.com"},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "email already registered",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("email already registered", nil))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "email already registered",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with request body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/auth/register").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.authHandler.Register(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required" example:"organizer"`
+}
+
+type UserResponse struct {
+	UserID    string `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Email     string `json:"email" example:"organizer@example.com"`
+	Role      string `json:"role" example:"organizer"`
+	CreatedAt string `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+	UpdatedAt string `json:"updated_at" example:"2025-01-02T10:00:00+07:00"`
+}
+
+// @Summary		Update the Role of a User
+// @Description	Sets the role of a user (admin only): admin, organizer, box_office or customer. Tokens already issued keep the previous role until they are refreshed
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string														true	"User ID"
+// @Param			request	body		UpdateUserRoleRequest										true	"New role"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=UserResponse,metadata=nil}	"Role updated"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}						"Bad Request - Invalid input"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}						"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}						"Forbidden - The user is not an admin"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}						"User not found"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}						"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/admin/users/{id}/role [put]
+func (h *authHandler) UpdateUserRole(c *gin.Context) {
+	var request UpdateUserRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	user, err := h.authUsecase.UpdateUserRole(c.Request.Context(), authUsecase.UpdateUserRoleInput{
+		UserID: c.Param("id"),
+		Role:   request.Role,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newUserResponse(user))
+}
+
+func (h *authHandler) newUserResponse(user *entity.User) UserResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return UserResponse{
+		UserID:    user.ID.String(),
+		Email:     user.Email,
+		Role:      user.Role.String(),
+		CreatedAt: user.CreatedAt.In(loc).Format(time.RFC3339),
+		UpdatedAt: user.UpdatedAt.In(loc).Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,162 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/auth"
+	"ticket-reservation/internal/domain/entity"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAuthHandler_UpdateUserRole(t *testing.T) {
+	userID := uuid.New()
+	user := &entity.User{
+		ID:        userID,
+		Email:     "organizer@example.com",
+		Role:      entity.UserRoleOrganizer,
+		CreatedAt: time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC),
+	}
+
+	validRequest := handler.UpdateUserRoleRequest{
+		Role: "organizer",
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful role update",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					UpdateUserRole(gomock.Any(), authUsecase.UpdateUserRoleInput{
+						UserID: userID.String(),
+						Role:   "organizer",
+					}).
+					Return(user, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"user_id":    userID.String(),
+					"email":      "organizer@example.com",
+					"role":       "organizer",
+					"created_at": "2025-01-01T10:00:00+07:00",
+					"updated_at": "2025-01-02T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name:           "missing role",
+			requestBody:    map[string]interface{}{},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "unknown role",
+			requestBody: handler.UpdateUserRoleRequest{Role: "superuser"},
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name:        "user not found",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("user not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "user not found",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockAuthUsecase.EXPECT().
+					UpdateUserRole(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with request body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPut).
+				Path("/admin/users/"+userID.String()+"/role").
+				Param("id", userID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.authHandler.UpdateUserRole(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type CheckInRequest struct {
+	Payload string `json:"payload" binding:"required" example:"eyJ0aWQiOiIxMjNlNDU2Ny1lODliLTEyZDMtYTQ1Ni00MjY2MTQxNzQwMDAifQ.c2lnbmF0dXJl"`
+	Gate    string `json:"gate" binding:"required" example:"A"`
+}
+
+type CheckInResponse struct {
+	TicketID   string `json:"ticket_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConcertID  string `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ZoneName   string `json:"zone_name" example:"VIP"`
+	SeatNumber string `json:"seat_number" example:"A1"`
+	Holder     string `json:"holder" example:"2bb80d537b1da3e3"`
+	Gate       string `json:"gate" example:"A"`
+	ScannerID  string `json:"scanner_id" example:"gate-a-1"`
+	AdmittedAt string `json:"admitted_at" example:"2025-01-01T18:05:00+07:00"`
+}
+
+// @Summary		Check In a Ticket
+// @Description	Admits the holder of a ticket scanned at a gate. The payload must be signed by the ticket signing key and belong to a confirmed reservation of a concert taking place today; a ticket is admitted only once, a second scan is refused with the time and gate of the first admission
+// @Tags			Check-in
+// @Accept			json
+// @Produce		json
+// @Param			X-Scanner-Key	header		string																true	"API key of the scanner device"
+// @Param			request			body		CheckInRequest														true	"Scanned ticket"
+// @Success		201				{object}	httpresponse.SuccessResponse{data=CheckInResponse,metadata=nil}	"Ticket holder admitted"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}								"Bad Request - Invalid input"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Unknown scanner key"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}								"Ticket not found"
+// @Failure		409				{object}	httpresponse.ErrorResponse{data=object}								"Conflict - Ticket already admitted"
+// @Failure		422				{object}	httpresponse.ErrorResponse{data=object}								"Unprocessable Entity - Invalid signature, ticket not for today's concert or cancelled"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}								"Internal Server Error - Unexpected error occurred"
+// @Router			/checkin [post]
+func (h *checkinHandler) CheckIn(c *gin.Context) {
+	var request CheckInRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	result, err := h.checkinUsecase.CheckIn(c.Request.Context(), checkinUsecase.CheckInInput{
+		Payload:   request.Payload,
+		Gate:      request.Gate,
+		ScannerID: c.GetString(middleware.ScannerIDContextKey),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, CheckInResponse{
+		TicketID:   result.Ticket.ID.String(),
+		ConcertID:  result.Ticket.ConcertID.String(),
+		ZoneName:   result.Ticket.ZoneName,
+		SeatNumber: result.Ticket.SeatNumber,
+		Holder:     result.Ticket.Holder,
+		Gate:       result.Checkin.Gate,
+		ScannerID:  result.Checkin.ScannerID,
+		AdmittedAt: result.Checkin.AdmittedAt.In(loc).Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,187 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/checkin"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestCheckinHandler_CheckIn(t *testing.T) {
+	ticketID := uuid.New()
+	concertID := uuid.New()
+	admittedAt := time.Date(2025, 1, 1, 11, 5, 0, 0, time.UTC)
+
+	validRequest := handler.CheckInRequest{
+		Payload: "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+		Gate:    "A",
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful admission",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					CheckIn(gomock.Any(), checkinUsecase.CheckInInput{
+						Payload:   "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+						Gate:      "A",
+						ScannerID: "gate-a-1",
+					}).
+					Return(&checkinUsecase.CheckInResult{
+						Ticket: &entity.Ticket{
+							ID:         ticketID,
+							ConcertID:  concertID,
+							ZoneName:   "VIP",
+							SeatNumber: "A1",
+							Holder:     "2bb80d537b1da3e3",
+						},
+						Checkin: &entity.TicketCheckin{
+							ID:         uuid.New(),
+							TicketID:   ticketID,
+							ConcertID:  concertID,
+							Gate:       "A",
+							ScannerID:  "gate-a-1",
+							AdmittedAt: admittedAt,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"ticket_id":   ticketID.String(),
+					"concert_id":  concertID.String(),
+					"zone_name":   "VIP",
+					"seat_number": "A1",
+					"holder":      "2bb80d537b1da3e3",
+					"gate":        "A",
+					"scanner_id":  "gate-a-1",
+					"admitted_at": "2025-01-01T18:05:00+07:00",
+				},
+			},
+		},
+		{
+			name:           "missing gate",
+			requestBody:    map[string]interface{}{"payload": "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl"},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "invalid ticket",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					CheckIn(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewUnprocessableEntityError("the ticket is not valid", nil))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-404000",
+				"message": "the ticket is not valid",
+			},
+		},
+		{
+			name:        "ticket already admitted",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					CheckIn(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("already admitted at 18:05 at gate B", map[string]string{
+						"admitted_at": "2025-01-01T18:05:00+07:00",
+						"gate":        "B",
+					}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "already admitted at 18:05 at gate B",
+				"data": map[string]interface{}{
+					"admitted_at": "2025-01-01T18:05:00+07:00",
+					"gate":        "B",
+				},
+			},
+		},
+		{
+			name:        "usecase internal error",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					CheckIn(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with request body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/checkin").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// Scanner authenticated by the ScannerAuth middleware
+			c.Set(middleware.ScannerIDContextKey, "gate-a-1")
+
+			// Execute the handler
+			h.checkinHandler.CheckIn(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/internal/util/httpresponse"
+	"ticket-reservation/pkg/signedtoken"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScannerBundleResponse struct {
+	ConcertID   string `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	GeneratedAt string `json:"generated_at" example:"2025-01-01T16:00:00+07:00"`
+	TicketCount int    `json:"ticket_count" example:"1500"`
+	// Ed25519 public key, base64 encoded, verifying the bundle and the ticket payloads
+	PublicKey string `json:"public_key" example:"O2onvM62pC1io6jQKm8Nc2UyFXcd4kOmOsBIoYtZ2ik="`
+	// Signed token of the bundle; its payload is JSON with the concert_id, the ticket_ids and generated_at in unix seconds
+	Bundle string `json:"bundle" example:"eyJjb25jZXJ0X2lkIjoiMTIzZTQ1NjctZTg5Yi0xMmQzLWE0NTYtNDI2NjE0MTc0MDAwIn0.c2lnbmF0dXJl"`
+}
+
+// @Summary		Export the Scanner Bundle of a Concert
+// @Description	Returns the valid tickets of a concert as a bundle signed with the ticket signing key, together with the public key, so that gate scanners validate tickets while offline. Tickets of cancelled reservations are left out
+// @Tags			Check-in
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id	path		string																	true	"Concert ID"
+// @Success		200	{object}	httpresponse.SuccessResponse{data=ScannerBundleResponse,metadata=nil}	"Signed scanner bundle"
+// @Failure		400	{object}	httpresponse.ErrorResponse{data=nil}									"Bad Request - Invalid input"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - The user is not an admin"
+// @Failure		404	{object}	httpresponse.ErrorResponse{data=nil}									"Concert not found"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Router			/admin/concerts/{id}/scanner-bundle [get]
+func (h *checkinHandler) ExportScannerBundle(c *gin.Context) {
+	result, err := h.checkinUsecase.ExportScannerBundle(c.Request.Context(), checkinUsecase.ExportScannerBundleInput{
+		ConcertID: c.Param("id"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	httpresponse.Success(c, ScannerBundleResponse{
+		ConcertID:   result.Bundle.ConcertID.String(),
+		GeneratedAt: time.Unix(result.Bundle.GeneratedAt, 0).In(loc).Format(time.RFC3339),
+		TicketCount: len(result.Bundle.TicketIDs),
+		PublicKey:   signedtoken.EncodePublicKey(result.PublicKey),
+		Bundle:      result.Token,
+	})
+}
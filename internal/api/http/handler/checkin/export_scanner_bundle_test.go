@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/pkg/signedtoken"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestCheckinHandler_ExportScannerBundle(t *testing.T) {
+	concertID := uuid.New()
+	publicKey := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+	generatedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful export",
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					ExportScannerBundle(gomock.Any(), checkinUsecase.ExportScannerBundleInput{ConcertID: concertID.String()}).
+					Return(&checkinUsecase.ScannerBundleResult{
+						Bundle: &entity.ScannerBundle{
+							ConcertID:   concertID,
+							TicketIDs:   []uuid.UUID{uuid.New(), uuid.New()},
+							GeneratedAt: generatedAt.Unix(),
+						},
+						Token:     "eyJjb25jZXJ0X2lkIjoiMSJ9.c2lnbmF0dXJl",
+						PublicKey: publicKey,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"concert_id":   concertID.String(),
+					"generated_at": "2025-01-01T16:00:00+07:00",
+					"ticket_count": float64(2),
+					"public_key":   signedtoken.EncodePublicKey(publicKey),
+					"bundle":       "eyJjb25jZXJ0X2lkIjoiMSJ9.c2lnbmF0dXJl",
+				},
+			},
+		},
+		{
+			name: "concert not found",
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					ExportScannerBundle(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "concert not found",
+			},
+		},
+		{
+			name: "usecase internal error",
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					ExportScannerBundle(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/admin/concerts/:id/scanner-bundle").
+				Param("id", concertID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.checkinHandler.ExportScannerBundle(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
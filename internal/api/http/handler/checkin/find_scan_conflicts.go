@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScanConflictResponse struct {
+	TicketID   string   `json:"ticket_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ZoneName   string   `json:"zone_name" example:"VIP"`
+	SeatNumber string   `json:"seat_number" example:"A1"`
+	ScannerIDs []string `json:"scanner_ids" example:"gate-a-1,gate-b-1"`
+	// Admission kept by the server, absent when none was recorded
+	Admission *ScanResponse  `json:"admission,omitempty"`
+	Scans     []ScanResponse `json:"scans"`
+}
+
+type ScanResponse struct {
+	Gate      string `json:"gate" example:"B"`
+	ScannerID string `json:"scanner_id" example:"gate-b-1"`
+	ScannedAt string `json:"scanned_at" example:"2025-01-01T18:05:00+07:00"`
+}
+
+// @Summary		Find the Scan Conflicts of a Concert
+// @Description	Reports the tickets of a concert scanned by more than one scanner, from the uploaded scan logs and the online check-ins, in the order of their first scan
+// @Tags			Check-in
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id	path		string																		true	"Concert ID"
+// @Success		200	{object}	httpresponse.SuccessResponse{data=[]ScanConflictResponse,metadata=nil}	"Conflict report"
+// @Failure		400	{object}	httpresponse.ErrorResponse{data=nil}										"Bad Request - Invalid input"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}										"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}										"Forbidden - The user is not an admin"
+// @Failure		404	{object}	httpresponse.ErrorResponse{data=nil}										"Concert not found"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}										"Internal Server Error - Unexpected error occurred"
+// @Router			/admin/concerts/{id}/scan-conflicts [get]
+func (h *checkinHandler) FindScanConflicts(c *gin.Context) {
+	conflicts, err := h.checkinUsecase.FindScanConflicts(c.Request.Context(), checkinUsecase.FindScanConflictsInput{
+		ConcertID: c.Param("id"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	response := make([]ScanConflictResponse, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		response = append(response, h.newScanConflictResponse(conflict))
+	}
+	httpresponse.Success(c, response)
+}
+
+func (h *checkinHandler) newScanConflictResponse(conflict entity.ScanConflict) ScanConflictResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := ScanConflictResponse{
+		TicketID:   conflict.Ticket.ID.String(),
+		ZoneName:   conflict.Ticket.ZoneName,
+		SeatNumber: conflict.Ticket.SeatNumber,
+		ScannerIDs: conflict.ScannerIDs(),
+		Scans:      make([]ScanResponse, 0, len(conflict.Scans)),
+	}
+	if conflict.Checkin != nil {
+		response.Admission = &ScanResponse{
+			Gate:      conflict.Checkin.Gate,
+			ScannerID: conflict.Checkin.ScannerID,
+			ScannedAt: conflict.Checkin.AdmittedAt.In(loc).Format(time.RFC3339),
+		}
+	}
+	for _, scan := range conflict.Scans {
+		response.Scans = append(response.Scans, ScanResponse{
+			Gate:      scan.Gate,
+			ScannerID: scan.ScannerID,
+			ScannedAt: scan.ScannedAt.In(loc).Format(time.RFC3339),
+		})
+	}
+	return response
+}
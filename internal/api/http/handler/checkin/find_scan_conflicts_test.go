@@ -0,0 +1,141 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestCheckinHandler_FindScanConflicts(t *testing.T) {
+	concertID := uuid.New()
+	ticketID := uuid.New()
+	admittedAt := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful retrieval",
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					FindScanConflicts(gomock.Any(), checkinUsecase.FindScanConflictsInput{ConcertID: concertID.String()}).
+					Return(entity.ScanConflicts{
+						{
+							Ticket:  entity.Ticket{ID: ticketID, ConcertID: concertID, ZoneName: "VIP", SeatNumber: "A1"},
+							Checkin: &entity.TicketCheckin{TicketID: ticketID, Gate: "A", ScannerID: "gate-a-1", AdmittedAt: admittedAt},
+							Scans: entity.TicketScans{
+								{TicketID: ticketID, Gate: "B", ScannerID: "gate-b-1", ScannedAt: admittedAt.Add(5 * time.Minute)},
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{
+					map[string]interface{}{
+						"ticket_id":   ticketID.String(),
+						"zone_name":   "VIP",
+						"seat_number": "A1",
+						"scanner_ids": []interface{}{"gate-a-1", "gate-b-1"},
+						"admission": map[string]interface{}{
+							"gate":       "A",
+							"scanner_id": "gate-a-1",
+							"scanned_at": "2025-01-01T18:00:00+07:00",
+						},
+						"scans": []interface{}{
+							map[string]interface{}{
+								"gate":       "B",
+								"scanner_id": "gate-b-1",
+								"scanned_at": "2025-01-01T18:05:00+07:00",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "concert not found",
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					FindScanConflicts(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "concert not found",
+			},
+		},
+		{
+			name: "usecase internal error",
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					FindScanConflicts(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/admin/concerts/:id/scan-conflicts").
+				Param("id", concertID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.checkinHandler.FindScanConflicts(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CheckinHandler interface {
+	CheckIn(c *gin.Context)
+	ExportScannerBundle(c *gin.Context)
+	UploadScans(c *gin.Context)
+	FindScanConflicts(c *gin.Context)
+}
+
+type checkinHandler struct {
+	appConfig      config.AppConfig
+	checkinUsecase checkinUsecase.CheckinUsecase
+}
+
+func NewCheckinHandler(appConfig config.AppConfig, checkinUsecase checkinUsecase.CheckinUsecase) CheckinHandler {
+	return &checkinHandler{
+		appConfig:      appConfig,
+		checkinUsecase: checkinUsecase,
+	}
+}
@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/checkin"
+	"ticket-reservation/internal/config"
+	checkin_mocks "ticket-reservation/internal/usecase/checkin/mocks"
+)
+
+type testHelper struct {
+	ctrl               *gomock.Controller
+	appConfig          config.AppConfig
+	mockCheckinUsecase *checkin_mocks.MockCheckinUsecase
+	checkinHandler     handler.CheckinHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockCheckinUsecase := checkin_mocks.NewMockCheckinUsecase(ctrl)
+
+	checkinHandler := handler.NewCheckinHandler(appConfig, mockCheckinUsecase)
+
+	return &testHelper{
+		ctrl:               ctrl,
+		appConfig:          appConfig,
+		mockCheckinUsecase: mockCheckinUsecase,
+		checkinHandler:     checkinHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewCheckinHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockCheckinUsecase := checkin_mocks.NewMockCheckinUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewCheckinHandler(appConfig, mockCheckinUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
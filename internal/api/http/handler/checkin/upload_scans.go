@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"ticket-reservation/internal/api/http/middleware"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type UploadScansRequest struct {
+	Scans []ScanRequest `json:"scans" binding:"required"`
+}
+
+type ScanRequest struct {
+	TicketID  string    `json:"ticket_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Gate      string    `json:"gate" binding:"required" example:"B"`
+	ScannedAt time.Time `json:"scanned_at" binding:"required" example:"2025-01-01T18:05:00+07:00"`
+}
+
+type UploadScansResponse struct {
+	Received int `json:"received" example:"120"`
+	Recorded int `json:"recorded" example:"118"` // Scans that were not uploaded before
+	Admitted int `json:"admitted" example:"115"`
+	// Tickets already admitted by another scanner, listed in the conflict report of the concert
+	ConflictTicketIDs []string `json:"conflict_ticket_ids" example:"123e4567-e89b-12d3-a456-426614174000"`
+	UnknownTicketIDs  []string `json:"unknown_ticket_ids" example:"123e4567-e89b-12d3-a456-426614174000"`
+}
+
+// @Summary		Upload a Scan Log
+// @Description	Merges the scans a gate scanner recorded while offline, in batches of at most 1000. A log can be uploaded again safely: each scan is recorded once, a ticket not admitted yet is admitted at its earliest scan, and tickets already admitted by another scanner are returned as conflicts
+// @Tags			Check-in
+// @Accept			json
+// @Produce		json
+// @Param			X-Scanner-Key	header		string																true	"API key of the scanner device"
+// @Param			request			body		UploadScansRequest													true	"Scan log"
+// @Success		200				{object}	httpresponse.SuccessResponse{data=UploadScansResponse,metadata=nil}	"Scan log merged"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}								"Bad Request - Invalid input"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Unknown scanner key"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}								"Internal Server Error - Unexpected error occurred"
+// @Router			/checkin/scans [post]
+func (h *checkinHandler) UploadScans(c *gin.Context) {
+	var request UploadScansRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	scans := make([]checkinUsecase.ScanInput, 0, len(request.Scans))
+	for _, scan := range request.Scans {
+		scans = append(scans, checkinUsecase.ScanInput{
+			TicketID:  scan.TicketID,
+			Gate:      scan.Gate,
+			ScannedAt: scan.ScannedAt,
+		})
+	}
+
+	result, err := h.checkinUsecase.UploadScans(c.Request.Context(), checkinUsecase.UploadScansInput{
+		ScannerID: c.GetString(middleware.ScannerIDContextKey),
+		Scans:     scans,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, UploadScansResponse{
+		Received:          result.Received,
+		Recorded:          result.Recorded,
+		Admitted:          result.Admitted,
+		ConflictTicketIDs: uuidStrings(result.ConflictTicketIDs),
+		UnknownTicketIDs:  uuidStrings(result.UnknownTicketIDs),
+	})
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	return values
+}
@@ -0,0 +1,155 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestCheckinHandler_UploadScans(t *testing.T) {
+	ticketID := uuid.New()
+	conflictTicketID := uuid.New()
+	scannedAt := time.Date(2025, 1, 1, 18, 5, 0, 0, time.FixedZone("ICT", 7*60*60))
+
+	validRequest := map[string]interface{}{
+		"scans": []map[string]interface{}{
+			{"ticket_id": ticketID.String(), "gate": "B", "scanned_at": "2025-01-01T18:05:00+07:00"},
+			{"ticket_id": conflictTicketID.String(), "gate": "B", "scanned_at": "2025-01-01T18:05:00+07:00"},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful upload",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					UploadScans(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input checkinUsecase.UploadScansInput) (*checkinUsecase.UploadScansResult, error) {
+						assert.Equal(t, "gate-b-1", input.ScannerID)
+						require.Len(t, input.Scans, 2)
+						assert.Equal(t, ticketID.String(), input.Scans[0].TicketID)
+						assert.Equal(t, "B", input.Scans[0].Gate)
+						assert.True(t, scannedAt.Equal(input.Scans[0].ScannedAt))
+						return &checkinUsecase.UploadScansResult{
+							Received:          2,
+							Recorded:          2,
+							Admitted:          1,
+							ConflictTicketIDs: []uuid.UUID{conflictTicketID},
+						}, nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"received":            float64(2),
+					"recorded":            float64(2),
+					"admitted":            float64(1),
+					"conflict_ticket_ids": []interface{}{conflictTicketID.String()},
+					"unknown_ticket_ids":  []interface{}{},
+				},
+			},
+		},
+		{
+			name:           "missing scans",
+			requestBody:    map[string]interface{}{},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "invalid scan log",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					UploadScans(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			requestBody: validRequest,
+			setupMocks: func(h *testHelper) {
+				h.mockCheckinUsecase.EXPECT().
+					UploadScans(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with request body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/checkin/scans").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// Scanner authenticated by the ScannerAuth middleware
+			c.Set(middleware.ScannerIDContextKey, "gate-b-1")
+
+			// Execute the handler
+			h.checkinHandler.UploadScans(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
 	"ticket-reservation/internal/domain/entity"
 	concertUsecase "ticket-reservation/internal/usecase/concert"
 	"ticket-reservation/internal/util/httpresponse"
@@ -25,16 +26,26 @@ type createConcertResponse struct {
 }
 
 // @Summary		Create Concert
-// @Description	Create a new concert
+// @Description	Create a new concert (admin or organizer), a concert created by an organizer is owned by them
 // @Tags			Concert
 // @Accept			json
 // @Produce		json
 // @Param			request	body		createConcertRequest													true	"Concert creation input"
 // @Success		201		{object}	httpresponse.SuccessResponse{data=createConcertResponse,metadata=nil}	"Concert created"
 // @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}									"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - Only admins and organizers can create concerts"
 // @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal server error"
+// @Security		ApiKeyAuth
 // @Router			/concerts [post]
 func (h *concertHandler) CreateConcert(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
 	var input createConcertRequest
 	if err := c.ShouldBindJSON(&input); err != nil {
 		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
@@ -43,9 +54,10 @@ func (h *concertHandler) CreateConcert(c *gin.Context) {
 	}
 
 	createdConcert, err := h.concertUsecase.CreateConcert(c.Request.Context(), concertUsecase.CreateConcertInput{
-		Name:  input.Name,
-		Venue: input.Venue,
-		Date:  input.Date,
+		Name:      input.Name,
+		Venue:     input.Venue,
+		Date:      input.Date,
+		Principal: principal,
 	})
 	if err != nil {
 		httpresponse.Error(c, err)
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"ticket-reservation/internal/api/http/middleware"
 	"ticket-reservation/internal/domain/entity"
 	concertUsecase "ticket-reservation/internal/usecase/concert"
 	"ticket-reservation/pkg/testhelper"
@@ -39,8 +40,11 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 		"date":  "2025-12-25T20:00:00+07:00",
 	}
 
+	principal := &entity.Principal{UserID: uuid.New(), Email: "organizer@example.com", Role: entity.UserRoleOrganizer}
+
 	tests := []struct {
 		name             string
+		principal        *entity.Principal
 		requestBody      interface{}
 		setupMocks       func(h *testHelper)
 		expectedStatus   int
@@ -48,6 +52,7 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 	}{
 		{
 			name:        "successful concert creation",
+			principal:   principal,
 			requestBody: validRequestBody,
 			setupMocks: func(h *testHelper) {
 				h.mockConcertUsecase.EXPECT().
@@ -57,6 +62,7 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 						assert.Equal(t, "New Year Concert 2025", input.Name)
 						assert.Equal(t, "Bangkok Arena", input.Venue)
 						assert.Equal(t, time.Date(2025, 12, 25, 20, 0, 0, 0, bangkokTime).UTC(), input.Date.UTC())
+						assert.Equal(t, principal, input.Principal)
 						return expectedConcert, nil
 					})
 			},
@@ -72,7 +78,8 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid JSON body - missing required fields",
+			name:      "invalid JSON body - missing required fields",
+			principal: principal,
 			requestBody: map[string]interface{}{
 				"name": "Concert Without Venue",
 				// Missing venue and date
@@ -87,7 +94,8 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid JSON body - malformed date",
+			name:      "invalid JSON body - malformed date",
+			principal: principal,
 			requestBody: map[string]interface{}{
 				"name":  "New Year Concert 2025",
 				"venue": "Bangkok Arena",
@@ -104,6 +112,7 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 		},
 		{
 			name:        "usecase validation error",
+			principal:   principal,
 			requestBody: validRequestBody,
 			setupMocks: func(h *testHelper) {
 				h.mockConcertUsecase.EXPECT().
@@ -118,6 +127,7 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 		},
 		{
 			name:        "usecase internal error",
+			principal:   principal,
 			requestBody: validRequestBody,
 			setupMocks: func(h *testHelper) {
 				h.mockConcertUsecase.EXPECT().
@@ -130,6 +140,33 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 				"message": "An unexpected error occurred. Please try again later.",
 			},
 		},
+		{
+			name:        "not authenticated",
+			principal:   nil,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected without a principal
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:        "concert managed by another organizer",
+			principal:   principal,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertUsecase.EXPECT().
+					CreateConcert(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the concert is managed by another organizer", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the concert is managed by another organizer",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -151,6 +188,10 @@ func TestConcertHandler_CreateConcert(t *testing.T) {
 				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
 				MustBuild(t)
 
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
 			// Execute the handler
 			h.concertHandler.CreateConcert(c)
 
@@ -22,10 +22,11 @@ type FindAllConcertsQuery struct {
 }
 
 type findAllConcertsResponse struct {
-	ID    string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name  string `json:"name" example:"Concert Name"`
-	Venue string `json:"venue" example:"Concert Venue"`
-	Date  string `json:"date" example:"2025-01-01T10:00:00+07:00"`
+	ID                 string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name               string `json:"name" example:"Concert Name"`
+	Venue              string `json:"venue" example:"Concert Venue"`
+	Date               string `json:"date" example:"2025-01-01T10:00:00+07:00"`
+	WaitingRoomEnabled bool   `json:"waiting_room_enabled" example:"false"`
 }
 
 // @Summary		List Concerts
@@ -41,6 +42,7 @@ type findAllConcertsResponse struct {
 // @Param			sortOrder	query		string																									false	"Sort order (default: asc) (options: asc, desc)"
 // @Success		200			{object}	httpresponse.SuccessResponse{data=[]findAllConcertsResponse,metadata=httpresponse.PaginationMetadata}	"List of concerts with pagination details"
 // @Failure		400			{object}	httpresponse.ErrorResponse{data=nil}																	"Bad request"
+// @Failure		429			{object}	httpresponse.ErrorResponse{data=object}																"Too Many Requests - Rate limit exceeded, see Retry-After"
 // @Failure		500			{object}	httpresponse.ErrorResponse{data=nil}																	"Internal server error"
 // @Router			/concerts [get]
 func (h *concertHandler) FindAllConcerts(c *gin.Context) {
@@ -100,10 +102,11 @@ func (h *concertHandler) newFindAllConcertsResponse(concerts entity.Concerts) []
 	response := make([]findAllConcertsResponse, 0, len(concerts))
 	for _, concert := range concerts {
 		response = append(response, findAllConcertsResponse{
-			ID:    concert.ID.String(),
-			Name:  concert.Name,
-			Venue: concert.Venue,
-			Date:  concert.Date.In(loc).Format(time.RFC3339),
+			ID:                 concert.ID.String(),
+			Name:               concert.Name,
+			Venue:              concert.Venue,
+			Date:               concert.Date.In(loc).Format(time.RFC3339),
+			WaitingRoomEnabled: concert.WaitingRoomEnabled,
 		})
 	}
 	return response
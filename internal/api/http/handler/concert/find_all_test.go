@@ -90,16 +90,18 @@ func TestConcertHandler_FindAllConcerts(t *testing.T) {
 				"code": "ERR-200000",
 				"data": []interface{}{
 					map[string]interface{}{
-						"id":    concertID1.String(),
-						"name":  "Summer Festival 2025",
-						"venue": "Bangkok Arena",
-						"date":  "2025-06-15T19:00:00+07:00",
+						"id":                   concertID1.String(),
+						"name":                 "Summer Festival 2025",
+						"venue":                "Bangkok Arena",
+						"date":                 "2025-06-15T19:00:00+07:00",
+						"waiting_room_enabled": false,
 					},
 					map[string]interface{}{
-						"id":    concertID2.String(),
-						"name":  "Rock Concert 2025",
-						"venue": "Impact Arena",
-						"date":  "2025-08-20T20:00:00+07:00",
+						"id":                   concertID2.String(),
+						"name":                 "Rock Concert 2025",
+						"venue":                "Impact Arena",
+						"date":                 "2025-08-20T20:00:00+07:00",
+						"waiting_room_enabled": false,
 					},
 				},
 				"metadata": map[string]interface{}{
@@ -143,16 +145,18 @@ func TestConcertHandler_FindAllConcerts(t *testing.T) {
 				"code": "ERR-200000",
 				"data": []interface{}{
 					map[string]interface{}{
-						"id":    concertID1.String(),
-						"name":  "Summer Festival 2025",
-						"venue": "Bangkok Arena",
-						"date":  "2025-06-15T19:00:00+07:00",
+						"id":                   concertID1.String(),
+						"name":                 "Summer Festival 2025",
+						"venue":                "Bangkok Arena",
+						"date":                 "2025-06-15T19:00:00+07:00",
+						"waiting_room_enabled": false,
 					},
 					map[string]interface{}{
-						"id":    concertID2.String(),
-						"name":  "Rock Concert 2025",
-						"venue": "Impact Arena",
-						"date":  "2025-08-20T20:00:00+07:00",
+						"id":                   concertID2.String(),
+						"name":                 "Rock Concert 2025",
+						"venue":                "Impact Arena",
+						"date":                 "2025-08-20T20:00:00+07:00",
+						"waiting_room_enabled": false,
 					},
 				},
 				"metadata": map[string]interface{}{
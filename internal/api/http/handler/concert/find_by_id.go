@@ -11,10 +11,11 @@ import (
 )
 
 type findOneConcertResponse struct {
-	ID    string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Name  string `json:"name" example:"Concert Name"`
-	Venue string `json:"venue" example:"Concert Venue"`
-	Date  string `json:"date" example:"2025-01-01T10:00:00+07:00"`
+	ID                 string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name               string `json:"name" example:"Concert Name"`
+	Venue              string `json:"venue" example:"Concert Venue"`
+	Date               string `json:"date" example:"2025-01-01T10:00:00+07:00"`
+	WaitingRoomEnabled bool   `json:"waiting_room_enabled" example:"false"`
 }
 
 // @Summary		Find Concert by ID
@@ -25,6 +26,7 @@ type findOneConcertResponse struct {
 // @Success		200	{object}	httpresponse.SuccessResponse{data=findOneConcertResponse,metadata=nil}	"Concert found"
 // @Failure		400	{object}	httpresponse.ErrorResponse{data=nil}									"Bad request"
 // @Failure		404	{object}	httpresponse.ErrorResponse{data=nil}									"Concert not found"
+// @Failure		429	{object}	httpresponse.ErrorResponse{data=object}								"Too Many Requests - Rate limit exceeded, see Retry-After"
 // @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}									"Internal server error"
 // @Router			/concerts/{id} [get]
 func (h *concertHandler) FindConcertByID(c *gin.Context) {
@@ -47,9 +49,10 @@ func (h *concertHandler) newFindOneConcertResponse(concert *entity.Concert) find
 
 	loc, _ := time.LoadLocation(h.appConfig.Timezone)
 	return findOneConcertResponse{
-		ID:    concert.ID.String(),
-		Name:  concert.Name,
-		Venue: concert.Venue,
-		Date:  concert.Date.In(loc).Format(time.RFC3339),
+		ID:                 concert.ID.String(),
+		Name:               concert.Name,
+		Venue:              concert.Venue,
+		Date:               concert.Date.In(loc).Format(time.RFC3339),
+		WaitingRoomEnabled: concert.WaitingRoomEnabled,
 	}
 }
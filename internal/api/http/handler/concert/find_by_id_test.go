@@ -56,10 +56,11 @@ func TestConcertHandler_FindConcertByID(t *testing.T) {
 			expectedResponse: map[string]interface{}{
 				"code": "ERR-200000",
 				"data": map[string]interface{}{
-					"id":    concertID.String(),
-					"name":  "New Year Concert 2025",
-					"venue": "Bangkok Arena",
-					"date":  "2025-12-25T20:00:00+07:00",
+					"id":                   concertID.String(),
+					"name":                 "New Year Concert 2025",
+					"venue":                "Bangkok Arena",
+					"date":                 "2025-12-25T20:00:00+07:00",
+					"waiting_room_enabled": false,
 				},
 			},
 		},
@@ -11,6 +11,7 @@ type ConcertHandler interface {
 	CreateConcert(c *gin.Context)
 	FindConcertByID(c *gin.Context)
 	FindAllConcerts(c *gin.Context)
+	UpdateConcertWaitingRoom(c *gin.Context)
 }
 
 type concertHandler struct {
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	concertUsecase "ticket-reservation/internal/usecase/concert"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type updateConcertWaitingRoomRequest struct {
+	Enabled *bool `json:"enabled" example:"true"`
+}
+
+type updateConcertWaitingRoomResponse struct {
+	ID                 string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name               string `json:"name" example:"Concert Name"`
+	Venue              string `json:"venue" example:"Concert Venue"`
+	Date               string `json:"date" example:"2025-01-01T10:00:00+07:00"`
+	WaitingRoomEnabled bool   `json:"waiting_room_enabled" example:"true"`
+}
+
+// @Summary		Update Concert Waiting Room
+// @Description	Switch the waiting room of a concert on or off (admin, or the organizer who owns the concert). While it is on, buyers must be admitted from the waiting room before reserving seats
+// @Tags			Concert
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string																				true	"Concert ID"
+// @Param			request	body		updateConcertWaitingRoomRequest														true	"Waiting room input"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=updateConcertWaitingRoomResponse,metadata=nil}	"Waiting room updated"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}												"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}												"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}												"Forbidden - The concert is managed by another organizer"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}												"Concert not found"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}												"Internal server error"
+// @Security		ApiKeyAuth
+// @Router			/concerts/{id}/waiting-room [put]
+func (h *concertHandler) UpdateConcertWaitingRoom(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var input updateConcertWaitingRoomRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	concert, err := h.concertUsecase.UpdateConcertWaitingRoom(c.Request.Context(), concertUsecase.UpdateConcertWaitingRoomInput{
+		ID:        c.Param("id"),
+		Enabled:   input.Enabled,
+		Principal: principal,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newUpdateConcertWaitingRoomResponse(concert))
+}
+
+func (h *concertHandler) newUpdateConcertWaitingRoomResponse(concert *entity.Concert) updateConcertWaitingRoomResponse {
+	if concert == nil {
+		return updateConcertWaitingRoomResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return updateConcertWaitingRoomResponse{
+		ID:                 concert.ID.String(),
+		Name:               concert.Name,
+		Venue:              concert.Venue,
+		Date:               concert.Date.In(loc).Format(time.RFC3339),
+		WaitingRoomEnabled: concert.WaitingRoomEnabled,
+	}
+}
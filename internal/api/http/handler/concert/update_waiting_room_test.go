@@ -0,0 +1,186 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	concertUsecase "ticket-reservation/internal/usecase/concert"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestConcertHandler_UpdateConcertWaitingRoom(t *testing.T) {
+	concertID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "organizer@example.com", Role: entity.UserRoleOrganizer}
+	updatedConcert := &entity.Concert{
+		ID:                 concertID,
+		Name:               "Test Concert",
+		Venue:              "Test Venue",
+		Date:               time.Date(2025, 12, 25, 13, 0, 0, 0, time.UTC),
+		OrganizerID:        &principal.UserID,
+		WaitingRoomEnabled: true,
+	}
+
+	tests := []struct {
+		name             string
+		principal        *entity.Principal
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful waiting room update",
+			principal:   principal,
+			requestBody: map[string]interface{}{"enabled": true},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertUsecase.EXPECT().
+					UpdateConcertWaitingRoom(gomock.Any(), concertUsecase.UpdateConcertWaitingRoomInput{
+						ID:        concertID.String(),
+						Enabled:   pointer.ToPointer(true),
+						Principal: principal,
+					}).
+					Return(updatedConcert, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":                   concertID.String(),
+					"name":                 "Test Concert",
+					"venue":                "Test Venue",
+					"date":                 "2025-12-25T20:00:00+07:00",
+					"waiting_room_enabled": true,
+				},
+			},
+		},
+		{
+			name:        "invalid JSON body",
+			principal:   principal,
+			requestBody: "not-an-object",
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected for validation errors
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "not authenticated",
+			principal:   nil,
+			requestBody: map[string]interface{}{"enabled": true},
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected without a principal
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:        "concert managed by another organizer",
+			principal:   principal,
+			requestBody: map[string]interface{}{"enabled": false},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertUsecase.EXPECT().
+					UpdateConcertWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the concert is managed by another organizer", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the concert is managed by another organizer",
+			},
+		},
+		{
+			name:        "concert not found",
+			principal:   principal,
+			requestBody: map[string]interface{}{"enabled": true},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertUsecase.EXPECT().
+					UpdateConcertWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "concert not found",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			principal:   principal,
+			requestBody: map[string]interface{}{"enabled": true},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertUsecase.EXPECT().
+					UpdateConcertWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPut).
+				Path("/concerts/:id/waiting-room").
+				Param("id", concertID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute the handler
+			h.concertHandler.UpdateConcertWaitingRoom(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
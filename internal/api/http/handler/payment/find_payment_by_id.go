@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"ticket-reservation/internal/api/http/middleware"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// @Summary		Get Payment by ID
+// @Description	Retrieves a payment of a reservation held by the signed-in user, with the ticket, discount, fee and VAT lines of its amount
+// @Tags			Payment
+// @Produce		json
+// @Param			id				path		string															true	"Payment ID"
+// @Success		200				{object}	httpresponse.SuccessResponse{data=PaymentResponse,metadata=nil}	"Payment retrieved successfully"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}							"Bad Request - Invalid input"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}							"Unauthorized - Missing or invalid access token"
+// @Failure		403				{object}	httpresponse.ErrorResponse{data=nil}							"Forbidden - Payment belongs to another user"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}							"Payment not found"
+// @Failure		429				{object}	httpresponse.ErrorResponse{data=object}						"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}							"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/payments/{id} [get]
+func (h *paymentHandler) FindPaymentByID(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	payment, err := h.paymentUsecase.FindOnePayment(c.Request.Context(), paymentUsecase.FindOnePaymentInput{
+		PaymentID: c.Param("id"),
+		SessionID: principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newPaymentResponse(payment))
+}
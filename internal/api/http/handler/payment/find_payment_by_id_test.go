@@ -0,0 +1,178 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentHandler_FindPaymentByID(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	amount := decimal.RequireFromString("1500.5")
+	createdAt := time.Date(2025, 1, 1, 3, 2, 58, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful retrieval of a failed payment",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					FindOnePayment(gomock.Any(), paymentUsecase.FindOnePaymentInput{
+						PaymentID: paymentID.String(),
+						SessionID: sessionID,
+					}).
+					Return(&entity.Payment{
+						ID:            paymentID,
+						ReservationID: reservationID,
+						Status:        entity.PaymentStatusFailed,
+						Amount:        &amount,
+						PaymentMethod: pointer.ToPointer("fake_declined"),
+						Provider:      pointer.ToPointer("fake"),
+						CreatedAt:     createdAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"payment_id":     paymentID.String(),
+					"reservation_id": reservationID.String(),
+					"status":         "failed",
+					"amount":         "1500.50",
+					"payment_method": "fake_declined",
+					"provider":       "fake",
+					"created_at":     "2025-01-01T10:02:58+07:00",
+				},
+			},
+		},
+		{
+			name: "successful retrieval of a paid payment with line items",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					FindOnePayment(gomock.Any(), gomock.Any()).
+					Return(&entity.Payment{
+						ID:            paymentID,
+						ReservationID: reservationID,
+						Status:        entity.PaymentStatusPaid,
+						Amount:        pointer.ToPointer(decimal.RequireFromString("1605")),
+						CreatedAt:     createdAt,
+						LineItems: entity.PaymentLineItems{
+							{PaymentID: paymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1500")},
+							{PaymentID: paymentID, LineNumber: 2, Type: entity.LineItemTypeVAT, Rate: pointer.ToPointer(decimal.RequireFromString("7")), Amount: decimal.RequireFromString("105")},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"payment_id":     paymentID.String(),
+					"reservation_id": reservationID.String(),
+					"status":         "paid",
+					"amount":         "1605.00",
+					"created_at":     "2025-01-01T10:02:58+07:00",
+					"line_items": []interface{}{
+						map[string]interface{}{"type": "ticket", "amount": "1500.00"},
+						map[string]interface{}{"type": "vat", "rate": "7.00", "amount": "105.00"},
+					},
+				},
+			},
+		},
+		{
+			name: "payment belongs to another session",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					FindOnePayment(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the payment does not belong to this session", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the payment does not belong to this session",
+			},
+		},
+		{
+			name: "payment not found",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					FindOnePayment(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("payment not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "payment not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/payments/:id").
+				Param("id", paymentID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			c.Set(middleware.PrincipalContextKey, principal)
+
+			// Execute the handler
+			h.paymentHandler.FindPaymentByID(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+			// Optional fields are left out when they are not set
+			if data, ok := responseBody["data"].(map[string]interface{}); ok {
+				assert.NotContains(t, data, "paid_at")
+				assert.NotContains(t, data, "provider_reference")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+const (
+	ReceiptFormatJSON = "json"
+	ReceiptFormatHTML = "html"
+	ReceiptFormatPDF  = "pdf"
+)
+
+type ReceiptResponse struct {
+	ReceiptID      string                    `json:"receipt_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PaymentID      string                    `json:"payment_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DocumentNumber string                    `json:"document_number" example:"RC00000042"`
+	ReceiptNumber  int64                     `json:"receipt_number" example:"42"`
+	Seller         ReceiptSellerResponse     `json:"seller"`
+	Description    string                    `json:"description" example:"Ticket VIP, seat A1"`
+	Currency       string                    `json:"currency" example:"THB"`
+	Amount         string                    `json:"amount" example:"1605.00"`
+	VATAmount      string                    `json:"vat_amount" example:"105.00"`
+	IssuedAt       string                    `json:"issued_at" example:"2025-01-01T10:03:00+07:00"`
+	LineItems      []PaymentLineItemResponse `json:"line_items"`
+}
+
+type ReceiptSellerResponse struct {
+	TaxID   string `json:"tax_id" example:"0105555000001"`
+	Name    string `json:"name" example:"Ticket Co., Ltd."`
+	Address string `json:"address,omitempty" example:"1 Rama IV Road, Bangkok 10500"`
+}
+
+// @Summary		Get Payment Receipt
+// @Description	Retrieves the receipt issued when a payment of the signed-in user was paid, as JSON or as a printable HTML or PDF document
+// @Tags			Payment
+// @Produce		json,html,application/pdf
+// @Param			id				path		string															true	"Payment ID"
+// @Param			format			query		string															false	"Receipt format"	Enums(json, html, pdf)	default(json)
+// @Success		200				{object}	httpresponse.SuccessResponse{data=ReceiptResponse,metadata=nil}	"Receipt retrieved successfully"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}							"Bad Request - Invalid input or format"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}							"Unauthorized - Missing or invalid access token"
+// @Failure		403				{object}	httpresponse.ErrorResponse{data=nil}							"Forbidden - Payment belongs to another user"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}							"Payment not found or not paid yet"
+// @Failure		429				{object}	httpresponse.ErrorResponse{data=object}						"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}							"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/payments/{id}/receipt [get]
+func (h *paymentHandler) FindPaymentReceipt(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	format := c.DefaultQuery("format", ReceiptFormatJSON)
+	if format != ReceiptFormatJSON && format != ReceiptFormatHTML && format != ReceiptFormatPDF {
+		err := errsFramework.NewBadRequestError("unsupported receipt format", map[string]string{"format": format})
+		httpresponse.Error(c, err)
+		return
+	}
+
+	receipt, err := h.paymentUsecase.FindPaymentReceipt(c.Request.Context(), paymentUsecase.FindPaymentReceiptInput{
+		PaymentID: c.Param("id"),
+		SessionID: principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	switch format {
+	case ReceiptFormatHTML:
+		document, err := renderReceiptHTML(receipt, loc)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to render receipt", nil))
+			httpresponse.Error(c, err)
+			return
+		}
+		c.Data(http.StatusOK, "text/html; charset=utf-8", document)
+	case ReceiptFormatPDF:
+		c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s.pdf"`, receipt.DocumentNumber))
+		c.Data(http.StatusOK, "application/pdf", renderReceiptPDF(receipt, loc))
+	default:
+		httpresponse.Success(c, newReceiptResponse(receipt, loc))
+	}
+}
+
+func newReceiptResponse(receipt *entity.Receipt, loc *time.Location) ReceiptResponse {
+	response := ReceiptResponse{
+		ReceiptID:      receipt.ID.String(),
+		PaymentID:      receipt.PaymentID.String(),
+		DocumentNumber: receipt.DocumentNumber,
+		ReceiptNumber:  receipt.ReceiptNumber,
+		Seller: ReceiptSellerResponse{
+			TaxID:   receipt.Seller.TaxID,
+			Name:    receipt.Seller.Name,
+			Address: receipt.Seller.Address,
+		},
+		Description: receipt.Description,
+		Currency:    receipt.Currency,
+		Amount:      receipt.Amount.StringFixed(2),
+		VATAmount:   receipt.VATAmount().StringFixed(2),
+		IssuedAt:    receipt.IssuedAt.In(loc).Format(time.RFC3339),
+		LineItems:   make([]PaymentLineItemResponse, 0, len(receipt.LineItems)),
+	}
+	for _, lineItem := range receipt.LineItems {
+		response.LineItems = append(response.LineItems, newPaymentLineItemResponse(lineItem))
+	}
+	return response
+}
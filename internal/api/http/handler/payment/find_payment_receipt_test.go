@@ -0,0 +1,210 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/payment"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentHandler_FindPaymentReceipt(t *testing.T) {
+	receiptID := uuid.New()
+	paymentID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+
+	receipt := &entity.Receipt{
+		ID:        receiptID,
+		PaymentID: paymentID,
+		Seller: entity.Seller{
+			TaxID: "0105555000001",
+			Name:  "Ticket Co., Ltd.",
+		},
+		ReceiptNumber:  42,
+		DocumentNumber: "RC00000042",
+		Description:    "Ticket VIP, seat A1",
+		Currency:       "THB",
+		Amount:         decimal.RequireFromString("1605"),
+		IssuedAt:       time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC),
+		LineItems: entity.PaymentLineItems{
+			{PaymentID: paymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1500")},
+			{PaymentID: paymentID, LineNumber: 2, Type: entity.LineItemTypeVAT, Rate: pointer.ToPointer(decimal.RequireFromString("7")), Amount: decimal.RequireFromString("105")},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		format              string
+		setupMocks          func(h *testHelper)
+		expectedStatus      int
+		expectedContentType string
+		expectedResponse    map[string]interface{}
+		expectedContains    []string
+	}{
+		{
+			name: "receipt as json by default",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					FindPaymentReceipt(gomock.Any(), paymentUsecase.FindPaymentReceiptInput{
+						PaymentID: paymentID.String(),
+						SessionID: sessionID,
+					}).
+					Return(receipt, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"receipt_id":      receiptID.String(),
+					"payment_id":      paymentID.String(),
+					"document_number": "RC00000042",
+					"receipt_number":  float64(42),
+					"seller": map[string]interface{}{
+						"tax_id": "0105555000001",
+						"name":   "Ticket Co., Ltd.",
+					},
+					"description": "Ticket VIP, seat A1",
+					"currency":    "THB",
+					"amount":      "1605.00",
+					"vat_amount":  "105.00",
+					"issued_at":   "2025-01-01T10:03:00+07:00",
+					"line_items": []interface{}{
+						map[string]interface{}{"type": "ticket", "amount": "1500.00"},
+						map[string]interface{}{"type": "vat", "rate": "7.00", "amount": "105.00"},
+					},
+				},
+			},
+		},
+		{
+			name:   "receipt as html",
+			format: handler.ReceiptFormatHTML,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().FindPaymentReceipt(gomock.Any(), gomock.Any()).Return(receipt, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedContains: []string{
+				"Receipt No. RC00000042",
+				"Ticket Co., Ltd.",
+				"Tax ID 0105555000001",
+				"01 Jan 2025 10:03",
+				"VAT (7.00%)",
+				"1605.00",
+			},
+		},
+		{
+			name:   "receipt as pdf",
+			format: handler.ReceiptFormatPDF,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().FindPaymentReceipt(gomock.Any(), gomock.Any()).Return(receipt, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/pdf",
+			expectedContains: []string{
+				"%PDF-1.4",
+				"Receipt No. RC00000042",
+				"1605.00",
+			},
+		},
+		{
+			name:           "unsupported format",
+			format:         "docx",
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unsupported receipt format",
+			},
+		},
+		{
+			name:   "receipt not found",
+			format: handler.ReceiptFormatPDF,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					FindPaymentReceipt(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("receipt not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "receipt not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters and format using testhelper
+			builder := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/payments/:id/receipt").
+				Param("id", paymentID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger()))
+			if tt.format != "" {
+				builder = builder.Query("format", tt.format)
+			}
+			c := builder.MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			c.Set(middleware.PrincipalContextKey, principal)
+
+			// Execute the handler
+			h.paymentHandler.FindPaymentReceipt(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+			}
+
+			// Assert the printable documents
+			for _, expected := range tt.expectedContains {
+				assert.True(t, bytes.Contains(w.Body.Bytes(), []byte(expected)), "Expected body to contain '%s'", expected)
+			}
+			if tt.format == handler.ReceiptFormatPDF && tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, `inline; filename="RC00000042.pdf"`, w.Header().Get("Content-Disposition"))
+			}
+
+			// Assert response body
+			if tt.expectedResponse != nil {
+				var responseBody map[string]interface{}
+				err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedResponse {
+					actualValue, exists := responseBody[key]
+					assert.True(t, exists, "Expected key '%s' to exist in response", key)
+					assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type PaymentWebhookResponse struct {
+	EventID   string           `json:"event_id" example:"evt_0001"`
+	Duplicate bool             `json:"duplicate" example:"false"`
+	Payment   *PaymentResponse `json:"payment,omitempty"`
+}
+
+// @Summary		Receive a Payment Webhook
+// @Description	Applies a payment event sent by a provider; the raw body must be signed with the provider secret and redelivered events are acknowledged without effect
+// @Tags			Payment
+// @Accept			json
+// @Produce		json
+// @Param			provider			path		string																	true	"Payment provider"
+// @Param			X-Webhook-Timestamp	header		string																	true	"Unix time at which the body was signed"
+// @Param			X-Webhook-Signature	header		string																	true	"Hex encoded HMAC-SHA256 of <timestamp>.<raw body>"
+// @Param			request				body		paymentUsecase.PaymentWebhookPayload									true	"Webhook event"
+// @Success		200					{object}	httpresponse.SuccessResponse{data=PaymentWebhookResponse,metadata=nil}	"Event processed successfully"
+// @Failure		400					{object}	httpresponse.ErrorResponse{data=nil}									"Bad Request - Invalid payload"
+// @Failure		401					{object}	httpresponse.ErrorResponse{data=object}									"Unauthorized - Invalid signature or stale timestamp"
+// @Failure		403					{object}	httpresponse.ErrorResponse{data=object}									"Forbidden - Payment processed by another provider"
+// @Failure		404					{object}	httpresponse.ErrorResponse{data=object}									"Unknown provider or payment not found"
+// @Failure		500					{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Router			/webhooks/payments/{provider} [post]
+func (h *paymentHandler) HandlePaymentWebhook(c *gin.Context) {
+	// The signature covers the exact bytes sent by the provider, the body must not be decoded before it is verified
+	payload, err := c.GetRawData()
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to read request body", nil))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	result, err := h.paymentUsecase.HandlePaymentWebhook(c.Request.Context(), paymentUsecase.HandlePaymentWebhookInput{
+		Provider:  c.Param("provider"),
+		Timestamp: c.GetHeader(WebhookTimestampHeader),
+		Signature: c.GetHeader(WebhookSignatureHeader),
+		Payload:   payload,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	response := PaymentWebhookResponse{
+		EventID:   result.EventID,
+		Duplicate: result.Duplicate,
+	}
+	if result.Payment != nil {
+		payment := h.newPaymentResponse(result.Payment)
+		response.Payment = &payment
+	}
+	httpresponse.Success(c, response)
+}
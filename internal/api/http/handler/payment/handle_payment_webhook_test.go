@@ -0,0 +1,179 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/payment"
+	"ticket-reservation/internal/domain/entity"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/testhelper"
+	"ticket-reservation/pkg/webhooksignature"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentHandler_HandlePaymentWebhook(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	amount := decimal.RequireFromString("1500")
+	createdAt := time.Date(2025, 1, 1, 3, 2, 58, 0, time.UTC)
+	paidAt := time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC)
+
+	// Signed fixture, the handler must hand over the exact bytes that were signed
+	payload := json.RawMessage(`{"id":"evt_0001","type":"payment.succeeded","data":{"payment_id":"` + paymentID.String() + `","provider_reference":"fake_ch_1"}}`)
+	signedAt := time.Now()
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	signature := webhooksignature.Sign("whsec_test_fake", signedAt, payload)
+
+	expectedInput := paymentUsecase.HandlePaymentWebhookInput{
+		Provider:  "fake",
+		Timestamp: timestamp,
+		Signature: signature,
+		Payload:   payload,
+	}
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful processing of a succeeded event",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					HandlePaymentWebhook(gomock.Any(), expectedInput).
+					Return(&paymentUsecase.PaymentWebhookResult{
+						EventID: "evt_0001",
+						Payment: &entity.Payment{
+							ID:                paymentID,
+							ReservationID:     reservationID,
+							Status:            entity.PaymentStatusPaid,
+							Amount:            &amount,
+							PaidAt:            &paidAt,
+							PaymentMethod:     pointer.ToPointer("credit_card"),
+							Provider:          pointer.ToPointer("fake"),
+							ProviderReference: pointer.ToPointer("fake_ch_1"),
+							CreatedAt:         createdAt,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"event_id":  "evt_0001",
+					"duplicate": false,
+					"payment": map[string]interface{}{
+						"payment_id":         paymentID.String(),
+						"reservation_id":     reservationID.String(),
+						"status":             "paid",
+						"amount":             "1500.00",
+						"payment_method":     "credit_card",
+						"provider":           "fake",
+						"provider_reference": "fake_ch_1",
+						"paid_at":            "2025-01-01T10:03:00+07:00",
+						"created_at":         "2025-01-01T10:02:58+07:00",
+					},
+				},
+			},
+		},
+		{
+			name: "redelivered event is acknowledged",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					HandlePaymentWebhook(gomock.Any(), expectedInput).
+					Return(&paymentUsecase.PaymentWebhookResult{EventID: "evt_0001", Duplicate: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"event_id":  "evt_0001",
+					"duplicate": true,
+				},
+			},
+		},
+		{
+			name: "invalid signature",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					HandlePaymentWebhook(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewUnauthorizedError("invalid webhook signature", nil))
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-901000",
+				"message": "invalid webhook signature",
+			},
+		},
+		{
+			name: "unknown provider",
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					HandlePaymentWebhook(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("unknown payment provider", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "unknown payment provider",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with the provider path parameter and signature headers using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/webhooks/payments/:provider").
+				Param("provider", "fake").
+				Header(handler.WebhookTimestampHeader, timestamp).
+				Header(handler.WebhookSignatureHeader, signature).
+				JSONBody(payload).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.paymentHandler.HandlePaymentWebhook(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
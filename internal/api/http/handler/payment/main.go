@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// WebhookTimestampHeader carries the unix time at which the provider signed a webhook delivery
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of "<timestamp>.<raw body>"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+type PaymentHandler interface {
+	PayReservation(c *gin.Context)
+	FindPaymentByID(c *gin.Context)
+	FindPaymentReceipt(c *gin.Context)
+	HandlePaymentWebhook(c *gin.Context)
+	RefundPayment(c *gin.Context)
+}
+
+type paymentHandler struct {
+	appConfig      config.AppConfig
+	paymentUsecase paymentUsecase.PaymentUsecase
+}
+
+func NewPaymentHandler(appConfig config.AppConfig, paymentUsecase paymentUsecase.PaymentUsecase) PaymentHandler {
+	return &paymentHandler{
+		appConfig:      appConfig,
+		paymentUsecase: paymentUsecase,
+	}
+}
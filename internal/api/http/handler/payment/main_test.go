@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/payment"
+	"ticket-reservation/internal/config"
+	payment_mocks "ticket-reservation/internal/usecase/payment/mocks"
+)
+
+type testHelper struct {
+	ctrl               *gomock.Controller
+	appConfig          config.AppConfig
+	mockPaymentUsecase *payment_mocks.MockPaymentUsecase
+	paymentHandler     handler.PaymentHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockPaymentUsecase := payment_mocks.NewMockPaymentUsecase(ctrl)
+
+	paymentHandler := handler.NewPaymentHandler(appConfig, mockPaymentUsecase)
+
+	return &testHelper{
+		ctrl:               ctrl,
+		appConfig:          appConfig,
+		mockPaymentUsecase: mockPaymentUsecase,
+		paymentHandler:     paymentHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewPaymentHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockPaymentUsecase := payment_mocks.NewMockPaymentUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewPaymentHandler(appConfig, mockPaymentUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/base64"
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/internal/util/httpresponse"
+	"ticket-reservation/pkg/promptpay"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+// qrImageSize is the width and height in pixels of the QR code images returned with payments
+const qrImageSize = 512
+
+type PayReservationRequest struct {
+	PaymentMethod string  `json:"payment_method" binding:"required" example:"credit_card"`
+	Amount        string  `json:"amount" binding:"required" example:"1500.00"`
+	PromoCode     *string `json:"promo_code,omitempty" example:"EARLYBIRD"`
+}
+
+type PaymentResponse struct {
+	PaymentID         string  `json:"payment_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReservationID     string  `json:"reservation_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status            string  `json:"status" example:"paid"`
+	Amount            string  `json:"amount" example:"1500.00"`
+	PaymentMethod     *string `json:"payment_method,omitempty" example:"credit_card"`
+	Provider          *string `json:"provider,omitempty" example:"fake"`
+	ProviderReference *string `json:"provider_reference,omitempty" example:"fake_ch_123e4567-e89b-12d3-a456-426614174000"`
+	PromoCodeID       *string `json:"promo_code_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	DiscountAmount    *string `json:"discount_amount,omitempty" example:"300.00"`
+	PaidAt            *string `json:"paid_at,omitempty" example:"2025-01-01T10:03:00+07:00"`
+	CreatedAt         string  `json:"created_at" example:"2025-01-01T10:02:58+07:00"`
+	// QR code the customer scans to pay, only returned while a payment made by scanning is waiting for the transfer
+	QRPayload *string `json:"qr_payload,omitempty" example:"00020101021230630016A00000067701011201150105555000001010220123E4567E89B42D3A4565802TH530376454071500.0063045EBE"`
+	QRImage   *string `json:"qr_image,omitempty" example:"data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAA..."`
+	// Breakdown of the amount, only returned when a single payment is retrieved
+	LineItems []PaymentLineItemResponse `json:"line_items,omitempty"`
+}
+
+type PaymentLineItemResponse struct {
+	Type   string  `json:"type" example:"vat"`
+	Rate   *string `json:"rate,omitempty" example:"7.00"`
+	Amount string  `json:"amount" example:"105.00"`
+}
+
+// @Summary		Pay a Reservation
+// @Description	Charges a pending reservation held by the signed-in user, optionally discounted by a promo code; on success the reservation is confirmed and the seat booked. With the "promptpay" payment method nothing is charged, the payment is returned initiated with the PromptPay QR code to scan, and the reservation is confirmed once the bank notifies the transfer
+// @Tags			Payment
+// @Accept			json
+// @Produce		json
+// @Param			id				path		string															true	"Reservation ID"
+// @Param			request			body		PayReservationRequest											true	"Payment Request"
+// @Success		201				{object}	httpresponse.SuccessResponse{data=PaymentResponse,metadata=nil}	"Reservation paid successfully"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}							"Bad Request - Invalid input"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}							"Unauthorized - Missing or invalid access token"
+// @Failure		403				{object}	httpresponse.ErrorResponse{data=nil}							"Forbidden - Reservation belongs to another user"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}							"Reservation or promo code not found"
+// @Failure		409				{object}	httpresponse.ErrorResponse{data=object}							"Conflict - Reservation can no longer be paid or another payment is in progress"
+// @Failure		422				{object}	httpresponse.ErrorResponse{data=object}							"Unprocessable Entity - Payment declined or method not available, promo code cannot be used or amount does not match the reservation price"
+// @Failure		429				{object}	httpresponse.ErrorResponse{data=object}						"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}							"Internal Server Error - Unexpected error occurred"
+// @Failure		502				{object}	httpresponse.ErrorResponse{data=object}							"Bad Gateway - Payment provider error"
+// @Security		ApiKeyAuth
+// @Router			/reservations/{id}/pay [post]
+func (h *paymentHandler) PayReservation(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var request PayReservationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	payment, err := h.paymentUsecase.PayReservation(c.Request.Context(), paymentUsecase.PayReservationInput{
+		ReservationID: c.Param("id"),
+		SessionID:     principal.SessionID(),
+		PaymentMethod: request.PaymentMethod,
+		Amount:        request.Amount,
+		PromoCode:     request.PromoCode,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newPaymentResponse(payment))
+}
+
+func (h *paymentHandler) newPaymentResponse(payment *entity.Payment) PaymentResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := PaymentResponse{
+		PaymentID:         payment.ID.String(),
+		ReservationID:     payment.ReservationID.String(),
+		Status:            payment.Status.String(),
+		PaymentMethod:     payment.PaymentMethod,
+		Provider:          payment.Provider,
+		ProviderReference: payment.ProviderReference,
+		CreatedAt:         payment.CreatedAt.In(loc).Format(time.RFC3339),
+	}
+	if payment.Amount != nil {
+		response.Amount = payment.Amount.StringFixed(2)
+	}
+	if payment.PromoCodeID != nil {
+		response.PromoCodeID = pointer.ToPointer(payment.PromoCodeID.String())
+	}
+	if payment.DiscountAmount != nil {
+		response.DiscountAmount = pointer.ToPointer(payment.DiscountAmount.StringFixed(2))
+	}
+	if payment.PaidAt != nil {
+		response.PaidAt = pointer.ToPointer(payment.PaidAt.In(loc).Format(time.RFC3339))
+	}
+	if payment.QRPayload != nil && payment.Status == entity.PaymentStatusInitiated {
+		response.QRPayload = payment.QRPayload
+		// The raw payload is enough to render the code, a failed rendering only leaves the image out
+		if image, err := promptpay.PNG(*payment.QRPayload, qrImageSize); err == nil {
+			response.QRImage = pointer.ToPointer("data:image/png;base64," + base64.StdEncoding.EncodeToString(image))
+		}
+	}
+	for _, lineItem := range payment.LineItems {
+		response.LineItems = append(response.LineItems, newPaymentLineItemResponse(lineItem))
+	}
+	return response
+}
+
+func newPaymentLineItemResponse(lineItem entity.PaymentLineItem) PaymentLineItemResponse {
+	response := PaymentLineItemResponse{
+		Type:   lineItem.Type.String(),
+		Amount: lineItem.Amount.StringFixed(2),
+	}
+	if lineItem.Rate != nil {
+		response.Rate = pointer.ToPointer(lineItem.Rate.StringFixed(2))
+	}
+	return response
+}
@@ -0,0 +1,334 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	handler "ticket-reservation/internal/api/http/handler/payment"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentHandler_PayReservation(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	promoCodeID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	amount := decimal.RequireFromString("1500")
+	paidAt := time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 3, 2, 58, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful payment",
+			requestBody: map[string]interface{}{
+				"payment_method": "credit_card",
+				"amount":         "1500.00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					PayReservation(gomock.Any(), paymentUsecase.PayReservationInput{
+						ReservationID: reservationID.String(),
+						SessionID:     sessionID,
+						PaymentMethod: "credit_card",
+						Amount:        "1500.00",
+					}).
+					Return(&entity.Payment{
+						ID:                paymentID,
+						ReservationID:     reservationID,
+						Status:            entity.PaymentStatusPaid,
+						Amount:            &amount,
+						PaidAt:            &paidAt,
+						PaymentMethod:     pointer.ToPointer("credit_card"),
+						Provider:          pointer.ToPointer("fake"),
+						ProviderReference: pointer.ToPointer("fake_ch_123"),
+						CreatedAt:         createdAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"payment_id":         paymentID.String(),
+					"reservation_id":     reservationID.String(),
+					"status":             "paid",
+					"amount":             "1500.00",
+					"payment_method":     "credit_card",
+					"provider":           "fake",
+					"provider_reference": "fake_ch_123",
+					"paid_at":            "2025-01-01T10:03:00+07:00",
+					"created_at":         "2025-01-01T10:02:58+07:00",
+				},
+			},
+		},
+		{
+			name: "successful payment with a promo code",
+			requestBody: map[string]interface{}{
+				"payment_method": "credit_card",
+				"amount":         "1200.00",
+				"promo_code":     "EARLYBIRD",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					PayReservation(gomock.Any(), paymentUsecase.PayReservationInput{
+						ReservationID: reservationID.String(),
+						SessionID:     sessionID,
+						PaymentMethod: "credit_card",
+						Amount:        "1200.00",
+						PromoCode:     pointer.ToPointer("EARLYBIRD"),
+					}).
+					Return(&entity.Payment{
+						ID:                paymentID,
+						ReservationID:     reservationID,
+						Status:            entity.PaymentStatusPaid,
+						Amount:            pointer.ToPointer(decimal.RequireFromString("1200")),
+						PaidAt:            &paidAt,
+						PaymentMethod:     pointer.ToPointer("credit_card"),
+						Provider:          pointer.ToPointer("fake"),
+						ProviderReference: pointer.ToPointer("fake_ch_123"),
+						PromoCodeID:       &promoCodeID,
+						DiscountAmount:    pointer.ToPointer(decimal.RequireFromString("300")),
+						CreatedAt:         createdAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"payment_id":         paymentID.String(),
+					"reservation_id":     reservationID.String(),
+					"status":             "paid",
+					"amount":             "1200.00",
+					"payment_method":     "credit_card",
+					"provider":           "fake",
+					"provider_reference": "fake_ch_123",
+					"promo_code_id":      promoCodeID.String(),
+					"discount_amount":    "300.00",
+					"paid_at":            "2025-01-01T10:03:00+07:00",
+					"created_at":         "2025-01-01T10:02:58+07:00",
+				},
+			},
+		},
+		{
+			name: "missing amount",
+			requestBody: map[string]interface{}{
+				"payment_method": "credit_card",
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name: "payment declined",
+			requestBody: map[string]interface{}{
+				"payment_method": "fake_declined",
+				"amount":         "1500.00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					PayReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewPaymentDeclinedError(map[string]string{"payment_id": paymentID.String()}))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-404001",
+				"message": "the payment was declined.",
+				"data":    map[string]interface{}{"payment_id": paymentID.String()},
+			},
+		},
+		{
+			name: "reservation can no longer be paid",
+			requestBody: map[string]interface{}{
+				"payment_method": "credit_card",
+				"amount":         "1500.00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					PayReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("the reservation can no longer be paid", map[string]string{"status": "expired"}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "the reservation can no longer be paid",
+				"data":    map[string]interface{}{"status": "expired"},
+			},
+		},
+		{
+			name: "payment provider error",
+			requestBody: map[string]interface{}{
+				"payment_method": "credit_card",
+				"amount":         "1500.00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					PayReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewThirdPartyError("failed to charge the payment", nil))
+			},
+			expectedStatus: http.StatusBadGateway,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-502000",
+				"message": "failed to charge the payment",
+			},
+		},
+		{
+			name: "usecase internal error",
+			requestBody: map[string]interface{}{
+				"payment_method": "credit_card",
+				"amount":         "1500.00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					PayReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters and JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/reservations/:id/pay").
+				Param("id", reservationID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			c.Set(middleware.PrincipalContextKey, principal)
+
+			// Execute the handler
+			h.paymentHandler.PayReservation(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
+
+func TestPaymentHandler_PayReservation_PromptPay(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	qrPayload := "00020101021230630016A00000067701011201150105555000001010220123E4567E89B42D3A4565802TH530376454071500.0063045EBE"
+
+	h := initTest(t)
+	defer h.Done()
+
+	h.mockPaymentUsecase.EXPECT().
+		PayReservation(gomock.Any(), paymentUsecase.PayReservationInput{
+			ReservationID: reservationID.String(),
+			SessionID:     sessionID,
+			PaymentMethod: "promptpay",
+			Amount:        "1500.00",
+		}).
+		Return(&entity.Payment{
+			ID:            paymentID,
+			ReservationID: reservationID,
+			Status:        entity.PaymentStatusInitiated,
+			Amount:        pointer.ToPointer(decimal.RequireFromString("1500")),
+			PaymentMethod: pointer.ToPointer("promptpay"),
+			Provider:      pointer.ToPointer("promptpay"),
+			QRPayload:     pointer.ToPointer(qrPayload),
+			CreatedAt:     time.Date(2025, 1, 1, 3, 2, 58, 0, time.UTC),
+		}, nil)
+
+	// Create response recorder
+	w := httptest.NewRecorder()
+
+	// Create Gin context with path parameters and JSON body using testhelper
+	c := testhelper.NewGinCtx(w).
+		Method(http.MethodPost).
+		Path("/reservations/:id/pay").
+		Param("id", reservationID.String()).
+		JSONBody(map[string]interface{}{
+			"payment_method": "promptpay",
+			"amount":         "1500.00",
+		}).
+		WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+		MustBuild(t)
+	// User authenticated by the Authenticate middleware
+	c.Set(middleware.PrincipalContextKey, principal)
+
+	// Execute the handler
+	h.paymentHandler.PayReservation(c)
+
+	// The payment waits for the transfer, the QR code is returned both raw and as a PNG image
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var responseBody struct {
+		Data handler.PaymentResponse `json:"data"`
+	}
+	err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+	require.NoError(t, err)
+	assert.Equal(t, "initiated", responseBody.Data.Status)
+	assert.Equal(t, qrPayload, pointer.GetValue(responseBody.Data.QRPayload))
+
+	require.NotNil(t, responseBody.Data.QRImage)
+	encoded, found := strings.CutPrefix(*responseBody.Data.QRImage, "data:image/png;base64,")
+	require.True(t, found, "Expected a PNG data URI")
+	image, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	decoded, err := png.Decode(bytes.NewReader(image))
+	require.NoError(t, err)
+	assert.Equal(t, 512, decoded.Bounds().Dx())
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/pkg/textpdf"
+	"time"
+)
+
+// receiptDateFormat is the issue date as printed on receipts
+const receiptDateFormat = "02 Jan 2006 15:04"
+
+var lineItemLabels = map[entity.LineItemType]string{
+	entity.LineItemTypeTicket:     "Ticket",
+	entity.LineItemTypeDiscount:   "Discount",
+	entity.LineItemTypeServiceFee: "Service fee",
+	entity.LineItemTypePaymentFee: "Payment fee",
+	entity.LineItemTypeVAT:        "VAT",
+}
+
+var receiptTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Receipt {{.DocumentNumber}}</title>
+<style>
+body { font-family: sans-serif; max-width: 40em; margin: 2em auto; }
+table { width: 100%; border-collapse: collapse; }
+td { padding: 0.25em 0; }
+td.amount { text-align: right; }
+tr.total td { border-top: 1px solid #000; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>Receipt / Tax Invoice (Abbreviated)</h1>
+<p>{{.SellerName}}<br>Tax ID {{.SellerTaxID}}{{if .SellerAddress}}<br>{{.SellerAddress}}{{end}}</p>
+<p>Receipt No. {{.DocumentNumber}}<br>Date {{.IssuedAt}}<br>Payment {{.PaymentID}}</p>
+<p>{{.Description}}</p>
+<table>
+{{range .Lines}}<tr><td>{{.Label}}</td><td class="amount">{{.Amount}}</td></tr>
+{{end}}<tr class="total"><td>Total ({{.Currency}})</td><td class="amount">{{.Total}}</td></tr>
+</table>
+<p>VAT included {{.VATAmount}} {{.Currency}}</p>
+</body>
+</html>
+`))
+
+type receiptDocument struct {
+	SellerName     string
+	SellerTaxID    string
+	SellerAddress  string
+	DocumentNumber string
+	IssuedAt       string
+	PaymentID      string
+	Description    string
+	Currency       string
+	Lines          []receiptDocumentLine
+	Total          string
+	VATAmount      string
+}
+
+type receiptDocumentLine struct {
+	Label  string
+	Amount string
+}
+
+func newReceiptDocument(receipt *entity.Receipt, loc *time.Location) receiptDocument {
+	document := receiptDocument{
+		SellerName:     receipt.Seller.Name,
+		SellerTaxID:    receipt.Seller.TaxID,
+		SellerAddress:  receipt.Seller.Address,
+		DocumentNumber: receipt.DocumentNumber,
+		IssuedAt:       receipt.IssuedAt.In(loc).Format(receiptDateFormat),
+		PaymentID:      receipt.PaymentID.String(),
+		Description:    receipt.Description,
+		Currency:       receipt.Currency,
+		Lines:          make([]receiptDocumentLine, 0, len(receipt.LineItems)),
+		Total:          receipt.Amount.StringFixed(2),
+		VATAmount:      receipt.VATAmount().StringFixed(2),
+	}
+	for _, lineItem := range receipt.LineItems {
+		label := lineItemLabels[lineItem.Type]
+		if lineItem.Rate != nil {
+			label = fmt.Sprintf("%s (%s%%)", label, lineItem.Rate.StringFixed(2))
+		}
+		document.Lines = append(document.Lines, receiptDocumentLine{Label: label, Amount: lineItem.Amount.StringFixed(2)})
+	}
+	return document
+}
+
+// renderReceiptHTML renders the receipt as a printable HTML page.
+func renderReceiptHTML(receipt *entity.Receipt, loc *time.Location) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := receiptTemplate.Execute(&buf, newReceiptDocument(receipt, loc)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderReceiptPDF renders the receipt as a plain text PDF with the same content as the HTML page.
+func renderReceiptPDF(receipt *entity.Receipt, loc *time.Location) []byte {
+	document := newReceiptDocument(receipt, loc)
+	lines := []string{
+		"Receipt / Tax Invoice (Abbreviated)",
+		"",
+		document.SellerName,
+		"Tax ID " + document.SellerTaxID,
+	}
+	if document.SellerAddress != "" {
+		lines = append(lines, document.SellerAddress)
+	}
+	lines = append(lines,
+		"",
+		"Receipt No. "+document.DocumentNumber,
+		"Date "+document.IssuedAt,
+		"Payment "+document.PaymentID,
+		"",
+		document.Description,
+		"",
+	)
+	for _, line := range document.Lines {
+		lines = append(lines, fmt.Sprintf("%-40s %12s", line.Label, line.Amount))
+	}
+	lines = append(lines,
+		fmt.Sprintf("%-40s %12s", "Total ("+document.Currency+")", document.Total),
+		"",
+		fmt.Sprintf("VAT included %s %s", document.VATAmount, document.Currency),
+	)
+	return textpdf.Render(lines)
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"net/http"
+	"ticket-reservation/internal/domain/entity"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type RefundPaymentRequest struct {
+	Amount      string `json:"amount" binding:"required" example:"500.00"`
+	Reason      string `json:"reason" example:"customer cannot attend"`
+	ReleaseSeat bool   `json:"release_seat" example:"false"`
+}
+
+type RefundPaymentResponse struct {
+	Refund       RefundResponse  `json:"refund"`
+	Payment      PaymentResponse `json:"payment"`
+	SeatReleased bool            `json:"seat_released" example:"false"`
+}
+
+type RefundResponse struct {
+	RefundID          string  `json:"refund_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PaymentID         string  `json:"payment_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status            string  `json:"status" example:"succeeded"`
+	Amount            string  `json:"amount" example:"500.00"`
+	Reason            *string `json:"reason,omitempty" example:"customer cannot attend"`
+	ProviderReference *string `json:"provider_reference,omitempty" example:"fake_re_123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt         string  `json:"created_at" example:"2025-01-02T09:00:00+07:00"`
+}
+
+// @Summary		Refund a Payment
+// @Description	Gives back part or all of a paid payment (admin or box office); a full refund may release the booked seat back to inventory
+// @Tags			Payment
+// @Accept			json
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id		path		string																	true	"Payment ID"
+// @Param			request	body		RefundPaymentRequest													true	"Refund Request"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=RefundPaymentResponse,metadata=nil}	"Payment refunded successfully"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=object}									"Bad Request - Invalid input or seat release on a partial refund"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - The user is not an admin or box office staff"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}									"Payment not found"
+// @Failure		409		{object}	httpresponse.ErrorResponse{data=object}									"Conflict - Payment cannot be refunded or another refund is in progress"
+// @Failure		422		{object}	httpresponse.ErrorResponse{data=object}									"Unprocessable Entity - Amount exceeds the refundable amount or refund declined"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Failure		502		{object}	httpresponse.ErrorResponse{data=object}									"Bad Gateway - Payment provider error"
+// @Router			/payments/{id}/refunds [post]
+func (h *paymentHandler) RefundPayment(c *gin.Context) {
+	var request RefundPaymentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	result, err := h.paymentUsecase.RefundPayment(c.Request.Context(), paymentUsecase.RefundPaymentInput{
+		PaymentID:   c.Param("id"),
+		Amount:      request.Amount,
+		Reason:      request.Reason,
+		ReleaseSeat: request.ReleaseSeat,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, RefundPaymentResponse{
+		Refund:       h.newRefundResponse(result.Refund),
+		Payment:      h.newPaymentResponse(result.Payment),
+		SeatReleased: result.SeatReleased,
+	})
+}
+
+func (h *paymentHandler) newRefundResponse(refund *entity.Refund) RefundResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return RefundResponse{
+		RefundID:          refund.ID.String(),
+		PaymentID:         refund.PaymentID.String(),
+		Status:            refund.Status.String(),
+		Amount:            refund.Amount.StringFixed(2),
+		Reason:            refund.Reason,
+		ProviderReference: refund.ProviderReference,
+		CreatedAt:         refund.CreatedAt.In(loc).Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,220 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentHandler_RefundPayment(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	refundID := uuid.New()
+	amount := decimal.RequireFromString("1500")
+	paidAt := time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 3, 2, 58, 0, time.UTC)
+	refundedAt := time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful full refund releasing the seat",
+			requestBody: map[string]interface{}{
+				"amount":       "1500.00",
+				"reason":       "customer cannot attend",
+				"release_seat": true,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					RefundPayment(gomock.Any(), paymentUsecase.RefundPaymentInput{
+						PaymentID:   paymentID.String(),
+						Amount:      "1500.00",
+						Reason:      "customer cannot attend",
+						ReleaseSeat: true,
+					}).
+					Return(&paymentUsecase.RefundPaymentResult{
+						Refund: &entity.Refund{
+							ID:                refundID,
+							PaymentID:         paymentID,
+							Status:            entity.RefundStatusSucceeded,
+							Amount:            amount,
+							Reason:            pointer.ToPointer("customer cannot attend"),
+							ProviderReference: pointer.ToPointer("fake_re_123"),
+							CreatedAt:         refundedAt,
+						},
+						Payment: &entity.Payment{
+							ID:                paymentID,
+							ReservationID:     reservationID,
+							Status:            entity.PaymentStatusRefunded,
+							Amount:            &amount,
+							PaidAt:            &paidAt,
+							PaymentMethod:     pointer.ToPointer("credit_card"),
+							Provider:          pointer.ToPointer("fake"),
+							ProviderReference: pointer.ToPointer("fake_ch_123"),
+							CreatedAt:         createdAt,
+						},
+						SeatReleased: true,
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"refund": map[string]interface{}{
+						"refund_id":          refundID.String(),
+						"payment_id":         paymentID.String(),
+						"status":             "succeeded",
+						"amount":             "1500.00",
+						"reason":             "customer cannot attend",
+						"provider_reference": "fake_re_123",
+						"created_at":         "2025-01-02T09:00:00+07:00",
+					},
+					"payment": map[string]interface{}{
+						"payment_id":         paymentID.String(),
+						"reservation_id":     reservationID.String(),
+						"status":             "refunded",
+						"amount":             "1500.00",
+						"payment_method":     "credit_card",
+						"provider":           "fake",
+						"provider_reference": "fake_ch_123",
+						"paid_at":            "2025-01-01T10:03:00+07:00",
+						"created_at":         "2025-01-01T10:02:58+07:00",
+					},
+					"seat_released": true,
+				},
+			},
+		},
+		{
+			name:           "missing amount",
+			requestBody:    map[string]interface{}{"reason": "customer cannot attend"},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "amount exceeds the refundable amount",
+			requestBody: map[string]interface{}{"amount": "2000.00"},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					RefundPayment(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewUnprocessableEntityError("the amount exceeds the refundable amount", map[string]string{"refundable_amount": "1500.00"}))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-404000",
+				"message": "the amount exceeds the refundable amount",
+				"data":    map[string]interface{}{"refundable_amount": "1500.00"},
+			},
+		},
+		{
+			name:        "refund declined",
+			requestBody: map[string]interface{}{"amount": "500.00"},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					RefundPayment(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewRefundDeclinedError(map[string]string{"refund_id": refundID.String()}))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-404002",
+				"message": "the refund was declined.",
+				"data":    map[string]interface{}{"refund_id": refundID.String()},
+			},
+		},
+		{
+			name:        "payment cannot be refunded",
+			requestBody: map[string]interface{}{"amount": "500.00"},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					RefundPayment(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("the payment cannot be refunded", map[string]string{"status": "initiated"}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "the payment cannot be refunded",
+				"data":    map[string]interface{}{"status": "initiated"},
+			},
+		},
+		{
+			name:        "usecase internal error",
+			requestBody: map[string]interface{}{"amount": "500.00"},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentUsecase.EXPECT().
+					RefundPayment(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters and JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/payments/:id/refunds").
+				Param("id", paymentID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.paymentHandler.RefundPayment(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+	promoCodeUsecase "ticket-reservation/internal/usecase/promo_code"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type createPromoCodeRequest struct {
+	Code                     string     `json:"code" binding:"required" example:"EARLYBIRD"`
+	DiscountType             string     `json:"discount_type" binding:"required" example:"percentage"`
+	DiscountValue            string     `json:"discount_value" binding:"required" example:"20"`
+	MaxRedemptions           *int64     `json:"max_redemptions,omitempty" example:"100"`
+	MaxRedemptionsPerSession *int64     `json:"max_redemptions_per_session,omitempty" example:"1"`
+	ValidFrom                *time.Time `json:"valid_from,omitempty" example:"2025-01-01T00:00:00+07:00"`
+	ValidUntil               *time.Time `json:"valid_until,omitempty" example:"2025-02-01T00:00:00+07:00"`
+	ConcertID                *string    `json:"concert_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ZoneID                   *string    `json:"zone_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+}
+
+// @Summary		Create Promo Code
+// @Description	Create a percentage or fixed discount code with optional usage caps, validity window and concert/zone restriction
+// @Tags			Promo Code
+// @Accept			json
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			request	body		createPromoCodeRequest												true	"Promo code creation input"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=promoCodeResponse,metadata=nil}	"Promo code created"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}								"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}								"Forbidden - The user is not an admin"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}								"Concert or zone not found"
+// @Failure		409		{object}	httpresponse.ErrorResponse{data=object}								"Conflict - Promo code already exists"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}								"Internal server error"
+// @Router			/admin/promo-codes [post]
+func (h *promoCodeHandler) CreatePromoCode(c *gin.Context) {
+	var request createPromoCodeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	promoCode, err := h.promoCodeUsecase.CreatePromoCode(c.Request.Context(), promoCodeUsecase.CreatePromoCodeInput{
+		Code:                     request.Code,
+		DiscountType:             request.DiscountType,
+		DiscountValue:            request.DiscountValue,
+		MaxRedemptions:           request.MaxRedemptions,
+		MaxRedemptionsPerSession: request.MaxRedemptionsPerSession,
+		ValidFrom:                request.ValidFrom,
+		ValidUntil:               request.ValidUntil,
+		ConcertID:                request.ConcertID,
+		ZoneID:                   request.ZoneID,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newPromoCodeResponse(promoCode))
+}
@@ -0,0 +1,179 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	promoCodeUsecase "ticket-reservation/internal/usecase/promo_code"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPromoCodeHandler_CreatePromoCode(t *testing.T) {
+	promoCodeID := uuid.New()
+	concertID := uuid.New()
+	validUntil := time.Date(2025, 1, 31, 17, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2024, 12, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful promo code creation",
+			requestBody: map[string]interface{}{
+				"code":            "earlybird",
+				"discount_type":   "percentage",
+				"discount_value":  "20",
+				"max_redemptions": 100,
+				"valid_until":     "2025-02-01T00:00:00+07:00",
+				"concert_id":      concertID.String(),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeUsecase.EXPECT().
+					CreatePromoCode(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input promoCodeUsecase.CreatePromoCodeInput) (*entity.PromoCode, error) {
+						assert.Equal(t, "earlybird", input.Code)
+						assert.Equal(t, "percentage", input.DiscountType)
+						assert.Equal(t, "20", input.DiscountValue)
+						assert.Equal(t, int64(100), pointer.GetValue(input.MaxRedemptions))
+						assert.Nil(t, input.MaxRedemptionsPerSession)
+						assert.Equal(t, validUntil, input.ValidUntil.UTC())
+						assert.Equal(t, concertID.String(), pointer.GetValue(input.ConcertID))
+						return &entity.PromoCode{
+							ID:             promoCodeID,
+							Code:           "EARLYBIRD",
+							DiscountType:   entity.PromoCodeDiscountTypePercentage,
+							DiscountValue:  decimal.RequireFromString("20"),
+							MaxRedemptions: pointer.ToPointer(int64(100)),
+							ValidUntil:     &validUntil,
+							ConcertID:      &concertID,
+							CreatedAt:      createdAt,
+						}, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":              promoCodeID.String(),
+					"code":            "EARLYBIRD",
+					"discount_type":   "percentage",
+					"discount_value":  "20.00",
+					"max_redemptions": float64(100),
+					"valid_until":     "2025-02-01T00:00:00+07:00",
+					"concert_id":      concertID.String(),
+					"created_at":      "2024-12-01T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name: "missing discount value",
+			requestBody: map[string]interface{}{
+				"code":          "EARLYBIRD",
+				"discount_type": "percentage",
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name: "code already exists",
+			requestBody: map[string]interface{}{
+				"code":           "EARLYBIRD",
+				"discount_type":  "percentage",
+				"discount_value": "20",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeUsecase.EXPECT().
+					CreatePromoCode(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("promo code already exists", map[string]string{"code": "EARLYBIRD"}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "promo code already exists",
+				"data": map[string]interface{}{
+					"code": "EARLYBIRD",
+				},
+			},
+		},
+		{
+			name: "usecase internal error",
+			requestBody: map[string]interface{}{
+				"code":           "EARLYBIRD",
+				"discount_type":  "percentage",
+				"discount_value": "20",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeUsecase.EXPECT().
+					CreatePromoCode(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/admin/promo-codes").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.promoCodeHandler.CreatePromoCode(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
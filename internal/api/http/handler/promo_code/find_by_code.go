@@ -0,0 +1,41 @@
+package handler
+
+import (
+	promoCodeUsecase "ticket-reservation/internal/usecase/promo_code"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+)
+
+type findPromoCodeResponse struct {
+	promoCodeResponse
+	Redemptions int64 `json:"redemptions" example:"42"`
+}
+
+// @Summary		Find Promo Code by Code
+// @Description	Retrieve a promo code and the number of redemptions counted against its caps
+// @Tags			Promo Code
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			code	path		string																true	"Promo code"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=findPromoCodeResponse,metadata=nil}	"Promo code found"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}									"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - The user is not an admin"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}									"Promo code not found"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal server error"
+// @Router			/admin/promo-codes/{code} [get]
+func (h *promoCodeHandler) FindPromoCodeByCode(c *gin.Context) {
+	usage, err := h.promoCodeUsecase.FindOnePromoCode(c.Request.Context(), promoCodeUsecase.FindOnePromoCodeInput{
+		Code: c.Param("code"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, findPromoCodeResponse{
+		promoCodeResponse: h.newPromoCodeResponse(usage.PromoCode),
+		Redemptions:       usage.Redemptions,
+	})
+}
@@ -0,0 +1,125 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	promoCodeUsecase "ticket-reservation/internal/usecase/promo_code"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPromoCodeHandler_FindPromoCodeByCode(t *testing.T) {
+	promoCodeID := uuid.New()
+	zoneID := uuid.New()
+	createdAt := time.Date(2024, 12, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		code             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful retrieval",
+			code: "VIP300",
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeUsecase.EXPECT().
+					FindOnePromoCode(gomock.Any(), promoCodeUsecase.FindOnePromoCodeInput{Code: "VIP300"}).
+					Return(&promoCodeUsecase.PromoCodeUsage{
+						PromoCode: &entity.PromoCode{
+							ID:                       promoCodeID,
+							Code:                     "VIP300",
+							DiscountType:             entity.PromoCodeDiscountTypeFixed,
+							DiscountValue:            decimal.RequireFromString("300"),
+							MaxRedemptionsPerSession: pointer.ToPointer(int64(1)),
+							ZoneID:                   &zoneID,
+							CreatedAt:                createdAt,
+						},
+						Redemptions: 42,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":                          promoCodeID.String(),
+					"code":                        "VIP300",
+					"discount_type":               "fixed",
+					"discount_value":              "300.00",
+					"max_redemptions_per_session": float64(1),
+					"zone_id":                     zoneID.String(),
+					"created_at":                  "2024-12-01T10:00:00+07:00",
+					"redemptions":                 float64(42),
+				},
+			},
+		},
+		{
+			name: "promo code not found",
+			code: "UNKNOWN",
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeUsecase.EXPECT().
+					FindOnePromoCode(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("promo code not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "promo code not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/admin/promo-codes/:code").
+				Param("code", tt.code).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.promoCodeHandler.FindPromoCodeByCode(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
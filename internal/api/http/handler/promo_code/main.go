@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	promoCodeUsecase "ticket-reservation/internal/usecase/promo_code"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type PromoCodeHandler interface {
+	CreatePromoCode(c *gin.Context)
+	FindPromoCodeByCode(c *gin.Context)
+}
+
+type promoCodeHandler struct {
+	appConfig        config.AppConfig
+	promoCodeUsecase promoCodeUsecase.PromoCodeUsecase
+}
+
+func NewPromoCodeHandler(appConfig config.AppConfig, promoCodeUsecase promoCodeUsecase.PromoCodeUsecase) PromoCodeHandler {
+	return &promoCodeHandler{
+		appConfig:        appConfig,
+		promoCodeUsecase: promoCodeUsecase,
+	}
+}
+
+type promoCodeResponse struct {
+	ID                       string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Code                     string  `json:"code" example:"EARLYBIRD"`
+	DiscountType             string  `json:"discount_type" example:"percentage"`
+	DiscountValue            string  `json:"discount_value" example:"20.00"`
+	MaxRedemptions           *int64  `json:"max_redemptions,omitempty" example:"100"`
+	MaxRedemptionsPerSession *int64  `json:"max_redemptions_per_session,omitempty" example:"1"`
+	ValidFrom                *string `json:"valid_from,omitempty" example:"2025-01-01T00:00:00+07:00"`
+	ValidUntil               *string `json:"valid_until,omitempty" example:"2025-02-01T00:00:00+07:00"`
+	ConcertID                *string `json:"concert_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ZoneID                   *string `json:"zone_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt                string  `json:"created_at" example:"2024-12-01T10:00:00+07:00"`
+}
+
+func (h *promoCodeHandler) newPromoCodeResponse(promoCode *entity.PromoCode) promoCodeResponse {
+	if promoCode == nil {
+		return promoCodeResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := promoCodeResponse{
+		ID:                       promoCode.ID.String(),
+		Code:                     promoCode.Code,
+		DiscountType:             promoCode.DiscountType.String(),
+		DiscountValue:            promoCode.DiscountValue.StringFixed(2),
+		MaxRedemptions:           promoCode.MaxRedemptions,
+		MaxRedemptionsPerSession: promoCode.MaxRedemptionsPerSession,
+		CreatedAt:                promoCode.CreatedAt.In(loc).Format(time.RFC3339),
+	}
+	if promoCode.ValidFrom != nil {
+		response.ValidFrom = pointer.ToPointer(promoCode.ValidFrom.In(loc).Format(time.RFC3339))
+	}
+	if promoCode.ValidUntil != nil {
+		response.ValidUntil = pointer.ToPointer(promoCode.ValidUntil.In(loc).Format(time.RFC3339))
+	}
+	if promoCode.ConcertID != nil {
+		response.ConcertID = pointer.ToPointer(promoCode.ConcertID.String())
+	}
+	if promoCode.ZoneID != nil {
+		response.ZoneID = pointer.ToPointer(promoCode.ZoneID.String())
+	}
+	return response
+}
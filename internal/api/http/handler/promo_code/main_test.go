@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/promo_code"
+	"ticket-reservation/internal/config"
+	promo_code_mocks "ticket-reservation/internal/usecase/promo_code/mocks"
+)
+
+type testHelper struct {
+	ctrl                 *gomock.Controller
+	appConfig            config.AppConfig
+	mockPromoCodeUsecase *promo_code_mocks.MockPromoCodeUsecase
+	promoCodeHandler     handler.PromoCodeHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockPromoCodeUsecase := promo_code_mocks.NewMockPromoCodeUsecase(ctrl)
+
+	promoCodeHandler := handler.NewPromoCodeHandler(appConfig, mockPromoCodeUsecase)
+
+	return &testHelper{
+		ctrl:                 ctrl,
+		appConfig:            appConfig,
+		mockPromoCodeUsecase: mockPromoCodeUsecase,
+		promoCodeHandler:     promoCodeHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewPromoCodeHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockPromoCodeUsecase := promo_code_mocks.NewMockPromoCodeUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewPromoCodeHandler(appConfig, mockPromoCodeUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type CancelReservationResponse struct {
+	ReservationID string `json:"reservation_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SeatID        string `json:"seat_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status        string `json:"status" example:"cancelled"`
+	ReservedAt    string `json:"reserved_at" example:"2025-01-01T10:00:00+07:00"`
+	ExpiresAt     string `json:"expires_at" example:"2025-01-01T10:05:00+07:00"`
+}
+
+// @Summary		Cancel a Reservation
+// @Description	Cancels a pending reservation held by the signed-in user and releases its seat immediately
+// @Tags			Reservation
+// @Produce		json
+// @Param			id				path		string																	true	"Reservation ID"
+// @Success		200				{object}	httpresponse.SuccessResponse{data=CancelReservationResponse,metadata=nil}	"Reservation cancelled successfully"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}									"Bad Request - Invalid input"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403				{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - Reservation belongs to another user"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}									"Reservation not found"
+// @Failure		409				{object}	httpresponse.ErrorResponse{data=object}									"Conflict - Reservation can no longer be cancelled"
+// @Failure		429				{object}	httpresponse.ErrorResponse{data=object}								"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/reservations/{id} [delete]
+func (h *reservationHandler) CancelReservation(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	result, err := h.reservationUsecase.CancelReservation(c.Request.Context(), reservationUsecase.CancelReservationInput{
+		ReservationID: c.Param("id"),
+		SessionID:     principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newCancelReservationResponse(result))
+}
+
+func (h *reservationHandler) newCancelReservationResponse(reservation *entity.Reservation) CancelReservationResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return CancelReservationResponse{
+		ReservationID: reservation.ID.String(),
+		SeatID:        reservation.SeatID.String(),
+		Status:        reservation.Status.String(),
+		ReservedAt:    reservation.ReservedAt.In(loc).Format(time.RFC3339),
+		ExpiresAt:     reservation.ExpiresAt.In(loc).Format(time.RFC3339),
+	}
+}
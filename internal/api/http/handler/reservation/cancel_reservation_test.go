@@ -0,0 +1,180 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestReservationHandler_CancelReservation(t *testing.T) {
+	reservationID := uuid.New()
+	seatID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	reservedAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 1, 1, 3, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		principal        *entity.Principal
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:      "successful cancellation",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					CancelReservation(gomock.Any(), reservationUsecase.CancelReservationInput{
+						ReservationID: reservationID.String(),
+						SessionID:     sessionID,
+					}).
+					Return(&entity.Reservation{
+						ID:         reservationID,
+						SeatID:     seatID,
+						SessionID:  sessionID,
+						Status:     entity.ReservationStatusCancelled,
+						ReservedAt: reservedAt,
+						ExpiresAt:  expiresAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"reservation_id": reservationID.String(),
+					"seat_id":        seatID.String(),
+					"status":         "cancelled",
+					"reserved_at":    "2025-01-01T10:00:00+07:00",
+					"expires_at":     "2025-01-01T10:05:00+07:00",
+				},
+			},
+		},
+		{
+			name:           "not authenticated",
+			principal:      nil,
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:      "reservation belongs to another user",
+			principal: &entity.Principal{UserID: uuid.New()},
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					CancelReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the reservation does not belong to this session", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the reservation does not belong to this session",
+			},
+		},
+		{
+			name:      "reservation not found",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					CancelReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("reservation not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "reservation not found",
+			},
+		},
+		{
+			name:      "reservation can no longer be cancelled",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					CancelReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("the reservation can no longer be cancelled", map[string]string{"status": "confirmed"}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "the reservation can no longer be cancelled",
+				"data":    map[string]interface{}{"status": "confirmed"},
+			},
+		},
+		{
+			name:      "usecase internal error",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					CancelReservation(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			builder := testhelper.NewGinCtx(w).
+				Method(http.MethodDelete).
+				Path("/reservations/:id").
+				Param("id", reservationID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger()))
+			c := builder.MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute the handler
+			h.reservationHandler.CancelReservation(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
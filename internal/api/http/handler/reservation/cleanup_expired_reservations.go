@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CleanupExpiredReservationsResponse struct {
+	ExpiredReservations int `json:"expired_reservations" example:"12"`
+	ReleasedSeats       int `json:"released_seats" example:"12"`
+}
+
+// @Summary		Cleanup Expired Reservations
+// @Description	Expires pending reservations past their deadline and frees their seats (admin only)
+// @Tags			Reservation
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Success		200	{object}	httpresponse.SuccessResponse{data=CleanupExpiredReservationsResponse,metadata=nil}	"Expired reservations cleaned up"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}												"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}												"Forbidden - The user is not an admin"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}												"Internal Server Error - Unexpected error occurred"
+// @Router			/admin/cleanup-expired [post]
+func (h *reservationHandler) CleanupExpiredReservations(c *gin.Context) {
+	result, err := h.reservationUsecase.CleanupExpiredReservations(c.Request.Context())
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, CleanupExpiredReservationsResponse{
+		ExpiredReservations: result.ExpiredReservations,
+		ReleasedSeats:       result.ReleasedSeats,
+	})
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"encoding/base64"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// qrImageSize is the width and height in pixels of the QR code images returned with tickets
+const qrImageSize = 512
+
+type TicketResponse struct {
+	TicketID      string `json:"ticket_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ReservationID string `json:"reservation_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConcertID     string `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ZoneID        string `json:"zone_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SeatID        string `json:"seat_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ZoneName      string `json:"zone_name" example:"VIP"`
+	SeatNumber    string `json:"seat_number" example:"A1"`
+	Holder        string `json:"holder" example:"2bb80d537b1da3e3"`
+	IssuedAt      string `json:"issued_at" example:"2025-01-01T10:03:00+07:00"`
+	// Signed token encoded in the QR code, gates verify it with the public key of the ticket signing key
+	Payload string  `json:"payload" example:"eyJ0aWQiOiIxMjNlNDU2Ny1lODliLTEyZDMtYTQ1Ni00MjY2MTQxNzQwMDAifQ.c2lnbmF0dXJl"`
+	QRImage *string `json:"qr_image,omitempty" example:"data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAA..."`
+}
+
+// @Summary		Find the Tickets of a Reservation
+// @Description	Returns the tickets of a confirmed reservation held by the signed-in user. Each ticket carries a payload signed with the Ed25519 ticket signing key and its QR code image, so that gates verify tickets without calling the server
+// @Tags			Reservation
+// @Produce		json
+// @Param			id				path		string																true	"Reservation ID"
+// @Success		200				{object}	httpresponse.SuccessResponse{data=[]TicketResponse,metadata=nil}	"Tickets of the reservation"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}								"Bad Request - Invalid input"
+// @Failure		401				{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Missing or invalid access token"
+// @Failure		403				{object}	httpresponse.ErrorResponse{data=nil}								"Forbidden - Reservation belongs to another user"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}								"Reservation not found"
+// @Failure		409				{object}	httpresponse.ErrorResponse{data=object}								"Conflict - Reservation is not confirmed"
+// @Failure		429				{object}	httpresponse.ErrorResponse{data=object}							"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}								"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/reservations/{id}/tickets [get]
+func (h *reservationHandler) FindReservationTickets(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	tickets, err := h.reservationUsecase.FindReservationTickets(c.Request.Context(), reservationUsecase.FindReservationTicketsInput{
+		ReservationID: c.Param("id"),
+		SessionID:     principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	response := make([]TicketResponse, 0, len(tickets))
+	for _, ticket := range tickets {
+		response = append(response, h.newTicketResponse(ticket))
+	}
+	httpresponse.Success(c, response)
+}
+
+func (h *reservationHandler) newTicketResponse(ticket entity.Ticket) TicketResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := TicketResponse{
+		TicketID:      ticket.ID.String(),
+		ReservationID: ticket.ReservationID.String(),
+		ConcertID:     ticket.ConcertID.String(),
+		ZoneID:        ticket.ZoneID.String(),
+		SeatID:        ticket.SeatID.String(),
+		ZoneName:      ticket.ZoneName,
+		SeatNumber:    ticket.SeatNumber,
+		Holder:        ticket.Holder,
+		IssuedAt:      ticket.IssuedAt.In(loc).Format(time.RFC3339),
+		Payload:       ticket.Payload,
+	}
+	// The payload is enough to render the code, a failed rendering only leaves the image out
+	if image, err := qrcode.Encode(ticket.Payload, qrcode.Medium, qrImageSize); err == nil {
+		response.QRImage = pointer.ToPointer("data:image/png;base64," + base64.StdEncoding.EncodeToString(image))
+	}
+	return response
+}
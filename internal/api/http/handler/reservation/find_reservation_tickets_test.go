@@ -0,0 +1,190 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestReservationHandler_FindReservationTickets(t *testing.T) {
+	ticketID := uuid.New()
+	reservationID := uuid.New()
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	issuedAt := time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+		expectedTicket   map[string]interface{}
+	}{
+		{
+			name: "successful retrieval with QR images",
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					FindReservationTickets(gomock.Any(), reservationUsecase.FindReservationTicketsInput{
+						ReservationID: reservationID.String(),
+						SessionID:     sessionID,
+					}).
+					Return(entity.Tickets{
+						{
+							ID:            ticketID,
+							ReservationID: reservationID,
+							ConcertID:     concertID,
+							ZoneID:        zoneID,
+							SeatID:        seatID,
+							ZoneName:      "VIP",
+							SeatNumber:    "A1",
+							Holder:        "2bb80d537b1da3e3",
+							Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+							IssuedAt:      issuedAt,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+			},
+			expectedTicket: map[string]interface{}{
+				"ticket_id":      ticketID.String(),
+				"reservation_id": reservationID.String(),
+				"concert_id":     concertID.String(),
+				"zone_id":        zoneID.String(),
+				"seat_id":        seatID.String(),
+				"zone_name":      "VIP",
+				"seat_number":    "A1",
+				"holder":         "2bb80d537b1da3e3",
+				"payload":        "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+				"issued_at":      "2025-01-01T10:03:00+07:00",
+			},
+		},
+		{
+			name: "reservation belongs to another session",
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					FindReservationTickets(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the reservation does not belong to this session", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the reservation does not belong to this session",
+			},
+		},
+		{
+			name: "reservation not found",
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					FindReservationTickets(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("reservation not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "reservation not found",
+			},
+		},
+		{
+			name: "reservation is not confirmed",
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					FindReservationTickets(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("tickets are only issued for confirmed reservations", map[string]string{"status": "pending"}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "tickets are only issued for confirmed reservations",
+				"data":    map[string]interface{}{"status": "pending"},
+			},
+		},
+		{
+			name: "usecase internal error",
+			setupMocks: func(h *testHelper) {
+				h.mockReservationUsecase.EXPECT().
+					FindReservationTickets(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/reservations/:id/tickets").
+				Param("id", reservationID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			c.Set(middleware.PrincipalContextKey, principal)
+
+			// Execute the handler
+			h.reservationHandler.FindReservationTickets(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+
+			if tt.expectedTicket != nil {
+				data, ok := responseBody["data"].([]interface{})
+				require.True(t, ok)
+				require.Len(t, data, 1)
+				ticket := data[0].(map[string]interface{})
+				for key, expectedValue := range tt.expectedTicket {
+					assert.Equal(t, expectedValue, ticket[key], "Mismatch for key '%s'", key)
+				}
+				qrImage, _ := ticket["qr_image"].(string)
+				assert.True(t, strings.HasPrefix(qrImage, "data:image/png;base64,"), "Expected a PNG data URI, got %q", qrImage)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReservationHandler interface {
+	CancelReservation(c *gin.Context)
+	CleanupExpiredReservations(c *gin.Context)
+	FindReservationTickets(c *gin.Context)
+}
+
+type reservationHandler struct {
+	appConfig          config.AppConfig
+	reservationUsecase reservationUsecase.ReservationUsecase
+}
+
+func NewReservationHandler(appConfig config.AppConfig, reservationUsecase reservationUsecase.ReservationUsecase) ReservationHandler {
+	return &reservationHandler{
+		appConfig:          appConfig,
+		reservationUsecase: reservationUsecase,
+	}
+}
@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/reservation"
+	"ticket-reservation/internal/config"
+	reservation_mocks "ticket-reservation/internal/usecase/reservation/mocks"
+)
+
+type testHelper struct {
+	ctrl                   *gomock.Controller
+	appConfig              config.AppConfig
+	mockReservationUsecase *reservation_mocks.MockReservationUsecase
+	reservationHandler     handler.ReservationHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockReservationUsecase := reservation_mocks.NewMockReservationUsecase(ctrl)
+
+	reservationHandler := handler.NewReservationHandler(appConfig, mockReservationUsecase)
+
+	return &testHelper{
+		ctrl:                   ctrl,
+		appConfig:              appConfig,
+		mockReservationUsecase: mockReservationUsecase,
+		reservationHandler:     reservationHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewReservationHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockReservationUsecase := reservation_mocks.NewMockReservationUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewReservationHandler(appConfig, mockReservationUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
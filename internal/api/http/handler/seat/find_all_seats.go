@@ -0,0 +1,68 @@
+package handler
+
+import (
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindAllSeatsResponse struct {
+	ID          string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SeatNumber  string  `json:"seat_number" example:"A1"`
+	Status      string  `json:"status" example:"available"`
+	LockedUntil *string `json:"locked_until" example:"2025-01-01T10:05:00+07:00"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    string  `json:"currency" example:"THB"`
+}
+
+// @Summary		List Seat Map
+// @Description	List every seat in a zone with its current status and price (seats whose lock has expired are reported as available)
+// @Tags			Seat
+// @Produce		json
+// @Param			id		path		string																	true	"Concert ID"
+// @Param			zone_id	path		string																	true	"Zone ID"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=[]FindAllSeatsResponse,metadata=nil}	"Seat map of the zone"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}									"Bad Request - Invalid input"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}									"Zone not found"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}								"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Router			/concerts/{id}/zones/{zone_id}/seats [get]
+func (h *seatHandler) FindAllSeats(c *gin.Context) {
+	seatMap, err := h.seatUsecase.FindAllSeats(c.Request.Context(), seatUsecase.FindAllSeatsInput{
+		ConcertID: c.Param("id"),
+		ZoneID:    c.Param("zone_id"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newFindAllSeatsResponse(seatMap))
+}
+
+func (h *seatHandler) newFindAllSeatsResponse(seatMap *seatUsecase.SeatMap) []FindAllSeatsResponse {
+	if seatMap == nil || len(seatMap.Seats) == 0 {
+		return []FindAllSeatsResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := make([]FindAllSeatsResponse, 0, len(seatMap.Seats))
+	for _, seat := range seatMap.Seats {
+		var lockedUntil *string
+		if seat.LockedUntil != nil {
+			lockedUntil = pointer.ToPointer(seat.LockedUntil.In(loc).Format(time.RFC3339))
+		}
+		response = append(response, FindAllSeatsResponse{
+			ID:          seat.ID.String(),
+			SeatNumber:  seat.SeatNumber,
+			Status:      seat.Status.String(),
+			LockedUntil: lockedUntil,
+			Price:       formatPrice(seat.EffectivePrice(seatMap.Zone)),
+			Currency:    seatMap.Zone.Currency,
+		})
+	}
+	return response
+}
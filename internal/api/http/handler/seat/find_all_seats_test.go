@@ -0,0 +1,155 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatHandler_FindAllSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	lockedUntil := time.Date(2025, 1, 1, 3, 5, 0, 0, time.UTC)
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP", Price: pointer.ToPointer(decimal.RequireFromString("3500")), Currency: "THB"}
+	seats := entity.Seats{
+		{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable},
+		{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A2", Status: entity.SeatStatusPending, LockedUntil: &lockedUntil, Price: pointer.ToPointer(decimal.RequireFromString("4500.5"))},
+	}
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful seat map retrieval",
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					FindAllSeats(gomock.Any(), seatUsecase.FindAllSeatsInput{
+						ConcertID: concertID.String(),
+						ZoneID:    zoneID.String(),
+					}).
+					Return(&seatUsecase.SeatMap{Zone: zone, Seats: seats}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{
+					map[string]interface{}{
+						"id":           seats[0].ID.String(),
+						"seat_number":  "A1",
+						"status":       "available",
+						"locked_until": nil,
+						"price":        "3500.00",
+						"currency":     "THB",
+					},
+					map[string]interface{}{
+						"id":           seats[1].ID.String(),
+						"seat_number":  "A2",
+						"status":       "pending",
+						"locked_until": "2025-01-01T10:05:00+07:00",
+						"price":        "4500.50",
+						"currency":     "THB",
+					},
+				},
+			},
+		},
+		{
+			name: "empty seat map",
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					FindAllSeats(gomock.Any(), gomock.Any()).
+					Return(&seatUsecase.SeatMap{Zone: zone, Seats: entity.Seats{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{},
+			},
+		},
+		{
+			name: "zone not found",
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					FindAllSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "zone not found",
+			},
+		},
+		{
+			name: "usecase internal error",
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					FindAllSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/concerts/:id/zones/:zone_id/seats").
+				Param("id", concertID.String()).
+				Param("zone_id", zoneID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.seatHandler.FindAllSeats(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type GenerateSeatsRequest struct {
+	RowLabels          []string `json:"row_labels" example:"A,B,C" binding:"required"`
+	SeatsPerRow        int      `json:"seats_per_row" example:"20" binding:"required"`
+	SkippedNumbers     []int    `json:"skipped_numbers" example:"13"`
+	AislesAfter        []int    `json:"aisles_after" example:"5,15"`
+	NumberingDirection *string  `json:"numbering_direction" example:"left_to_right"`
+	Price              *string  `json:"price" example:"4500.00"`
+}
+
+type GenerateSeatsResponse struct {
+	ZoneID       string                      `json:"zone_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedCount int                         `json:"created_count" example:"60"`
+	Seats        []GenerateSeatsSeatResponse `json:"seats"`
+}
+
+type GenerateSeatsSeatResponse struct {
+	ID         string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SeatNumber string  `json:"seat_number" example:"A1"`
+	Status     string  `json:"status" example:"available"`
+	Price      *string `json:"price" example:"4500.00"`
+}
+
+// @Summary		Generate Seats
+// @Description	Bulk-create the seats of a zone from a row/column layout in a single transaction (admin, or the organizer who owns the concert)
+// @Tags			Seat
+// @Accept			json
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id		path		string																	true	"Concert ID"
+// @Param			zone_id	path		string																	true	"Zone ID"
+// @Param			request	body		GenerateSeatsRequest													true	"Seat layout (numbering_direction: left_to_right (default), right_to_left; skipped_numbers are never given to a seat; aisles_after lists the seats, counted from the left, followed by an aisle; price overrides the zone price)"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=GenerateSeatsResponse,metadata=nil}	"Seats generated"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}									"Bad Request - Invalid input"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}									"Forbidden - The concert is managed by another organizer"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}									"Concert or zone not found"
+// @Failure		409		{object}	httpresponse.ErrorResponse{data=object}									"Conflict - Some seats already exist (per-seat report in data.conflicts)"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Router			/admin/concerts/{id}/zones/{zone_id}/seats/generate [post]
+func (h *seatHandler) GenerateSeats(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var request GenerateSeatsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	numberingDirection := entity.SeatNumberingLeftToRight.String() // Default numbering direction
+	if request.NumberingDirection != nil {
+		numberingDirection = pointer.GetValue(request.NumberingDirection)
+	}
+
+	seats, err := h.seatUsecase.GenerateSeats(c.Request.Context(), seatUsecase.GenerateSeatsInput{
+		ConcertID:          c.Param("id"),
+		ZoneID:             c.Param("zone_id"),
+		RowLabels:          request.RowLabels,
+		SeatsPerRow:        request.SeatsPerRow,
+		SkippedNumbers:     request.SkippedNumbers,
+		AislesAfter:        request.AislesAfter,
+		NumberingDirection: numberingDirection,
+		Price:              request.Price,
+		Principal:          principal,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newGenerateSeatsResponse(c.Param("zone_id"), pointer.GetValue(seats)))
+}
+
+func (h *seatHandler) newGenerateSeatsResponse(zoneID string, seats entity.Seats) GenerateSeatsResponse {
+	response := GenerateSeatsResponse{
+		ZoneID:       zoneID,
+		CreatedCount: len(seats),
+		Seats:        make([]GenerateSeatsSeatResponse, 0, len(seats)),
+	}
+	for _, seat := range seats {
+		response.Seats = append(response.Seats, GenerateSeatsSeatResponse{
+			ID:         seat.ID.String(),
+			SeatNumber: seat.SeatNumber,
+			Status:     seat.Status.String(),
+			Price:      formatPrice(seat.Price),
+		})
+	}
+	return response
+}
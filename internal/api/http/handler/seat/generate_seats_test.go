@@ -0,0 +1,279 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestSeatHandler_GenerateSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seats := entity.Seats{
+		{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable},
+		{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A2", Status: entity.SeatStatusAvailable},
+	}
+
+	principal := &entity.Principal{UserID: uuid.New(), Email: "organizer@example.com", Role: entity.UserRoleOrganizer}
+
+	tests := []struct {
+		name             string
+		principal        *entity.Principal
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:      "successful seat generation with default direction",
+			principal: principal,
+			requestBody: map[string]interface{}{
+				"row_labels":    []string{"A"},
+				"seats_per_row": 2,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					GenerateSeats(gomock.Any(), seatUsecase.GenerateSeatsInput{
+						ConcertID:          concertID.String(),
+						ZoneID:             zoneID.String(),
+						RowLabels:          []string{"A"},
+						SeatsPerRow:        2,
+						NumberingDirection: "left_to_right",
+						Principal:          principal,
+					}).
+					Return(&seats, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"zone_id":       zoneID.String(),
+					"created_count": float64(2),
+					"seats": []interface{}{
+						map[string]interface{}{
+							"id":          seats[0].ID.String(),
+							"seat_number": "A1",
+							"status":      "available",
+							"price":       nil,
+						},
+						map[string]interface{}{
+							"id":          seats[1].ID.String(),
+							"seat_number": "A2",
+							"status":      "available",
+							"price":       nil,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "successful seat generation with explicit direction, skipped numbers and aisles",
+			principal: principal,
+			requestBody: map[string]interface{}{
+				"row_labels":          []string{"A", "B"},
+				"seats_per_row":       14,
+				"skipped_numbers":     []int{13},
+				"aisles_after":        []int{4, 10},
+				"numbering_direction": "right_to_left",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					GenerateSeats(gomock.Any(), seatUsecase.GenerateSeatsInput{
+						ConcertID:          concertID.String(),
+						ZoneID:             zoneID.String(),
+						RowLabels:          []string{"A", "B"},
+						SeatsPerRow:        14,
+						SkippedNumbers:     []int{13},
+						AislesAfter:        []int{4, 10},
+						NumberingDirection: "right_to_left",
+						Principal:          principal,
+					}).
+					Return(&entity.Seats{}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+			},
+		},
+		{
+			name:        "invalid JSON body",
+			principal:   principal,
+			requestBody: "invalid-json",
+			setupMocks: func(h *testHelper) {
+				// No usecase call expected
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:      "missing required fields",
+			principal: principal,
+			requestBody: map[string]interface{}{
+				"skipped_numbers": []int{13},
+			},
+			setupMocks: func(h *testHelper) {
+				// No usecase call expected
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:      "seats already exist",
+			principal: principal,
+			requestBody: map[string]interface{}{
+				"row_labels":    []string{"A"},
+				"seats_per_row": 2,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					GenerateSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewSeatsAlreadyExistError([]errs.SeatConflict{
+						{SeatNumber: "A2", Reason: "the seat already exists in the zone"},
+					}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403003",
+				"message": "some seats already exist in the zone.",
+				"data": map[string]interface{}{
+					"conflicts": []interface{}{
+						map[string]interface{}{
+							"seat_number": "A2",
+							"reason":      "the seat already exists in the zone",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "zone not found",
+			principal: principal,
+			requestBody: map[string]interface{}{
+				"row_labels":    []string{"A"},
+				"seats_per_row": 2,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					GenerateSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "zone not found",
+			},
+		},
+		{
+			name:      "usecase internal error",
+			principal: principal,
+			requestBody: map[string]interface{}{
+				"row_labels":    []string{"A"},
+				"seats_per_row": 2,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					GenerateSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+		{
+			name:        "not authenticated",
+			principal:   nil,
+			requestBody: map[string]interface{}{"row_labels": []string{"A"}, "seats_per_row": 2},
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected without a principal
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:        "concert managed by another organizer",
+			principal:   principal,
+			requestBody: map[string]interface{}{"row_labels": []string{"A"}, "seats_per_row": 2},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					GenerateSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the concert is managed by another organizer", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the concert is managed by another organizer",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters and body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/admin/concerts/:id/zones/:zone_id/seats/generate").
+				Param("id", concertID.String()).
+				Param("zone_id", zoneID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute the handler
+			h.seatHandler.GenerateSeats(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -5,10 +5,16 @@ import (
 	seatUsecase "ticket-reservation/internal/usecase/seat"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 )
 
 type SeatHandler interface {
 	ReserveSeat(c *gin.Context)
+	ReserveSeats(c *gin.Context)
+	ReserveBestAvailableSeats(c *gin.Context)
+	FindAllSeats(c *gin.Context)
+	GenerateSeats(c *gin.Context)
+	StreamSeatStatus(c *gin.Context)
 }
 
 type seatHandler struct {
@@ -22,3 +28,12 @@ func NewSeatHandler(appConfig config.AppConfig, seatUsecase seatUsecase.SeatUsec
 		seatUsecase: seatUsecase,
 	}
 }
+
+// formatPrice formats a price with 2 decimal places, nil stays nil for seats that are not priced.
+func formatPrice(price *decimal.Decimal) *string {
+	if price == nil {
+		return nil
+	}
+	formatted := price.StringFixed(2)
+	return &formatted
+}
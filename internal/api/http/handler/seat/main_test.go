@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/seat"
+	"ticket-reservation/internal/config"
+	seat_mocks "ticket-reservation/internal/usecase/seat/mocks"
+)
+
+type testHelper struct {
+	ctrl            *gomock.Controller
+	appConfig       config.AppConfig
+	mockSeatUsecase *seat_mocks.MockSeatUsecase
+	seatHandler     handler.SeatHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockSeatUsecase := seat_mocks.NewMockSeatUsecase(ctrl)
+
+	seatHandler := handler.NewSeatHandler(appConfig, mockSeatUsecase)
+
+	return &testHelper{
+		ctrl:            ctrl,
+		appConfig:       appConfig,
+		mockSeatUsecase: mockSeatUsecase,
+		seatHandler:     seatHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewSeatHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockSeatUsecase := seat_mocks.NewMockSeatUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewSeatHandler(appConfig, mockSeatUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type ReserveBestAvailableSeatsRequest struct {
+	Quantity   int     `json:"quantity" example:"2" binding:"required"`
+	Preference *string `json:"preference" example:"centre"`
+}
+
+type ReserveBestAvailableSeatsResponse struct {
+	GroupID      string                      `json:"group_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Seats        []BestAvailableSeatResponse `json:"seats"`
+	Reservations []ReserveSeatResponse       `json:"reservations"`
+}
+
+type BestAvailableSeatResponse struct {
+	ID         string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SeatNumber string `json:"seat_number" example:"A1"`
+}
+
+// @Summary		Reserve Best Available Seats
+// @Description	Picks the given number of adjacent seats in the same row of a zone and reserves them for the signed-in user
+// @Tags			Seat
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string																				true	"Concert ID"
+// @Param			zone_id	path		string																				true	"Zone ID"
+// @Param			request	body		ReserveBestAvailableSeatsRequest													true	"Reservation Request (preference: centre (default), front)"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=ReserveBestAvailableSeatsResponse,metadata=nil}	"Seats reserved successfully"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}												"Bad Request - Invalid input"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}												"Unauthorized - Missing or invalid access token"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}												"Concert or zone not found"
+// @Failure		409		{object}	httpresponse.ErrorResponse{data=object}												"Conflict - No adjacent seats are available"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}											"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}												"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/concerts/{id}/zones/{zone_id}/best-available [post]
+func (h *seatHandler) ReserveBestAvailableSeats(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var request ReserveBestAvailableSeatsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	preference := entity.SeatPreferenceCentre.String() // Default seat preference
+	if request.Preference != nil {
+		preference = pointer.GetValue(request.Preference)
+	}
+
+	result, err := h.seatUsecase.ReserveBestAvailableSeats(c.Request.Context(), seatUsecase.ReserveBestAvailableSeatsInput{
+		ConcertID:  c.Param("id"),
+		ZoneID:     c.Param("zone_id"),
+		Quantity:   request.Quantity,
+		Preference: preference,
+		SessionID:  principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newReserveBestAvailableSeatsResponse(result))
+}
+
+func (h *seatHandler) newReserveBestAvailableSeatsResponse(result *seatUsecase.BestAvailableSeats) ReserveBestAvailableSeatsResponse {
+	reservations := h.newReserveSeatsResponse(result.Reservations)
+	response := ReserveBestAvailableSeatsResponse{
+		GroupID:      reservations.GroupID,
+		Seats:        make([]BestAvailableSeatResponse, 0, len(result.Seats)),
+		Reservations: reservations.Reservations,
+	}
+	for _, seat := range result.Seats {
+		response.Seats = append(response.Seats, BestAvailableSeatResponse{
+			ID:         seat.ID.String(),
+			SeatNumber: seat.SeatNumber,
+		})
+	}
+	return response
+}
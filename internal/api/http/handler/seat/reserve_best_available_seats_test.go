@@ -0,0 +1,211 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatHandler_ReserveBestAvailableSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	groupID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	reservedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := reservedAt.Add(5 * time.Minute)
+	price := decimal.RequireFromString("3500.00")
+	seats := entity.Seats{
+		{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "B3", Status: entity.SeatStatusAvailable},
+		{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "B4", Status: entity.SeatStatusAvailable},
+	}
+	result := &seatUsecase.BestAvailableSeats{
+		Seats: seats,
+		Reservations: entity.Reservations{
+			{ID: uuid.New(), SeatID: seats[0].ID, GroupID: &groupID, SessionID: sessionID, Status: entity.ReservationStatusPending, ReservedAt: reservedAt, ExpiresAt: expiresAt, Price: &price, Currency: pointer.ToPointer("THB")},
+			{ID: uuid.New(), SeatID: seats[1].ID, GroupID: &groupID, SessionID: sessionID, Status: entity.ReservationStatusPending, ReservedAt: reservedAt, ExpiresAt: expiresAt, Price: &price, Currency: pointer.ToPointer("THB")},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful reservation with default preference",
+			requestBody: map[string]interface{}{
+				"quantity": 2,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveBestAvailableSeats(gomock.Any(), seatUsecase.ReserveBestAvailableSeatsInput{
+						ConcertID:  concertID.String(),
+						ZoneID:     zoneID.String(),
+						Quantity:   2,
+						Preference: "centre",
+						SessionID:  sessionID,
+					}).
+					Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"group_id": groupID.String(),
+					"seats": []interface{}{
+						map[string]interface{}{"id": seats[0].ID.String(), "seat_number": "B3"},
+						map[string]interface{}{"id": seats[1].ID.String(), "seat_number": "B4"},
+					},
+					"reservations": []interface{}{
+						map[string]interface{}{
+							"reservation_id": result.Reservations[0].ID.String(),
+							"seat_id":        seats[0].ID.String(),
+							"status":         "pending",
+							"reserved_at":    "2025-01-01T17:00:00+07:00",
+							"expires_at":     "2025-01-01T17:05:00+07:00",
+							"price":          "3500.00",
+							"currency":       "THB",
+						},
+						map[string]interface{}{
+							"reservation_id": result.Reservations[1].ID.String(),
+							"seat_id":        seats[1].ID.String(),
+							"status":         "pending",
+							"reserved_at":    "2025-01-01T17:00:00+07:00",
+							"expires_at":     "2025-01-01T17:05:00+07:00",
+							"price":          "3500.00",
+							"currency":       "THB",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "explicit front preference",
+			requestBody: map[string]interface{}{
+				"quantity":   2,
+				"preference": "front",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveBestAvailableSeats(gomock.Any(), seatUsecase.ReserveBestAvailableSeatsInput{
+						ConcertID:  concertID.String(),
+						ZoneID:     zoneID.String(),
+						Quantity:   2,
+						Preference: "front",
+						SessionID:  sessionID,
+					}).
+					Return(result, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+			},
+		},
+		{
+			name:           "missing quantity",
+			requestBody:    map[string]interface{}{},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name: "no adjacent seats available",
+			requestBody: map[string]interface{}{
+				"quantity": 8,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveBestAvailableSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("no adjacent seats are available", map[string]interface{}{"quantity": 8}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "no adjacent seats are available",
+			},
+		},
+		{
+			name: "usecase internal error",
+			requestBody: map[string]interface{}{
+				"quantity": 2,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveBestAvailableSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters and body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/concerts/:id/zones/:zone_id/best-available").
+				Param("id", concertID.String()).
+				Param("zone_id", zoneID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			c.Set(middleware.PrincipalContextKey, principal)
+
+			// Execute the handler
+			h.seatHandler.ReserveBestAvailableSeats(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
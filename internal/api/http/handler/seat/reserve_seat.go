@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"ticket-reservation/internal/api/http/middleware"
 	"ticket-reservation/internal/domain/entity"
 	seatUsecase "ticket-reservation/internal/usecase/seat"
 	"ticket-reservation/internal/util/httpresponse"
@@ -10,37 +11,37 @@ import (
 	errsFramework "github.com/kittipat1413/go-common/framework/errors"
 )
 
-type ReserveSeatRequest struct {
-	SessionID string `json:"session_id" binding:"required"`
-}
-
 type ReserveSeatResponse struct {
-	ReservationID string `json:"reservation_id"`
-	SeatID        string `json:"seat_id"`
-	Status        string `json:"status"`
-	ReservedAt    string `json:"reserved_at"`
-	ExpiresAt     string `json:"expires_at"`
+	ReservationID string  `json:"reservation_id"`
+	SeatID        string  `json:"seat_id"`
+	Status        string  `json:"status"`
+	ReservedAt    string  `json:"reserved_at"`
+	ExpiresAt     string  `json:"expires_at"`
+	Price         *string `json:"price"`
+	Currency      *string `json:"currency"`
 }
 
 // @Summary		Reserve a Seat
-// @Description	Reserves a seat for a concert by locking it for the current session
+// @Description	Reserves a seat for a concert by locking it for the signed-in user
 // @Tags			Seat
 // @Accept			json
 // @Produce		json
 // @Param			id			path		string																true	"Concert ID"
 // @Param			zone_id		path		string																true	"Zone ID"
 // @Param			seat_number	path		string																true	"Seat Number"
-// @Param			request		body		ReserveSeatRequest													true	"Reservation Request"
 // @Success		200			{object}	httpresponse.SuccessResponse{data=ReserveSeatResponse,metadata=nil}	"Seat reserved successfully"
 // @Failure		400			{object}	httpresponse.ErrorResponse{data=nil}								"Bad Request - Invalid input"
+// @Failure		401			{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Missing or invalid access token"
 // @Failure		409			{object}	httpresponse.ErrorResponse{data=nil}								"Conflict - Seat already reserved"
+// @Failure		429			{object}	httpresponse.ErrorResponse{data=object}							"Too Many Requests - Rate limit exceeded, see Retry-After"
 // @Failure		500			{object}	httpresponse.ErrorResponse{data=nil}								"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
 // @Router			/concerts/{id}/zones/{zone_id}/seats/{seat_number}/reserve [post]
 func (h *seatHandler) ReserveSeat(c *gin.Context) {
-	var request ReserveSeatRequest
-	if err := c.ShouldBindJSON(&request); err != nil {
-		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
-		httpresponse.Error(c, err)
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
 		return
 	}
 
@@ -48,7 +49,7 @@ func (h *seatHandler) ReserveSeat(c *gin.Context) {
 		ConcertID: c.Param("id"),
 		ZoneID:    c.Param("zone_id"),
 		SeatID:    c.Param("seat_id"),
-		SessionID: request.SessionID,
+		SessionID: principal.SessionID(),
 	})
 
 	if err != nil {
@@ -67,5 +68,7 @@ func (h *seatHandler) newReserveSeatResponse(reservation *entity.Reservation) Re
 		Status:        reservation.Status.String(),
 		ReservedAt:    reservation.ReservedAt.In(loc).Format(time.RFC3339),
 		ExpiresAt:     reservation.ExpiresAt.In(loc).Format(time.RFC3339),
+		Price:         formatPrice(reservation.Price),
+		Currency:      reservation.Currency,
 	}
 }
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type ReserveSeatsRequest struct {
+	SeatIDs []string `json:"seat_ids" binding:"required"`
+}
+
+type ReserveSeatsResponse struct {
+	GroupID      string                `json:"group_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Reservations []ReserveSeatResponse `json:"reservations"`
+}
+
+// @Summary		Reserve Multiple Seats
+// @Description	Reserves up to 10 seats of a zone at once for the signed-in user; either every seat is reserved or none is
+// @Tags			Seat
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string																	true	"Concert ID"
+// @Param			zone_id	path		string																	true	"Zone ID"
+// @Param			request	body		ReserveSeatsRequest														true	"Reservation Request"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=ReserveSeatsResponse,metadata=nil}	"Seats reserved successfully"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}									"Bad Request - Invalid input"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}									"Unauthorized - Missing or invalid access token"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}									"Concert or zone not found"
+// @Failure		409		{object}	httpresponse.ErrorResponse{data=object}									"Conflict - Some seats cannot be reserved (per-seat report in data.conflicts)"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}								"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}									"Internal Server Error - Unexpected error occurred"
+// @Security		ApiKeyAuth
+// @Router			/concerts/{id}/zones/{zone_id}/reservations [post]
+func (h *seatHandler) ReserveSeats(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var request ReserveSeatsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	reservations, err := h.seatUsecase.ReserveSeats(c.Request.Context(), seatUsecase.ReserveSeatsInput{
+		ConcertID: c.Param("id"),
+		ZoneID:    c.Param("zone_id"),
+		SeatIDs:   request.SeatIDs,
+		SessionID: principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newReserveSeatsResponse(pointer.GetValue(reservations)))
+}
+
+func (h *seatHandler) newReserveSeatsResponse(reservations entity.Reservations) ReserveSeatsResponse {
+	response := ReserveSeatsResponse{
+		Reservations: make([]ReserveSeatResponse, 0, len(reservations)),
+	}
+	for _, reservation := range reservations {
+		if reservation.GroupID != nil {
+			response.GroupID = reservation.GroupID.String()
+		}
+		response.Reservations = append(response.Reservations, h.newReserveSeatResponse(&reservation))
+	}
+	return response
+}
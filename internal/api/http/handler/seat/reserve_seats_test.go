@@ -0,0 +1,190 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/pkg/testhelper"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatHandler_ReserveSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	groupID := uuid.New()
+	firstSeatID := uuid.New()
+	secondSeatID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "fan@example.com"}
+	sessionID := principal.SessionID()
+	reservedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := reservedAt.Add(5 * time.Minute)
+	price := decimal.RequireFromString("3500.00")
+	reservations := entity.Reservations{
+		{ID: uuid.New(), SeatID: firstSeatID, GroupID: &groupID, SessionID: sessionID, Status: entity.ReservationStatusPending, ReservedAt: reservedAt, ExpiresAt: expiresAt, Price: &price, Currency: pointer.ToPointer("THB")},
+		{ID: uuid.New(), SeatID: secondSeatID, GroupID: &groupID, SessionID: sessionID, Status: entity.ReservationStatusPending, ReservedAt: reservedAt, ExpiresAt: expiresAt, Price: &price, Currency: pointer.ToPointer("THB")},
+	}
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful reservation of multiple seats",
+			requestBody: map[string]interface{}{
+				"seat_ids": []string{firstSeatID.String(), secondSeatID.String()},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveSeats(gomock.Any(), seatUsecase.ReserveSeatsInput{
+						ConcertID: concertID.String(),
+						ZoneID:    zoneID.String(),
+						SeatIDs:   []string{firstSeatID.String(), secondSeatID.String()},
+						SessionID: sessionID,
+					}).
+					Return(&reservations, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"group_id": groupID.String(),
+					"reservations": []interface{}{
+						map[string]interface{}{
+							"reservation_id": reservations[0].ID.String(),
+							"seat_id":        firstSeatID.String(),
+							"status":         "pending",
+							"reserved_at":    "2025-01-01T17:00:00+07:00",
+							"expires_at":     "2025-01-01T17:05:00+07:00",
+							"price":          "3500.00",
+							"currency":       "THB",
+						},
+						map[string]interface{}{
+							"reservation_id": reservations[1].ID.String(),
+							"seat_id":        secondSeatID.String(),
+							"status":         "pending",
+							"reserved_at":    "2025-01-01T17:00:00+07:00",
+							"expires_at":     "2025-01-01T17:05:00+07:00",
+							"price":          "3500.00",
+							"currency":       "THB",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "missing seat IDs",
+			requestBody:    map[string]interface{}{},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name: "some seats cannot be reserved",
+			requestBody: map[string]interface{}{
+				"seat_ids": []string{firstSeatID.String(), secondSeatID.String()},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errs.NewSeatsUnavailableError([]errs.SeatReservationConflict{
+						{SeatID: secondSeatID.String(), SeatNumber: "A2", Reason: errs.SeatConflictReasonBooked},
+					}))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403004",
+				"message": "some seats could not be reserved.",
+				"data": map[string]interface{}{
+					"conflicts": []interface{}{
+						map[string]interface{}{
+							"seat_id":     secondSeatID.String(),
+							"seat_number": "A2",
+							"reason":      "booked",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "usecase internal error",
+			requestBody: map[string]interface{}{
+				"seat_ids": []string{firstSeatID.String()},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockSeatUsecase.EXPECT().
+					ReserveSeats(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters and body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/concerts/:id/zones/:zone_id/reservations").
+				Param("id", concertID.String()).
+				Param("zone_id", zoneID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+			// User authenticated by the Authenticate middleware
+			c.Set(middleware.PrincipalContextKey, principal)
+
+			// Execute the handler
+			h.seatHandler.ReserveSeats(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
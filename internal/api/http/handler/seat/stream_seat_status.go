@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+const (
+	// LastEventIDHeader is sent by browsers reconnecting an event stream, it carries the version of the last event received
+	LastEventIDHeader = "Last-Event-ID"
+
+	SeatStatusMessageReady      = "ready"       // The client is up to date, live events follow
+	SeatStatusMessageSeatStatus = "seat_status" // A seat changed status
+	SeatStatusMessageReset      = "reset"       // Events were lost, the client must reload the seat map
+	SeatStatusMessageHeartbeat  = "heartbeat"   // Nothing happened for a while, the stream is still alive
+	SeatStatusMessageLagging    = "lagging"     // The client fell behind and is disconnected, it must reconnect from the version
+
+	// seatStatusWriteTimeout is how long a message may take to reach the client before it is disconnected
+	seatStatusWriteTimeout = 10 * time.Second
+)
+
+var seatStatusUpgrader = websocket.Upgrader{
+	// The seat map is public and the stream carries no credentials, so it is open to pages of any origin
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type StreamSeatStatusQuery struct {
+	SinceVersion *int64 `form:"since_version"`
+}
+
+type seatStatusMessage struct {
+	Type        string  `json:"type" example:"seat_status"`
+	Version     int64   `json:"version" example:"42"`
+	SeatID      *string `json:"seat_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SeatNumber  *string `json:"seat_number,omitempty" example:"A1"`
+	Status      *string `json:"status,omitempty" example:"pending"`
+	LockedUntil *string `json:"locked_until,omitempty" example:"2025-01-01T10:05:00+07:00"`
+	OccurredAt  *string `json:"occurred_at,omitempty" example:"2025-01-01T10:00:00+07:00"`
+}
+
+// @Summary		Stream Seat Status
+// @Description	Streams the status changes of the seats of a zone as Server-Sent Events, or over a WebSocket when the request is an upgrade.
+// @Description	Every message is a JSON object with a type: "seat_status" for a seat changing status, "ready" once the client is up to date,
+// @Description	"reset" when events were lost and the seat map must be reloaded, "heartbeat" when nothing happened for a while and
+// @Description	"lagging" right before a client too slow to keep up is disconnected. Events are numbered by version:
+// @Description	a client resumes after the last version it received with since_version, or with Last-Event-ID as browsers do for event streams.
+// @Tags			Seat
+// @Produce		text/event-stream
+// @Param			id				path		string									true	"Concert ID"
+// @Param			zone_id			path		string									true	"Zone ID"
+// @Param			since_version	query		int64									false	"Version of the last event received, omit to start from now"
+// @Param			Last-Event-ID	header		string									false	"Version of the last event received, sent by browsers reconnecting an event stream"
+// @Success		200				{object}	seatStatusMessage						"Stream of seat status messages"
+// @Failure		400				{object}	httpresponse.ErrorResponse{data=nil}	"Bad Request - Invalid input"
+// @Failure		404				{object}	httpresponse.ErrorResponse{data=nil}	"Zone not found"
+// @Failure		429				{object}	httpresponse.ErrorResponse{data=object}	"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500				{object}	httpresponse.ErrorResponse{data=nil}	"Internal Server Error - Unexpected error occurred"
+// @Router			/concerts/{id}/zones/{zone_id}/seats/stream [get]
+func (h *seatHandler) StreamSeatStatus(c *gin.Context) {
+	var query StreamSeatStatusQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+	if lastEventID := c.GetHeader(LastEventIDHeader); query.SinceVersion == nil && lastEventID != "" {
+		version, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid Last-Event-ID", nil))
+			httpresponse.Error(c, err)
+			return
+		}
+		query.SinceVersion = &version
+	}
+
+	// Errors up to here are answered as usual, the stream only starts once the client is known to be up to date
+	stream, err := h.seatUsecase.StreamSeatStatus(c.Request.Context(), seatUsecase.StreamSeatStatusInput{
+		ConcertID:    c.Param("id"),
+		ZoneID:       c.Param("zone_id"),
+		SinceVersion: query.SinceVersion,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+	defer stream.Close()
+
+	ctx := c.Request.Context()
+	var writer seatStatusWriter
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		conn, err := seatStatusUpgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			// The upgrader has already answered with an HTTP error
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		writer = newWebSocketSeatStatusWriter(conn, h.appConfig.SeatStreamHeartbeat, cancel)
+	} else {
+		writer = newEventStreamSeatStatusWriter(c)
+	}
+	defer writer.Close()
+
+	h.sendSeatStatus(ctx, stream, writer)
+}
+
+// sendSeatStatus writes the updates of the stream until the client is gone or falls behind.
+func (h *seatHandler) sendSeatStatus(ctx context.Context, stream seatUsecase.SeatStatusStream, writer seatStatusWriter) {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	var version int64
+	for ready := false; ; ready = true {
+		update, err := stream.Next(ctx)
+		if err != nil {
+			if errors.Is(err, entity.ErrSeatEventSubscriberTooSlow) {
+				_ = writer.Write(seatStatusMessage{Type: SeatStatusMessageLagging, Version: version})
+			} else if ctx.Err() == nil {
+				logger.FromContext(ctx).Error(ctx, "failed to stream seat status", err, nil)
+			}
+			return
+		}
+		version = update.Version
+
+		for _, message := range newSeatStatusMessages(update, !ready, loc) {
+			if err := writer.Write(message); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// newSeatStatusMessages returns the messages telling the client about the update, the first update of a stream
+// is followed by a ready message.
+func newSeatStatusMessages(update *seatUsecase.SeatStatusUpdate, first bool, loc *time.Location) []seatStatusMessage {
+	switch {
+	case update.Heartbeat:
+		return []seatStatusMessage{{Type: SeatStatusMessageHeartbeat, Version: update.Version}}
+	case update.Reset:
+		messages := []seatStatusMessage{{Type: SeatStatusMessageReset, Version: update.Version}}
+		if first {
+			messages = append(messages, seatStatusMessage{Type: SeatStatusMessageReady, Version: update.Version})
+		}
+		return messages
+	}
+
+	messages := make([]seatStatusMessage, 0, len(update.Events)+1)
+	for _, event := range update.Events {
+		var lockedUntil *string
+		if event.LockedUntil != nil {
+			lockedUntil = pointer.ToPointer(event.LockedUntil.In(loc).Format(time.RFC3339))
+		}
+		messages = append(messages, seatStatusMessage{
+			Type:        SeatStatusMessageSeatStatus,
+			Version:     event.Version,
+			SeatID:      pointer.ToPointer(event.SeatID.String()),
+			SeatNumber:  pointer.ToPointer(event.SeatNumber),
+			Status:      pointer.ToPointer(event.Status.String()),
+			LockedUntil: lockedUntil,
+			OccurredAt:  pointer.ToPointer(event.OccurredAt.In(loc).Format(time.RFC3339)),
+		})
+	}
+	if first {
+		messages = append(messages, seatStatusMessage{Type: SeatStatusMessageReady, Version: update.Version})
+	}
+	return messages
+}
+
+// seatStatusWriter sends the messages of a seat status stream over one transport.
+type seatStatusWriter interface {
+	Write(message seatStatusMessage) error
+	Close()
+}
+
+// eventStreamSeatStatusWriter sends the messages as Server-Sent Events named by their type. The messages a client
+// resumes after carry their version as event ID, so that browsers send it back in Last-Event-ID when reconnecting.
+type eventStreamSeatStatusWriter struct {
+	c          *gin.Context
+	controller *http.ResponseController
+}
+
+func newEventStreamSeatStatusWriter(c *gin.Context) *eventStreamSeatStatusWriter {
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no") // Keep reverse proxies from buffering the stream
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	return &eventStreamSeatStatusWriter{
+		c:          c,
+		controller: http.NewResponseController(c.Writer),
+	}
+}
+
+func (w *eventStreamSeatStatusWriter) Write(message seatStatusMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	// Not every writer supports deadlines, the stream then ends when the client goes away
+	_ = w.controller.SetWriteDeadline(time.Now().Add(seatStatusWriteTimeout))
+	if message.Type != SeatStatusMessageHeartbeat && message.Type != SeatStatusMessageLagging {
+		if _, err := fmt.Fprintf(w.c.Writer, "id: %d\n", message.Version); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w.c.Writer, "event: %s\ndata: %s\n\n", message.Type, data); err != nil {
+		return err
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+func (w *eventStreamSeatStatusWriter) Close() {}
+
+// webSocketSeatStatusWriter sends the messages as JSON text messages. Heartbeats are also sent as pings,
+// and the connection is cancelled when the client stops answering them or closes it.
+type webSocketSeatStatusWriter struct {
+	conn *websocket.Conn
+}
+
+func newWebSocketSeatStatusWriter(conn *websocket.Conn, heartbeatInterval time.Duration, cancel context.CancelFunc) *webSocketSeatStatusWriter {
+	// The client answers pings with pongs, a client silent for two heartbeats is gone
+	extendReadDeadline := func() {
+		if heartbeatInterval > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(2*heartbeatInterval + seatStatusWriteTimeout))
+		}
+	}
+	extendReadDeadline()
+	conn.SetPongHandler(func(string) error {
+		extendReadDeadline()
+		return nil
+	})
+
+	// Reading handles the pongs and the close of the client, the messages of the client are ignored
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	return &webSocketSeatStatusWriter{conn: conn}
+}
+
+func (w *webSocketSeatStatusWriter) Write(message seatStatusMessage) error {
+	deadline := time.Now().Add(seatStatusWriteTimeout)
+	if message.Type == SeatStatusMessageHeartbeat {
+		if err := w.conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+			return err
+		}
+	}
+	_ = w.conn.SetWriteDeadline(deadline)
+	return w.conn.WriteJSON(message)
+}
+
+func (w *webSocketSeatStatusWriter) Close() {
+	deadline := time.Now().Add(seatStatusWriteTimeout)
+	_ = w.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline)
+	_ = w.conn.Close()
+}
@@ -0,0 +1,236 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	seatUsecase "ticket-reservation/internal/usecase/seat"
+	seat_mocks "ticket-reservation/internal/usecase/seat/mocks"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatHandler_StreamSeatStatus(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	occurredAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	lockedUntil := occurredAt.Add(5 * time.Minute)
+	pendingEvent := entity.SeatStatusEvent{
+		Version:     41,
+		ConcertID:   concertID,
+		ZoneID:      zoneID,
+		SeatID:      seatID,
+		SeatNumber:  "A1",
+		Status:      entity.SeatStatusPending,
+		LockedUntil: &lockedUntil,
+		OccurredAt:  occurredAt,
+	}
+	input := func(sinceVersion *int64) seatUsecase.StreamSeatStatusInput {
+		return seatUsecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String(), SinceVersion: sinceVersion}
+	}
+	// expectUpdates makes the stream return the updates, then the error
+	expectUpdates := func(stream *seat_mocks.MockSeatStatusStream, err error, updates ...*seatUsecase.SeatStatusUpdate) {
+		calls := make([]*gomock.Call, 0, len(updates)+1)
+		for _, update := range updates {
+			calls = append(calls, stream.EXPECT().Next(gomock.Any()).Return(update, nil))
+		}
+		calls = append(calls, stream.EXPECT().Next(gomock.Any()).Return(nil, err))
+		gomock.InOrder(calls...)
+		stream.EXPECT().Close()
+	}
+
+	tests := []struct {
+		name           string
+		query          map[string]any
+		headers        map[string]string
+		setupMocks     func(h *testHelper, stream *seat_mocks.MockSeatStatusStream)
+		expectedStatus int
+		expectedBody   string
+		expectedCode   string
+	}{
+		{
+			name: "stream from now",
+			setupMocks: func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {
+				h.mockSeatUsecase.EXPECT().StreamSeatStatus(gomock.Any(), input(nil)).Return(stream, nil)
+				expectUpdates(stream, context.Canceled,
+					&seatUsecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 40},
+					&seatUsecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{pendingEvent}, Version: 41},
+					&seatUsecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 41, Heartbeat: true},
+				)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "id: 40\nevent: ready\ndata: {\"type\":\"ready\",\"version\":40}\n\n" +
+				"id: 41\nevent: seat_status\ndata: {\"type\":\"seat_status\",\"version\":41,\"seat_id\":\"" + seatID.String() + "\",\"seat_number\":\"A1\",\"status\":\"pending\",\"locked_until\":\"2025-01-01T10:05:00+07:00\",\"occurred_at\":\"2025-01-01T10:00:00+07:00\"}\n\n" +
+				"event: heartbeat\ndata: {\"type\":\"heartbeat\",\"version\":41}\n\n",
+		},
+		{
+			name:    "resume from Last-Event-ID",
+			headers: map[string]string{"Last-Event-ID": "40"},
+			setupMocks: func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {
+				h.mockSeatUsecase.EXPECT().StreamSeatStatus(gomock.Any(), input(pointer.ToPointer(int64(40)))).Return(stream, nil)
+				expectUpdates(stream, context.Canceled,
+					&seatUsecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{pendingEvent}, Version: 41},
+				)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "id: 41\nevent: seat_status\ndata: {\"type\":\"seat_status\",\"version\":41,\"seat_id\":\"" + seatID.String() + "\",\"seat_number\":\"A1\",\"status\":\"pending\",\"locked_until\":\"2025-01-01T10:05:00+07:00\",\"occurred_at\":\"2025-01-01T10:00:00+07:00\"}\n\n" +
+				"id: 41\nevent: ready\ndata: {\"type\":\"ready\",\"version\":41}\n\n",
+		},
+		{
+			name:    "since_version takes precedence over Last-Event-ID and a lost history resets the client",
+			query:   map[string]any{"since_version": 1},
+			headers: map[string]string{"Last-Event-ID": "40"},
+			setupMocks: func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {
+				h.mockSeatUsecase.EXPECT().StreamSeatStatus(gomock.Any(), input(pointer.ToPointer(int64(1)))).Return(stream, nil)
+				expectUpdates(stream, context.Canceled,
+					&seatUsecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 2000, Reset: true},
+				)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "id: 2000\nevent: reset\ndata: {\"type\":\"reset\",\"version\":2000}\n\n" +
+				"id: 2000\nevent: ready\ndata: {\"type\":\"ready\",\"version\":2000}\n\n",
+		},
+		{
+			name: "slow client is told to reconnect",
+			setupMocks: func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {
+				h.mockSeatUsecase.EXPECT().StreamSeatStatus(gomock.Any(), input(nil)).Return(stream, nil)
+				expectUpdates(stream, entity.ErrSeatEventSubscriberTooSlow,
+					&seatUsecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 40},
+				)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "id: 40\nevent: ready\ndata: {\"type\":\"ready\",\"version\":40}\n\n" +
+				"event: lagging\ndata: {\"type\":\"lagging\",\"version\":40}\n\n",
+		},
+		{
+			name:           "invalid since_version",
+			query:          map[string]any{"since_version": "abc"},
+			setupMocks:     func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "ERR-401000",
+		},
+		{
+			name:           "invalid Last-Event-ID",
+			headers:        map[string]string{"Last-Event-ID": "abc"},
+			setupMocks:     func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "ERR-401000",
+		},
+		{
+			name: "zone not found",
+			setupMocks: func(h *testHelper, stream *seat_mocks.MockSeatStatusStream) {
+				h.mockSeatUsecase.EXPECT().StreamSeatStatus(gomock.Any(), input(nil)).Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "ERR-402000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+			stream := seat_mocks.NewMockSeatStatusStream(h.ctrl)
+			tt.setupMocks(h, stream)
+
+			w := httptest.NewRecorder()
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/concerts/:id/zones/:zone_id/seats/stream").
+				Param("id", concertID.String()).
+				Param("zone_id", zoneID.String()).
+				Queries(tt.query).
+				Headers(tt.headers).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute
+			h.seatHandler.StreamSeatStatus(c)
+
+			// Assert
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedCode != "" {
+				var responseBody map[string]interface{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &responseBody))
+				assert.Equal(t, tt.expectedCode, responseBody["code"])
+				return
+			}
+			assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestSeatHandler_StreamSeatStatus_WebSocket(t *testing.T) {
+	h := initTest(t)
+	defer h.Done()
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	stream := seat_mocks.NewMockSeatStatusStream(h.ctrl)
+
+	h.mockSeatUsecase.EXPECT().
+		StreamSeatStatus(gomock.Any(), seatUsecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String(), SinceVersion: pointer.ToPointer(int64(40))}).
+		Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Next(gomock.Any()).Return(&seatUsecase.SeatStatusUpdate{
+			Events: entity.SeatStatusEvents{
+				{Version: 41, ConcertID: concertID, ZoneID: zoneID, SeatID: seatID, SeatNumber: "A1", Status: entity.SeatStatusBooked, OccurredAt: time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)},
+			},
+			Version: 41,
+		}, nil),
+		stream.EXPECT().Next(gomock.Any()).Return(nil, entity.ErrSeatEventSubscriberTooSlow),
+	)
+	stream.EXPECT().Close()
+
+	router := gin.New()
+	router.GET("/concerts/:id/zones/:zone_id/seats/stream", h.seatHandler.StreamSeatStatus)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	// Execute
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/concerts/" + concertID.String() + "/zones/" + zoneID.String() + "/seats/stream?since_version=40"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Assert
+	var messages []map[string]interface{}
+	for {
+		var message map[string]interface{}
+		if err := conn.ReadJSON(&message); err != nil {
+			assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "unexpected error: %v", err)
+			break
+		}
+		messages = append(messages, message)
+	}
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"type":        "seat_status",
+			"version":     float64(41),
+			"seat_id":     seatID.String(),
+			"seat_number": "A1",
+			"status":      "booked",
+			"occurred_at": "2025-01-01T10:00:00+07:00",
+		},
+		{"type": "ready", "version": float64(41)},
+		{"type": "lagging", "version": float64(41)},
+	}, messages)
+}
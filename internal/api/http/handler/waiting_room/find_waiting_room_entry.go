@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"ticket-reservation/internal/api/http/middleware"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// @Summary		Find Waiting Room Entry
+// @Description	Poll the place of the signed-in user in the waiting room of a concert. Once admitted, the response carries the pass to send in X-Waiting-Room-Pass when reserving seats
+// @Tags			Waiting Room
+// @Produce		json
+// @Param			id	path		string																		true	"Concert ID"
+// @Success		200	{object}	httpresponse.SuccessResponse{data=waitingRoomEntryResponse,metadata=nil}	"Waiting room entry"
+// @Failure		400	{object}	httpresponse.ErrorResponse{data=nil}										"Bad request"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}										"Unauthorized - Missing or invalid access token"
+// @Failure		404	{object}	httpresponse.ErrorResponse{data=nil}										"Not in the waiting room, or the admission has ended"
+// @Failure		429	{object}	httpresponse.ErrorResponse{data=object}									"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}										"Internal server error"
+// @Security		ApiKeyAuth
+// @Router			/concerts/{id}/waiting-room [get]
+func (h *waitingRoomHandler) FindWaitingRoomEntry(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	result, err := h.waitingRoomUsecase.FindWaitingRoomEntry(c.Request.Context(), waitingRoomUsecase.FindWaitingRoomEntryInput{
+		ConcertID: c.Param("id"),
+		SessionID: principal.SessionID(),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newWaitingRoomEntryResponse(result))
+}
@@ -0,0 +1,197 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWaitingRoomHandler_JoinWaitingRoom(t *testing.T) {
+	concertID := uuid.New()
+	principal := &entity.Principal{UserID: uuid.New(), Email: "customer@example.com", Role: entity.UserRoleCustomer}
+	admittedUntil := time.Date(2025, 12, 25, 3, 10, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		principal        *entity.Principal
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:      "buyer waiting in the queue",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomUsecase.EXPECT().
+					JoinWaitingRoom(gomock.Any(), waitingRoomUsecase.JoinWaitingRoomInput{
+						ConcertID: concertID.String(),
+						SessionID: principal.SessionID(),
+					}).
+					Return(&waitingRoomUsecase.WaitingRoomResult{
+						Entry: &entity.WaitingRoomEntry{
+							ConcertID:   concertID,
+							SessionID:   principal.SessionID(),
+							Status:      entity.WaitingRoomEntryStatusWaiting,
+							Position:    42,
+							QueueLength: 1500,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"concert_id":     concertID.String(),
+					"status":         "waiting",
+					"position":       float64(42),
+					"queue_length":   float64(1500),
+					"admitted_until": nil,
+					"pass":           nil,
+				},
+			},
+		},
+		{
+			name:      "admitted buyer gets the pass",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomUsecase.EXPECT().
+					JoinWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(&waitingRoomUsecase.WaitingRoomResult{
+						Entry: &entity.WaitingRoomEntry{
+							ConcertID:     concertID,
+							SessionID:     principal.SessionID(),
+							Status:        entity.WaitingRoomEntryStatusAdmitted,
+							QueueLength:   1458,
+							AdmittedUntil: &admittedUntil,
+						},
+						Pass: pointer.ToPointer("waiting-room-pass"),
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"concert_id":     concertID.String(),
+					"status":         "admitted",
+					"position":       float64(0),
+					"queue_length":   float64(1458),
+					"admitted_until": "2025-12-25T10:10:00+07:00",
+					"pass":           "waiting-room-pass",
+				},
+			},
+		},
+		{
+			name:      "not authenticated",
+			principal: nil,
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected without a principal
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:      "usecase not found error",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomUsecase.EXPECT().
+					JoinWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "concert not found",
+			},
+		},
+		{
+			name:      "concert without waiting room",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomUsecase.EXPECT().
+					JoinWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewConflictError("the concert has no waiting room enabled", nil))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-403000",
+				"message": "the concert has no waiting room enabled",
+			},
+		},
+		{
+			name:      "usecase internal error",
+			principal: principal,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomUsecase.EXPECT().
+					JoinWaitingRoom(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("redis connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/concerts/:id/waiting-room").
+				Param("id", concertID.String()).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute the handler
+			h.waitingRoomHandler.JoinWaitingRoom(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
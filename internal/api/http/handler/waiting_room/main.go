@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type WaitingRoomHandler interface {
+	JoinWaitingRoom(c *gin.Context)
+	FindWaitingRoomEntry(c *gin.Context)
+}
+
+type waitingRoomHandler struct {
+	appConfig          config.AppConfig
+	waitingRoomUsecase waitingRoomUsecase.WaitingRoomUsecase
+}
+
+func NewWaitingRoomHandler(appConfig config.AppConfig, waitingRoomUsecase waitingRoomUsecase.WaitingRoomUsecase) WaitingRoomHandler {
+	return &waitingRoomHandler{
+		appConfig:          appConfig,
+		waitingRoomUsecase: waitingRoomUsecase,
+	}
+}
+
+type waitingRoomEntryResponse struct {
+	ConcertID     string  `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status        string  `json:"status" example:"waiting"`
+	Position      int64   `json:"position" example:"42"`
+	QueueLength   int64   `json:"queue_length" example:"1500"`
+	AdmittedUntil *string `json:"admitted_until" example:"2025-01-01T10:10:00+07:00"`
+	Pass          *string `json:"pass" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+func (h *waitingRoomHandler) newWaitingRoomEntryResponse(result *waitingRoomUsecase.WaitingRoomResult) waitingRoomEntryResponse {
+	if result == nil || result.Entry == nil {
+		return waitingRoomEntryResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := waitingRoomEntryResponse{
+		ConcertID:   result.Entry.ConcertID.String(),
+		Status:      result.Entry.Status.String(),
+		Position:    result.Entry.Position,
+		QueueLength: result.Entry.QueueLength,
+		Pass:        result.Pass,
+	}
+	if result.Entry.AdmittedUntil != nil {
+		response.AdmittedUntil = pointer.ToPointer(result.Entry.AdmittedUntil.In(loc).Format(time.RFC3339))
+	}
+	return response
+}
@@ -0,0 +1,58 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/waiting_room"
+	"ticket-reservation/internal/config"
+	waiting_room_mocks "ticket-reservation/internal/usecase/waiting_room/mocks"
+)
+
+type testHelper struct {
+	ctrl                   *gomock.Controller
+	appConfig              config.AppConfig
+	mockWaitingRoomUsecase *waiting_room_mocks.MockWaitingRoomUsecase
+	waitingRoomHandler     handler.WaitingRoomHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+
+	mockWaitingRoomUsecase := waiting_room_mocks.NewMockWaitingRoomUsecase(ctrl)
+
+	waitingRoomHandler := handler.NewWaitingRoomHandler(appConfig, mockWaitingRoomUsecase)
+
+	return &testHelper{
+		ctrl:                   ctrl,
+		appConfig:              appConfig,
+		mockWaitingRoomUsecase: mockWaitingRoomUsecase,
+		waitingRoomHandler:     waitingRoomHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewWaitingRoomHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+	mockWaitingRoomUsecase := waiting_room_mocks.NewMockWaitingRoomUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewWaitingRoomHandler(appConfig, mockWaitingRoomUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
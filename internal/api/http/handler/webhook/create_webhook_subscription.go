@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type createWebhookSubscriptionRequest struct {
+	Name       string   `json:"name" binding:"required" example:"CRM"`
+	URL        string   `json:"url" binding:"required" example:"https://crm.example.com/webhooks/tickets"`
+	Secret     string   `json:"secret" binding:"required" example:"whsec_2f9c1a7e5b3d4c6e8a0b"`
+	EventTypes []string `json:"event_types" binding:"required" example:"reservation.created,payment.succeeded"`
+}
+
+// @Summary		Create Webhook Subscription
+// @Description	Subscribes an endpoint to events of the given types (concert.created, reservation.created, reservation.expired, reservation.confirmed, payment.succeeded).
+// @Description	Every delivery is a JSON POST signed with the secret: X-Webhook-Signature is the hex HMAC-SHA256 of "<X-Webhook-Timestamp>.<raw body>".
+// @Description	Deliveries are retried until the endpoint answers with a 2xx status, so the same event may arrive more than once with the same X-Webhook-Event-ID
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			request	body		createWebhookSubscriptionRequest											true	"Webhook subscription input"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=webhookSubscriptionResponse,metadata=nil}	"Webhook subscription created"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}										"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}										"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}										"Forbidden - The user is not an admin"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}										"Internal server error"
+// @Router			/admin/webhooks [post]
+func (h *webhookHandler) CreateWebhookSubscription(c *gin.Context) {
+	var request createWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	subscription, err := h.webhookUsecase.CreateWebhookSubscription(c.Request.Context(), webhookUsecase.CreateWebhookSubscriptionInput{
+		Name:       request.Name,
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: request.EventTypes,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newWebhookSubscriptionResponse(subscription))
+}
@@ -0,0 +1,166 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestWebhookHandler_CreateWebhookSubscription(t *testing.T) {
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful creation does not return the secret",
+			requestBody: map[string]interface{}{
+				"name":        "CRM",
+				"url":         "https://crm.example.com/webhooks",
+				"secret":      "whsec_0123456789abcdef",
+				"event_types": []string{"reservation.created", "payment.succeeded"},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					CreateWebhookSubscription(gomock.Any(), webhookUsecase.CreateWebhookSubscriptionInput{
+						Name:       "CRM",
+						URL:        "https://crm.example.com/webhooks",
+						Secret:     "whsec_0123456789abcdef",
+						EventTypes: []string{"reservation.created", "payment.succeeded"},
+					}).
+					Return(&entity.WebhookSubscription{
+						ID:         subscriptionID,
+						Name:       "CRM",
+						URL:        "https://crm.example.com/webhooks",
+						Secret:     "whsec_0123456789abcdef",
+						EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated, entity.WebhookEventTypePaymentSucceeded},
+						IsActive:   true,
+						CreatedAt:  createdAt,
+						UpdatedAt:  createdAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":          subscriptionID.String(),
+					"name":        "CRM",
+					"url":         "https://crm.example.com/webhooks",
+					"event_types": []interface{}{"reservation.created", "payment.succeeded"},
+					"is_active":   true,
+					"created_at":  "2025-01-01T10:00:00+07:00",
+					"updated_at":  "2025-01-01T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name: "missing required fields",
+			requestBody: map[string]interface{}{
+				"name": "CRM",
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name: "invalid event type",
+			requestBody: map[string]interface{}{
+				"name":        "CRM",
+				"url":         "https://crm.example.com/webhooks",
+				"secret":      "whsec_0123456789abcdef",
+				"event_types": []string{"seat.booked"},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name: "usecase internal error",
+			requestBody: map[string]interface{}{
+				"name":        "CRM",
+				"url":         "https://crm.example.com/webhooks",
+				"secret":      "whsec_0123456789abcdef",
+				"event_types": []string{"reservation.created"},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/admin/webhooks").
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.webhookHandler.CreateWebhookSubscription(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindAllWebhookDeliveriesQuery struct {
+	Status    *string `form:"status"`
+	EventType *string `form:"event_type"`
+	Limit     *int64  `form:"limit"`
+	Offset    *int64  `form:"offset"`
+}
+
+// @Summary		List Webhook Deliveries
+// @Description	Lists the deliveries of a webhook subscription, the most recent first, with the outcome of their last attempt. Used to debug a subscriber
+// @Tags			Admin
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id			path		string																									true	"Webhook subscription ID"
+// @Param			status		query		string																									false	"Delivery status (options: pending, delivered, dead_lettered)"
+// @Param			event_type	query		string																									false	"Event type"
+// @Param			limit		query		int64																									false	"Number of results to return (default: 20, max: 100)"
+// @Param			offset		query		int64																									false	"Number of results to skip (default: 0)"
+// @Success		200			{object}	httpresponse.SuccessResponse{data=[]webhookDeliveryResponse,metadata=httpresponse.PaginationMetadata}	"Webhook deliveries with pagination details"
+// @Failure		400			{object}	httpresponse.ErrorResponse{data=nil}																	"Bad request"
+// @Failure		401			{object}	httpresponse.ErrorResponse{data=nil}																	"Unauthorized - Missing or invalid access token"
+// @Failure		403			{object}	httpresponse.ErrorResponse{data=nil}																	"Forbidden - The user is not an admin"
+// @Failure		404			{object}	httpresponse.ErrorResponse{data=nil}																	"Webhook subscription not found"
+// @Failure		500			{object}	httpresponse.ErrorResponse{data=nil}																	"Internal server error"
+// @Router			/admin/webhooks/{id}/deliveries [get]
+func (h *webhookHandler) FindAllWebhookDeliveries(c *gin.Context) {
+	var query FindAllWebhookDeliveriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	var (
+		limit  = pointer.ToPointer(int64(20)) // Default limit to 20
+		offset = pointer.ToPointer(int64(0))  // Default offset to 0
+	)
+	if query.Limit != nil {
+		limit = query.Limit
+	}
+	if query.Offset != nil {
+		offset = query.Offset
+	}
+
+	deliveries, err := h.webhookUsecase.FindAllWebhookDeliveries(c.Request.Context(), webhookUsecase.FindAllWebhookDeliveriesInput{
+		SubscriptionID: c.Param("id"),
+		Status:         query.Status,
+		EventType:      query.EventType,
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithMetadata(c, h.newFindAllWebhookDeliveriesResponse(deliveries.GetData()), httpresponse.PaginationMetadata{Pagination: deliveries.GetPagination()})
+}
+
+func (h *webhookHandler) newFindAllWebhookDeliveriesResponse(deliveries []entity.WebhookDelivery) []webhookDeliveryResponse {
+	response := make([]webhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response = append(response, h.newWebhookDeliveryResponse(delivery))
+	}
+	return response
+}
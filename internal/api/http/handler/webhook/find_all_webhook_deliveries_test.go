@@ -0,0 +1,211 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookHandler_FindAllWebhookDeliveries(t *testing.T) {
+	subscriptionID := uuid.New()
+	deliveryID := uuid.New()
+	eventID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	lastAttemptAt := time.Date(2025, 1, 1, 3, 0, 1, 0, time.UTC)
+
+	newPage := func(deliveries []entity.WebhookDelivery, limit, offset int64) entity.Page[entity.WebhookDelivery] {
+		page, err := entity.NewPage(func() ([]entity.WebhookDelivery, entity.PageProvider[entity.WebhookDelivery], entity.Pagination, error) {
+			return deliveries, nil, entity.NewPagination(int64(len(deliveries)), limit, offset), nil
+		})
+		require.NoError(t, err)
+		return page
+	}
+
+	tests := []struct {
+		name             string
+		queryParams      map[string]any
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful retrieval with filters",
+			queryParams: map[string]any{
+				"status":     "dead_lettered",
+				"event_type": "payment.succeeded",
+				"limit":      10,
+				"offset":     0,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookDeliveries(gomock.Any(), webhookUsecase.FindAllWebhookDeliveriesInput{
+						SubscriptionID: subscriptionID.String(),
+						Status:         pointer.ToPointer("dead_lettered"),
+						EventType:      pointer.ToPointer("payment.succeeded"),
+						Limit:          pointer.ToPointer(int64(10)),
+						Offset:         pointer.ToPointer(int64(0)),
+					}).
+					Return(newPage([]entity.WebhookDelivery{
+						{
+							ID:                 deliveryID,
+							SubscriptionID:     subscriptionID,
+							EventID:            eventID,
+							EventType:          entity.WebhookEventTypePaymentSucceeded,
+							Payload:            `{"id":"` + eventID.String() + `"}`,
+							Status:             entity.WebhookDeliveryStatusDeadLettered,
+							Attempts:           8,
+							NextAttemptAt:      lastAttemptAt,
+							LastAttemptAt:      &lastAttemptAt,
+							LastResponseStatus: pointer.ToPointer(http.StatusServiceUnavailable),
+							LastError:          pointer.ToPointer("unexpected response status 503"),
+							CreatedAt:          createdAt,
+							UpdatedAt:          lastAttemptAt,
+						},
+					}, 10, 0), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{
+					map[string]interface{}{
+						"id":                   deliveryID.String(),
+						"subscription_id":      subscriptionID.String(),
+						"event_id":             eventID.String(),
+						"event_type":           "payment.succeeded",
+						"payload":              map[string]interface{}{"id": eventID.String()},
+						"status":               "dead_lettered",
+						"attempts":             float64(8),
+						"next_attempt_at":      "2025-01-01T10:00:01+07:00",
+						"last_attempt_at":      "2025-01-01T10:00:01+07:00",
+						"last_response_status": float64(503),
+						"last_error":           "unexpected response status 503",
+						"created_at":           "2025-01-01T10:00:00+07:00",
+					},
+				},
+				"metadata": map[string]interface{}{
+					"pagination": map[string]interface{}{
+						"total":        float64(1),
+						"limit":        float64(10),
+						"offset":       float64(0),
+						"current_page": float64(1),
+						"page_count":   float64(1),
+					},
+				},
+			},
+		},
+		{
+			name:        "default pagination",
+			queryParams: map[string]any{},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookDeliveries(gomock.Any(), webhookUsecase.FindAllWebhookDeliveriesInput{
+						SubscriptionID: subscriptionID.String(),
+						Limit:          pointer.ToPointer(int64(20)),
+						Offset:         pointer.ToPointer(int64(0)),
+					}).
+					Return(newPage([]entity.WebhookDelivery{}, 20, 0), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{},
+			},
+		},
+		{
+			name: "invalid query parameters",
+			queryParams: map[string]any{
+				"limit": "ten",
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "webhook subscription not found",
+			queryParams: map[string]any{},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookDeliveries(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("webhook subscription not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "webhook subscription not found",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			queryParams: map[string]any{},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookDeliveries(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path param and query parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/admin/webhooks/"+subscriptionID.String()+"/deliveries").
+				Param("id", subscriptionID.String()).
+				Queries(tt.queryParams).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.webhookHandler.FindAllWebhookDeliveries(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary		List Webhook Subscriptions
+// @Description	Lists every webhook subscription, inactive ones included. Secrets are never returned
+// @Tags			Admin
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Success		200	{object}	httpresponse.SuccessResponse{data=[]webhookSubscriptionResponse,metadata=nil}	"Webhook subscriptions found"
+// @Failure		401	{object}	httpresponse.ErrorResponse{data=nil}										"Unauthorized - Missing or invalid access token"
+// @Failure		403	{object}	httpresponse.ErrorResponse{data=nil}										"Forbidden - The user is not an admin"
+// @Failure		500	{object}	httpresponse.ErrorResponse{data=nil}										"Internal server error"
+// @Router			/admin/webhooks [get]
+func (h *webhookHandler) FindAllWebhookSubscriptions(c *gin.Context) {
+	subscriptions, err := h.webhookUsecase.FindAllWebhookSubscriptions(c.Request.Context())
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	response := make([]webhookSubscriptionResponse, 0, len(*subscriptions))
+	for _, subscription := range *subscriptions {
+		response = append(response, h.newWebhookSubscriptionResponse(&subscription))
+	}
+	httpresponse.Success(c, response)
+}
@@ -0,0 +1,132 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/pkg/testhelper"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestWebhookHandler_FindAllWebhookSubscriptions(t *testing.T) {
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name: "successful retrieval",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookSubscriptions(gomock.Any()).
+					Return(&entity.WebhookSubscriptions{
+						{
+							ID:         subscriptionID,
+							Name:       "Analytics",
+							URL:        "https://analytics.example.com/hooks",
+							Secret:     "whsec_0123456789abcdef",
+							EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeConcertCreated},
+							IsActive:   false,
+							CreatedAt:  createdAt,
+							UpdatedAt:  updatedAt,
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{
+					map[string]interface{}{
+						"id":          subscriptionID.String(),
+						"name":        "Analytics",
+						"url":         "https://analytics.example.com/hooks",
+						"event_types": []interface{}{"concert.created"},
+						"is_active":   false,
+						"created_at":  "2025-01-01T10:00:00+07:00",
+						"updated_at":  "2025-01-02T10:00:00+07:00",
+					},
+				},
+			},
+		},
+		{
+			name: "no webhook subscriptions",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookSubscriptions(gomock.Any()).
+					Return(&entity.WebhookSubscriptions{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": []interface{}{},
+			},
+		},
+		{
+			name: "usecase internal error",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					FindAllWebhookSubscriptions(gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/admin/webhooks").
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.webhookHandler.FindAllWebhookSubscriptions(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"encoding/json"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type WebhookHandler interface {
+	CreateWebhookSubscription(c *gin.Context)
+	FindAllWebhookSubscriptions(c *gin.Context)
+	UpdateWebhookSubscription(c *gin.Context)
+	FindAllWebhookDeliveries(c *gin.Context)
+}
+
+type webhookHandler struct {
+	appConfig      config.AppConfig
+	webhookUsecase webhookUsecase.WebhookUsecase
+}
+
+func NewWebhookHandler(appConfig config.AppConfig, webhookUsecase webhookUsecase.WebhookUsecase) WebhookHandler {
+	return &webhookHandler{
+		appConfig:      appConfig,
+		webhookUsecase: webhookUsecase,
+	}
+}
+
+// webhookSubscriptionResponse never carries the secret, it is only known to the admin who set it and the subscriber
+type webhookSubscriptionResponse struct {
+	ID         string   `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name       string   `json:"name" example:"CRM"`
+	URL        string   `json:"url" example:"https://crm.example.com/webhooks/tickets"`
+	EventTypes []string `json:"event_types" example:"reservation.created,payment.succeeded"`
+	IsActive   bool     `json:"is_active" example:"true"`
+	CreatedAt  string   `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+	UpdatedAt  string   `json:"updated_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+type webhookDeliveryResponse struct {
+	ID                 string          `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SubscriptionID     string          `json:"subscription_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	EventID            string          `json:"event_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	EventType          string          `json:"event_type" example:"reservation.created"`
+	Payload            json.RawMessage `json:"payload" swaggertype:"object"`
+	Status             string          `json:"status" example:"pending"`
+	Attempts           int             `json:"attempts" example:"2"`
+	NextAttemptAt      string          `json:"next_attempt_at" example:"2025-01-01T10:00:04+07:00"`
+	LastAttemptAt      *string         `json:"last_attempt_at,omitempty" example:"2025-01-01T10:00:02+07:00"`
+	LastResponseStatus *int            `json:"last_response_status,omitempty" example:"503"`
+	LastError          *string         `json:"last_error,omitempty" example:"unexpected response status 503"`
+	DeliveredAt        *string         `json:"delivered_at,omitempty" example:"2025-01-01T10:00:06+07:00"`
+	CreatedAt          string          `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+func (h *webhookHandler) newWebhookSubscriptionResponse(subscription *entity.WebhookSubscription) webhookSubscriptionResponse {
+	if subscription == nil {
+		return webhookSubscriptionResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := webhookSubscriptionResponse{
+		ID:         subscription.ID.String(),
+		Name:       subscription.Name,
+		URL:        subscription.URL,
+		EventTypes: make([]string, 0, len(subscription.EventTypes)),
+		IsActive:   subscription.IsActive,
+		CreatedAt:  subscription.CreatedAt.In(loc).Format(time.RFC3339),
+		UpdatedAt:  subscription.UpdatedAt.In(loc).Format(time.RFC3339),
+	}
+	for _, eventType := range subscription.EventTypes {
+		response.EventTypes = append(response.EventTypes, eventType.String())
+	}
+	return response
+}
+
+func (h *webhookHandler) newWebhookDeliveryResponse(delivery entity.WebhookDelivery) webhookDeliveryResponse {
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := webhookDeliveryResponse{
+		ID:                 delivery.ID.String(),
+		SubscriptionID:     delivery.SubscriptionID.String(),
+		EventID:            delivery.EventID.String(),
+		EventType:          delivery.EventType.String(),
+		Payload:            json.RawMessage(delivery.Payload),
+		Status:             delivery.Status.String(),
+		Attempts:           delivery.Attempts,
+		NextAttemptAt:      delivery.NextAttemptAt.In(loc).Format(time.RFC3339),
+		LastResponseStatus: delivery.LastResponseStatus,
+		LastError:          delivery.LastError,
+		CreatedAt:          delivery.CreatedAt.In(loc).Format(time.RFC3339),
+	}
+	if delivery.LastAttemptAt != nil {
+		response.LastAttemptAt = pointer.ToPointer(delivery.LastAttemptAt.In(loc).Format(time.RFC3339))
+	}
+	if delivery.DeliveredAt != nil {
+		response.DeliveredAt = pointer.ToPointer(delivery.DeliveredAt.In(loc).Format(time.RFC3339))
+	}
+	return response
+}
@@ -0,0 +1,58 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/webhook"
+	"ticket-reservation/internal/config"
+	webhook_mocks "ticket-reservation/internal/usecase/webhook/mocks"
+)
+
+type testHelper struct {
+	ctrl               *gomock.Controller
+	appConfig          config.AppConfig
+	mockWebhookUsecase *webhook_mocks.MockWebhookUsecase
+	webhookHandler     handler.WebhookHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+
+	mockWebhookUsecase := webhook_mocks.NewMockWebhookUsecase(ctrl)
+
+	webhookHandler := handler.NewWebhookHandler(appConfig, mockWebhookUsecase)
+
+	return &testHelper{
+		ctrl:               ctrl,
+		appConfig:          appConfig,
+		mockWebhookUsecase: mockWebhookUsecase,
+		webhookHandler:     webhookHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewWebhookHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+	mockWebhookUsecase := webhook_mocks.NewMockWebhookUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewWebhookHandler(appConfig, mockWebhookUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type updateWebhookSubscriptionRequest struct {
+	Name       *string  `json:"name,omitempty" example:"CRM"`
+	URL        *string  `json:"url,omitempty" example:"https://crm.example.com/webhooks/tickets"`
+	Secret     *string  `json:"secret,omitempty" example:"whsec_2f9c1a7e5b3d4c6e8a0b"`
+	EventTypes []string `json:"event_types,omitempty" example:"reservation.created,payment.succeeded"`
+	IsActive   *bool    `json:"is_active,omitempty" example:"false"`
+}
+
+// @Summary		Update Webhook Subscription
+// @Description	Changes the fields given of a webhook subscription. A new URL or secret applies to the next attempt of every pending delivery,
+// @Description	a deactivated subscription gets no new deliveries and its pending ones are dead-lettered
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		ApiKeyAuth
+// @Param			id		path		string																		true	"Webhook subscription ID"
+// @Param			request	body		updateWebhookSubscriptionRequest											true	"Fields to update"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=webhookSubscriptionResponse,metadata=nil}	"Webhook subscription updated"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}										"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}										"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}										"Forbidden - The user is not an admin"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}										"Webhook subscription not found"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}										"Internal server error"
+// @Router			/admin/webhooks/{id} [patch]
+func (h *webhookHandler) UpdateWebhookSubscription(c *gin.Context) {
+	var request updateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	subscription, err := h.webhookUsecase.UpdateWebhookSubscription(c.Request.Context(), webhookUsecase.UpdateWebhookSubscriptionInput{
+		ID:         c.Param("id"),
+		Name:       request.Name,
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: request.EventTypes,
+		IsActive:   request.IsActive,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newWebhookSubscriptionResponse(subscription))
+}
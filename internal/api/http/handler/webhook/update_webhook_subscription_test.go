@@ -0,0 +1,163 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookHandler_UpdateWebhookSubscription(t *testing.T) {
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		subscriptionID   string
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:           "successful deactivation",
+			subscriptionID: subscriptionID.String(),
+			requestBody: map[string]interface{}{
+				"is_active": false,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					UpdateWebhookSubscription(gomock.Any(), webhookUsecase.UpdateWebhookSubscriptionInput{
+						ID:       subscriptionID.String(),
+						IsActive: pointer.ToPointer(false),
+					}).
+					Return(&entity.WebhookSubscription{
+						ID:         subscriptionID,
+						Name:       "CRM",
+						URL:        "https://crm.example.com/webhooks",
+						Secret:     "whsec_0123456789abcdef",
+						EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated},
+						IsActive:   false,
+						CreatedAt:  createdAt,
+						UpdatedAt:  updatedAt,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":          subscriptionID.String(),
+					"name":        "CRM",
+					"url":         "https://crm.example.com/webhooks",
+					"event_types": []interface{}{"reservation.created"},
+					"is_active":   false,
+					"created_at":  "2025-01-01T10:00:00+07:00",
+					"updated_at":  "2025-01-02T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name:           "invalid request body",
+			subscriptionID: subscriptionID.String(),
+			requestBody: map[string]interface{}{
+				"is_active": "no",
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:           "webhook subscription not found",
+			subscriptionID: subscriptionID.String(),
+			requestBody: map[string]interface{}{
+				"name": "CRM",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					UpdateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("webhook subscription not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "webhook subscription not found",
+			},
+		},
+		{
+			name:           "usecase internal error",
+			subscriptionID: subscriptionID.String(),
+			requestBody: map[string]interface{}{
+				"name": "CRM",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookUsecase.EXPECT().
+					UpdateWebhookSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path param and JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPatch).
+				Path("/admin/webhooks/"+tt.subscriptionID).
+				Param("id", tt.subscriptionID).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.webhookHandler.UpdateWebhookSubscription(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"net/http"
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type createZoneRequest struct {
+	Name        string  `json:"name" example:"VIP" binding:"required"`
+	Description *string `json:"description" example:"Front row seats"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    *string `json:"currency" example:"THB"`
+}
+
+type createZoneResponse struct {
+	ID          string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConcertID   string  `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string  `json:"name" example:"VIP"`
+	Description *string `json:"description" example:"Front row seats"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    string  `json:"currency" example:"THB"`
+	CreatedAt   string  `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+	UpdatedAt   string  `json:"updated_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+// @Summary		Create Zone
+// @Description	Create a new zone for a concert (admin, or the organizer who owns the concert)
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string																true	"Concert ID"
+// @Param			request	body		createZoneRequest													true	"Zone creation input"
+// @Success		201		{object}	httpresponse.SuccessResponse{data=createZoneResponse,metadata=nil}	"Zone created"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}								"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}								"Forbidden - The concert is managed by another organizer"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}								"Concert not found"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}								"Internal server error"
+// @Security		ApiKeyAuth
+// @Router			/concerts/{id}/zones [post]
+func (h *zoneHandler) CreateZone(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var input createZoneRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	createdZone, err := h.zoneUsecase.CreateZone(c.Request.Context(), zoneUsecase.CreateZoneInput{
+		ConcertID:   c.Param("id"),
+		Name:        input.Name,
+		Description: input.Description,
+		Price:       input.Price,
+		Currency:    input.Currency,
+		Principal:   principal,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithStatus(c, http.StatusCreated, h.newCreateZoneResponse(createdZone))
+}
+
+func (h *zoneHandler) newCreateZoneResponse(zone *entity.Zone) createZoneResponse {
+	if zone == nil {
+		return createZoneResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return createZoneResponse{
+		ID:          zone.ID.String(),
+		ConcertID:   zone.ConcertID.String(),
+		Name:        zone.Name,
+		Description: zone.Description,
+		Price:       formatPrice(zone.Price),
+		Currency:    zone.Currency,
+		CreatedAt:   zone.CreatedAt.In(loc).Format(time.RFC3339),
+		UpdatedAt:   zone.UpdatedAt.In(loc).Format(time.RFC3339),
+	}
+}
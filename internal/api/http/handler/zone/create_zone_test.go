@@ -0,0 +1,206 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneHandler_CreateZone(t *testing.T) {
+	concertID := uuid.New()
+	createdTime := time.Date(2024, 12, 1, 3, 0, 0, 0, time.UTC)
+	expectedZone := &entity.Zone{
+		ID:          uuid.New(),
+		ConcertID:   concertID,
+		Name:        "VIP",
+		Description: pointer.ToPointer("Front row seats"),
+		Price:       pointer.ToPointer(decimal.RequireFromString("3500")),
+		Currency:    "THB",
+		CreatedAt:   createdTime,
+		UpdatedAt:   createdTime,
+	}
+
+	validRequestBody := map[string]interface{}{
+		"name":        "VIP",
+		"description": "Front row seats",
+		"price":       "3500.00",
+		"currency":    "THB",
+	}
+
+	principal := &entity.Principal{UserID: uuid.New(), Email: "organizer@example.com", Role: entity.UserRoleOrganizer}
+
+	tests := []struct {
+		name             string
+		principal        *entity.Principal
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful zone creation",
+			principal:   principal,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					CreateZone(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input zoneUsecase.CreateZoneInput) (*entity.Zone, error) {
+						// Validate input
+						assert.Equal(t, concertID.String(), input.ConcertID)
+						assert.Equal(t, "VIP", input.Name)
+						assert.Equal(t, "Front row seats", pointer.GetValue(input.Description))
+						assert.Equal(t, "3500.00", pointer.GetValue(input.Price))
+						assert.Equal(t, "THB", pointer.GetValue(input.Currency))
+						assert.Equal(t, principal, input.Principal)
+						return expectedZone, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":          expectedZone.ID.String(),
+					"concert_id":  concertID.String(),
+					"name":        "VIP",
+					"description": "Front row seats",
+					"price":       "3500.00",
+					"currency":    "THB",
+					"created_at":  "2024-12-01T10:00:00+07:00",
+					"updated_at":  "2024-12-01T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name:        "invalid JSON body - missing name",
+			principal:   principal,
+			requestBody: map[string]interface{}{"description": "No name"},
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected for validation errors
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "concert not found",
+			principal:   principal,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					CreateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "concert not found",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			principal:   principal,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					CreateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+		{
+			name:        "not authenticated",
+			principal:   nil,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected without a principal
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:        "concert managed by another organizer",
+			principal:   principal,
+			requestBody: validRequestBody,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					CreateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the concert is managed by another organizer", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the concert is managed by another organizer",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPost).
+				Path("/concerts/:id/zones").
+				Param("id", concertID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute the handler
+			h.zoneHandler.CreateZone(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindAllZonesQuery struct {
+	Limit  *int64 `form:"limit"`
+	Offset *int64 `form:"offset"`
+}
+
+type findAllZonesResponse struct {
+	ID          string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConcertID   string  `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string  `json:"name" example:"VIP"`
+	Description *string `json:"description" example:"Front row seats"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    string  `json:"currency" example:"THB"`
+	CreatedAt   string  `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+	UpdatedAt   string  `json:"updated_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+// @Summary		List Zones
+// @Description	List all zones of a concert
+// @Tags			Zone
+// @Produce		json
+// @Param			id		path		string																								true	"Concert ID"
+// @Param			limit	query		int64																								false	"Number of results to return (default: 100)"
+// @Param			offset	query		int64																								false	"Number of results to skip (default: 0)"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=[]findAllZonesResponse,metadata=httpresponse.PaginationMetadata}	"List of zones with pagination details"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}																"Bad request"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}																"Concert not found"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}															"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}																"Internal server error"
+// @Router			/concerts/{id}/zones [get]
+func (h *zoneHandler) FindAllZones(c *gin.Context) {
+	var query FindAllZonesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	var (
+		limit  = pointer.ToPointer(int64(100)) // Default limit to 100
+		offset = pointer.ToPointer(int64(0))   // Default offset to 0
+	)
+	if query.Limit != nil {
+		limit = query.Limit
+	}
+	if query.Offset != nil {
+		offset = query.Offset
+	}
+
+	zones, err := h.zoneUsecase.FindAllZones(c.Request.Context(), zoneUsecase.FindAllZonesInput{
+		ConcertID: c.Param("id"),
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.SuccessWithMetadata(c, h.newFindAllZonesResponse(zones.GetData()), httpresponse.PaginationMetadata{Pagination: zones.GetPagination()})
+}
+
+func (h *zoneHandler) newFindAllZonesResponse(zones entity.Zones) []findAllZonesResponse {
+	if len(zones) == 0 {
+		return []findAllZonesResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	response := make([]findAllZonesResponse, 0, len(zones))
+	for _, zone := range zones {
+		response = append(response, findAllZonesResponse{
+			ID:          zone.ID.String(),
+			ConcertID:   zone.ConcertID.String(),
+			Name:        zone.Name,
+			Description: zone.Description,
+			Price:       formatPrice(zone.Price),
+			Currency:    zone.Currency,
+			CreatedAt:   zone.CreatedAt.In(loc).Format(time.RFC3339),
+			UpdatedAt:   zone.UpdatedAt.In(loc).Format(time.RFC3339),
+		})
+	}
+	return response
+}
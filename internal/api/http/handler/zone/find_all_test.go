@@ -0,0 +1,172 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneHandler_FindAllZones(t *testing.T) {
+	concertID := uuid.New()
+	createdTime := time.Date(2024, 12, 1, 3, 0, 0, 0, time.UTC)
+	testZones := entity.Zones{
+		{ID: uuid.New(), ConcertID: concertID, Name: "A", CreatedAt: createdTime, UpdatedAt: createdTime},
+		{ID: uuid.New(), ConcertID: concertID, Name: "B", CreatedAt: createdTime, UpdatedAt: createdTime},
+	}
+
+	newPage := func(zones entity.Zones, total, limit, offset int64) entity.Page[entity.Zone] {
+		page, _ := entity.NewPage(func() ([]entity.Zone, entity.PageProvider[entity.Zone], entity.Pagination, error) {
+			return zones, nil, entity.NewPagination(total, limit, offset), nil
+		})
+		return page
+	}
+
+	tests := []struct {
+		name             string
+		queryParams      map[string]any
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedCount    int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful find all zones with default pagination",
+			queryParams: map[string]any{},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindAllZones(gomock.Any(), zoneUsecase.FindAllZonesInput{
+						ConcertID: concertID.String(),
+						Limit:     pointer.ToPointer(int64(100)),
+						Offset:    pointer.ToPointer(int64(0)),
+					}).
+					Return(newPage(testZones, 2, 100, 0), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  2,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+			},
+		},
+		{
+			name:        "successful find all zones with custom pagination",
+			queryParams: map[string]any{"limit": 1, "offset": 1},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindAllZones(gomock.Any(), zoneUsecase.FindAllZonesInput{
+						ConcertID: concertID.String(),
+						Limit:     pointer.ToPointer(int64(1)),
+						Offset:    pointer.ToPointer(int64(1)),
+					}).
+					Return(newPage(testZones[1:], 2, 1, 1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+			},
+		},
+		{
+			name:        "invalid query parameter",
+			queryParams: map[string]any{"limit": "abc"},
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected for validation errors
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "concert not found",
+			queryParams: map[string]any{},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindAllZones(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "concert not found",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			queryParams: map[string]any{},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindAllZones(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with query parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/concerts/:id/zones").
+				Param("id", concertID.String()).
+				Queries(tt.queryParams).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.zoneHandler.FindAllZones(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+
+			if tt.expectedStatus == http.StatusOK {
+				data, ok := responseBody["data"].([]interface{})
+				require.True(t, ok)
+				assert.Len(t, data, tt.expectedCount)
+				assert.Contains(t, responseBody, "metadata")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+
+	"github.com/gin-gonic/gin"
+)
+
+type findOneZoneResponse struct {
+	ID          string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConcertID   string  `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string  `json:"name" example:"VIP"`
+	Description *string `json:"description" example:"Front row seats"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    string  `json:"currency" example:"THB"`
+	CreatedAt   string  `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+	UpdatedAt   string  `json:"updated_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+// @Summary		Find Zone by ID
+// @Description	Retrieve zone details of a concert by its ID
+// @Tags			Zone
+// @Produce		json
+// @Param			id		path		string																true	"Concert ID"
+// @Param			zone_id	path		string																true	"Zone ID"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=findOneZoneResponse,metadata=nil}	"Zone found"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}								"Bad request"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}								"Zone not found"
+// @Failure		429		{object}	httpresponse.ErrorResponse{data=object}							"Too Many Requests - Rate limit exceeded, see Retry-After"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}								"Internal server error"
+// @Router			/concerts/{id}/zones/{zone_id} [get]
+func (h *zoneHandler) FindZoneByID(c *gin.Context) {
+	zone, err := h.zoneUsecase.FindOneZone(c.Request.Context(), zoneUsecase.FindOneZoneInput{
+		ConcertID: c.Param("id"),
+		ZoneID:    c.Param("zone_id"),
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newFindOneZoneResponse(zone))
+}
+
+func (h *zoneHandler) newFindOneZoneResponse(zone *entity.Zone) findOneZoneResponse {
+	if zone == nil {
+		return findOneZoneResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return findOneZoneResponse{
+		ID:          zone.ID.String(),
+		ConcertID:   zone.ConcertID.String(),
+		Name:        zone.Name,
+		Description: zone.Description,
+		Price:       formatPrice(zone.Price),
+		Currency:    zone.Currency,
+		CreatedAt:   zone.CreatedAt.In(loc).Format(time.RFC3339),
+		UpdatedAt:   zone.UpdatedAt.In(loc).Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,153 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestZoneHandler_FindZoneByID(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	createdTime := time.Date(2024, 12, 1, 3, 0, 0, 0, time.UTC)
+	expectedZone := &entity.Zone{
+		ID:        zoneID,
+		ConcertID: concertID,
+		Name:      "VIP",
+		Currency:  "THB",
+		CreatedAt: createdTime,
+		UpdatedAt: createdTime,
+	}
+
+	tests := []struct {
+		name             string
+		zoneID           string
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:   "successful zone retrieval",
+			zoneID: zoneID.String(),
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindOneZone(gomock.Any(), zoneUsecase.FindOneZoneInput{
+						ConcertID: concertID.String(),
+						ZoneID:    zoneID.String(),
+					}).
+					Return(expectedZone, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":          zoneID.String(),
+					"concert_id":  concertID.String(),
+					"name":        "VIP",
+					"description": nil,
+					"price":       nil,
+					"currency":    "THB",
+					"created_at":  "2024-12-01T10:00:00+07:00",
+					"updated_at":  "2024-12-01T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name:   "zone not found",
+			zoneID: zoneID.String(),
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindOneZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "zone not found",
+			},
+		},
+		{
+			name:   "invalid UUID format",
+			zoneID: "invalid-uuid",
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindOneZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name:   "usecase internal error",
+			zoneID: zoneID.String(),
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					FindOneZone(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with path parameters using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodGet).
+				Path("/concerts/:id/zones/:zone_id").
+				Param("id", concertID.String()).
+				Param("zone_id", tt.zoneID).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			// Execute the handler
+			h.zoneHandler.FindZoneByID(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"ticket-reservation/internal/config"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+type ZoneHandler interface {
+	CreateZone(c *gin.Context)
+	FindZoneByID(c *gin.Context)
+	FindAllZones(c *gin.Context)
+	UpdateZone(c *gin.Context)
+}
+
+type zoneHandler struct {
+	appConfig   config.AppConfig
+	zoneUsecase zoneUsecase.ZoneUsecase
+}
+
+func NewZoneHandler(appConfig config.AppConfig, zoneUsecase zoneUsecase.ZoneUsecase) ZoneHandler {
+	return &zoneHandler{
+		appConfig:   appConfig,
+		zoneUsecase: zoneUsecase,
+	}
+}
+
+// formatPrice formats a price with 2 decimal places, nil stays nil for zones that are not on sale yet.
+func formatPrice(price *decimal.Decimal) *string {
+	if price == nil {
+		return nil
+	}
+	formatted := price.StringFixed(2)
+	return &formatted
+}
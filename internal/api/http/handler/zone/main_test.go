@@ -0,0 +1,65 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	handler "ticket-reservation/internal/api/http/handler/zone"
+	"ticket-reservation/internal/config"
+	zone_mocks "ticket-reservation/internal/usecase/zone/mocks"
+)
+
+type testHelper struct {
+	ctrl            *gomock.Controller
+	appConfig       config.AppConfig
+	mockZoneUsecase *zone_mocks.MockZoneUsecase
+	zoneHandler     handler.ZoneHandler
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockZoneUsecase := zone_mocks.NewMockZoneUsecase(ctrl)
+
+	zoneHandler := handler.NewZoneHandler(appConfig, mockZoneUsecase)
+
+	return &testHelper{
+		ctrl:            ctrl,
+		appConfig:       appConfig,
+		mockZoneUsecase: mockZoneUsecase,
+		zoneHandler:     zoneHandler,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewZoneHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockZoneUsecase := zone_mocks.NewMockZoneUsecase(ctrl)
+
+	// Execute
+	handler := handler.NewZoneHandler(appConfig, mockZoneUsecase)
+
+	// Assert
+	assert.NotNil(t, handler)
+}
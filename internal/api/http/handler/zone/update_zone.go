@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/internal/util/httpresponse"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type updateZoneRequest struct {
+	Name        *string `json:"name" example:"VIP"`
+	Description *string `json:"description" example:"Front row seats"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    *string `json:"currency" example:"THB"`
+}
+
+type updateZoneResponse struct {
+	ID          string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ConcertID   string  `json:"concert_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string  `json:"name" example:"VIP"`
+	Description *string `json:"description" example:"Front row seats"`
+	Price       *string `json:"price" example:"3500.00"`
+	Currency    string  `json:"currency" example:"THB"`
+	CreatedAt   string  `json:"created_at" example:"2025-01-01T10:00:00+07:00"`
+	UpdatedAt   string  `json:"updated_at" example:"2025-01-01T10:00:00+07:00"`
+}
+
+// @Summary		Update Zone
+// @Description	Partially update a zone of a concert (admin, or the organizer who owns the concert)
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string																true	"Concert ID"
+// @Param			zone_id	path		string																true	"Zone ID"
+// @Param			request	body		updateZoneRequest													true	"Zone update input"
+// @Success		200		{object}	httpresponse.SuccessResponse{data=updateZoneResponse,metadata=nil}	"Zone updated"
+// @Failure		400		{object}	httpresponse.ErrorResponse{data=nil}								"Bad request"
+// @Failure		401		{object}	httpresponse.ErrorResponse{data=nil}								"Unauthorized - Missing or invalid access token"
+// @Failure		403		{object}	httpresponse.ErrorResponse{data=nil}								"Forbidden - The concert is managed by another organizer"
+// @Failure		404		{object}	httpresponse.ErrorResponse{data=nil}								"Zone not found"
+// @Failure		500		{object}	httpresponse.ErrorResponse{data=nil}								"Internal server error"
+// @Security		ApiKeyAuth
+// @Router			/concerts/{id}/zones/{zone_id} [patch]
+func (h *zoneHandler) UpdateZone(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// response with default unauthorized error
+		httpresponse.Error(c, errsFramework.NewUnauthorizedError("", nil))
+		return
+	}
+
+	var input updateZoneRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("unable to parse request", map[string]string{"details": err.Error()}))
+		httpresponse.Error(c, err)
+		return
+	}
+
+	updatedZone, err := h.zoneUsecase.UpdateZone(c.Request.Context(), zoneUsecase.UpdateZoneInput{
+		ConcertID:   c.Param("id"),
+		ZoneID:      c.Param("zone_id"),
+		Name:        input.Name,
+		Description: input.Description,
+		Price:       input.Price,
+		Currency:    input.Currency,
+		Principal:   principal,
+	})
+	if err != nil {
+		httpresponse.Error(c, err)
+		return
+	}
+
+	httpresponse.Success(c, h.newUpdateZoneResponse(updatedZone))
+}
+
+func (h *zoneHandler) newUpdateZoneResponse(zone *entity.Zone) updateZoneResponse {
+	if zone == nil {
+		return updateZoneResponse{}
+	}
+
+	loc, _ := time.LoadLocation(h.appConfig.Timezone)
+	return updateZoneResponse{
+		ID:          zone.ID.String(),
+		ConcertID:   zone.ConcertID.String(),
+		Name:        zone.Name,
+		Description: zone.Description,
+		Price:       formatPrice(zone.Price),
+		Currency:    zone.Currency,
+		CreatedAt:   zone.CreatedAt.In(loc).Format(time.RFC3339),
+		UpdatedAt:   zone.UpdatedAt.In(loc).Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,215 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
+	"ticket-reservation/pkg/testhelper"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneHandler_UpdateZone(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	createdTime := time.Date(2024, 12, 1, 3, 0, 0, 0, time.UTC)
+	updatedTime := time.Date(2024, 12, 2, 3, 0, 0, 0, time.UTC)
+	updatedZone := &entity.Zone{
+		ID:          zoneID,
+		ConcertID:   concertID,
+		Name:        "VVIP",
+		Description: pointer.ToPointer("Closest to the stage"),
+		Price:       pointer.ToPointer(decimal.RequireFromString("4200.5")),
+		Currency:    "THB",
+		CreatedAt:   createdTime,
+		UpdatedAt:   updatedTime,
+	}
+
+	principal := &entity.Principal{UserID: uuid.New(), Email: "organizer@example.com", Role: entity.UserRoleOrganizer}
+
+	tests := []struct {
+		name             string
+		principal        *entity.Principal
+		requestBody      interface{}
+		setupMocks       func(h *testHelper)
+		expectedStatus   int
+		expectedResponse map[string]interface{}
+	}{
+		{
+			name:        "successful zone update",
+			principal:   principal,
+			requestBody: map[string]interface{}{"name": "VVIP", "description": "Closest to the stage", "price": "4200.50"},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					UpdateZone(gomock.Any(), zoneUsecase.UpdateZoneInput{
+						ConcertID:   concertID.String(),
+						ZoneID:      zoneID.String(),
+						Name:        pointer.ToPointer("VVIP"),
+						Description: pointer.ToPointer("Closest to the stage"),
+						Price:       pointer.ToPointer("4200.50"),
+						Principal:   principal,
+					}).
+					Return(updatedZone, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-200000",
+				"data": map[string]interface{}{
+					"id":          zoneID.String(),
+					"concert_id":  concertID.String(),
+					"name":        "VVIP",
+					"description": "Closest to the stage",
+					"price":       "4200.50",
+					"currency":    "THB",
+					"created_at":  "2024-12-01T10:00:00+07:00",
+					"updated_at":  "2024-12-02T10:00:00+07:00",
+				},
+			},
+		},
+		{
+			name:        "invalid JSON body",
+			principal:   principal,
+			requestBody: "not-an-object",
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected for validation errors
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "unable to parse request",
+			},
+		},
+		{
+			name:        "usecase validation error",
+			principal:   principal,
+			requestBody: map[string]interface{}{},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					UpdateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewBadRequestError("the request is invalid", nil))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-401000",
+				"message": "the request is invalid",
+			},
+		},
+		{
+			name:        "zone not found",
+			principal:   principal,
+			requestBody: map[string]interface{}{"name": "VVIP"},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					UpdateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-402000",
+				"message": "zone not found",
+			},
+		},
+		{
+			name:        "usecase internal error",
+			principal:   principal,
+			requestBody: map[string]interface{}{"name": "VVIP"},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					UpdateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-500000",
+				"message": "An unexpected error occurred. Please try again later.",
+			},
+		},
+		{
+			name:        "not authenticated",
+			principal:   nil,
+			requestBody: map[string]interface{}{"name": "VVIP"},
+			setupMocks: func(h *testHelper) {
+				// No usecase calls expected without a principal
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResponse: map[string]interface{}{
+				"code": "ERR-901000",
+			},
+		},
+		{
+			name:        "concert managed by another organizer",
+			principal:   principal,
+			requestBody: map[string]interface{}{"name": "VVIP"},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneUsecase.EXPECT().
+					UpdateZone(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewForbiddenError("the concert is managed by another organizer", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedResponse: map[string]interface{}{
+				"code":    "ERR-902000",
+				"message": "the concert is managed by another organizer",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks for this test case
+			tt.setupMocks(h)
+
+			// Create response recorder
+			w := httptest.NewRecorder()
+
+			// Create Gin context with JSON body using testhelper
+			c := testhelper.NewGinCtx(w).
+				Method(http.MethodPatch).
+				Path("/concerts/:id/zones/:zone_id").
+				Param("id", concertID.String()).
+				Param("zone_id", zoneID.String()).
+				JSONBody(tt.requestBody).
+				WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+				MustBuild(t)
+
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute the handler
+			h.zoneHandler.UpdateZone(c)
+
+			// Assert HTTP status code
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			// Assert response body
+			var responseBody map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedResponse {
+				actualValue, exists := responseBody[key]
+				assert.True(t, exists, "Expected key '%s' to exist in response", key)
+				assert.Equal(t, expectedValue, actualValue, "Mismatch for key '%s'", key)
+			}
+		})
+	}
+}
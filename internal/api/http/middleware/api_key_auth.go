@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	"ticket-reservation/internal/util/httpresponse"
+)
+
+const (
+	// APIKeyContextKey is the gin context key holding the *entity.APIKey a request was authenticated with
+	APIKeyContextKey = "api_key"
+)
+
+// ApiKeyAuth authenticates requests sending "Authorization: ApiKey <key>" with a key granted the scope,
+// and stores the key and the principal it acts as (see entity.APIKey.Principal) in the context.
+// Any other request is left to the next handler, so that it can be placed before Authenticate to accept both credentials.
+func (m *middleware) ApiKeyAuth(authenticator apiKeyUsecase.APIKeyUsecase, scope entity.APIKeyScope) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key, found := strings.CutPrefix(ctx.Request.Header.Get("Authorization"), "ApiKey ")
+		if !found {
+			ctx.Next()
+			return
+		}
+
+		apiKey, err := authenticator.AuthenticateAPIKey(ctx.Request.Context(), apiKeyUsecase.AuthenticateAPIKeyInput{
+			Key:   key,
+			Scope: scope,
+		})
+		if err != nil {
+			httpresponse.Error(ctx, err)
+			return
+		}
+
+		ctx.Set(APIKeyContextKey, apiKey)
+		ctx.Set(PrincipalContextKey, apiKey.Principal(scope))
+		ctx.Next()
+	}
+}
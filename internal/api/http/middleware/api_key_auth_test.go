@@ -0,0 +1,110 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	api_key_mocks "ticket-reservation/internal/usecase/api_key/mocks"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// TestMiddleware_ApiKeyAuth runs ApiKeyAuth followed by Authenticate, as on the routes accepting both credentials.
+func TestMiddleware_ApiKeyAuth(t *testing.T) {
+	jwtManager := newJWTManager(t)
+	user := &entity.User{ID: uuid.New(), Email: "fan@example.com", Role: entity.UserRoleCustomer}
+	apiKey := &entity.APIKey{ID: uuid.New(), Name: "Partner", Scopes: []entity.APIKeyScope{entity.APIKeyScopeReservationsWrite}}
+
+	tests := []struct {
+		name              string
+		headers           map[string]string
+		setupMocks        func(mockAPIKeyUsecase *api_key_mocks.MockAPIKeyUsecase)
+		expectedStatus    int
+		expectedCode      string
+		expectedAPIKey    *entity.APIKey
+		expectedPrincipal *entity.Principal
+	}{
+		{
+			name:    "valid api key",
+			headers: map[string]string{"Authorization": "ApiKey tr_live_secret"},
+			setupMocks: func(mockAPIKeyUsecase *api_key_mocks.MockAPIKeyUsecase) {
+				mockAPIKeyUsecase.EXPECT().
+					AuthenticateAPIKey(gomock.Any(), apiKeyUsecase.AuthenticateAPIKeyInput{Key: "tr_live_secret", Scope: entity.APIKeyScopeReservationsWrite}).
+					Return(apiKey, nil)
+			},
+			expectedStatus:    http.StatusOK,
+			expectedAPIKey:    apiKey,
+			expectedPrincipal: apiKey.Principal(entity.APIKeyScopeReservationsWrite),
+		},
+		{
+			name:    "rejected api key",
+			headers: map[string]string{"Authorization": "ApiKey tr_live_revoked"},
+			setupMocks: func(mockAPIKeyUsecase *api_key_mocks.MockAPIKeyUsecase) {
+				mockAPIKeyUsecase.EXPECT().
+					AuthenticateAPIKey(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewUnauthorizedError("the api key is invalid", nil))
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+		{
+			name:              "bearer token falls through to Authenticate",
+			headers:           map[string]string{"Authorization": "Bearer " + newToken(t, jwtManager, user, entity.AuthTokenTypeAccess)},
+			setupMocks:        func(mockAPIKeyUsecase *api_key_mocks.MockAPIKeyUsecase) {},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: &entity.Principal{UserID: user.ID, Email: user.Email, Role: entity.UserRoleCustomer},
+		},
+		{
+			name:           "missing credentials fall through to Authenticate",
+			setupMocks:     func(mockAPIKeyUsecase *api_key_mocks.MockAPIKeyUsecase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPIKeyUsecase := api_key_mocks.NewMockAPIKeyUsecase(ctrl)
+			tt.setupMocks(mockAPIKeyUsecase)
+
+			w := httptest.NewRecorder()
+			c := newGinCtx(t, w, tt.headers)
+			m := middleware.New()
+
+			// Execute
+			m.ApiKeyAuth(mockAPIKeyUsecase, entity.APIKeyScopeReservationsWrite)(c)
+			if !c.IsAborted() {
+				m.Authenticate(jwtManager)(c)
+			}
+
+			// Assert
+			if tt.expectedCode != "" {
+				assert.True(t, c.IsAborted())
+				assertErrorResponse(t, w, tt.expectedStatus, tt.expectedCode)
+				return
+			}
+			assert.False(t, c.IsAborted())
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			principal, ok := middleware.GetPrincipal(c)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedPrincipal, principal)
+			storedAPIKey, exists := c.Get(middleware.APIKeyContextKey)
+			if tt.expectedAPIKey != nil {
+				assert.Equal(t, tt.expectedAPIKey, storedAPIKey)
+			} else {
+				assert.False(t, exists)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/util/httpresponse"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+)
+
+const (
+	// PrincipalContextKey is the gin context key holding the *entity.Principal of the authenticated user
+	PrincipalContextKey = "principal"
+)
+
+// Authenticate lets through requests carrying a valid access token in "Authorization: Bearer <token>",
+// and stores the principal the token was issued to in the context.
+// Requests already authenticated by ApiKeyAuth are let through as they are.
+func (m *middleware) Authenticate(jwtManager jwtutil.JWTManager) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if _, ok := GetPrincipal(ctx); ok {
+			ctx.Next()
+			return
+		}
+
+		token, found := strings.CutPrefix(ctx.Request.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" {
+			// response with default unauthorized error
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("", nil))
+			return
+		}
+
+		var claims entity.AuthClaims
+		if err := jwtManager.ParseAndValidateToken(ctx.Request.Context(), token, &claims); err != nil || claims.TokenType != entity.AuthTokenTypeAccess {
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("the access token is invalid", nil))
+			return
+		}
+		principal, err := claims.Principal()
+		if err != nil {
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("the access token is invalid", nil))
+			return
+		}
+
+		ctx.Set(PrincipalContextKey, principal)
+		ctx.Next()
+	}
+}
+
+// GetPrincipal returns the principal stored by Authenticate, it is false on routes that are not authenticated.
+func GetPrincipal(ctx *gin.Context) (*entity.Principal, bool) {
+	value, exists := ctx.Get(PrincipalContextKey)
+	if !exists {
+		return nil, false
+	}
+	principal, ok := value.(*entity.Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,95 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+)
+
+func TestMiddleware_Authenticate(t *testing.T) {
+	jwtManager := newJWTManager(t)
+	user := &entity.User{ID: uuid.New(), Email: "fan@example.com", Role: entity.UserRoleCustomer}
+	apiKeyPrincipal := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleAdmin}
+
+	tests := []struct {
+		name              string
+		headers           map[string]string
+		principal         *entity.Principal // Set by a previous middleware
+		expectedStatus    int
+		expectedCode      string
+		expectedPrincipal *entity.Principal
+	}{
+		{
+			name:              "valid access token",
+			headers:           map[string]string{"Authorization": "Bearer " + newToken(t, jwtManager, user, entity.AuthTokenTypeAccess)},
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: &entity.Principal{UserID: user.ID, Email: user.Email, Role: entity.UserRoleCustomer},
+		},
+		{
+			name:              "already authenticated by an api key",
+			principal:         apiKeyPrincipal,
+			expectedStatus:    http.StatusOK,
+			expectedPrincipal: apiKeyPrincipal,
+		},
+		{
+			name:           "missing authorization header",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+		{
+			name:           "non-bearer authorization header",
+			headers:        map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+		{
+			name:           "empty bearer token",
+			headers:        map[string]string{"Authorization": "Bearer "},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+		{
+			name:           "malformed token",
+			headers:        map[string]string{"Authorization": "Bearer not-a-jwt"},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+		{
+			name:           "refresh token is not an access token",
+			headers:        map[string]string{"Authorization": "Bearer " + newToken(t, jwtManager, user, entity.AuthTokenTypeRefresh)},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c := newGinCtx(t, w, tt.headers)
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute
+			middleware.New().Authenticate(jwtManager)(c)
+
+			// Assert
+			if tt.expectedCode != "" {
+				assert.True(t, c.IsAborted())
+				assertErrorResponse(t, w, tt.expectedStatus, tt.expectedCode)
+				return
+			}
+			assert.False(t, c.IsAborted())
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			principal, ok := middleware.GetPrincipal(c)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedPrincipal, principal)
+		})
+	}
+}
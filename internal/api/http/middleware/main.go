@@ -2,10 +2,23 @@ package middleware
 
 import (
 	"github.com/gin-gonic/gin"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
 )
 
 type Middleware interface {
 	BasicAuth(username, password string) gin.HandlerFunc
+	ScannerAuth(scannerAPIKeys map[string]string) gin.HandlerFunc
+	Authenticate(jwtManager jwtutil.JWTManager) gin.HandlerFunc
+	RequireRole(roles ...entity.UserRole) gin.HandlerFunc
+	ApiKeyAuth(authenticator apiKeyUsecase.APIKeyUsecase, scope entity.APIKeyScope) gin.HandlerFunc
+	RateLimit(limiter domaincache.RateLimiterRepository, group entity.RateLimitGroup, limit entity.RateLimit) gin.HandlerFunc
+	RequireWaitingRoomPass(verifier waitingRoomUsecase.WaitingRoomUsecase) gin.HandlerFunc
 }
 
 type middleware struct{}
@@ -0,0 +1,67 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/pkg/testhelper"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+)
+
+// concertID is the "id" path parameter of the requests built by newGinCtx
+const concertID = "0b6a3c6e-7f0e-4a8e-9d3c-2f1f4b5a6c7d"
+
+func TestNew(t *testing.T) {
+	// Execute
+	m := middleware.New()
+
+	// Assert
+	assert.NotNil(t, m)
+}
+
+// newGinCtx builds the context of a GET request on /concerts/:id with the given headers,
+// the request context carries a noop logger as the middlewares log the errors they respond with.
+func newGinCtx(t *testing.T, w *httptest.ResponseRecorder, headers map[string]string) *gin.Context {
+	return testhelper.NewGinCtx(w).
+		Method(http.MethodGet).
+		Path("/concerts/"+concertID).
+		Param("id", concertID).
+		Headers(headers).
+		WithContext(logger.NewContext(context.Background(), logger.NewNoopLogger())).
+		MustBuild(t)
+}
+
+// newJWTManager returns a JWT manager signing the tokens of the tests.
+func newJWTManager(t *testing.T) jwtutil.JWTManager {
+	jwtManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte("test-secret"))
+	require.NoError(t, err)
+	return jwtManager
+}
+
+// newToken returns a token of the given type issued to the user.
+func newToken(t *testing.T, jwtManager jwtutil.JWTManager, user *entity.User, tokenType entity.AuthTokenType) string {
+	token, err := jwtManager.CreateToken(context.Background(), entity.NewAuthClaims(user, tokenType, time.Now(), time.Hour))
+	require.NoError(t, err)
+	return token
+}
+
+// assertErrorResponse asserts the status and the error code the middleware responded with.
+func assertErrorResponse(t *testing.T, w *httptest.ResponseRecorder, expectedStatus int, expectedCode string) {
+	assert.Equal(t, expectedStatus, w.Code)
+
+	var responseBody map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &responseBody))
+	assert.Equal(t, expectedCode, responseBody["code"])
+}
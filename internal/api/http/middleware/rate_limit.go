@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"ticket-reservation/internal/util/httpresponse"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+// RateLimit limits the requests every caller makes on the routes of the group, rejected requests get a 429 with Retry-After.
+// Callers are told apart by the principal authenticated by the previous middlewares, or else by their IP,
+// so that it is placed after the authentication middlewares of the route.
+// The RateLimit-* headers tell the caller its budget. A request is let through when Redis cannot be reached,
+// so that losing Redis does not take down every route.
+func (m *middleware) RateLimit(limiter domaincache.RateLimiterRepository, group entity.RateLimitGroup, limit entity.RateLimit) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if limit.IsZero() {
+			ctx.Next()
+			return
+		}
+
+		result, err := limiter.Allow(ctx.Request.Context(), group, rateLimitSubject(ctx), limit)
+		if err != nil {
+			logger.FromContext(ctx.Request.Context()).Error(ctx.Request.Context(), "failed to check the rate limit, the request is let through", err, logger.Fields{
+				"rate_limit_group": group.String(),
+			})
+			ctx.Next()
+			return
+		}
+
+		header := ctx.Writer.Header()
+		header.Set("RateLimit-Limit", strconv.FormatInt(result.Limit, 10))
+		header.Set("RateLimit-Remaining", strconv.FormatInt(result.Remaining, 10))
+		header.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.ResetAfter), 10))
+		if !result.Allowed {
+			retryAfter := ceilSeconds(result.RetryAfter)
+			header.Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+			httpresponse.Error(ctx, errs.NewTooManyRequestsError(map[string]interface{}{
+				"retry_after_seconds": retryAfter,
+			}))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// rateLimitSubject returns who the request is counted against, the signed-in user or API key, or else the client IP.
+func rateLimitSubject(ctx *gin.Context) string {
+	if principal, ok := GetPrincipal(ctx); ok {
+		return "session:" + principal.SessionID()
+	}
+	return "ip:" + ctx.ClientIP()
+}
+
+// ceilSeconds rounds a wait up to whole seconds, as sent in the Retry-After and RateLimit-Reset headers.
+func ceilSeconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,122 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/api/http/middleware"
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	"ticket-reservation/internal/domain/entity"
+)
+
+func TestMiddleware_RateLimit(t *testing.T) {
+	limit := entity.RateLimit{Requests: 20, Window: time.Minute}
+	principal := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleCustomer}
+
+	tests := []struct {
+		name            string
+		limit           entity.RateLimit
+		principal       *entity.Principal
+		setupMocks      func(mockRateLimiter *cache_mocks.MockRateLimiterRepository)
+		expectedStatus  int
+		expectedCode    string
+		expectedHeaders map[string]string // An empty value asserts that the header is not set
+	}{
+		{
+			name:      "allowed request of a signed-in user",
+			limit:     limit,
+			principal: principal,
+			setupMocks: func(mockRateLimiter *cache_mocks.MockRateLimiterRepository) {
+				mockRateLimiter.EXPECT().
+					Allow(gomock.Any(), entity.RateLimitGroupReserve, "session:"+principal.SessionID(), limit).
+					Return(&entity.RateLimitResult{Allowed: true, Limit: 20, Remaining: 19, ResetAfter: 3 * time.Second}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit":     "20",
+				"RateLimit-Remaining": "19",
+				"RateLimit-Reset":     "3",
+				"Retry-After":         "",
+			},
+		},
+		{
+			name:  "rejected request of an anonymous caller",
+			limit: limit,
+			setupMocks: func(mockRateLimiter *cache_mocks.MockRateLimiterRepository) {
+				mockRateLimiter.EXPECT().
+					Allow(gomock.Any(), entity.RateLimitGroupReserve, "ip:192.0.2.1", limit).
+					Return(&entity.RateLimitResult{Allowed: false, Limit: 20, Remaining: 0, RetryAfter: 2500 * time.Millisecond, ResetAfter: time.Minute}, nil)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedCode:   "ERR-400001",
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit":     "20",
+				"RateLimit-Remaining": "0",
+				"RateLimit-Reset":     "60",
+				"Retry-After":         "3",
+			},
+		},
+		{
+			name:  "limiter error lets the request through",
+			limit: limit,
+			setupMocks: func(mockRateLimiter *cache_mocks.MockRateLimiterRepository) {
+				mockRateLimiter.EXPECT().
+					Allow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("redis: connection refused"))
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit": "",
+				"Retry-After":     "",
+			},
+		},
+		{
+			name:           "zero limit is not checked",
+			limit:          entity.RateLimit{},
+			setupMocks:     func(mockRateLimiter *cache_mocks.MockRateLimiterRepository) {},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit": "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRateLimiter := cache_mocks.NewMockRateLimiterRepository(ctrl)
+			tt.setupMocks(mockRateLimiter)
+
+			w := httptest.NewRecorder()
+			c := newGinCtx(t, w, nil)
+			c.Request.RemoteAddr = "192.0.2.1:54321"
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute
+			middleware.New().RateLimit(mockRateLimiter, entity.RateLimitGroupReserve, tt.limit)(c)
+
+			// Assert
+			for key, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(key), "header %s", key)
+			}
+			if tt.expectedCode != "" {
+				assert.True(t, c.IsAborted())
+				assertErrorResponse(t, w, tt.expectedStatus, tt.expectedCode)
+				return
+			}
+			assert.False(t, c.IsAborted())
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
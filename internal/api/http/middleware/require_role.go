@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/util/httpresponse"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// RequireRole lets through requests of a principal having one of the given roles, it must run after Authenticate.
+// Requests without a principal are rejected as unauthorized, and requests of any other role as forbidden.
+func (m *middleware) RequireRole(roles ...entity.UserRole) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := GetPrincipal(ctx)
+		if !ok {
+			// response with default unauthorized error
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("", nil))
+			return
+		}
+		if !principal.HasRole(roles...) {
+			httpresponse.Error(ctx, errsFramework.NewForbiddenError("the user is not allowed to perform this action", nil))
+			return
+		}
+
+		ctx.Next()
+	}
+}
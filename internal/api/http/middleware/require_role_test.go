@@ -0,0 +1,61 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+)
+
+func TestMiddleware_RequireRole(t *testing.T) {
+	tests := []struct {
+		name           string
+		principal      *entity.Principal
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "principal has one of the roles",
+			principal:      &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "principal has another role",
+			principal:      &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleCustomer},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "ERR-902000",
+		},
+		{
+			name:           "no principal",
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c := newGinCtx(t, w, nil)
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute
+			middleware.New().RequireRole(entity.UserRoleAdmin, entity.UserRoleOrganizer)(c)
+
+			// Assert
+			if tt.expectedCode != "" {
+				assert.True(t, c.IsAborted())
+				assertErrorResponse(t, w, tt.expectedStatus, tt.expectedCode)
+				return
+			}
+			assert.False(t, c.IsAborted())
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+	"ticket-reservation/internal/util/httpresponse"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+const (
+	// WaitingRoomPassHeader is the request header carrying the pass of a buyer admitted from the waiting room
+	WaitingRoomPassHeader = "X-Waiting-Room-Pass"
+)
+
+// RequireWaitingRoomPass lets through requests on the concert of the "id" path parameter when the concert has no waiting room,
+// or when X-Waiting-Room-Pass carries a pass issued to the principal for the concert. It must run after Authenticate.
+func (m *middleware) RequireWaitingRoomPass(verifier waitingRoomUsecase.WaitingRoomUsecase) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := GetPrincipal(ctx)
+		if !ok {
+			// response with default unauthorized error
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("", nil))
+			return
+		}
+
+		_, err := verifier.VerifyWaitingRoomPass(ctx.Request.Context(), waitingRoomUsecase.VerifyWaitingRoomPassInput{
+			ConcertID: ctx.Param("id"),
+			SessionID: principal.SessionID(),
+			Pass:      ctx.Request.Header.Get(WaitingRoomPassHeader),
+		})
+		if err != nil {
+			httpresponse.Error(ctx, err)
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,96 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/api/http/middleware"
+	"ticket-reservation/internal/domain/entity"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+	waiting_room_mocks "ticket-reservation/internal/usecase/waiting_room/mocks"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestMiddleware_RequireWaitingRoomPass(t *testing.T) {
+	principal := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleCustomer}
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		principal      *entity.Principal
+		setupMocks     func(mockWaitingRoomUsecase *waiting_room_mocks.MockWaitingRoomUsecase)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:      "valid pass",
+			headers:   map[string]string{middleware.WaitingRoomPassHeader: "pass-token"},
+			principal: principal,
+			setupMocks: func(mockWaitingRoomUsecase *waiting_room_mocks.MockWaitingRoomUsecase) {
+				mockWaitingRoomUsecase.EXPECT().
+					VerifyWaitingRoomPass(gomock.Any(), waitingRoomUsecase.VerifyWaitingRoomPassInput{
+						ConcertID: concertID,
+						SessionID: principal.SessionID(),
+						Pass:      "pass-token",
+					}).
+					Return(&entity.WaitingRoomPassClaims{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "missing pass",
+			principal: principal,
+			setupMocks: func(mockWaitingRoomUsecase *waiting_room_mocks.MockWaitingRoomUsecase) {
+				mockWaitingRoomUsecase.EXPECT().
+					VerifyWaitingRoomPass(gomock.Any(), waitingRoomUsecase.VerifyWaitingRoomPassInput{
+						ConcertID: concertID,
+						SessionID: principal.SessionID(),
+					}).
+					Return(nil, errsFramework.NewForbiddenError("a waiting room pass is required to reserve seats of this concert", nil))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   "ERR-902000",
+		},
+		{
+			name:           "no principal",
+			headers:        map[string]string{middleware.WaitingRoomPassHeader: "pass-token"},
+			setupMocks:     func(mockWaitingRoomUsecase *waiting_room_mocks.MockWaitingRoomUsecase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "ERR-901000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWaitingRoomUsecase := waiting_room_mocks.NewMockWaitingRoomUsecase(ctrl)
+			tt.setupMocks(mockWaitingRoomUsecase)
+
+			w := httptest.NewRecorder()
+			c := newGinCtx(t, w, tt.headers)
+			if tt.principal != nil {
+				c.Set(middleware.PrincipalContextKey, tt.principal)
+			}
+
+			// Execute
+			middleware.New().RequireWaitingRoomPass(mockWaitingRoomUsecase)(c)
+
+			// Assert
+			if tt.expectedCode != "" {
+				assert.True(t, c.IsAborted())
+				assertErrorResponse(t, w, tt.expectedStatus, tt.expectedCode)
+				return
+			}
+			assert.False(t, c.IsAborted())
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
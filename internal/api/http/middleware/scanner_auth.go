@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+
+	"ticket-reservation/internal/util/httpresponse"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+const (
+	// ScannerKeyHeader is the request header carrying the API key of a gate scanner
+	ScannerKeyHeader = "X-Scanner-Key"
+	// ScannerIDContextKey is the gin context key holding the ID of the authenticated scanner
+	ScannerIDContextKey = "scanner_id"
+)
+
+// ScannerAuth lets through requests whose X-Scanner-Key matches the key of one of the scanners, and stores the scanner ID in the context.
+func (m *middleware) ScannerAuth(scannerAPIKeys map[string]string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.Request.Header.Get(ScannerKeyHeader)
+		if key == "" {
+			// response with default unauthorized error
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("", nil))
+			return
+		}
+		keyHash := sha256.Sum256([]byte(key))
+
+		// Compare with every key, so that the time taken does not tell which scanner almost matched
+		var scannerID string
+		for id, expectedKey := range scannerAPIKeys {
+			expectedKeyHash := sha256.Sum256([]byte(expectedKey))
+			if subtle.ConstantTimeCompare(keyHash[:], expectedKeyHash[:]) == 1 && expectedKey != "" {
+				scannerID = id
+			}
+		}
+		if scannerID == "" {
+			// response with default unauthorized error
+			httpresponse.Error(ctx, errsFramework.NewUnauthorizedError("", nil))
+			return
+		}
+
+		ctx.Set(ScannerIDContextKey, scannerID)
+		ctx.Next()
+	}
+}
@@ -16,13 +16,27 @@
 package httproute
 
 import (
+	apiKeyHandler "ticket-reservation/internal/api/http/handler/api_key"
+	authHandler "ticket-reservation/internal/api/http/handler/auth"
+	checkinHandler "ticket-reservation/internal/api/http/handler/checkin"
 	concertHandler "ticket-reservation/internal/api/http/handler/concert"
 	healthHandler "ticket-reservation/internal/api/http/handler/healthcheck"
+	paymentHandler "ticket-reservation/internal/api/http/handler/payment"
+	promoCodeHandler "ticket-reservation/internal/api/http/handler/promo_code"
+	reservationHandler "ticket-reservation/internal/api/http/handler/reservation"
 	seatHandler "ticket-reservation/internal/api/http/handler/seat"
+	waitingRoomHandler "ticket-reservation/internal/api/http/handler/waiting_room"
+	webhookHandler "ticket-reservation/internal/api/http/handler/webhook"
+	zoneHandler "ticket-reservation/internal/api/http/handler/zone"
 	"ticket-reservation/internal/api/http/middleware"
 	"ticket-reservation/internal/config"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
 
 	"github.com/gin-gonic/gin"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
 )
 
 type Router interface {
@@ -30,18 +44,46 @@ type Router interface {
 }
 
 type router struct {
-	cfg                config.AppConfig                 // Configuration for the application
-	Middleware         middleware.Middleware            // Middleware for handling requests
-	HealthCheckHandler healthHandler.HealthCheckHandler // Handler for health check routes
-	ConcertHandler     concertHandler.ConcertHandler    // Handler for concert routes
-	SeatHandler        seatHandler.SeatHandler          // Handler for seat routes
+	cfg                config.AppConfig                           // Configuration for the application
+	Middleware         middleware.Middleware                      // Middleware for handling requests
+	JWTManager         jwtutil.JWTManager                         // Verifies the access tokens of authenticated routes
+	APIKeyUsecase      apiKeyUsecase.APIKeyUsecase                // Verifies the partner API keys of authenticated routes
+	WaitingRoomUsecase waitingRoomUsecase.WaitingRoomUsecase      // Verifies the waiting room passes of seat reservation routes
+	RateLimiter        domaincache.RateLimiterRepository          // Counts the requests of rate limited routes
+	RateLimits         map[entity.RateLimitGroup]entity.RateLimit // Limit of every rate limited route group, a group without limit is not limited
+	HealthCheckHandler healthHandler.HealthCheckHandler           // Handler for health check routes
+	ConcertHandler     concertHandler.ConcertHandler              // Handler for concert routes
+	ZoneHandler        zoneHandler.ZoneHandler                    // Handler for zone routes
+	SeatHandler        seatHandler.SeatHandler                    // Handler for seat routes
+	ReservationHandler reservationHandler.ReservationHandler      // Handler for reservation routes
+	PaymentHandler     paymentHandler.PaymentHandler              // Handler for payment routes
+	PromoCodeHandler   promoCodeHandler.PromoCodeHandler          // Handler for promo code routes
+	CheckinHandler     checkinHandler.CheckinHandler              // Handler for gate check-in routes
+	AuthHandler        authHandler.AuthHandler                    // Handler for user authentication routes
+	APIKeyHandler      apiKeyHandler.APIKeyHandler                // Handler for partner API key routes
+	WaitingRoomHandler waitingRoomHandler.WaitingRoomHandler      // Handler for waiting room routes
+	WebhookHandler     webhookHandler.WebhookHandler              // Handler for webhook subscription routes
 }
 
 type Dependency struct {
 	Middleware         middleware.Middleware
+	JWTManager         jwtutil.JWTManager
+	APIKeyUsecase      apiKeyUsecase.APIKeyUsecase
+	WaitingRoomUsecase waitingRoomUsecase.WaitingRoomUsecase
+	RateLimiter        domaincache.RateLimiterRepository
+	RateLimits         map[entity.RateLimitGroup]entity.RateLimit
 	HealthCheckHandler healthHandler.HealthCheckHandler
 	ConcertHandler     concertHandler.ConcertHandler
+	ZoneHandler        zoneHandler.ZoneHandler
 	SeatHandler        seatHandler.SeatHandler
+	ReservationHandler reservationHandler.ReservationHandler
+	PaymentHandler     paymentHandler.PaymentHandler
+	PromoCodeHandler   promoCodeHandler.PromoCodeHandler
+	CheckinHandler     checkinHandler.CheckinHandler
+	AuthHandler        authHandler.AuthHandler
+	APIKeyHandler      apiKeyHandler.APIKeyHandler
+	WaitingRoomHandler waitingRoomHandler.WaitingRoomHandler
+	WebhookHandler     webhookHandler.WebhookHandler
 }
 
 // NewHTTPRoutes creates a new instance of Router with the provided configuration and dependencies
@@ -49,25 +91,62 @@ func NewHTTPRoutes(cfg config.AppConfig, dep Dependency) Router {
 	return &router{
 		cfg:                cfg,
 		Middleware:         dep.Middleware,
+		JWTManager:         dep.JWTManager,
+		APIKeyUsecase:      dep.APIKeyUsecase,
+		WaitingRoomUsecase: dep.WaitingRoomUsecase,
+		RateLimiter:        dep.RateLimiter,
+		RateLimits:         dep.RateLimits,
 		HealthCheckHandler: dep.HealthCheckHandler,
 		ConcertHandler:     dep.ConcertHandler,
+		ZoneHandler:        dep.ZoneHandler,
 		SeatHandler:        dep.SeatHandler,
+		ReservationHandler: dep.ReservationHandler,
+		PaymentHandler:     dep.PaymentHandler,
+		PromoCodeHandler:   dep.PromoCodeHandler,
+		CheckinHandler:     dep.CheckinHandler,
+		AuthHandler:        dep.AuthHandler,
+		APIKeyHandler:      dep.APIKeyHandler,
+		WaitingRoomHandler: dep.WaitingRoomHandler,
+		WebhookHandler:     dep.WebhookHandler,
 	}
 }
 
-// RegisterRoutes registers the routes for the application
+// RegisterRoutes registers the routes for the application.
+// Each route is either public, authenticated by a non-user credential (basic auth, scanner key or provider signature),
+// or authenticated with an access token and restricted to the roles passed to RequireRole.
+// Partners may call the routes preceded by ApiKeyAuth with an API key granted its scope instead of an access token.
+// Routes open to customers and partners are rate limited per caller, after authentication so that callers are told apart.
+// Seats of a concert with the waiting room enabled are only reserved with the pass of a buyer admitted from the waiting room.
 func (r *router) RegisterRoutes(router *gin.Engine) {
 	r.applyHealthCheckRoutes(router)
+	r.applyAuthRoutes(router)
 	r.applyConcertRoutes(router)
+	r.applyZoneRoutes(router)
+	r.applyWaitingRoomRoutes(router)
 	r.applySeatReservationRoutes(router)
+	r.applyReservationRoutes(router)
+	r.applyPaymentRoutes(router)
+	r.applyWebhookRoutes(router)
+	r.applyCheckinRoutes(router)
+	r.applyAdminRoutes(router)
 }
 
-// applyHealthCheckRoutes applies the health check routes to the provided router
+// applyHealthCheckRoutes applies the health check routes to the provided router, they are called by probes with the admin API key rather than by users
 func (r *router) applyHealthCheckRoutes(router *gin.Engine) {
-	healthRoute := router.Group("/health")
+	healthRoute := router.Group("/health", r.Middleware.BasicAuth(r.cfg.AdminAPIKey, r.cfg.AdminAPISecret))
 	{
-		healthRoute.GET("/liveness", r.Middleware.BasicAuth(r.cfg.AdminAPIKey, r.cfg.AdminAPISecret), r.HealthCheckHandler.Liveness)
-		healthRoute.GET("/readiness", r.Middleware.BasicAuth(r.cfg.AdminAPIKey, r.cfg.AdminAPISecret), r.HealthCheckHandler.Readiness)
+		healthRoute.GET("/liveness", r.HealthCheckHandler.Liveness)
+		healthRoute.GET("/readiness", r.HealthCheckHandler.Readiness)
+	}
+}
+
+// applyAuthRoutes applies the user authentication routes to the provided router, they are public
+func (r *router) applyAuthRoutes(router *gin.Engine) {
+	authRoute := router.Group("/auth", r.rateLimit(entity.RateLimitGroupAuth))
+	{
+		authRoute.POST("/register", r.AuthHandler.Register)
+		authRoute.POST("/login", r.AuthHandler.Login)
+		authRoute.POST("/refresh", r.AuthHandler.Refresh)
 	}
 }
 
@@ -75,16 +154,123 @@ func (r *router) applyHealthCheckRoutes(router *gin.Engine) {
 func (r *router) applyConcertRoutes(router *gin.Engine) {
 	concertRoute := router.Group("/concerts")
 	{
-		concertRoute.GET("/", r.ConcertHandler.FindAllConcerts)
-		concertRoute.POST("/", r.ConcertHandler.CreateConcert)
-		concertRoute.GET("/:id", r.ConcertHandler.FindConcertByID)
+		// public, concerts:read
+		concertRoute.GET("/", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeConcertsRead), r.rateLimit(entity.RateLimitGroupBrowse), r.ConcertHandler.FindAllConcerts)
+		concertRoute.GET("/:id", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeConcertsRead), r.rateLimit(entity.RateLimitGroupBrowse), r.ConcertHandler.FindConcertByID)
+		// admin, organizer (the organizer becomes the owner of the concert)
+		concertRoute.POST("/", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeAdmin), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleAdmin, entity.UserRoleOrganizer), r.ConcertHandler.CreateConcert)
+		// admin, organizer (owner of the concert only)
+		concertRoute.PUT("/:id/waiting-room", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeAdmin), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleAdmin, entity.UserRoleOrganizer), r.ConcertHandler.UpdateConcertWaitingRoom)
+	}
+}
+
+// applyZoneRoutes applies the zone routes to the provided router
+func (r *router) applyZoneRoutes(router *gin.Engine) {
+	zoneRoute := router.Group("/concerts/:id/zones")
+	{
+		// public, concerts:read
+		zoneRoute.GET("/", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeConcertsRead), r.rateLimit(entity.RateLimitGroupBrowse), r.ZoneHandler.FindAllZones)
+		zoneRoute.GET("/:zone_id", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeConcertsRead), r.rateLimit(entity.RateLimitGroupBrowse), r.ZoneHandler.FindZoneByID)
+		// admin, organizer (owner of the concert only)
+		zoneRoute.POST("/", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeAdmin), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleAdmin, entity.UserRoleOrganizer), r.ZoneHandler.CreateZone)
+		zoneRoute.PATCH("/:zone_id", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeAdmin), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleAdmin, entity.UserRoleOrganizer), r.ZoneHandler.UpdateZone)
+	}
+}
+
+// applyWaitingRoomRoutes applies the waiting room routes to the provided router, buyers join the queue of a concert and poll it for their pass
+func (r *router) applyWaitingRoomRoutes(router *gin.Engine) {
+	// customer, box_office, reservations:write
+	waitingRoomRoute := router.Group("/concerts/:id/waiting-room", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeReservationsWrite), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleCustomer, entity.UserRoleBoxOffice), r.rateLimit(entity.RateLimitGroupBrowse))
+	{
+		waitingRoomRoute.POST("", r.WaitingRoomHandler.JoinWaitingRoom)
+		waitingRoomRoute.GET("", r.WaitingRoomHandler.FindWaitingRoomEntry)
 	}
 }
 
-// applySeatRoutes applies the seat reservation routes to the provided router
+// applySeatRoutes applies the seat reservation routes to the provided router, the reservations made are owned by the signed-in user
 func (r *router) applySeatReservationRoutes(router *gin.Engine) {
 	seatRoute := router.Group("/concerts/:id/zones/:zone_id/seats")
 	{
-		seatRoute.POST("/:seat_id/reserve", r.SeatHandler.ReserveSeat)
+		// public, concerts:read
+		seatRoute.GET("/", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeConcertsRead), r.rateLimit(entity.RateLimitGroupBrowse), r.SeatHandler.FindAllSeats)
+		// public, concerts:read; Server-Sent Events, or a WebSocket on upgrade requests
+		seatRoute.GET("/stream", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeConcertsRead), r.rateLimit(entity.RateLimitGroupBrowse), r.SeatHandler.StreamSeatStatus)
+		// customer, box_office, reservations:write
+		seatRoute.POST("/:seat_id/reserve", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeReservationsWrite), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleCustomer, entity.UserRoleBoxOffice), r.rateLimit(entity.RateLimitGroupReserve), r.Middleware.RequireWaitingRoomPass(r.WaitingRoomUsecase), r.SeatHandler.ReserveSeat)
+	}
+	// customer, box_office, reservations:write
+	zoneReservationRoute := router.Group("/concerts/:id/zones/:zone_id", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeReservationsWrite), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleCustomer, entity.UserRoleBoxOffice), r.rateLimit(entity.RateLimitGroupReserve), r.Middleware.RequireWaitingRoomPass(r.WaitingRoomUsecase))
+	{
+		zoneReservationRoute.POST("/reservations", r.SeatHandler.ReserveSeats)
+		zoneReservationRoute.POST("/best-available", r.SeatHandler.ReserveBestAvailableSeats)
+	}
+}
+
+// applyReservationRoutes applies the reservation routes to the provided router, they are only served to the user who owns the reservation
+func (r *router) applyReservationRoutes(router *gin.Engine) {
+	// customer, box_office, reservations:write
+	reservationRoute := router.Group("/reservations", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeReservationsWrite), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleCustomer, entity.UserRoleBoxOffice), r.rateLimit(entity.RateLimitGroupCheckout))
+	{
+		reservationRoute.DELETE("/:id", r.ReservationHandler.CancelReservation)
+		reservationRoute.POST("/:id/pay", r.PaymentHandler.PayReservation)
+		reservationRoute.GET("/:id/tickets", r.ReservationHandler.FindReservationTickets)
 	}
 }
+
+// applyPaymentRoutes applies the payment routes to the provided router
+func (r *router) applyPaymentRoutes(router *gin.Engine) {
+	paymentRoute := router.Group("/payments")
+	{
+		// customer, box_office, reservations:write (owner of the payment only)
+		paymentRoute.GET("/:id", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeReservationsWrite), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleCustomer, entity.UserRoleBoxOffice), r.rateLimit(entity.RateLimitGroupCheckout), r.PaymentHandler.FindPaymentByID)
+		paymentRoute.GET("/:id/receipt", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeReservationsWrite), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleCustomer, entity.UserRoleBoxOffice), r.rateLimit(entity.RateLimitGroupCheckout), r.PaymentHandler.FindPaymentReceipt)
+		// admin, box_office
+		paymentRoute.POST("/:id/refunds", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeAdmin), r.Middleware.Authenticate(r.JWTManager), r.Middleware.RequireRole(entity.UserRoleAdmin, entity.UserRoleBoxOffice), r.PaymentHandler.RefundPayment)
+	}
+}
+
+// applyWebhookRoutes applies the routes called by external providers, they authenticate with a signature instead of a user
+func (r *router) applyWebhookRoutes(router *gin.Engine) {
+	webhookRoute := router.Group("/webhooks")
+	{
+		webhookRoute.POST("/payments/:provider", r.PaymentHandler.HandlePaymentWebhook)
+	}
+}
+
+// applyCheckinRoutes applies the routes called by scanner devices at the gates, they authenticate with a scanner API key
+func (r *router) applyCheckinRoutes(router *gin.Engine) {
+	checkinRoute := router.Group("/checkin", r.Middleware.ScannerAuth(r.cfg.ScannerAPIKeys))
+	{
+		checkinRoute.POST("", r.CheckinHandler.CheckIn)
+		checkinRoute.POST("/scans", r.CheckinHandler.UploadScans)
+	}
+}
+
+// applyAdminRoutes applies the administrative routes to the provided router
+func (r *router) applyAdminRoutes(router *gin.Engine) {
+	adminRoute := router.Group("/admin", r.Middleware.ApiKeyAuth(r.APIKeyUsecase, entity.APIKeyScopeAdmin), r.Middleware.Authenticate(r.JWTManager))
+	{
+		// admin
+		adminRoute.POST("/cleanup-expired", r.Middleware.RequireRole(entity.UserRoleAdmin), r.ReservationHandler.CleanupExpiredReservations)
+		adminRoute.POST("/promo-codes", r.Middleware.RequireRole(entity.UserRoleAdmin), r.PromoCodeHandler.CreatePromoCode)
+		adminRoute.GET("/promo-codes/:code", r.Middleware.RequireRole(entity.UserRoleAdmin), r.PromoCodeHandler.FindPromoCodeByCode)
+		adminRoute.GET("/concerts/:id/scanner-bundle", r.Middleware.RequireRole(entity.UserRoleAdmin), r.CheckinHandler.ExportScannerBundle)
+		adminRoute.GET("/concerts/:id/scan-conflicts", r.Middleware.RequireRole(entity.UserRoleAdmin), r.CheckinHandler.FindScanConflicts)
+		adminRoute.PUT("/users/:id/role", r.Middleware.RequireRole(entity.UserRoleAdmin), r.AuthHandler.UpdateUserRole)
+		adminRoute.POST("/api-keys", r.Middleware.RequireRole(entity.UserRoleAdmin), r.APIKeyHandler.CreateAPIKey)
+		adminRoute.GET("/api-keys", r.Middleware.RequireRole(entity.UserRoleAdmin), r.APIKeyHandler.FindAllAPIKeys)
+		adminRoute.POST("/api-keys/:id/rotate", r.Middleware.RequireRole(entity.UserRoleAdmin), r.APIKeyHandler.RotateAPIKey)
+		adminRoute.DELETE("/api-keys/:id", r.Middleware.RequireRole(entity.UserRoleAdmin), r.APIKeyHandler.RevokeAPIKey)
+		adminRoute.POST("/webhooks", r.Middleware.RequireRole(entity.UserRoleAdmin), r.WebhookHandler.CreateWebhookSubscription)
+		adminRoute.GET("/webhooks", r.Middleware.RequireRole(entity.UserRoleAdmin), r.WebhookHandler.FindAllWebhookSubscriptions)
+		adminRoute.PATCH("/webhooks/:id", r.Middleware.RequireRole(entity.UserRoleAdmin), r.WebhookHandler.UpdateWebhookSubscription)
+		adminRoute.GET("/webhooks/:id/deliveries", r.Middleware.RequireRole(entity.UserRoleAdmin), r.WebhookHandler.FindAllWebhookDeliveries)
+		// admin, organizer (owner of the concert only)
+		adminRoute.POST("/concerts/:id/zones/:zone_id/seats/generate", r.Middleware.RequireRole(entity.UserRoleAdmin, entity.UserRoleOrganizer), r.SeatHandler.GenerateSeats)
+	}
+}
+
+// rateLimit limits the requests every caller makes on the routes of the group
+func (r *router) rateLimit(group entity.RateLimitGroup) gin.HandlerFunc {
+	return r.Middleware.RateLimit(r.RateLimiter, group, r.RateLimits[group])
+}
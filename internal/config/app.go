@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"time"
 
 	cfgFramework "github.com/kittipat1413/go-common/framework/config"
@@ -11,14 +12,104 @@ type AppConfig struct {
 	AdminAPISecret string
 	Timezone       string
 	SeatLockTTL    time.Duration
+	// Expiry sweeper settings
+	ExpirySweepInterval  time.Duration
+	ExpirySweepBatchSize int
+	// Payment settings
+	PaymentGateway          string
+	PaymentWebhookSecrets   map[string]string // provider name to webhook signing secret, a provider without secret cannot send webhooks
+	PaymentWebhookTolerance time.Duration
+	PromptPayBillerID       string
+	// Pricing settings, rates are decimal strings so that they are parsed without floating point errors
+	ServiceFeePercent string
+	ServiceFeeFixed   string
+	PaymentFeePercent string
+	VATPercent        string
+	PriceRounding     string
+	// Receipt settings, receipt numbers run on per seller tax ID
+	ReceiptSellerTaxID   string
+	ReceiptSellerName    string
+	ReceiptSellerAddress string
+	ReceiptNumberPrefix  string
+	// Ticket settings
+	TicketSigningKey string
+	ScannerAPIKeys   map[string]string // scanner ID to API key, a scanner without key cannot check tickets in
+	// Auth settings
+	JWTSecret          string
+	JWTAccessTokenTTL  time.Duration
+	JWTRefreshTokenTTL time.Duration
+	// Rate limit settings per route group, limits are written "<requests>/<window>" like "20/1m", empty disables the limit
+	RateLimitAuth     string
+	RateLimitBrowse   string
+	RateLimitReserve  string
+	RateLimitCheckout string
+	// Waiting room settings
+	WaitingRoomAdmitInterval  time.Duration
+	WaitingRoomAdmitBatchSize int
+	WaitingRoomPassTTL        time.Duration
+	// Seat status stream settings
+	SeatStreamHeartbeat time.Duration
+	// Outbound webhook settings
+	WebhookDeliveryInterval  time.Duration
+	WebhookDeliveryBatchSize int
+	WebhookDeliveryLease     time.Duration
+	WebhookMaxAttempts       int
+	WebhookBackoffBase       time.Duration
+	WebhookBackoffMax        time.Duration
+	WebhookTimeout           time.Duration
 	// Add business feature flags here
 }
 
 func LoadAppConfig(cfg *cfgFramework.Config) AppConfig {
 	return AppConfig{
-		AdminAPIKey:    cfg.GetString(AdminAPIKey),
-		AdminAPISecret: cfg.GetString(AdminAPISecret),
-		Timezone:       cfg.GetString(AppTimezoneKey),
-		SeatLockTTL:    cfg.GetDuration(SeatLockTTLKey),
+		AdminAPIKey:               cfg.GetString(AdminAPIKey),
+		AdminAPISecret:            cfg.GetString(AdminAPISecret),
+		Timezone:                  cfg.GetString(AppTimezoneKey),
+		SeatLockTTL:               cfg.GetDuration(SeatLockTTLKey),
+		ExpirySweepInterval:       cfg.GetDuration(ExpirySweepIntervalKey),
+		ExpirySweepBatchSize:      cfg.GetInt(ExpirySweepBatchSizeKey),
+		PaymentGateway:            cfg.GetString(PaymentGatewayKey),
+		PaymentWebhookSecrets:     loadLowerCasedStringMap(cfg, PaymentWebhookSecretsKey),
+		PaymentWebhookTolerance:   cfg.GetDuration(PaymentWebhookToleranceKey),
+		PromptPayBillerID:         cfg.GetString(PromptPayBillerIDKey),
+		ServiceFeePercent:         cfg.GetString(ServiceFeePercentKey),
+		ServiceFeeFixed:           cfg.GetString(ServiceFeeFixedKey),
+		PaymentFeePercent:         cfg.GetString(PaymentFeePercentKey),
+		VATPercent:                cfg.GetString(VATPercentKey),
+		PriceRounding:             cfg.GetString(PriceRoundingKey),
+		ReceiptSellerTaxID:        cfg.GetString(ReceiptSellerTaxIDKey),
+		ReceiptSellerName:         cfg.GetString(ReceiptSellerNameKey),
+		ReceiptSellerAddress:      cfg.GetString(ReceiptSellerAddressKey),
+		ReceiptNumberPrefix:       cfg.GetString(ReceiptNumberPrefixKey),
+		TicketSigningKey:          cfg.GetString(TicketSigningKeyKey),
+		ScannerAPIKeys:            loadLowerCasedStringMap(cfg, ScannerAPIKeysKey),
+		JWTSecret:                 cfg.GetString(JWTSecretKey),
+		JWTAccessTokenTTL:         cfg.GetDuration(JWTAccessTokenTTLKey),
+		JWTRefreshTokenTTL:        cfg.GetDuration(JWTRefreshTokenTTLKey),
+		RateLimitAuth:             cfg.GetString(RateLimitAuthKey),
+		RateLimitBrowse:           cfg.GetString(RateLimitBrowseKey),
+		RateLimitReserve:          cfg.GetString(RateLimitReserveKey),
+		RateLimitCheckout:         cfg.GetString(RateLimitCheckoutKey),
+		WaitingRoomAdmitInterval:  cfg.GetDuration(WaitingRoomAdmitIntervalKey),
+		WaitingRoomAdmitBatchSize: cfg.GetInt(WaitingRoomAdmitBatchSizeKey),
+		WaitingRoomPassTTL:        cfg.GetDuration(WaitingRoomPassTTLKey),
+		SeatStreamHeartbeat:       cfg.GetDuration(SeatStreamHeartbeatKey),
+		WebhookDeliveryInterval:   cfg.GetDuration(WebhookDeliveryIntervalKey),
+		WebhookDeliveryBatchSize:  cfg.GetInt(WebhookDeliveryBatchSizeKey),
+		WebhookDeliveryLease:      cfg.GetDuration(WebhookDeliveryLeaseKey),
+		WebhookMaxAttempts:        cfg.GetInt(WebhookMaxAttemptsKey),
+		WebhookBackoffBase:        cfg.GetDuration(WebhookBackoffBaseKey),
+		WebhookBackoffMax:         cfg.GetDuration(WebhookBackoffMaxKey),
+		WebhookTimeout:            cfg.GetDuration(WebhookTimeoutKey),
 	}
 }
+
+// loadLowerCasedStringMap reads a string map like the per-provider webhook secrets with lower-cased keys.
+// Keys from env.yaml are already lower-cased by viper, keys from a JSON environment variable are not.
+func loadLowerCasedStringMap(cfg *cfgFramework.Config, key string) map[string]string {
+	values := make(map[string]string)
+	for name, value := range cfg.GetStringMapString(key) {
+		values[strings.ToLower(name)] = value
+	}
+	return values
+}
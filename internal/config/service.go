@@ -8,14 +8,17 @@ type ServiceConfig struct {
 	Env          string
 	ErrorPrefix  string
 	OtelExporter string
+	// TrustedProxies are the proxies whose X-Forwarded-For header gives the client IP, empty trusts no proxy
+	TrustedProxies []string
 }
 
 func LoadServiceConfig(cfg *cfgFramework.Config) ServiceConfig {
 	return ServiceConfig{
-		Name:         cfg.GetString(ServiceNameKey),
-		Port:         cfg.GetString(ServicePortKey),
-		Env:          cfg.GetString(ServiceEnvKey),
-		ErrorPrefix:  cfg.GetString(ServiceErrPrefixKey),
-		OtelExporter: cfg.GetString(OtelExporterKey),
+		Name:           cfg.GetString(ServiceNameKey),
+		Port:           cfg.GetString(ServicePortKey),
+		Env:            cfg.GetString(ServiceEnvKey),
+		ErrorPrefix:    cfg.GetString(ServiceErrPrefixKey),
+		OtelExporter:   cfg.GetString(OtelExporterKey),
+		TrustedProxies: cfg.GetStringSlice(TrustedProxiesKey),
 	}
 }
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"fmt"
+	"ticket-reservation/internal/domain/entity"
 
 	"github.com/google/uuid"
 )
@@ -9,6 +10,16 @@ import (
 const (
 	SeatLockCacheKeyFormat = "seat_lock:concert:%s:zone:%s:seat:%s" // Format: seat_lock:concert:<concert_id>:zone:<zone_id>:seat:<seat_id>
 	SeatMapCacheKeyFormat  = "seat_map:concert:%s:zone:%s"          // Format: seat_map:concert:<concert_id>:zone:<zone_id>
+	SeatMapSizeKeyFormat   = "seat_map_size:concert:%s:zone:%s"     // Format: seat_map_size:concert:<concert_id>:zone:<zone_id>
+	RateLimitKeyFormat     = "rate_limit:%s:%s"                     // Format: rate_limit:<group>:<subject>
+	// The keys of a waiting room share the hash tag of the concert, so that its scripts run on a single cluster slot
+	WaitingRoomQueueKeyFormat     = "waiting_room:concert:{%s}:queue"      // Format: waiting_room:concert:{<concert_id>}:queue
+	WaitingRoomAdmittedKeyFormat  = "waiting_room:concert:{%s}:admitted"   // Format: waiting_room:concert:{<concert_id>}:admitted
+	WaitingRoomAdmitTickKeyFormat = "waiting_room:concert:{%s}:admit_tick" // Format: waiting_room:concert:{<concert_id>}:admit_tick
+	// The keys of the seat status events of a zone share the hash tag of the zone, for the same reason
+	SeatEventVersionKeyFormat = "seat_events:{concert:%s:zone:%s}:version" // Format: seat_events:{concert:<concert_id>:zone:<zone_id>}:version
+	SeatEventHistoryKeyFormat = "seat_events:{concert:%s:zone:%s}:history" // Format: seat_events:{concert:<concert_id>:zone:<zone_id>}:history
+	SeatEventChannelFormat    = "seat_events:concert:%s:zone:%s"           // Format: seat_events:concert:<concert_id>:zone:<zone_id>
 )
 
 func GetSeatLockKey(concertID, zoneID, seatID uuid.UUID) string {
@@ -18,3 +29,35 @@ func GetSeatLockKey(concertID, zoneID, seatID uuid.UUID) string {
 func GetSeatMapKey(concertID, zoneID uuid.UUID) string {
 	return fmt.Sprintf(SeatMapCacheKeyFormat, concertID.String(), zoneID.String())
 }
+
+func GetSeatMapSizeKey(concertID, zoneID uuid.UUID) string {
+	return fmt.Sprintf(SeatMapSizeKeyFormat, concertID.String(), zoneID.String())
+}
+
+func GetRateLimitKey(group entity.RateLimitGroup, subject string) string {
+	return fmt.Sprintf(RateLimitKeyFormat, group.String(), subject)
+}
+
+func GetWaitingRoomQueueKey(concertID uuid.UUID) string {
+	return fmt.Sprintf(WaitingRoomQueueKeyFormat, concertID.String())
+}
+
+func GetWaitingRoomAdmittedKey(concertID uuid.UUID) string {
+	return fmt.Sprintf(WaitingRoomAdmittedKeyFormat, concertID.String())
+}
+
+func GetWaitingRoomAdmitTickKey(concertID uuid.UUID) string {
+	return fmt.Sprintf(WaitingRoomAdmitTickKeyFormat, concertID.String())
+}
+
+func GetSeatEventVersionKey(concertID, zoneID uuid.UUID) string {
+	return fmt.Sprintf(SeatEventVersionKeyFormat, concertID.String(), zoneID.String())
+}
+
+func GetSeatEventHistoryKey(concertID, zoneID uuid.UUID) string {
+	return fmt.Sprintf(SeatEventHistoryKeyFormat, concertID.String(), zoneID.String())
+}
+
+func GetSeatEventChannel(concertID, zoneID uuid.UUID) string {
+	return fmt.Sprintf(SeatEventChannelFormat, concertID.String(), zoneID.String())
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./rate_limiter_repository.go
+
+// Package cache_mocks is a generated GoMock package.
+package cache_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRateLimiterRepository is a mock of RateLimiterRepository interface.
+type MockRateLimiterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimiterRepositoryMockRecorder
+}
+
+// MockRateLimiterRepositoryMockRecorder is the mock recorder for MockRateLimiterRepository.
+type MockRateLimiterRepositoryMockRecorder struct {
+	mock *MockRateLimiterRepository
+}
+
+// NewMockRateLimiterRepository creates a new mock instance.
+func NewMockRateLimiterRepository(ctrl *gomock.Controller) *MockRateLimiterRepository {
+	mock := &MockRateLimiterRepository{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimiterRepository) EXPECT() *MockRateLimiterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimiterRepository) Allow(ctx context.Context, group entity.RateLimitGroup, subject string, limit entity.RateLimit) (*entity.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, group, subject, limit)
+	ret0, _ := ret[0].(*entity.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimiterRepositoryMockRecorder) Allow(ctx, group, subject, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimiterRepository)(nil).Allow), ctx, group, subject, limit)
+}
@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./seat_event_repository.go
+
+// Package cache_mocks is a generated GoMock package.
+package cache_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	cache "ticket-reservation/internal/domain/cache"
+	entity "ticket-reservation/internal/domain/entity"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSeatEventRepository is a mock of SeatEventRepository interface.
+type MockSeatEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatEventRepositoryMockRecorder
+}
+
+// MockSeatEventRepositoryMockRecorder is the mock recorder for MockSeatEventRepository.
+type MockSeatEventRepositoryMockRecorder struct {
+	mock *MockSeatEventRepository
+}
+
+// NewMockSeatEventRepository creates a new mock instance.
+func NewMockSeatEventRepository(ctrl *gomock.Controller) *MockSeatEventRepository {
+	mock := &MockSeatEventRepository{ctrl: ctrl}
+	mock.recorder = &MockSeatEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatEventRepository) EXPECT() *MockSeatEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindSince mocks base method.
+func (m *MockSeatEventRepository) FindSince(ctx context.Context, concertID, zoneID uuid.UUID, version int64) (*entity.SeatStatusEventBacklog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSince", ctx, concertID, zoneID, version)
+	ret0, _ := ret[0].(*entity.SeatStatusEventBacklog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSince indicates an expected call of FindSince.
+func (mr *MockSeatEventRepositoryMockRecorder) FindSince(ctx, concertID, zoneID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSince", reflect.TypeOf((*MockSeatEventRepository)(nil).FindSince), ctx, concertID, zoneID, version)
+}
+
+// FindVersion mocks base method.
+func (m *MockSeatEventRepository) FindVersion(ctx context.Context, concertID, zoneID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindVersion", ctx, concertID, zoneID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindVersion indicates an expected call of FindVersion.
+func (mr *MockSeatEventRepositoryMockRecorder) FindVersion(ctx, concertID, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindVersion", reflect.TypeOf((*MockSeatEventRepository)(nil).FindVersion), ctx, concertID, zoneID)
+}
+
+// Publish mocks base method.
+func (m *MockSeatEventRepository) Publish(ctx context.Context, concertID, zoneID uuid.UUID, events entity.SeatStatusEvents) (entity.SeatStatusEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, concertID, zoneID, events)
+	ret0, _ := ret[0].(entity.SeatStatusEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockSeatEventRepositoryMockRecorder) Publish(ctx, concertID, zoneID, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockSeatEventRepository)(nil).Publish), ctx, concertID, zoneID, events)
+}
+
+// Subscribe mocks base method.
+func (m *MockSeatEventRepository) Subscribe(ctx context.Context, concertID, zoneID uuid.UUID) (cache.SeatEventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, concertID, zoneID)
+	ret0, _ := ret[0].(cache.SeatEventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSeatEventRepositoryMockRecorder) Subscribe(ctx, concertID, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSeatEventRepository)(nil).Subscribe), ctx, concertID, zoneID)
+}
+
+// MockSeatEventSubscription is a mock of SeatEventSubscription interface.
+type MockSeatEventSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatEventSubscriptionMockRecorder
+}
+
+// MockSeatEventSubscriptionMockRecorder is the mock recorder for MockSeatEventSubscription.
+type MockSeatEventSubscriptionMockRecorder struct {
+	mock *MockSeatEventSubscription
+}
+
+// NewMockSeatEventSubscription creates a new mock instance.
+func NewMockSeatEventSubscription(ctrl *gomock.Controller) *MockSeatEventSubscription {
+	mock := &MockSeatEventSubscription{ctrl: ctrl}
+	mock.recorder = &MockSeatEventSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatEventSubscription) EXPECT() *MockSeatEventSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSeatEventSubscription) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSeatEventSubscriptionMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSeatEventSubscription)(nil).Close))
+}
+
+// Err mocks base method.
+func (m *MockSeatEventSubscription) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockSeatEventSubscriptionMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockSeatEventSubscription)(nil).Err))
+}
+
+// Events mocks base method.
+func (m *MockSeatEventSubscription) Events() <-chan entity.SeatStatusEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].(<-chan entity.SeatStatusEvent)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockSeatEventSubscriptionMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockSeatEventSubscription)(nil).Events))
+}
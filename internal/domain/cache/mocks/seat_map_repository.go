@@ -67,6 +67,21 @@ func (mr *MockSeatMapRepositoryMockRecorder) GetSeat(ctx, concertID, zoneID, sea
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeat", reflect.TypeOf((*MockSeatMapRepository)(nil).GetSeat), ctx, concertID, zoneID, seatNumber)
 }
 
+// GetSeatCount mocks base method.
+func (m *MockSeatMapRepository) GetSeatCount(ctx context.Context, concertID, zoneID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeatCount", ctx, concertID, zoneID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeatCount indicates an expected call of GetSeatCount.
+func (mr *MockSeatMapRepositoryMockRecorder) GetSeatCount(ctx, concertID, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatCount", reflect.TypeOf((*MockSeatMapRepository)(nil).GetSeatCount), ctx, concertID, zoneID)
+}
+
 // SetSeat mocks base method.
 func (m *MockSeatMapRepository) SetSeat(ctx context.Context, concertID, zoneID uuid.UUID, seat entity.Seat, ttl time.Duration) error {
 	m.ctrl.T.Helper()
@@ -80,3 +95,31 @@ func (mr *MockSeatMapRepositoryMockRecorder) SetSeat(ctx, concertID, zoneID, sea
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeat", reflect.TypeOf((*MockSeatMapRepository)(nil).SetSeat), ctx, concertID, zoneID, seat, ttl)
 }
+
+// SetSeatCount mocks base method.
+func (m *MockSeatMapRepository) SetSeatCount(ctx context.Context, concertID, zoneID uuid.UUID, count int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeatCount", ctx, concertID, zoneID, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSeatCount indicates an expected call of SetSeatCount.
+func (mr *MockSeatMapRepositoryMockRecorder) SetSeatCount(ctx, concertID, zoneID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeatCount", reflect.TypeOf((*MockSeatMapRepository)(nil).SetSeatCount), ctx, concertID, zoneID, count)
+}
+
+// SetSeats mocks base method.
+func (m *MockSeatMapRepository) SetSeats(ctx context.Context, concertID, zoneID uuid.UUID, seats entity.Seats, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeats", ctx, concertID, zoneID, seats, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSeats indicates an expected call of SetSeats.
+func (mr *MockSeatMapRepositoryMockRecorder) SetSeats(ctx, concertID, zoneID, seats, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeats", reflect.TypeOf((*MockSeatMapRepository)(nil).SetSeats), ctx, concertID, zoneID, seats, ttl)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./waiting_room_repository.go
+
+// Package cache_mocks is a generated GoMock package.
+package cache_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWaitingRoomRepository is a mock of WaitingRoomRepository interface.
+type MockWaitingRoomRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitingRoomRepositoryMockRecorder
+}
+
+// MockWaitingRoomRepositoryMockRecorder is the mock recorder for MockWaitingRoomRepository.
+type MockWaitingRoomRepositoryMockRecorder struct {
+	mock *MockWaitingRoomRepository
+}
+
+// NewMockWaitingRoomRepository creates a new mock instance.
+func NewMockWaitingRoomRepository(ctrl *gomock.Controller) *MockWaitingRoomRepository {
+	mock := &MockWaitingRoomRepository{ctrl: ctrl}
+	mock.recorder = &MockWaitingRoomRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitingRoomRepository) EXPECT() *MockWaitingRoomRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Admit mocks base method.
+func (m *MockWaitingRoomRepository) Admit(ctx context.Context, concertID uuid.UUID, count int64, interval, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admit", ctx, concertID, count, interval, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Admit indicates an expected call of Admit.
+func (mr *MockWaitingRoomRepositoryMockRecorder) Admit(ctx, concertID, count, interval, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admit", reflect.TypeOf((*MockWaitingRoomRepository)(nil).Admit), ctx, concertID, count, interval, ttl)
+}
+
+// FindEntry mocks base method.
+func (m *MockWaitingRoomRepository) FindEntry(ctx context.Context, concertID uuid.UUID, sessionID string) (*entity.WaitingRoomEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEntry", ctx, concertID, sessionID)
+	ret0, _ := ret[0].(*entity.WaitingRoomEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEntry indicates an expected call of FindEntry.
+func (mr *MockWaitingRoomRepositoryMockRecorder) FindEntry(ctx, concertID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEntry", reflect.TypeOf((*MockWaitingRoomRepository)(nil).FindEntry), ctx, concertID, sessionID)
+}
+
+// Join mocks base method.
+func (m *MockWaitingRoomRepository) Join(ctx context.Context, concertID uuid.UUID, sessionID string) (*entity.WaitingRoomEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", ctx, concertID, sessionID)
+	ret0, _ := ret[0].(*entity.WaitingRoomEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockWaitingRoomRepositoryMockRecorder) Join(ctx, concertID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockWaitingRoomRepository)(nil).Join), ctx, concertID, sessionID)
+}
@@ -0,0 +1,13 @@
+package cache
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+)
+
+//go:generate mockgen -source=./rate_limiter_repository.go -destination=./mocks/rate_limiter_repository.go -package=cache_mocks
+type RateLimiterRepository interface {
+	// Allow counts a request of the subject against the limit of the route group, atomically across all instances.
+	// The request is only counted when it is allowed.
+	Allow(ctx context.Context, group entity.RateLimitGroup, subject string, limit entity.RateLimit) (*entity.RateLimitResult, error)
+}
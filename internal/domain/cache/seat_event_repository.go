@@ -0,0 +1,33 @@
+package cache
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./seat_event_repository.go -destination=./mocks/seat_event_repository.go -package=cache_mocks
+type SeatEventRepository interface {
+	// Publish numbers the events of the zone, keeps them for clients resuming later and sends them to the subscribers
+	// of the zone on every instance. It returns the events with their version set.
+	Publish(ctx context.Context, concertID, zoneID uuid.UUID, events entity.SeatStatusEvents) (entity.SeatStatusEvents, error)
+	// FindVersion returns the version of the last event published for the zone, 0 when there is none yet.
+	FindVersion(ctx context.Context, concertID, zoneID uuid.UUID) (int64, error)
+	// FindSince returns the events of the zone published after the version.
+	FindSince(ctx context.Context, concertID, zoneID uuid.UUID, version int64) (*entity.SeatStatusEventBacklog, error)
+	// Subscribe starts receiving the events published for the zone from now on.
+	// The subscription must be closed once done with.
+	Subscribe(ctx context.Context, concertID, zoneID uuid.UUID) (SeatEventSubscription, error)
+}
+
+// SeatEventSubscription receives the events published for a zone.
+type SeatEventSubscription interface {
+	// Events returns the channel of the events, closed when the subscription ends.
+	Events() <-chan entity.SeatStatusEvent
+	// Err returns why the subscription ended, entity.ErrSeatEventSubscriberTooSlow when the events were not read
+	// fast enough and nil when it was closed.
+	Err() error
+	// Close ends the subscription.
+	Close()
+}
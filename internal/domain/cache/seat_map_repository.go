@@ -17,8 +17,15 @@ const (
 type SeatMapRepository interface {
 	// SetSeat updates a complete seat entity with field-level TTL
 	SetSeat(ctx context.Context, concertID, zoneID uuid.UUID, seat entity.Seat, ttl time.Duration) error
+	// SetSeats updates multiple seat entities at once, applying the same field-level TTL to all of them
+	SetSeats(ctx context.Context, concertID, zoneID uuid.UUID, seats entity.Seats, ttl time.Duration) error
 	// GetSeat retrieves a complete seat entity
 	GetSeat(ctx context.Context, concertID, zoneID uuid.UUID, seatNumber string) (*entity.Seat, error)
 	// GetAllSeats retrieves all seat entities for a zone
 	GetAllSeats(ctx context.Context, concertID, zoneID uuid.UUID) (*entity.Seats, error)
+	// SetSeatCount stores the total number of seats in a zone, used to detect missing (expired) seat map fields
+	SetSeatCount(ctx context.Context, concertID, zoneID uuid.UUID, count int64) error
+	// GetSeatCount retrieves the total number of seats in a zone
+	// Returns NotFoundError if the count has not been cached yet.
+	GetSeatCount(ctx context.Context, concertID, zoneID uuid.UUID) (int64, error)
 }
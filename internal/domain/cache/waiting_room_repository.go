@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./waiting_room_repository.go -destination=./mocks/waiting_room_repository.go -package=cache_mocks
+type WaitingRoomRepository interface {
+	// Join queues the session in the waiting room of the concert and returns its entry.
+	// A session already waiting keeps its place, a session already admitted stays admitted.
+	Join(ctx context.Context, concertID uuid.UUID, sessionID string) (*entity.WaitingRoomEntry, error)
+	// FindEntry returns the entry of the session in the waiting room of the concert.
+	// Returns NotFoundError if the session is neither waiting nor admitted.
+	FindEntry(ctx context.Context, concertID uuid.UUID, sessionID string) (*entity.WaitingRoomEntry, error)
+	// Admit lets in up to count sessions from the head of the queue for ttl and returns how many were let in.
+	// Sessions are let in at most once per interval for a concert, however many instances call it.
+	Admit(ctx context.Context, concertID uuid.UUID, count int64, interval, ttl time.Duration) (int64, error)
+}
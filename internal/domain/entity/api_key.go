@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAPIKeyScope = fmt.Errorf("invalid api key scope")
+)
+
+const (
+	// apiKeyTag starts every API key, so that a leaked key is easy to recognise
+	apiKeyTag = "trk"
+	// apiKeyPrefixBytes and apiKeySecretBytes are the random bytes of the public prefix and of the secret of a key
+	apiKeyPrefixBytes = 6
+	apiKeySecretBytes = 32
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeConcertsRead      APIKeyScope = "concerts:read"      // Reads concerts, zones and seat maps
+	APIKeyScopeReservationsWrite APIKeyScope = "reservations:write" // Reserves, pays and cancels seats, the reservations are owned by the key
+	APIKeyScopeAdmin             APIKeyScope = "admin"              // Calls the administrative routes as an admin
+)
+
+var apiKeyScopeStringMapper = map[APIKeyScope]string{
+	APIKeyScopeConcertsRead:      "concerts:read",
+	APIKeyScopeReservationsWrite: "reservations:write",
+	APIKeyScopeAdmin:             "admin",
+}
+
+func (s APIKeyScope) String() string {
+	return apiKeyScopeStringMapper[s]
+}
+
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case APIKeyScopeConcertsRead, APIKeyScopeReservationsWrite, APIKeyScopeAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into an APIKeyScope. It returns an error if the string is not a valid APIKeyScope.
+func (s APIKeyScope) Parse(scope string) (APIKeyScope, error) {
+	apiKeyScope := APIKeyScope(scope)
+	if !apiKeyScope.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidAPIKeyScope, scope)
+	}
+	return apiKeyScope, nil
+}
+
+// Role returns the role a key acts with on the routes of the scope, see APIKey.Principal.
+func (s APIKeyScope) Role() UserRole {
+	switch s {
+	case APIKeyScopeAdmin:
+		return UserRoleAdmin
+	case APIKeyScopeReservationsWrite:
+		return UserRoleCustomer
+	default:
+		return ""
+	}
+}
+
+// APIKey is the credential of a partner integration. The key itself is only known when it is issued,
+// it is looked up by its prefix and checked against its hash.
+type APIKey struct {
+	ID         uuid.UUID
+	Name       string
+	Prefix     string // Public part of the key, shown in listings to tell keys apart
+	KeyHash    string // Hex SHA-256 of the whole key
+	Scopes     []APIKeyScope
+	ExpiresAt  *time.Time // Nil when the key never expires
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type APIKeys []APIKey
+
+// GenerateAPIKey returns a new random key in the form "trk_<prefix>_<secret>" with its prefix and hash.
+// Keys carry 256 random bits, so a plain SHA-256 is enough to store them and cheap to check on every request.
+func GenerateAPIKey() (key string, prefix string, keyHash string, err error) {
+	prefixBytes := make([]byte, apiKeyPrefixBytes)
+	if _, err := rand.Read(prefixBytes); err != nil {
+		return "", "", "", err
+	}
+	secretBytes := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", "", "", err
+	}
+	prefix = hex.EncodeToString(prefixBytes)
+	key = apiKeyTag + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(secretBytes)
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hash stored for a key.
+func HashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// ParseAPIKeyPrefix returns the prefix of a key, it is false when the key is not in the form issued by GenerateAPIKey.
+func ParseAPIKeyPrefix(key string) (string, bool) {
+	// The secret is base64url encoded and may contain "_" itself
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyTag || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// Matches reports whether the key is the one the hash was computed from.
+func (k *APIKey) Matches(key string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(k.KeyHash)) == 1
+}
+
+// IsActive reports whether the key can still be used at the given time, that is neither revoked nor expired.
+func (k *APIKey) IsActive(at time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || at.Before(*k.ExpiresAt))
+}
+
+// HasScope reports whether the key was granted the scope.
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// Principal returns the principal a request authenticated with the key acts as on the routes of the scope.
+// Its UserID is the ID of the key, so that the reservations made with a key are owned by the key and survive its rotation.
+func (k *APIKey) Principal(scope APIKeyScope) *Principal {
+	return &Principal{UserID: k.ID, Role: scope.Role()}
+}
@@ -7,12 +7,35 @@ import (
 )
 
 type Concert struct {
-	ID        uuid.UUID
-	Name      string
-	Venue     string
-	Date      time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          uuid.UUID
+	Name        string
+	Venue       string
+	Date        time.Time
+	OrganizerID *uuid.UUID // User who created the concert, nil when it was created by an admin
+	// WaitingRoomEnabled puts buyers through the waiting room, only admitted buyers may reserve seats
+	WaitingRoomEnabled bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 type Concerts []Concert
+
+// IsOnDayOf reports whether the concert takes place on the calendar day of t in the given location.
+func (c *Concert) IsOnDayOf(t time.Time, loc *time.Location) bool {
+	concertYear, concertMonth, concertDay := c.Date.In(loc).Date()
+	year, month, day := t.In(loc).Date()
+	return concertYear == year && concertMonth == month && concertDay == day
+}
+
+// IsManageableBy reports whether the principal may change the concert, its zones and its seats.
+// Admins manage every concert, organizers only the concerts they own.
+func (c *Concert) IsManageableBy(principal *Principal) bool {
+	switch {
+	case principal.HasRole(UserRoleAdmin):
+		return true
+	case principal.HasRole(UserRoleOrganizer):
+		return c.OrganizerID != nil && *c.OrganizerID == principal.UserID
+	default:
+		return false
+	}
+}
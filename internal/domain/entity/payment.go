@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,18 +13,33 @@ var (
 	ErrInvalidPaymentStatus = fmt.Errorf("invalid payment status")
 )
 
+const (
+	// PaymentMethodPromptPay is paid by scanning a PromptPay QR code with a banking app, instead of being charged through
+	// the payment gateway; the bank notifies the outcome through the payment webhook of the PromptPay provider
+	PaymentMethodPromptPay = "promptpay"
+	PromptPayProvider      = "promptpay"
+)
+
 type PaymentStatus string
 
 const (
 	PaymentStatusInitiated PaymentStatus = "initiated"
 	PaymentStatusPaid      PaymentStatus = "paid"
 	PaymentStatusFailed    PaymentStatus = "failed"
+	// Refunded payments were paid first, part or all of the amount was given back afterwards
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	// Refund required payments were charged after their reservation could no longer be confirmed, the amount is owed back
+	PaymentStatusRefundRequired PaymentStatus = "refund_required"
 )
 
 var paymentStatusStringMapper = map[PaymentStatus]string{
-	PaymentStatusInitiated: "initiated",
-	PaymentStatusPaid:      "paid",
-	PaymentStatusFailed:    "failed",
+	PaymentStatusInitiated:         "initiated",
+	PaymentStatusPaid:              "paid",
+	PaymentStatusFailed:            "failed",
+	PaymentStatusRefunded:          "refunded",
+	PaymentStatusPartiallyRefunded: "partially_refunded",
+	PaymentStatusRefundRequired:    "refund_required",
 }
 
 func (s PaymentStatus) String() string {
@@ -32,7 +48,7 @@ func (s PaymentStatus) String() string {
 
 func (s PaymentStatus) IsValid() bool {
 	switch s {
-	case PaymentStatusInitiated, PaymentStatusPaid, PaymentStatusFailed:
+	case PaymentStatusInitiated, PaymentStatusPaid, PaymentStatusFailed, PaymentStatusRefunded, PaymentStatusPartiallyRefunded, PaymentStatusRefundRequired:
 		return true
 	default:
 		return false
@@ -49,16 +65,111 @@ func (s PaymentStatus) Parse(status string) (PaymentStatus, error) {
 }
 
 type Payment struct {
-	ID            uuid.UUID
-	ReservationID uuid.UUID
-	Status        PaymentStatus
-	Amount        *decimal.Decimal
-	PaidAt        *time.Time
-	PaymentMethod *string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID                uuid.UUID
+	ReservationID     uuid.UUID
+	Status            PaymentStatus
+	Amount            *decimal.Decimal
+	PaidAt            *time.Time
+	PaymentMethod     *string
+	Provider          *string // Name of the payment gateway that processed the payment
+	ProviderReference *string // Reference of the charge on the payment gateway side
+	PromoCodeID       *uuid.UUID
+	DiscountAmount    *decimal.Decimal // Taken off the reservation price by the promo code, the amount is what was charged
+	QRPayload         *string          // Payload of the QR code the customer scans to pay, for payment methods paid by scanning
+	LineItems         PaymentLineItems // Breakdown of the amount, only loaded when the payment is read for display
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewPayment(reservationID uuid.UUID, amount decimal.Decimal, paymentMethod string, provider string) *Payment {
+	return &Payment{
+		ID:            uuid.New(),
+		ReservationID: reservationID,
+		Status:        PaymentStatusInitiated,
+		Amount:        &amount,
+		PaymentMethod: &paymentMethod,
+		Provider:      &provider,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+}
+
+// IsPaidByScanning reports whether the customer pays by scanning a QR code rather than being charged through the gateway.
+func (p *Payment) IsPaidByScanning() bool {
+	return p.PaymentMethod != nil && *p.PaymentMethod == PaymentMethodPromptPay
+}
+
+// PromptPayReference returns the reference of the payment on a PromptPay bill, the first 20 hex digits of its ID in upper case.
+func (p *Payment) PromptPayReference() string {
+	return strings.ToUpper(strings.ReplaceAll(p.ID.String(), "-", ""))[:20]
 }
 
 func (p *Payment) IsPaid() bool {
 	return p.Status == PaymentStatusPaid
 }
+
+// CanRefund reports whether money was taken for the payment and part of it may still be given back.
+func (p *Payment) CanRefund() bool {
+	return p.Amount != nil && (p.Status == PaymentStatusPaid || p.Status == PaymentStatusPartiallyRefunded || p.Status == PaymentStatusRefundRequired)
+}
+
+// RefundableAmount returns the part of the payment amount that is not covered by the given refunds yet.
+// Pending refunds are counted, so that two refunds in flight cannot give back more than was paid.
+func (p *Payment) RefundableAmount(refunds Refunds) decimal.Decimal {
+	if p.Amount == nil {
+		return decimal.Zero
+	}
+	return p.Amount.Sub(refunds.CommittedAmount())
+}
+
+// StatusAfterRefund returns the status of a paid payment once the given amount has been refunded in total.
+func (p *Payment) StatusAfterRefund(refundedAmount decimal.Decimal) PaymentStatus {
+	switch {
+	case !refundedAmount.IsPositive():
+		return PaymentStatusPaid
+	case p.Amount != nil && refundedAmount.GreaterThanOrEqual(*p.Amount):
+		return PaymentStatusRefunded
+	default:
+		return PaymentStatusPartiallyRefunded
+	}
+}
+
+// UsesPromoCode reports whether the payment was made with the given promo code, a nil promo code matches a payment without one.
+func (p *Payment) UsesPromoCode(promoCode *PromoCode) bool {
+	if promoCode == nil || p.PromoCodeID == nil {
+		return promoCode == nil && p.PromoCodeID == nil
+	}
+	return *p.PromoCodeID == promoCode.ID
+}
+
+type Payments []Payment
+
+// PaymentLineItem is a line of the breakdown stored with a payment, numbered in the order of the breakdown.
+type PaymentLineItem struct {
+	ID         uuid.UUID
+	PaymentID  uuid.UUID
+	LineNumber int
+	Type       LineItemType
+	Rate       *decimal.Decimal
+	Amount     decimal.Decimal
+	CreatedAt  time.Time
+}
+
+type PaymentLineItems []PaymentLineItem
+
+// NewPaymentLineItems numbers the lines of the breakdown for the payment.
+func NewPaymentLineItems(paymentID uuid.UUID, breakdown PriceBreakdown) PaymentLineItems {
+	lineItems := make(PaymentLineItems, 0, len(breakdown.LineItems))
+	for i, lineItem := range breakdown.LineItems {
+		lineItems = append(lineItems, PaymentLineItem{
+			ID:         uuid.New(),
+			PaymentID:  paymentID,
+			LineNumber: i + 1,
+			Type:       lineItem.Type,
+			Rate:       lineItem.Rate,
+			Amount:     lineItem.Amount,
+			CreatedAt:  time.Now(),
+		})
+	}
+	return lineItems
+}
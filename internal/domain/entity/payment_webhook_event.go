@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentWebhookEventType is the kind of event a payment provider notifies about.
+type PaymentWebhookEventType string
+
+const (
+	PaymentWebhookEventTypeSucceeded PaymentWebhookEventType = "payment.succeeded"
+	PaymentWebhookEventTypeFailed    PaymentWebhookEventType = "payment.failed"
+)
+
+func (t PaymentWebhookEventType) String() string {
+	return string(t)
+}
+
+// PaymentWebhookEvent records a webhook event that has been processed, so that a redelivery of the same event is ignored.
+type PaymentWebhookEvent struct {
+	ID        uuid.UUID
+	Provider  string
+	EventID   string // ID given to the event by the provider, unique per provider
+	EventType PaymentWebhookEventType
+	PaymentID *uuid.UUID
+	CreatedAt time.Time
+}
+
+func NewPaymentWebhookEvent(provider string, eventID string, eventType PaymentWebhookEventType, paymentID *uuid.UUID) *PaymentWebhookEvent {
+	return &PaymentWebhookEvent{
+		ID:        uuid.New(),
+		Provider:  provider,
+		EventID:   eventID,
+		EventType: eventType,
+		PaymentID: paymentID,
+		CreatedAt: time.Now(),
+	}
+}
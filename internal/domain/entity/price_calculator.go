@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidLineItemType = fmt.Errorf("invalid line item type")
+	ErrInvalidRoundingMode = fmt.Errorf("invalid rounding mode")
+	ErrInvalidPricingRate  = fmt.Errorf("invalid pricing rate")
+)
+
+type LineItemType string
+
+const (
+	LineItemTypeTicket     LineItemType = "ticket"      // The price of the seat captured on the reservation
+	LineItemTypeDiscount   LineItemType = "discount"    // The promo code discount, the only negative line
+	LineItemTypeServiceFee LineItemType = "service_fee" // The fee charged for the ticketing service
+	LineItemTypePaymentFee LineItemType = "payment_fee" // The fee passed on from the payment provider
+	LineItemTypeVAT        LineItemType = "vat"         // The value added tax on every line above
+)
+
+var lineItemTypeStringMapper = map[LineItemType]string{
+	LineItemTypeTicket:     "ticket",
+	LineItemTypeDiscount:   "discount",
+	LineItemTypeServiceFee: "service_fee",
+	LineItemTypePaymentFee: "payment_fee",
+	LineItemTypeVAT:        "vat",
+}
+
+func (t LineItemType) String() string {
+	return lineItemTypeStringMapper[t]
+}
+
+func (t LineItemType) IsValid() bool {
+	switch t {
+	case LineItemTypeTicket, LineItemTypeDiscount, LineItemTypeServiceFee, LineItemTypePaymentFee, LineItemTypeVAT:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a LineItemType. It returns an error if the string is not a valid LineItemType.
+func (t LineItemType) Parse(lineItemType string) (LineItemType, error) {
+	parsed := LineItemType(lineItemType)
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLineItemType, lineItemType)
+	}
+	return parsed, nil
+}
+
+type RoundingMode string
+
+const (
+	RoundingModeHalfUp   RoundingMode = "half_up"   // Halves are rounded away from zero
+	RoundingModeHalfEven RoundingMode = "half_even" // Halves are rounded to the even cent (banker's rounding)
+	RoundingModeUp       RoundingMode = "up"        // Any fraction of a cent is rounded away from zero
+	RoundingModeDown     RoundingMode = "down"      // Any fraction of a cent is dropped
+)
+
+func (m RoundingMode) IsValid() bool {
+	switch m {
+	case RoundingModeHalfUp, RoundingModeHalfEven, RoundingModeUp, RoundingModeDown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Round rounds the amount to the cent.
+func (m RoundingMode) Round(amount decimal.Decimal) decimal.Decimal {
+	switch m {
+	case RoundingModeHalfEven:
+		return amount.RoundBank(2)
+	case RoundingModeUp:
+		return amount.RoundUp(2)
+	case RoundingModeDown:
+		return amount.RoundDown(2)
+	default:
+		return amount.Round(2)
+	}
+}
+
+// LineItem is one line of a price breakdown. Rate is the percentage the amount was computed with, if any.
+type LineItem struct {
+	Type   LineItemType
+	Rate   *decimal.Decimal
+	Amount decimal.Decimal
+}
+
+// PriceBreakdown is the line-itemised total of an order, the amounts of the lines add up to the total.
+type PriceBreakdown struct {
+	LineItems []LineItem
+	Total     decimal.Decimal
+}
+
+type PriceCalculatorConfig struct {
+	ServiceFeePercent decimal.Decimal // Percentage of the discounted ticket price
+	ServiceFeeFixed   decimal.Decimal // Flat amount added to the service fee of every ticket
+	PaymentFeePercent decimal.Decimal // Percentage of the discounted ticket price and service fee
+	VATPercent        decimal.Decimal // Percentage of every line before tax
+	Rounding          RoundingMode    // Rounding applied to every computed line
+}
+
+// PriceCalculator turns a ticket price into the line items charged for it.
+type PriceCalculator struct {
+	config PriceCalculatorConfig
+}
+
+// NewPriceCalculator returns a calculator for the config. Rates must not be negative and the rounding mode must be known.
+func NewPriceCalculator(config PriceCalculatorConfig) (*PriceCalculator, error) {
+	if !config.Rounding.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRoundingMode, config.Rounding)
+	}
+	for name, rate := range map[string]decimal.Decimal{
+		"service fee percent": config.ServiceFeePercent,
+		"service fee fixed":   config.ServiceFeeFixed,
+		"payment fee percent": config.PaymentFeePercent,
+		"vat percent":         config.VATPercent,
+	} {
+		if rate.IsNegative() {
+			return nil, fmt.Errorf("%w: %s must not be negative", ErrInvalidPricingRate, name)
+		}
+	}
+	return &PriceCalculator{config: config}, nil
+}
+
+// Calculate breaks the ticket price down into the ticket, the discount, the fees and the VAT charged on top of them.
+// Fees and VAT are rounded line by line, so that the total is the exact sum of the lines. Lines without an amount are left out,
+// except for the ticket.
+func (c *PriceCalculator) Calculate(price decimal.Decimal, discount decimal.Decimal) PriceBreakdown {
+	breakdown := PriceBreakdown{
+		LineItems: []LineItem{{Type: LineItemTypeTicket, Amount: price}},
+	}
+	if discount.IsPositive() {
+		breakdown.LineItems = append(breakdown.LineItems, LineItem{Type: LineItemTypeDiscount, Amount: discount.Neg()})
+	}
+	base := price.Sub(discount)
+
+	serviceFee := c.percentOf(base, c.config.ServiceFeePercent).Add(c.config.ServiceFeeFixed)
+	breakdown.addFee(LineItemTypeServiceFee, c.config.ServiceFeePercent, serviceFee)
+
+	paymentFee := c.percentOf(base.Add(serviceFee), c.config.PaymentFeePercent)
+	breakdown.addFee(LineItemTypePaymentFee, c.config.PaymentFeePercent, paymentFee)
+
+	subtotal := base.Add(serviceFee).Add(paymentFee)
+	vat := c.percentOf(subtotal, c.config.VATPercent)
+	breakdown.addFee(LineItemTypeVAT, c.config.VATPercent, vat)
+
+	breakdown.Total = subtotal.Add(vat)
+	return breakdown
+}
+
+func (c *PriceCalculator) percentOf(amount decimal.Decimal, percent decimal.Decimal) decimal.Decimal {
+	return c.config.Rounding.Round(amount.Mul(percent).Div(decimal.NewFromInt(100)))
+}
+
+func (b *PriceBreakdown) addFee(lineItemType LineItemType, percent decimal.Decimal, amount decimal.Decimal) {
+	if amount.IsZero() {
+		return
+	}
+	lineItem := LineItem{Type: lineItemType, Amount: amount}
+	if percent.IsPositive() {
+		lineItem.Rate = &percent
+	}
+	b.LineItems = append(b.LineItems, lineItem)
+}
@@ -0,0 +1,278 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+)
+
+func TestNewPriceCalculator(t *testing.T) {
+	validConfig := entity.PriceCalculatorConfig{
+		ServiceFeePercent: decimal.RequireFromString("5"),
+		ServiceFeeFixed:   decimal.RequireFromString("10"),
+		PaymentFeePercent: decimal.RequireFromString("3"),
+		VATPercent:        decimal.RequireFromString("7"),
+		Rounding:          entity.RoundingModeHalfUp,
+	}
+
+	tests := []struct {
+		name          string
+		config        func() entity.PriceCalculatorConfig
+		expectedError error
+		errorContains string
+	}{
+		{
+			name:   "valid config",
+			config: func() entity.PriceCalculatorConfig { return validConfig },
+		},
+		{
+			name: "zero rates are allowed",
+			config: func() entity.PriceCalculatorConfig {
+				return entity.PriceCalculatorConfig{Rounding: entity.RoundingModeDown}
+			},
+		},
+		{
+			name: "unknown rounding mode",
+			config: func() entity.PriceCalculatorConfig {
+				config := validConfig
+				config.Rounding = "nearest"
+				return config
+			},
+			expectedError: entity.ErrInvalidRoundingMode,
+			errorContains: "nearest",
+		},
+		{
+			name: "empty rounding mode",
+			config: func() entity.PriceCalculatorConfig {
+				config := validConfig
+				config.Rounding = ""
+				return config
+			},
+			expectedError: entity.ErrInvalidRoundingMode,
+		},
+		{
+			name: "negative service fee percent",
+			config: func() entity.PriceCalculatorConfig {
+				config := validConfig
+				config.ServiceFeePercent = decimal.RequireFromString("-1")
+				return config
+			},
+			expectedError: entity.ErrInvalidPricingRate,
+			errorContains: "service fee percent must not be negative",
+		},
+		{
+			name: "negative service fee fixed",
+			config: func() entity.PriceCalculatorConfig {
+				config := validConfig
+				config.ServiceFeeFixed = decimal.RequireFromString("-0.01")
+				return config
+			},
+			expectedError: entity.ErrInvalidPricingRate,
+			errorContains: "service fee fixed must not be negative",
+		},
+		{
+			name: "negative payment fee percent",
+			config: func() entity.PriceCalculatorConfig {
+				config := validConfig
+				config.PaymentFeePercent = decimal.RequireFromString("-3")
+				return config
+			},
+			expectedError: entity.ErrInvalidPricingRate,
+			errorContains: "payment fee percent must not be negative",
+		},
+		{
+			name: "negative vat percent",
+			config: func() entity.PriceCalculatorConfig {
+				config := validConfig
+				config.VATPercent = decimal.RequireFromString("-7")
+				return config
+			},
+			expectedError: entity.ErrInvalidPricingRate,
+			errorContains: "vat percent must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			calculator, err := entity.NewPriceCalculator(tt.config())
+
+			// Assert
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+				assert.Nil(t, calculator)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, calculator)
+			}
+		})
+	}
+}
+
+func TestPriceCalculator_Calculate_Rounding(t *testing.T) {
+	// Only a 10% service fee, so that the fee shows the rounding of a single line
+	newCalculator := func(t *testing.T, rounding entity.RoundingMode) *entity.PriceCalculator {
+		calculator, err := entity.NewPriceCalculator(entity.PriceCalculatorConfig{
+			ServiceFeePercent: decimal.RequireFromString("10"),
+			Rounding:          rounding,
+		})
+		require.NoError(t, err)
+		return calculator
+	}
+
+	tests := []struct {
+		name               string
+		rounding           entity.RoundingMode
+		price              string
+		expectedServiceFee string
+		expectedTotal      string
+	}{
+		// 10.005, a half cent after an even cent
+		{name: "half_up rounds the half away from zero", rounding: entity.RoundingModeHalfUp, price: "100.05", expectedServiceFee: "10.01", expectedTotal: "110.06"},
+		{name: "half_even rounds the half to the even cent", rounding: entity.RoundingModeHalfEven, price: "100.05", expectedServiceFee: "10.00", expectedTotal: "110.05"},
+		{name: "up rounds the half away from zero", rounding: entity.RoundingModeUp, price: "100.05", expectedServiceFee: "10.01", expectedTotal: "110.06"},
+		{name: "down drops the half", rounding: entity.RoundingModeDown, price: "100.05", expectedServiceFee: "10.00", expectedTotal: "110.05"},
+		// 10.015, a half cent after an odd cent
+		{name: "half_up rounds the half after an odd cent away from zero", rounding: entity.RoundingModeHalfUp, price: "100.15", expectedServiceFee: "10.02", expectedTotal: "110.17"},
+		{name: "half_even rounds the half after an odd cent to the even cent", rounding: entity.RoundingModeHalfEven, price: "100.15", expectedServiceFee: "10.02", expectedTotal: "110.17"},
+		{name: "up rounds the half after an odd cent away from zero", rounding: entity.RoundingModeUp, price: "100.15", expectedServiceFee: "10.02", expectedTotal: "110.17"},
+		{name: "down drops the half after an odd cent", rounding: entity.RoundingModeDown, price: "100.15", expectedServiceFee: "10.01", expectedTotal: "110.16"},
+		// 10.001, less than a half cent
+		{name: "half_up drops less than a half", rounding: entity.RoundingModeHalfUp, price: "100.01", expectedServiceFee: "10.00", expectedTotal: "110.01"},
+		{name: "half_even drops less than a half", rounding: entity.RoundingModeHalfEven, price: "100.01", expectedServiceFee: "10.00", expectedTotal: "110.01"},
+		{name: "up rounds any fraction away from zero", rounding: entity.RoundingModeUp, price: "100.01", expectedServiceFee: "10.01", expectedTotal: "110.02"},
+		{name: "down drops any fraction", rounding: entity.RoundingModeDown, price: "100.01", expectedServiceFee: "10.00", expectedTotal: "110.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculator := newCalculator(t, tt.rounding)
+
+			// Execute
+			breakdown := calculator.Calculate(decimal.RequireFromString(tt.price), decimal.Zero)
+
+			// Assert
+			require.Len(t, breakdown.LineItems, 2)
+			assert.Equal(t, entity.LineItemTypeTicket, breakdown.LineItems[0].Type)
+			assert.True(t, decimal.RequireFromString(tt.price).Equal(breakdown.LineItems[0].Amount))
+			assert.Equal(t, entity.LineItemTypeServiceFee, breakdown.LineItems[1].Type)
+			assert.Equal(t, tt.expectedServiceFee, breakdown.LineItems[1].Amount.StringFixed(2))
+			assert.Equal(t, tt.expectedTotal, breakdown.Total.StringFixed(2))
+		})
+	}
+}
+
+func TestPriceCalculator_Calculate(t *testing.T) {
+	rate := func(value string) *decimal.Decimal {
+		d := decimal.RequireFromString(value)
+		return &d
+	}
+
+	tests := []struct {
+		name              string
+		config            entity.PriceCalculatorConfig
+		price             string
+		discount          string
+		expectedLineItems []entity.LineItem
+		expectedTotal     string
+	}{
+		{
+			name: "every line with a discount",
+			config: entity.PriceCalculatorConfig{
+				ServiceFeePercent: decimal.RequireFromString("5"),
+				ServiceFeeFixed:   decimal.RequireFromString("10"),
+				PaymentFeePercent: decimal.RequireFromString("3"),
+				VATPercent:        decimal.RequireFromString("7"),
+				Rounding:          entity.RoundingModeHalfUp,
+			},
+			price:    "1000",
+			discount: "100",
+			expectedLineItems: []entity.LineItem{
+				{Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1000")},
+				{Type: entity.LineItemTypeDiscount, Amount: decimal.RequireFromString("-100")},
+				// 5% of 900 plus 10
+				{Type: entity.LineItemTypeServiceFee, Rate: rate("5"), Amount: decimal.RequireFromString("55")},
+				// 3% of 955
+				{Type: entity.LineItemTypePaymentFee, Rate: rate("3"), Amount: decimal.RequireFromString("28.65")},
+				// 7% of 983.65 is 68.8555
+				{Type: entity.LineItemTypeVAT, Rate: rate("7"), Amount: decimal.RequireFromString("68.86")},
+			},
+			expectedTotal: "1052.51",
+		},
+		{
+			name: "zero fees are left out",
+			config: entity.PriceCalculatorConfig{
+				VATPercent: decimal.RequireFromString("7"),
+				Rounding:   entity.RoundingModeHalfUp,
+			},
+			price:    "1000",
+			discount: "0",
+			expectedLineItems: []entity.LineItem{
+				{Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1000")},
+				{Type: entity.LineItemTypeVAT, Rate: rate("7"), Amount: decimal.RequireFromString("70")},
+			},
+			expectedTotal: "1070.00",
+		},
+		{
+			name: "fixed service fee has no rate",
+			config: entity.PriceCalculatorConfig{
+				ServiceFeeFixed: decimal.RequireFromString("20"),
+				Rounding:        entity.RoundingModeHalfUp,
+			},
+			price:    "500",
+			discount: "0",
+			expectedLineItems: []entity.LineItem{
+				{Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("500")},
+				{Type: entity.LineItemTypeServiceFee, Amount: decimal.RequireFromString("20")},
+			},
+			expectedTotal: "520.00",
+		},
+		{
+			name: "ticket line is kept without fees",
+			config: entity.PriceCalculatorConfig{
+				Rounding: entity.RoundingModeHalfUp,
+			},
+			price:    "0",
+			discount: "0",
+			expectedLineItems: []entity.LineItem{
+				{Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("0")},
+			},
+			expectedTotal: "0.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculator, err := entity.NewPriceCalculator(tt.config)
+			require.NoError(t, err)
+
+			// Execute
+			breakdown := calculator.Calculate(decimal.RequireFromString(tt.price), decimal.RequireFromString(tt.discount))
+
+			// Assert
+			require.Len(t, breakdown.LineItems, len(tt.expectedLineItems))
+			sum := decimal.Zero
+			for i, expected := range tt.expectedLineItems {
+				actual := breakdown.LineItems[i]
+				assert.Equal(t, expected.Type, actual.Type)
+				assert.True(t, expected.Amount.Equal(actual.Amount), "line %s: expected %s, got %s", expected.Type, expected.Amount, actual.Amount)
+				if expected.Rate == nil {
+					assert.Nil(t, actual.Rate)
+				} else if assert.NotNil(t, actual.Rate) {
+					assert.True(t, expected.Rate.Equal(*actual.Rate))
+				}
+				sum = sum.Add(actual.Amount)
+			}
+			assert.Equal(t, tt.expectedTotal, breakdown.Total.StringFixed(2))
+			// The total is the exact sum of the lines
+			assert.True(t, sum.Equal(breakdown.Total))
+		})
+	}
+}
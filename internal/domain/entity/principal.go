@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAuthClaims = fmt.Errorf("invalid auth claims")
+)
+
+type AuthTokenType string
+
+const (
+	AuthTokenTypeAccess  AuthTokenType = "access"  // Short-lived token sent with every authenticated request
+	AuthTokenTypeRefresh AuthTokenType = "refresh" // Long-lived token only accepted to issue a new pair of tokens
+	// Short-lived pass of a buyer admitted by the waiting room of a concert, see WaitingRoomPassClaims
+	AuthTokenTypeWaitingRoomPass AuthTokenType = "waiting_room_pass"
+)
+
+// Principal is the authenticated user of a request.
+type Principal struct {
+	UserID uuid.UUID
+	Email  string
+	Role   UserRole
+}
+
+// AuthClaims is what the JWTs issued to users carry, the subject is the ID of the user.
+type AuthClaims struct {
+	jwt.RegisteredClaims
+	TokenType AuthTokenType `json:"token_type"`
+	Email     string        `json:"email"`
+	Role      UserRole      `json:"role"` // Role of the user when the token was issued, a new role applies from the next refresh
+}
+
+func NewAuthClaims(user *User, tokenType AuthTokenType, issuedAt time.Time, ttl time.Duration) *AuthClaims {
+	return &AuthClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   user.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(ttl)),
+		},
+		TokenType: tokenType,
+		Email:     user.Email,
+		Role:      user.Role,
+	}
+}
+
+// Principal returns the user the claims were issued to.
+func (c *AuthClaims) Principal() (*Principal, error) {
+	userID, err := uuid.Parse(c.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAuthClaims, err)
+	}
+	role, err := new(UserRole).Parse(string(c.Role))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAuthClaims, err)
+	}
+	return &Principal{UserID: userID, Email: c.Email, Role: role}, nil
+}
+
+// SessionID returns the owner recorded on the reservations, payments and promo code redemptions of the user.
+// Ownership used to be a session ID chosen by the client, it is now the ID of the signed-in user.
+func (p *Principal) SessionID() string {
+	return p.UserID.String()
+}
+
+// HasRole reports whether the principal has one of the given roles.
+func (p *Principal) HasRole(roles ...UserRole) bool {
+	return p != nil && p.Role.IsOneOf(roles...)
+}
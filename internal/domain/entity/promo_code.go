@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidPromoCodeDiscountType = fmt.Errorf("invalid promo code discount type")
+)
+
+type PromoCodeDiscountType string
+
+const (
+	PromoCodeDiscountTypePercentage PromoCodeDiscountType = "percentage" // The discount value is a percentage of the price
+	PromoCodeDiscountTypeFixed      PromoCodeDiscountType = "fixed"      // The discount value is an amount in the currency of the price
+)
+
+var promoCodeDiscountTypeStringMapper = map[PromoCodeDiscountType]string{
+	PromoCodeDiscountTypePercentage: "percentage",
+	PromoCodeDiscountTypeFixed:      "fixed",
+}
+
+func (t PromoCodeDiscountType) String() string {
+	return promoCodeDiscountTypeStringMapper[t]
+}
+
+func (t PromoCodeDiscountType) IsValid() bool {
+	switch t {
+	case PromoCodeDiscountTypePercentage, PromoCodeDiscountTypeFixed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a PromoCodeDiscountType. It returns an error if the string is not a valid PromoCodeDiscountType.
+func (t PromoCodeDiscountType) Parse(discountType string) (PromoCodeDiscountType, error) {
+	promoCodeDiscountType := PromoCodeDiscountType(discountType)
+	if !promoCodeDiscountType.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidPromoCodeDiscountType, discountType)
+	}
+	return promoCodeDiscountType, nil
+}
+
+type PromoCode struct {
+	ID                       uuid.UUID
+	Code                     string
+	DiscountType             PromoCodeDiscountType
+	DiscountValue            decimal.Decimal
+	MaxRedemptions           *int64     // Redemptions allowed across every session, nil when unlimited
+	MaxRedemptionsPerSession *int64     // Redemptions allowed for a single session, nil when unlimited
+	ValidFrom                *time.Time // Start of the validity window, nil when valid from creation
+	ValidUntil               *time.Time // End of the validity window (exclusive), nil when it never expires
+	ConcertID                *uuid.UUID // Restricts the code to a concert when set
+	ZoneID                   *uuid.UUID // Restricts the code to a zone when set
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+// NormalizePromoCode returns the form promo codes are stored in, so that customers can type them in any case.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValidAt reports whether the time falls inside the validity window of the code.
+func (p *PromoCode) IsValidAt(now time.Time) bool {
+	if p.ValidFrom != nil && now.Before(*p.ValidFrom) {
+		return false
+	}
+	if p.ValidUntil != nil && !now.Before(*p.ValidUntil) {
+		return false
+	}
+	return true
+}
+
+// AppliesTo reports whether the code may be used for a seat of the zone.
+func (p *PromoCode) AppliesTo(zone *Zone) bool {
+	if p.ConcertID != nil && *p.ConcertID != zone.ConcertID {
+		return false
+	}
+	if p.ZoneID != nil && *p.ZoneID != zone.ID {
+		return false
+	}
+	return true
+}
+
+// Discount returns the amount taken off the price, rounded to the cent and never more than the price itself.
+func (p *PromoCode) Discount(price decimal.Decimal) decimal.Decimal {
+	var discount decimal.Decimal
+	switch p.DiscountType {
+	case PromoCodeDiscountTypePercentage:
+		discount = price.Mul(p.DiscountValue).Div(decimal.NewFromInt(100)).Round(2)
+	case PromoCodeDiscountTypeFixed:
+		discount = p.DiscountValue
+	}
+	return decimal.Min(discount, price)
+}
+
+type PromoCodes []PromoCode
+
+// PromoCodeRedemption records that a payment used a promo code.
+type PromoCodeRedemption struct {
+	ID             uuid.UUID
+	PromoCodeID    uuid.UUID
+	PaymentID      uuid.UUID
+	SessionID      string
+	DiscountAmount decimal.Decimal
+	CreatedAt      time.Time
+}
+
+func NewPromoCodeRedemption(promoCodeID uuid.UUID, paymentID uuid.UUID, sessionID string, discountAmount decimal.Decimal) *PromoCodeRedemption {
+	return &PromoCodeRedemption{
+		ID:             uuid.New(),
+		PromoCodeID:    promoCodeID,
+		PaymentID:      paymentID,
+		SessionID:      sessionID,
+		DiscountAmount: discountAmount,
+		CreatedAt:      time.Now(),
+	}
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRateLimit = fmt.Errorf("invalid rate limit")
+)
+
+// RateLimitGroup names a group of routes sharing the same limit, every caller has its own budget on each group.
+type RateLimitGroup string
+
+const (
+	RateLimitGroupAuth     RateLimitGroup = "auth"     // Sign-up, sign-in and token refresh
+	RateLimitGroupBrowse   RateLimitGroup = "browse"   // Public reads of concerts, zones and seat maps
+	RateLimitGroupReserve  RateLimitGroup = "reserve"  // Seat reservations, the routes hammered by bots during an on-sale
+	RateLimitGroupCheckout RateLimitGroup = "checkout" // Payments, cancellations and tickets of reservations
+)
+
+func (g RateLimitGroup) String() string {
+	return string(g)
+}
+
+// RateLimit allows a burst of Requests and then one request every Window / Requests, like a token bucket
+// holding Requests tokens and refilled at that rate. A zero limit disables rate limiting.
+type RateLimit struct {
+	Requests int64
+	Window   time.Duration
+}
+
+// ParseRateLimit parses a limit written "<requests>/<window>" like "20/1m". An empty string is the zero limit.
+func ParseRateLimit(value string) (RateLimit, error) {
+	if value == "" {
+		return RateLimit{}, nil
+	}
+	requestsValue, windowValue, found := strings.Cut(value, "/")
+	if !found {
+		return RateLimit{}, fmt.Errorf("%w: %s", ErrInvalidRateLimit, value)
+	}
+	requests, err := strconv.ParseInt(requestsValue, 10, 64)
+	if err != nil || requests <= 0 {
+		return RateLimit{}, fmt.Errorf("%w: %s", ErrInvalidRateLimit, value)
+	}
+	window, err := time.ParseDuration(windowValue)
+	if err != nil || window <= 0 {
+		return RateLimit{}, fmt.Errorf("%w: %s", ErrInvalidRateLimit, value)
+	}
+	return RateLimit{Requests: requests, Window: window}, nil
+}
+
+// IsZero reports whether the limit disables rate limiting.
+func (l RateLimit) IsZero() bool {
+	return l.Requests <= 0 || l.Window <= 0
+}
+
+// RateLimitResult is the outcome of counting a request against a RateLimit.
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64         // Requests that can still be made right away
+	RetryAfter time.Duration // Wait before the next request is allowed, zero when the request was allowed
+	ResetAfter time.Duration // Wait before the whole burst is available again
+}
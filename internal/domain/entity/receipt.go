@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Seller is the business issuing receipts, receipt numbers run on per seller tax ID.
+type Seller struct {
+	TaxID   string
+	Name    string
+	Address string
+}
+
+// Receipt is the tax invoice issued once a payment is paid. The seller details are copied at issue time,
+// so that a receipt always reprints the same even after the seller details change.
+type Receipt struct {
+	ID             uuid.UUID
+	PaymentID      uuid.UUID
+	Seller         Seller
+	ReceiptNumber  int64  // Gap-free running number of the seller
+	DocumentNumber string // Receipt number as printed on the document
+	Description    string
+	Currency       string
+	Amount         decimal.Decimal
+	IssuedAt       time.Time
+	LineItems      PaymentLineItems // Breakdown of the amount, only loaded when the receipt is read for display
+	CreatedAt      time.Time
+}
+
+func NewReceipt(payment *Payment, seller Seller, receiptNumber int64, documentPrefix string, description string, currency string) *Receipt {
+	var amount decimal.Decimal
+	if payment.Amount != nil {
+		amount = *payment.Amount
+	}
+	issuedAt := time.Now()
+	if payment.PaidAt != nil {
+		issuedAt = *payment.PaidAt
+	}
+	return &Receipt{
+		ID:             uuid.New(),
+		PaymentID:      payment.ID,
+		Seller:         seller,
+		ReceiptNumber:  receiptNumber,
+		DocumentNumber: FormatReceiptNumber(documentPrefix, receiptNumber),
+		Description:    description,
+		Currency:       currency,
+		Amount:         amount,
+		IssuedAt:       issuedAt,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// FormatReceiptNumber pads the running number to eight digits after the prefix, like "RC00000042".
+func FormatReceiptNumber(prefix string, receiptNumber int64) string {
+	return fmt.Sprintf("%s%08d", prefix, receiptNumber)
+}
+
+// VATAmount returns the total of the VAT lines of the receipt.
+func (r *Receipt) VATAmount() decimal.Decimal {
+	total := decimal.Zero
+	for _, lineItem := range r.LineItems {
+		if lineItem.Type == LineItemTypeVAT {
+			total = total.Add(lineItem.Amount)
+		}
+	}
+	return total
+}
@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidRefundStatus = fmt.Errorf("invalid refund status")
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending   RefundStatus = "pending"
+	RefundStatusSucceeded RefundStatus = "succeeded"
+	RefundStatusFailed    RefundStatus = "failed"
+)
+
+var refundStatusStringMapper = map[RefundStatus]string{
+	RefundStatusPending:   "pending",
+	RefundStatusSucceeded: "succeeded",
+	RefundStatusFailed:    "failed",
+}
+
+func (s RefundStatus) String() string {
+	return refundStatusStringMapper[s]
+}
+
+func (s RefundStatus) IsValid() bool {
+	switch s {
+	case RefundStatusPending, RefundStatusSucceeded, RefundStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a RefundStatus. It returns an error if the string is not a valid RefundStatus.
+func (s RefundStatus) Parse(status string) (RefundStatus, error) {
+	refundStatus := RefundStatus(status)
+	if !refundStatus.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidRefundStatus, status)
+	}
+	return refundStatus, nil
+}
+
+type Refund struct {
+	ID                uuid.UUID
+	PaymentID         uuid.UUID
+	Status            RefundStatus
+	Amount            decimal.Decimal
+	Reason            *string
+	ProviderReference *string // Reference of the refund on the payment gateway side
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewRefund(paymentID uuid.UUID, amount decimal.Decimal, reason *string) *Refund {
+	return &Refund{
+		ID:        uuid.New(),
+		PaymentID: paymentID,
+		Status:    RefundStatusPending,
+		Amount:    amount,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+type Refunds []Refund
+
+// CommittedAmount returns the total of the refunds that are pending or succeeded.
+func (rs Refunds) CommittedAmount() decimal.Decimal {
+	total := decimal.Zero
+	for _, refund := range rs {
+		if refund.Status != RefundStatusFailed {
+			total = total.Add(refund.Amount)
+		}
+	}
+	return total
+}
+
+// SucceededAmount returns the total of the refunds the provider has confirmed.
+func (rs Refunds) SucceededAmount() decimal.Decimal {
+	total := decimal.Zero
+	for _, refund := range rs {
+		if refund.Status == RefundStatusSucceeded {
+			total = total.Add(refund.Amount)
+		}
+	}
+	return total
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -17,12 +18,14 @@ const (
 	ReservationStatusPending   ReservationStatus = "pending"
 	ReservationStatusConfirmed ReservationStatus = "confirmed"
 	ReservationStatusExpired   ReservationStatus = "expired"
+	ReservationStatusCancelled ReservationStatus = "cancelled"
 )
 
 var reservationStatusStringMapper = map[ReservationStatus]string{
 	ReservationStatusPending:   "pending",
 	ReservationStatusConfirmed: "confirmed",
 	ReservationStatusExpired:   "expired",
+	ReservationStatusCancelled: "cancelled",
 }
 
 func (s ReservationStatus) String() string {
@@ -30,7 +33,7 @@ func (s ReservationStatus) String() string {
 }
 func (s ReservationStatus) IsValid() bool {
 	switch s {
-	case ReservationStatusPending, ReservationStatusConfirmed, ReservationStatusExpired:
+	case ReservationStatusPending, ReservationStatusConfirmed, ReservationStatusExpired, ReservationStatusCancelled:
 		return true
 	default:
 		return false
@@ -49,20 +52,27 @@ func (s ReservationStatus) Parse(status string) (ReservationStatus, error) {
 type Reservation struct {
 	ID         uuid.UUID
 	SeatID     uuid.UUID
+	GroupID    *uuid.UUID // Set when the reservation was made together with other seats in a cart
 	SessionID  string
 	Status     ReservationStatus
+	Price      *decimal.Decimal // Price of the seat when it was held, this is what the customer pays
+	Currency   *string
 	ReservedAt time.Time
 	ExpiresAt  time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
 
-func NewReservation(seatID uuid.UUID, sessionID string, expiresAt time.Time) *Reservation {
+// NewReservation holds the seat for the session until expiresAt, at the price the seat currently has in its zone.
+func NewReservation(seat *Seat, zone *Zone, sessionID string, expiresAt time.Time) *Reservation {
+	currency := zone.Currency
 	return &Reservation{
 		ID:         uuid.New(),
-		SeatID:     seatID,
+		SeatID:     seat.ID,
 		SessionID:  sessionID,
 		Status:     ReservationStatusPending,
+		Price:      seat.EffectivePrice(zone),
+		Currency:   &currency,
 		ReservedAt: time.Now(),
 		ExpiresAt:  expiresAt,
 		CreatedAt:  time.Now(),
@@ -78,4 +88,10 @@ func (r *Reservation) CanPay(now time.Time) bool {
 	return r.Status == ReservationStatusPending && now.Before(r.ExpiresAt)
 }
 
+// CanCancel reports whether the reservation is still a hold that can be given back.
+// Pending reservations whose expiration has passed can still be cancelled to release the seat early.
+func (r *Reservation) CanCancel() bool {
+	return r.Status == ReservationStatusPending
+}
+
 type Reservations []Reservation
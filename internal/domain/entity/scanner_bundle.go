@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"ticket-reservation/pkg/signedtoken"
+
+	"github.com/google/uuid"
+)
+
+// ScannerBundle lists the valid tickets of a concert, so that gate scanners validate tickets without reaching the server.
+// It is signed with the ticket signing key, scanners verify it with the same public key as the tickets.
+type ScannerBundle struct {
+	ConcertID   uuid.UUID   `json:"concert_id"`
+	TicketIDs   []uuid.UUID `json:"ticket_ids"`
+	GeneratedAt int64       `json:"generated_at"` // Unix seconds
+}
+
+func NewScannerBundle(concertID uuid.UUID, tickets Tickets, generatedAt time.Time) *ScannerBundle {
+	ticketIDs := make([]uuid.UUID, 0, len(tickets))
+	for _, ticket := range tickets {
+		ticketIDs = append(ticketIDs, ticket.ID)
+	}
+	return &ScannerBundle{
+		ConcertID:   concertID,
+		TicketIDs:   ticketIDs,
+		GeneratedAt: generatedAt.Unix(),
+	}
+}
+
+// SignScannerBundle returns the bundle as a signed token, in the same format as the ticket payloads.
+func (s *TicketSigner) SignScannerBundle(bundle *ScannerBundle) (string, error) {
+	encoded, err := json.Marshal(bundle)
+	if err != nil {
+		return "", err
+	}
+	return signedtoken.Sign(s.privateKey, encoded), nil
+}
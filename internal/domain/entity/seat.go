@@ -2,9 +2,13 @@ package entity
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -54,6 +58,8 @@ type Seat struct {
 	Status            SeatStatus
 	LockedUntil       *time.Time
 	LockedBySessionID *string
+	Price             *decimal.Decimal // Overrides the zone price when set
+	Position          *int             // Place in the row counted from the left from 1, an aisle takes a place; unset seats are never in a block
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
@@ -70,4 +76,59 @@ func (s *Seat) IsAvailable(now time.Time) bool {
 	return s.Status == SeatStatusAvailable || (s.Status == SeatStatusPending && s.LockedUntil != nil && now.After(*s.LockedUntil))
 }
 
+// EffectiveStatus returns the status as seen by clients at the given time.
+// A pending seat whose lock has already expired is reported as available.
+func (s *Seat) EffectiveStatus(now time.Time) SeatStatus {
+	if s.Status == SeatStatusPending && s.IsAvailable(now) {
+		return SeatStatusAvailable
+	}
+	return s.Status
+}
+
+// EffectivePrice returns the price the seat is sold at: its own price when it overrides the zone, the zone price otherwise.
+func (s *Seat) EffectivePrice(zone *Zone) *decimal.Decimal {
+	if s.Price != nil {
+		return s.Price
+	}
+	return zone.Price
+}
+
 type Seats []Seat
+
+// SortBySeatNumber sorts seats in natural order of their seat numbers (e.g. A2 before A10).
+func (ss Seats) SortBySeatNumber() {
+	sort.SliceStable(ss, func(i, j int) bool {
+		return compareSeatNumbers(ss[i].SeatNumber, ss[j].SeatNumber) < 0
+	})
+}
+
+// compareSeatNumbers compares seat numbers by their alphabetic prefix first, then by their numeric suffix.
+func compareSeatNumbers(a, b string) int {
+	aPrefix, aNumber := splitSeatNumber(a)
+	bPrefix, bNumber := splitSeatNumber(b)
+	if aPrefix != bPrefix {
+		if len(aPrefix) != len(bPrefix) {
+			return len(aPrefix) - len(bPrefix)
+		}
+		return strings.Compare(aPrefix, bPrefix)
+	}
+	if aNumber != bNumber {
+		if aNumber < bNumber {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func splitSeatNumber(seatNumber string) (prefix string, number int) {
+	idx := strings.IndexFunc(seatNumber, func(r rune) bool { return r >= '0' && r <= '9' })
+	if idx < 0 {
+		return seatNumber, 0
+	}
+	number, err := strconv.Atoi(seatNumber[idx:])
+	if err != nil {
+		return seatNumber, 0
+	}
+	return seatNumber[:idx], number
+}
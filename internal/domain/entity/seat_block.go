@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidSeatPreference = fmt.Errorf("invalid seat preference")
+)
+
+// SeatPreference tells how blocks of adjacent seats are ranked when seats are assigned automatically.
+type SeatPreference string
+
+const (
+	SeatPreferenceCentre SeatPreference = "centre" // Closest to the middle of the zone, both across the row and across the rows
+	SeatPreferenceFront  SeatPreference = "front"  // Closest to the first row, then closest to the middle of the row
+)
+
+func (p SeatPreference) String() string {
+	return string(p)
+}
+
+func (p SeatPreference) IsValid() bool {
+	switch p {
+	case SeatPreferenceCentre, SeatPreferenceFront:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a SeatPreference. It returns an error if the string is not a valid SeatPreference.
+func (p SeatPreference) Parse(preference string) (SeatPreference, error) {
+	seatPreference := SeatPreference(preference)
+	if !seatPreference.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSeatPreference, preference)
+	}
+	return seatPreference, nil
+}
+
+type seatBlock struct {
+	seats     Seats
+	rowIndex  int
+	rowOffset float64 // distance between the row and the middle row
+	offset    float64 // distance between the middle of the block and the middle of its row
+}
+
+// FindAdjacentBlocks returns every run of quantity available seats sitting next to each other in the same row,
+// best first according to the preference. The row of a seat is read from its seat number (e.g. "A12") and its place
+// in the row from its position; seats are adjacent when their positions follow each other, so an aisle splits a row
+// while a skipped seat number does not. Seats without a position are left out.
+// Rows are ordered like SortBySeatNumber orders row labels, the first row being the front row.
+func (ss Seats) FindAdjacentBlocks(quantity int, now time.Time, preference SeatPreference) []Seats {
+	if quantity <= 0 {
+		return nil
+	}
+
+	rows := make(map[string]Seats)
+	for _, seat := range ss {
+		if seat.Position == nil {
+			continue
+		}
+		row, _ := splitSeatNumber(seat.SeatNumber)
+		rows[row] = append(rows[row], seat)
+	}
+	rowLabels := make([]string, 0, len(rows))
+	for row := range rows {
+		rowLabels = append(rowLabels, row)
+	}
+	sort.Slice(rowLabels, func(i, j int) bool {
+		return compareSeatNumbers(rowLabels[i], rowLabels[j]) < 0
+	})
+
+	middleRow := float64(len(rowLabels)-1) / 2
+	blocks := []seatBlock{}
+	for rowIndex, row := range rowLabels {
+		rowSeats := rows[row]
+		rowSeats.sortByPosition()
+		firstPosition := *rowSeats[0].Position
+		lastPosition := *rowSeats[len(rowSeats)-1].Position
+		middlePosition := float64(firstPosition+lastPosition) / 2
+
+		for start := 0; start+quantity <= len(rowSeats); start++ {
+			candidate := rowSeats[start : start+quantity]
+			if !candidate.isAdjacentAndAvailable(now) {
+				continue
+			}
+			blockFirst := *candidate[0].Position
+			blockLast := *candidate[len(candidate)-1].Position
+			blocks = append(blocks, seatBlock{
+				seats:     append(Seats{}, candidate...),
+				rowIndex:  rowIndex,
+				rowOffset: math.Abs(float64(rowIndex) - middleRow),
+				offset:    math.Abs(float64(blockFirst+blockLast)/2 - middlePosition),
+			})
+		}
+	}
+
+	sort.SliceStable(blocks, func(i, j int) bool {
+		a, b := blocks[i], blocks[j]
+		if preference == SeatPreferenceFront {
+			if a.rowIndex != b.rowIndex {
+				return a.rowIndex < b.rowIndex
+			}
+			return a.offset < b.offset
+		}
+		if a.offset+a.rowOffset != b.offset+b.rowOffset {
+			return a.offset+a.rowOffset < b.offset+b.rowOffset
+		}
+		return a.rowIndex < b.rowIndex
+	})
+
+	result := make([]Seats, 0, len(blocks))
+	for _, block := range blocks {
+		result = append(result, block.seats)
+	}
+	return result
+}
+
+// sortByPosition sorts the seats of a row from left to right. Every seat must have a position.
+func (ss Seats) sortByPosition() {
+	sort.SliceStable(ss, func(i, j int) bool {
+		return *ss[i].Position < *ss[j].Position
+	})
+}
+
+// isAdjacentAndAvailable reports whether every seat is available and placed right after the previous one.
+// The seats must belong to the same row and be sorted by position.
+func (ss Seats) isAdjacentAndAvailable(now time.Time) bool {
+	for i, seat := range ss {
+		if !seat.IsAvailable(now) {
+			return false
+		}
+		if i > 0 && *seat.Position != *ss[i-1].Position+1 {
+			return false
+		}
+	}
+	return true
+}
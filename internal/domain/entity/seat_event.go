@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSeatEventSubscriberTooSlow = fmt.Errorf("seat event subscriber too slow")
+)
+
+// SeatStatusEvent tells that a seat of a zone changed status. The events of a zone are numbered by Version,
+// a counter increasing by one with every event, so that a client resumes after the last version it has seen.
+type SeatStatusEvent struct {
+	Version     int64 // Set when the event is published
+	ConcertID   uuid.UUID
+	ZoneID      uuid.UUID
+	SeatID      uuid.UUID
+	SeatNumber  string
+	Status      SeatStatus
+	LockedUntil *time.Time // End of the lock of a pending seat
+	OccurredAt  time.Time
+}
+
+type SeatStatusEvents []SeatStatusEvent
+
+// NewSeatStatusEvents returns the events telling the current status of the seats of a zone.
+func NewSeatStatusEvents(concertID, zoneID uuid.UUID, seats Seats, occurredAt time.Time) SeatStatusEvents {
+	events := make(SeatStatusEvents, 0, len(seats))
+	for _, seat := range seats {
+		events = append(events, SeatStatusEvent{
+			ConcertID:   concertID,
+			ZoneID:      zoneID,
+			SeatID:      seat.ID,
+			SeatNumber:  seat.SeatNumber,
+			Status:      seat.Status,
+			LockedUntil: seat.LockedUntil,
+			OccurredAt:  occurredAt,
+		})
+	}
+	return events
+}
+
+// LastVersion returns the version of the last event, or the given version when there are no events.
+func (es SeatStatusEvents) LastVersion(version int64) int64 {
+	if len(es) == 0 {
+		return version
+	}
+	return es[len(es)-1].Version
+}
+
+// SeatStatusEventBacklog is what a client missed since the last version it has seen.
+type SeatStatusEventBacklog struct {
+	Events  SeatStatusEvents // Events after the version, oldest first
+	Version int64            // Version of the last event published for the zone
+	// Truncated is set when some events after the version are no longer retained,
+	// the client must then reload the seat map instead of applying the events.
+	Truncated bool
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidSeatNumberingDirection = fmt.Errorf("invalid seat numbering direction")
+)
+
+type SeatNumberingDirection string
+
+const (
+	SeatNumberingLeftToRight SeatNumberingDirection = "left_to_right"
+	SeatNumberingRightToLeft SeatNumberingDirection = "right_to_left"
+)
+
+func (d SeatNumberingDirection) String() string {
+	return string(d)
+}
+
+func (d SeatNumberingDirection) IsValid() bool {
+	switch d {
+	case SeatNumberingLeftToRight, SeatNumberingRightToLeft:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a SeatNumberingDirection. It returns an error if the string is not a valid SeatNumberingDirection.
+func (d SeatNumberingDirection) Parse(direction string) (SeatNumberingDirection, error) {
+	numberingDirection := SeatNumberingDirection(direction)
+	if !numberingDirection.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSeatNumberingDirection, direction)
+	}
+	return numberingDirection, nil
+}
+
+// SeatLayout describes the seats of a zone as rows of SeatsPerRow seats side by side.
+// Seats are numbered from 1, the numbering direction telling on which side of the row seat 1 sits; numbers listed in
+// SkippedNumbers (e.g. 13) are never given to a seat, the numbering jumps over them without leaving a gap in the row.
+// An aisle runs after every seat listed in AislesAfter, counted from the left, so the seats on both sides are not adjacent.
+type SeatLayout struct {
+	RowLabels          []string
+	SeatsPerRow        int
+	SkippedNumbers     []int
+	AislesAfter        []int
+	NumberingDirection SeatNumberingDirection
+}
+
+// GenerateSeats builds the available seats of the layout for the given zone,
+// ordered row by row and from left to right within a row.
+func (l SeatLayout) GenerateSeats(zoneID uuid.UUID) Seats {
+	skipped := make(map[int]struct{}, len(l.SkippedNumbers))
+	for _, number := range l.SkippedNumbers {
+		skipped[number] = struct{}{}
+	}
+	aisles := make(map[int]struct{}, len(l.AislesAfter))
+	for _, seat := range l.AislesAfter {
+		aisles[seat] = struct{}{}
+	}
+
+	// The numbers of the seats of a row, from the seat numbered first
+	numbers := make([]int, 0, l.SeatsPerRow)
+	for number := 1; len(numbers) < l.SeatsPerRow; number++ {
+		if _, ok := skipped[number]; ok {
+			continue
+		}
+		numbers = append(numbers, number)
+	}
+
+	seats := make(Seats, 0, len(l.RowLabels)*l.SeatsPerRow)
+	for _, row := range l.RowLabels {
+		position := 0
+		for seat := 1; seat <= l.SeatsPerRow; seat++ {
+			position++
+			number := numbers[seat-1]
+			if l.NumberingDirection == SeatNumberingRightToLeft {
+				number = numbers[l.SeatsPerRow-seat]
+			}
+			seatPosition := position
+			seats = append(seats, Seat{
+				ZoneID:     zoneID,
+				SeatNumber: FormatSeatNumber(row, number),
+				Status:     SeatStatusAvailable,
+				Position:   &seatPosition,
+			})
+			// The aisle takes a place of its own
+			if _, ok := aisles[seat]; ok {
+				position++
+			}
+		}
+	}
+	return seats
+}
+
+// FormatSeatNumber builds a seat number from its row label and number (e.g. "A" and 12 gives "A12").
+func FormatSeatNumber(row string, number int) string {
+	return fmt.Sprintf("%s%d", row, number)
+}
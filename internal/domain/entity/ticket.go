@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"ticket-reservation/pkg/signedtoken"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTicket = fmt.Errorf("invalid ticket")
+)
+
+// Ticket is issued once a reservation is confirmed, one per reserved seat. The zone name and seat number are copied
+// at issue time, so that the ticket shows what was sold even after the venue layout changes.
+type Ticket struct {
+	ID            uuid.UUID
+	ReservationID uuid.UUID
+	ConcertID     uuid.UUID
+	ZoneID        uuid.UUID
+	SeatID        uuid.UUID
+	ZoneName      string
+	SeatNumber    string
+	Holder        string // Reference of the session that bought the ticket, see TicketHolder
+	Payload       string // Signed token encoded in the QR code of the ticket
+	IssuedAt      time.Time
+	CreatedAt     time.Time
+}
+
+type Tickets []Ticket
+
+// TicketClaims is what the QR code of a ticket carries. The keys are kept short to keep the code small and easy to scan.
+type TicketClaims struct {
+	TicketID   uuid.UUID `json:"tid"`
+	ConcertID  uuid.UUID `json:"cid"`
+	Zone       string    `json:"z"`
+	SeatNumber string    `json:"s"`
+	Holder     string    `json:"h"`
+	IssuedAt   int64     `json:"iat"` // Unix seconds
+}
+
+// NewTicket builds the ticket of a confirmed reservation, the payload is left empty until the ticket is signed.
+func NewTicket(reservation *Reservation, zone *Zone, seat *Seat, issuedAt time.Time) *Ticket {
+	return &Ticket{
+		ID:            uuid.New(),
+		ReservationID: reservation.ID,
+		ConcertID:     zone.ConcertID,
+		ZoneID:        zone.ID,
+		SeatID:        seat.ID,
+		ZoneName:      zone.Name,
+		SeatNumber:    seat.SeatNumber,
+		Holder:        TicketHolder(reservation.SessionID),
+		IssuedAt:      issuedAt.Truncate(time.Second),
+		CreatedAt:     time.Now(),
+	}
+}
+
+// TicketHolder returns the holder printed on tickets of a session. The session ID is what authorizes requests
+// on the reservation, so only the first 16 hex digits of its SHA-256 are shown.
+func TicketHolder(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Claims returns the claims signed into the payload of the ticket.
+func (t *Ticket) Claims() TicketClaims {
+	return TicketClaims{
+		TicketID:   t.ID,
+		ConcertID:  t.ConcertID,
+		Zone:       t.ZoneName,
+		SeatNumber: t.SeatNumber,
+		Holder:     t.Holder,
+		IssuedAt:   t.IssuedAt.Unix(),
+	}
+}
+
+// TicketSigner signs ticket payloads with the Ed25519 key of the service, gates holding the public key verify them offline.
+type TicketSigner struct {
+	privateKey ed25519.PrivateKey
+}
+
+func NewTicketSigner(privateKey ed25519.PrivateKey) *TicketSigner {
+	return &TicketSigner{privateKey: privateKey}
+}
+
+// PublicKey returns the key that verifies the payloads of the signer.
+func (s *TicketSigner) PublicKey() ed25519.PublicKey {
+	return s.privateKey.Public().(ed25519.PublicKey)
+}
+
+// Sign sets the payload of the ticket to its signed claims.
+func (s *TicketSigner) Sign(ticket *Ticket) error {
+	claims, err := json.Marshal(ticket.Claims())
+	if err != nil {
+		return err
+	}
+	ticket.Payload = signedtoken.Sign(s.privateKey, claims)
+	return nil
+}
+
+// Verify returns the claims of a payload signed by the signer.
+func (s *TicketSigner) Verify(payload string) (*TicketClaims, error) {
+	decoded, err := signedtoken.Verify(s.PublicKey(), payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTicket, err)
+	}
+	var claims TicketClaims
+	if err := json.Unmarshal(decoded, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTicket, err)
+	}
+	return &claims, nil
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TicketCheckin is the admission of a ticket at a gate, a ticket is admitted only once.
+type TicketCheckin struct {
+	ID         uuid.UUID
+	TicketID   uuid.UUID
+	ConcertID  uuid.UUID
+	Gate       string
+	ScannerID  string // Scanner credential that recorded the admission
+	AdmittedAt time.Time
+	CreatedAt  time.Time
+}
+
+type TicketCheckins []TicketCheckin
+
+func NewTicketCheckin(ticket *Ticket, gate string, scannerID string, admittedAt time.Time) *TicketCheckin {
+	return &TicketCheckin{
+		ID:         uuid.New(),
+		TicketID:   ticket.ID,
+		ConcertID:  ticket.ConcertID,
+		Gate:       gate,
+		ScannerID:  scannerID,
+		AdmittedAt: admittedAt,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TicketScan is a scan recorded by a gate scanner while validating tickets offline, uploaded later with the scan log of the device.
+type TicketScan struct {
+	ID         uuid.UUID
+	TicketID   uuid.UUID
+	ConcertID  uuid.UUID
+	Gate       string
+	ScannerID  string // Scanner credential that uploaded the scan
+	ScannedAt  time.Time
+	UploadedAt time.Time
+}
+
+type TicketScans []TicketScan
+
+func NewTicketScan(ticket *Ticket, gate string, scannerID string, scannedAt time.Time) *TicketScan {
+	return &TicketScan{
+		ID:         uuid.New(),
+		TicketID:   ticket.ID,
+		ConcertID:  ticket.ConcertID,
+		Gate:       gate,
+		ScannerID:  scannerID,
+		ScannedAt:  scannedAt,
+		UploadedAt: time.Now(),
+	}
+}
+
+// ScanConflict is a ticket scanned by more than one scanner, either offline or at an online check-in.
+type ScanConflict struct {
+	Ticket  Ticket
+	Checkin *TicketCheckin // Admission kept by the server, nil when none was recorded
+	Scans   TicketScans    // Uploaded scans, oldest first
+}
+
+type ScanConflicts []ScanConflict
+
+// ScannerIDs returns the distinct scanners that admitted or scanned the ticket.
+func (c *ScanConflict) ScannerIDs() []string {
+	seen := make(map[string]bool)
+	var scannerIDs []string
+	add := func(scannerID string) {
+		if !seen[scannerID] {
+			seen[scannerID] = true
+			scannerIDs = append(scannerIDs, scannerID)
+		}
+	}
+	if c.Checkin != nil {
+		add(c.Checkin.ScannerID)
+	}
+	for _, scan := range c.Scans {
+		add(scan.ScannerID)
+	}
+	return scannerIDs
+}
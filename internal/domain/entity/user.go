@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrPasswordTooLong = errors.New("password is longer than 72 bytes")
+	ErrInvalidUserRole = fmt.Errorf("invalid user role")
+)
+
+type UserRole string
+
+const (
+	UserRoleAdmin     UserRole = "admin"      // Manages every concert, promo code and user
+	UserRoleOrganizer UserRole = "organizer"  // Manages the concerts they created
+	UserRoleBoxOffice UserRole = "box_office" // Sells seats over the counter and refunds payments
+	UserRoleCustomer  UserRole = "customer"   // Reserves and pays for seats, the role of every new account
+)
+
+var userRoleStringMapper = map[UserRole]string{
+	UserRoleAdmin:     "admin",
+	UserRoleOrganizer: "organizer",
+	UserRoleBoxOffice: "box_office",
+	UserRoleCustomer:  "customer",
+}
+
+func (r UserRole) String() string {
+	return userRoleStringMapper[r]
+}
+
+func (r UserRole) IsValid() bool {
+	switch r {
+	case UserRoleAdmin, UserRoleOrganizer, UserRoleBoxOffice, UserRoleCustomer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a UserRole. It returns an error if the string is not a valid UserRole.
+func (r UserRole) Parse(role string) (UserRole, error) {
+	userRole := UserRole(role)
+	if !userRole.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidUserRole, role)
+	}
+	return userRole, nil
+}
+
+// IsOneOf reports whether the role is one of the given roles.
+func (r UserRole) IsOneOf(roles ...UserRole) bool {
+	return slices.Contains(roles, r)
+}
+
+// User is an account that signs in to reserve seats, the reservations of a user are owned by its ID.
+type User struct {
+	ID           uuid.UUID
+	Email        string // Lower-cased, see NormalizeEmail
+	PasswordHash string // bcrypt hash of the password, the password itself is never stored
+	Role         UserRole
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewUser builds a user with its password hashed. bcrypt only reads the first 72 bytes of a password,
+// so a longer password is rejected rather than silently cut.
+func NewUser(email string, password string) (*User, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return nil, ErrPasswordTooLong
+		}
+		return nil, err
+	}
+	now := time.Now()
+	return &User{
+		ID:           uuid.New(),
+		Email:        NormalizeEmail(email),
+		PasswordHash: string(hash),
+		Role:         UserRoleCustomer,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// NormalizeEmail returns the form emails are stored and looked up in, so that the same address is one account whatever its case.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// CheckPassword reports whether the password matches the hash of the user.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
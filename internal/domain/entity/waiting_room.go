@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type WaitingRoomEntryStatus string
+
+const (
+	WaitingRoomEntryStatusWaiting  WaitingRoomEntryStatus = "waiting"  // Queued, waiting for the admission controller
+	WaitingRoomEntryStatusAdmitted WaitingRoomEntryStatus = "admitted" // Let in, may reserve seats until the admission ends
+)
+
+func (s WaitingRoomEntryStatus) String() string {
+	return string(s)
+}
+
+// WaitingRoomEntry is the place of a buyer in the waiting room of a concert.
+type WaitingRoomEntry struct {
+	ConcertID     uuid.UUID
+	SessionID     string
+	Status        WaitingRoomEntryStatus
+	Position      int64      // 1-based place in the queue while waiting, 0 once admitted
+	QueueLength   int64      // Buyers still waiting in the queue
+	AdmittedUntil *time.Time // End of the admission, only set once admitted
+}
+
+// IsAdmitted reports whether the buyer was let in and the admission has not ended at the given time.
+func (e *WaitingRoomEntry) IsAdmitted(at time.Time) bool {
+	return e.Status == WaitingRoomEntryStatusAdmitted && e.AdmittedUntil != nil && at.Before(*e.AdmittedUntil)
+}
+
+// WaitingRoomPassClaims is what the pass of an admitted buyer carries, the subject is the session ID of the buyer.
+// The pass is signed like the tokens of users, so that the seat routes check it without a round trip to Redis.
+type WaitingRoomPassClaims struct {
+	jwt.RegisteredClaims
+	TokenType AuthTokenType `json:"token_type"`
+	ConcertID string        `json:"concert_id"`
+}
+
+// NewWaitingRoomPassClaims returns the claims of the pass of an admitted entry, the pass expires with the admission.
+func NewWaitingRoomPassClaims(entry *WaitingRoomEntry, issuedAt time.Time) *WaitingRoomPassClaims {
+	claims := &WaitingRoomPassClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       uuid.NewString(),
+			Subject:  entry.SessionID,
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+		TokenType: AuthTokenTypeWaitingRoomPass,
+		ConcertID: entry.ConcertID.String(),
+	}
+	if entry.AdmittedUntil != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*entry.AdmittedUntil)
+	}
+	return claims
+}
+
+// Allows reports whether the pass lets the session reserve seats of the concert.
+// The expiry is checked when the pass is parsed.
+func (c *WaitingRoomPassClaims) Allows(concertID uuid.UUID, sessionID string) bool {
+	return c.TokenType == AuthTokenTypeWaitingRoomPass && c.ConcertID == concertID.String() && c.Subject == sessionID
+}
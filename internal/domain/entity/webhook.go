@@ -0,0 +1,216 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidWebhookEventType      = fmt.Errorf("invalid webhook event type")
+	ErrInvalidWebhookDeliveryStatus = fmt.Errorf("invalid webhook delivery status")
+)
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeConcertCreated       WebhookEventType = "concert.created"
+	WebhookEventTypeReservationCreated   WebhookEventType = "reservation.created"
+	WebhookEventTypeReservationExpired   WebhookEventType = "reservation.expired"
+	WebhookEventTypeReservationConfirmed WebhookEventType = "reservation.confirmed"
+	WebhookEventTypePaymentSucceeded     WebhookEventType = "payment.succeeded"
+)
+
+var webhookEventTypeStringMapper = map[WebhookEventType]string{
+	WebhookEventTypeConcertCreated:       "concert.created",
+	WebhookEventTypeReservationCreated:   "reservation.created",
+	WebhookEventTypeReservationExpired:   "reservation.expired",
+	WebhookEventTypeReservationConfirmed: "reservation.confirmed",
+	WebhookEventTypePaymentSucceeded:     "payment.succeeded",
+}
+
+func (t WebhookEventType) String() string {
+	return webhookEventTypeStringMapper[t]
+}
+
+func (t WebhookEventType) IsValid() bool {
+	switch t {
+	case WebhookEventTypeConcertCreated, WebhookEventTypeReservationCreated, WebhookEventTypeReservationExpired,
+		WebhookEventTypeReservationConfirmed, WebhookEventTypePaymentSucceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a WebhookEventType. It returns an error if the string is not a valid WebhookEventType.
+func (t WebhookEventType) Parse(eventType string) (WebhookEventType, error) {
+	webhookEventType := WebhookEventType(eventType)
+	if !webhookEventType.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidWebhookEventType, eventType)
+	}
+	return webhookEventType, nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending      WebhookDeliveryStatus = "pending"       // Waiting for its next attempt
+	WebhookDeliveryStatusDelivered    WebhookDeliveryStatus = "delivered"     // The subscriber answered with a 2xx status
+	WebhookDeliveryStatusDeadLettered WebhookDeliveryStatus = "dead_lettered" // Every attempt failed, it is not sent again
+)
+
+var webhookDeliveryStatusStringMapper = map[WebhookDeliveryStatus]string{
+	WebhookDeliveryStatusPending:      "pending",
+	WebhookDeliveryStatusDelivered:    "delivered",
+	WebhookDeliveryStatusDeadLettered: "dead_lettered",
+}
+
+func (s WebhookDeliveryStatus) String() string {
+	return webhookDeliveryStatusStringMapper[s]
+}
+
+func (s WebhookDeliveryStatus) IsValid() bool {
+	switch s {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDeadLettered:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parse parses a string into a WebhookDeliveryStatus. It returns an error if the string is not a valid WebhookDeliveryStatus.
+func (s WebhookDeliveryStatus) Parse(status string) (WebhookDeliveryStatus, error) {
+	webhookDeliveryStatus := WebhookDeliveryStatus(status)
+	if !webhookDeliveryStatus.IsValid() {
+		return "", fmt.Errorf("%w: %s", ErrInvalidWebhookDeliveryStatus, status)
+	}
+	return webhookDeliveryStatus, nil
+}
+
+// WebhookSubscription is an endpoint of a partner system notified of the events of the given types.
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	Name       string
+	URL        string
+	Secret     string // Keys the HMAC-SHA256 signature of the deliveries, shared with the subscriber
+	EventTypes []WebhookEventType
+	IsActive   bool // Inactive subscriptions are not notified of new events
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WebhookSubscriptions []WebhookSubscription
+
+// Subscribes reports whether the subscription is notified of events of the type.
+func (s *WebhookSubscription) Subscribes(eventType WebhookEventType) bool {
+	return s.IsActive && slices.Contains(s.EventTypes, eventType)
+}
+
+// WebhookEvent is something that happened which subscribers are notified of. Every delivery of an event carries
+// the same ID, so that subscribers can tell a redelivery apart from a new event.
+type WebhookEvent struct {
+	ID         uuid.UUID
+	Type       WebhookEventType
+	OccurredAt time.Time
+	Data       map[string]any // Described object, as it is sent to subscribers
+}
+
+type WebhookEvents []WebhookEvent
+
+// Payload returns the JSON body posted to the subscribers of the event.
+func (e WebhookEvent) Payload() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"id":          e.ID,
+		"type":        e.Type,
+		"occurred_at": e.OccurredAt.UTC().Format(time.RFC3339),
+		"data":        e.Data,
+	})
+}
+
+// NewWebhookEventForConcert describes the concert to subscribers.
+func NewWebhookEventForConcert(eventType WebhookEventType, concert *Concert, occurredAt time.Time) WebhookEvent {
+	return WebhookEvent{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: occurredAt,
+		Data: map[string]any{
+			"concert_id":   concert.ID,
+			"name":         concert.Name,
+			"venue":        concert.Venue,
+			"date":         concert.Date.UTC().Format(time.RFC3339),
+			"organizer_id": concert.OrganizerID,
+		},
+	}
+}
+
+// NewWebhookEventsForReservations describes each reservation to subscribers, one event per reservation.
+func NewWebhookEventsForReservations(eventType WebhookEventType, reservations Reservations, occurredAt time.Time) WebhookEvents {
+	events := make(WebhookEvents, 0, len(reservations))
+	for _, reservation := range reservations {
+		events = append(events, WebhookEvent{
+			ID:         uuid.New(),
+			Type:       eventType,
+			OccurredAt: occurredAt,
+			Data: map[string]any{
+				"reservation_id": reservation.ID,
+				"group_id":       reservation.GroupID,
+				"seat_id":        reservation.SeatID,
+				"status":         reservation.Status,
+				"price":          reservation.Price,
+				"currency":       reservation.Currency,
+				"reserved_at":    reservation.ReservedAt.UTC().Format(time.RFC3339),
+				"expires_at":     reservation.ExpiresAt.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+	return events
+}
+
+// NewWebhookEventForPayment describes the payment to subscribers.
+func NewWebhookEventForPayment(eventType WebhookEventType, payment *Payment, occurredAt time.Time) WebhookEvent {
+	var paidAt *string
+	if payment.PaidAt != nil {
+		formatted := payment.PaidAt.UTC().Format(time.RFC3339)
+		paidAt = &formatted
+	}
+	return WebhookEvent{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: occurredAt,
+		Data: map[string]any{
+			"payment_id":         payment.ID,
+			"reservation_id":     payment.ReservationID,
+			"status":             payment.Status,
+			"amount":             payment.Amount,
+			"discount_amount":    payment.DiscountAmount,
+			"payment_method":     payment.PaymentMethod,
+			"provider":           payment.Provider,
+			"provider_reference": payment.ProviderReference,
+			"paid_at":            paidAt,
+		},
+	}
+}
+
+// WebhookDelivery is the notification of one event to one subscription, with the outcome of its attempts.
+type WebhookDelivery struct {
+	ID                 uuid.UUID
+	SubscriptionID     uuid.UUID
+	EventID            uuid.UUID
+	EventType          WebhookEventType
+	Payload            string // Body posted to the subscriber, the same bytes on every attempt
+	Status             WebhookDeliveryStatus
+	Attempts           int
+	NextAttemptAt      time.Time // A pending delivery is not attempted before, it is pushed back while an instance is sending it
+	LastAttemptAt      *time.Time
+	LastResponseStatus *int    // HTTP status of the last attempt, nil when no response was received
+	LastError          *string // Why the last attempt failed
+	DeliveredAt        *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type WebhookDeliveries []WebhookDelivery
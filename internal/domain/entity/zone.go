@@ -4,15 +4,26 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+// DefaultCurrency is the ISO 4217 currency of zones created without one.
+const DefaultCurrency = "THB"
+
 type Zone struct {
 	ID          uuid.UUID
 	ConcertID   uuid.UUID
 	Name        string
 	Description *string
+	Price       *decimal.Decimal // Price of every seat in the zone, unless the seat overrides it; nil when not on sale yet
+	Currency    string           // ISO 4217 code of the zone and seat prices
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
 type Zones []Zone
+
+// IsValidPrice reports whether price can be charged for a seat: not negative and in whole cents.
+func IsValidPrice(price decimal.Decimal) bool {
+	return !price.IsNegative() && price.Equal(price.Round(2))
+}
@@ -77,3 +77,102 @@ func (e *SeatLockedError) As(target interface{}) bool {
 		return false
 	}
 }
+
+// SeatConflict describes why a single seat could not be used by a bulk operation.
+type SeatConflict struct {
+	SeatNumber string `json:"seat_number"`
+	Reason     string `json:"reason"`
+}
+
+type SeatsAlreadyExistError struct {
+	*errsFramework.BaseError
+	Conflicts []SeatConflict
+}
+
+// NewSeatsAlreadyExistError creates a new SeatsAlreadyExistError instance reporting every clashing seat.
+func NewSeatsAlreadyExistError(conflicts []SeatConflict) error {
+	baseErr, err := errsFramework.NewBaseError(
+		StatusCodeSeatsAlreadyExist,
+		"some seats already exist in the zone.",
+		map[string]interface{}{"conflicts": conflicts},
+	)
+	if err != nil {
+		return err
+	}
+	return &SeatsAlreadyExistError{
+		BaseError: baseErr,
+		Conflicts: conflicts,
+	}
+}
+
+// As implements the error.As interface for SeatsAlreadyExistError.
+func (e *SeatsAlreadyExistError) As(target interface{}) bool {
+	if target == nil {
+		return false
+	}
+
+	switch t := target.(type) {
+	case **SeatsAlreadyExistError:
+		*t = e
+		return true
+	case *SeatsAlreadyExistError:
+		*t = *e
+		return true
+	default:
+		return false
+	}
+}
+
+// Reasons reported by SeatReservationConflict.
+const (
+	SeatConflictReasonNotFound  = "not_found"
+	SeatConflictReasonNotInZone = "not_in_zone"
+	SeatConflictReasonBooked    = "booked"
+	SeatConflictReasonLocked    = "locked"
+)
+
+// SeatReservationConflict describes why a single seat of a multi-seat reservation could not be reserved.
+type SeatReservationConflict struct {
+	SeatID     string `json:"seat_id"`
+	SeatNumber string `json:"seat_number,omitempty"`
+	Reason     string `json:"reason"`
+}
+
+type SeatsUnavailableError struct {
+	*errsFramework.BaseError
+	Conflicts []SeatReservationConflict
+}
+
+// NewSeatsUnavailableError creates a new SeatsUnavailableError instance reporting every seat that could not be reserved.
+func NewSeatsUnavailableError(conflicts []SeatReservationConflict) error {
+	baseErr, err := errsFramework.NewBaseError(
+		StatusCodeSeatsUnavailable,
+		"some seats could not be reserved.",
+		map[string]interface{}{"conflicts": conflicts},
+	)
+	if err != nil {
+		return err
+	}
+	return &SeatsUnavailableError{
+		BaseError: baseErr,
+		Conflicts: conflicts,
+	}
+}
+
+// As implements the error.As interface for SeatsUnavailableError.
+func (e *SeatsUnavailableError) As(target interface{}) bool {
+	if target == nil {
+		return false
+	}
+
+	switch t := target.(type) {
+	case **SeatsUnavailableError:
+		*t = e
+		return true
+	case *SeatsUnavailableError:
+		*t = *e
+		return true
+	default:
+		return false
+	}
+}
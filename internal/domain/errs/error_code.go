@@ -7,6 +7,11 @@ import (
 const (
 	StatusCodeSuccess                      = errsFramework.StatusCodeSuccess // 200000
 	StatusCodeServiceCircuitBreakerTripped = "503001"                        // service circuit breaker tripped error code
+	StatusCodeTooManyRequests              = "400001"                        // client error when the rate limit of the caller is exceeded, sent with the 429 status
 	StatusCodeSeatBooked                   = "403001"                        // conflict error when trying to book a seat that is already booked
 	StatusCodeSeatLocked                   = "403002"                        // conflict error when trying to book a seat that is already locked
+	StatusCodeSeatsAlreadyExist            = "403003"                        // conflict error when generating seats that already exist in the zone
+	StatusCodeSeatsUnavailable             = "403004"                        // conflict error when some seats of a multi-seat reservation cannot be reserved
+	StatusCodePaymentDeclined              = "404001"                        // unprocessable entity error when the payment provider declines the charge
+	StatusCodeRefundDeclined               = "404002"                        // unprocessable entity error when the payment provider declines the refund
 )
@@ -0,0 +1,43 @@
+package errs
+
+import (
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type TooManyRequestsError struct {
+	*errsFramework.BaseError
+}
+
+// NewTooManyRequestsError creates a new TooManyRequestsError instance using the too many requests error code.
+// The error code falls in the client error category, httpresponse.Error sends it with the 429 status.
+func NewTooManyRequestsError(data interface{}) error {
+	baseErr, err := errsFramework.NewBaseError(
+		StatusCodeTooManyRequests,
+		"too many requests. please try again later.",
+		data,
+	)
+	if err != nil {
+		return err
+	}
+	return &TooManyRequestsError{
+		BaseError: baseErr,
+	}
+}
+
+// As implements the error.As interface for TooManyRequestsError.
+func (e *TooManyRequestsError) As(target interface{}) bool {
+	if target == nil {
+		return false
+	}
+
+	switch t := target.(type) {
+	case **TooManyRequestsError:
+		*t = e
+		return true
+	case *TooManyRequestsError:
+		*t = *e
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+package errs
+
+import (
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+type PaymentDeclinedError struct {
+	*errsFramework.BaseError
+}
+
+// NewPaymentDeclinedError creates a new PaymentDeclinedError instance using the payment declined error code.
+func NewPaymentDeclinedError(data interface{}) error {
+	baseErr, err := errsFramework.NewBaseError(
+		StatusCodePaymentDeclined,
+		"the payment was declined.",
+		data,
+	)
+	if err != nil {
+		return err
+	}
+	return &PaymentDeclinedError{
+		BaseError: baseErr,
+	}
+}
+
+// As implements the error.As interface for PaymentDeclinedError.
+func (e *PaymentDeclinedError) As(target interface{}) bool {
+	if target == nil {
+		return false
+	}
+
+	switch t := target.(type) {
+	case **PaymentDeclinedError:
+		*t = e
+		return true
+	case *PaymentDeclinedError:
+		*t = *e
+		return true
+	default:
+		return false
+	}
+}
+
+type RefundDeclinedError struct {
+	*errsFramework.BaseError
+}
+
+// NewRefundDeclinedError creates a new RefundDeclinedError instance using the refund declined error code.
+func NewRefundDeclinedError(data interface{}) error {
+	baseErr, err := errsFramework.NewBaseError(
+		StatusCodeRefundDeclined,
+		"the refund was declined.",
+		data,
+	)
+	if err != nil {
+		return err
+	}
+	return &RefundDeclinedError{
+		BaseError: baseErr,
+	}
+}
+
+// As implements the error.As interface for RefundDeclinedError.
+func (e *RefundDeclinedError) As(target interface{}) bool {
+	if target == nil {
+		return false
+	}
+
+	switch t := target.(type) {
+	case **RefundDeclinedError:
+		*t = e
+		return true
+	case *RefundDeclinedError:
+		*t = *e
+		return true
+	default:
+		return false
+	}
+}
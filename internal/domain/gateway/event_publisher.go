@@ -0,0 +1,19 @@
+package gateway
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+)
+
+//go:generate mockgen -source=./event_publisher.go -destination=./mocks/event_publisher.go -package=gateway_mocks
+type EventPublisher interface {
+	// PublishSeatStatus sends the events to the subscribers of the seat status stream of their zones.
+	// It must only be called once the change the events describe is committed, so that subscribers never see a seat
+	// change that was rolled back. Errors are only logged, like the seat map updates the events go along with.
+	PublishSeatStatus(ctx context.Context, events entity.SeatStatusEvents)
+	// EnqueueWebhookEvents creates the webhook deliveries of the events in the transaction making the change they
+	// describe, so that subscribers are only told about committed changes. The webhook dispatcher sends them later.
+	// On error the transaction is left usable, a caller that must not fail may log the error and carry on.
+	EnqueueWebhookEvents(ctx context.Context, tx db.SqlExecer, events entity.WebhookEvents) error
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./event_publisher.go
+
+// Package gateway_mocks is a generated GoMock package.
+package gateway_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	db "ticket-reservation/internal/infra/db"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+}
+
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// EnqueueWebhookEvents mocks base method.
+func (m *MockEventPublisher) EnqueueWebhookEvents(ctx context.Context, tx db.SqlExecer, events entity.WebhookEvents) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueWebhookEvents", ctx, tx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueWebhookEvents indicates an expected call of EnqueueWebhookEvents.
+func (mr *MockEventPublisherMockRecorder) EnqueueWebhookEvents(ctx, tx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueWebhookEvents", reflect.TypeOf((*MockEventPublisher)(nil).EnqueueWebhookEvents), ctx, tx, events)
+}
+
+// PublishSeatStatus mocks base method.
+func (m *MockEventPublisher) PublishSeatStatus(ctx context.Context, events entity.SeatStatusEvents) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PublishSeatStatus", ctx, events)
+}
+
+// PublishSeatStatus indicates an expected call of PublishSeatStatus.
+func (mr *MockEventPublisherMockRecorder) PublishSeatStatus(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishSeatStatus", reflect.TypeOf((*MockEventPublisher)(nil).PublishSeatStatus), ctx, events)
+}
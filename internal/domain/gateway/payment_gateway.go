@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrPaymentDeclined indicates that the payment provider refused the charge, e.g. insufficient funds.
+	ErrPaymentDeclined = errors.New("payment declined")
+	// ErrRefundDeclined indicates that the payment provider refused the refund, e.g. the charge is too old to be refunded.
+	ErrRefundDeclined = errors.New("refund declined")
+)
+
+//go:generate mockgen -source=./payment_gateway.go -destination=./mocks/payment_gateway.go -package=gateway_mocks
+type PaymentGateway interface {
+	// Provider returns the name of the payment provider, stored with every payment processed by the gateway.
+	Provider() string
+	// Charge charges the amount of the payment with the given payment method.
+	// Returns ErrPaymentDeclined if the provider declined the charge; any other error means the outcome is unknown.
+	Charge(ctx context.Context, input ChargeInput) (*ChargeResult, error)
+	// Refund gives back part or all of a charge.
+	// Returns ErrRefundDeclined if the provider declined the refund; any other error means the outcome is unknown.
+	Refund(ctx context.Context, input RefundInput) (*RefundResult, error)
+}
+
+type ChargeInput struct {
+	PaymentID     uuid.UUID // Sent as the idempotency key so that a retried charge is not taken twice
+	Amount        decimal.Decimal
+	PaymentMethod string
+}
+
+type ChargeResult struct {
+	ProviderReference string // Reference of the charge on the provider side
+}
+
+type RefundInput struct {
+	RefundID          uuid.UUID // Sent as the idempotency key so that a retried refund is not given twice
+	ProviderReference string    // Reference of the charge to refund
+	Amount            decimal.Decimal
+}
+
+type RefundResult struct {
+	ProviderReference string // Reference of the refund on the provider side
+}
@@ -0,0 +1,20 @@
+package gateway
+
+import "context"
+
+//go:generate mockgen -source=./webhook_sender.go -destination=./mocks/webhook_sender.go -package=gateway_mocks
+type WebhookSender interface {
+	// Send posts the payload to the URL of a webhook subscriber.
+	// Any response is a result, whatever its status; an error means no response was received.
+	Send(ctx context.Context, input SendWebhookInput) (*SendWebhookResult, error)
+}
+
+type SendWebhookInput struct {
+	URL     string
+	Headers map[string]string // Sent along the JSON content type, e.g. the signature of the payload
+	Payload []byte
+}
+
+type SendWebhookResult struct {
+	StatusCode int
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./api_key_repository.go -destination=./mocks/api_key_repository.go -package=repository_mocks
+type APIKeyRepository interface {
+	CreateOne(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error)
+	// FindAll returns every key, revoked and expired ones included, the most recent first.
+	FindAll(ctx context.Context) (*entity.APIKeys, error)
+	FindOne(ctx context.Context, id uuid.UUID) (*entity.APIKey, error)
+	// FindOneByPrefix finds a key by the public prefix of the key, see entity.ParseAPIKeyPrefix.
+	FindOneByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error)
+	UpdateOne(ctx context.Context, input UpdateAPIKeyInput) (*entity.APIKey, error)
+	WithTx(tx db.SqlExecer) APIKeyRepository // Optional: WithTx if you want to use a transaction
+}
+
+// UpdateAPIKeyInput updates the non-nil fields of a key, Prefix and KeyHash are replaced together when the key is rotated.
+type UpdateAPIKeyInput struct {
+	ID         uuid.UUID
+	Prefix     *string
+	KeyHash    *string
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
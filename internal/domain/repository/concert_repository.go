@@ -15,15 +15,22 @@ type ConcertRepository interface {
 	CreateOne(ctx context.Context, concert *entity.Concert) (*entity.Concert, error)
 	FindOne(ctx context.Context, id uuid.UUID) (*entity.Concert, error)
 	FindAll(ctx context.Context, filter FindAllConcertsFilter) (*entity.Concerts, int64, error)
+	UpdateOne(ctx context.Context, input UpdateConcertInput) (*entity.Concert, error)
 	WithTx(tx db.SqlExecer) ConcertRepository // Optional: WithTx if you want to use a transaction
 }
 
 type FindAllConcertsFilter struct {
-	StartDate *time.Time
-	EndDate   *time.Time
-	Venue     *string
-	Limit     *int64
-	Offset    *int64
-	SortBy    *string
-	SortOrder *entity.SortOrder
+	StartDate          *time.Time
+	EndDate            *time.Time
+	Venue              *string
+	WaitingRoomEnabled *bool
+	Limit              *int64
+	Offset             *int64
+	SortBy             *string
+	SortOrder          *entity.SortOrder
+}
+
+type UpdateConcertInput struct {
+	ID                 uuid.UUID
+	WaitingRoomEnabled *bool
 }
@@ -84,6 +84,21 @@ func (mr *MockConcertRepositoryMockRecorder) FindOne(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockConcertRepository)(nil).FindOne), ctx, id)
 }
 
+// UpdateOne mocks base method.
+func (m *MockConcertRepository) UpdateOne(ctx context.Context, input repository.UpdateConcertInput) (*entity.Concert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOne", ctx, input)
+	ret0, _ := ret[0].(*entity.Concert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOne indicates an expected call of UpdateOne.
+func (mr *MockConcertRepositoryMockRecorder) UpdateOne(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOne", reflect.TypeOf((*MockConcertRepository)(nil).UpdateOne), ctx, input)
+}
+
 // WithTx mocks base method.
 func (m *MockConcertRepository) WithTx(tx db.SqlExecer) repository.ConcertRepository {
 	m.ctrl.T.Helper()
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./payment_webhook_event_repository.go
+
+// Package repository_mocks is a generated GoMock package.
+package repository_mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	repository "ticket-reservation/internal/domain/repository"
+	db "ticket-reservation/internal/infra/db"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentWebhookEventRepository is a mock of PaymentWebhookEventRepository interface.
+type MockPaymentWebhookEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentWebhookEventRepositoryMockRecorder
+}
+
+// MockPaymentWebhookEventRepositoryMockRecorder is the mock recorder for MockPaymentWebhookEventRepository.
+type MockPaymentWebhookEventRepositoryMockRecorder struct {
+	mock *MockPaymentWebhookEventRepository
+}
+
+// NewMockPaymentWebhookEventRepository creates a new mock instance.
+func NewMockPaymentWebhookEventRepository(ctrl *gomock.Controller) *MockPaymentWebhookEventRepository {
+	mock := &MockPaymentWebhookEventRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentWebhookEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentWebhookEventRepository) EXPECT() *MockPaymentWebhookEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateOne mocks base method.
+func (m *MockPaymentWebhookEventRepository) CreateOne(ctx context.Context, event *entity.PaymentWebhookEvent) (*entity.PaymentWebhookEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOne", ctx, event)
+	ret0, _ := ret[0].(*entity.PaymentWebhookEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOne indicates an expected call of CreateOne.
+func (mr *MockPaymentWebhookEventRepositoryMockRecorder) CreateOne(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOne", reflect.TypeOf((*MockPaymentWebhookEventRepository)(nil).CreateOne), ctx, event)
+}
+
+// WithTx mocks base method.
+func (m *MockPaymentWebhookEventRepository) WithTx(tx db.SqlExecer) repository.PaymentWebhookEventRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(repository.PaymentWebhookEventRepository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockPaymentWebhookEventRepositoryMockRecorder) WithTx(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockPaymentWebhookEventRepository)(nil).WithTx), tx)
+}
@@ -12,6 +12,7 @@ import (
 	db "ticket-reservation/internal/infra/db"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockReservationRepository is a mock of ReservationRepository interface.
@@ -52,6 +53,21 @@ func (mr *MockReservationRepositoryMockRecorder) CreateOne(ctx, reservation inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOne", reflect.TypeOf((*MockReservationRepository)(nil).CreateOne), ctx, reservation)
 }
 
+// ExpireMany mocks base method.
+func (m *MockReservationRepository) ExpireMany(ctx context.Context, input repository.ExpireManyReservationsInput) (*entity.Reservations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireMany", ctx, input)
+	ret0, _ := ret[0].(*entity.Reservations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireMany indicates an expected call of ExpireMany.
+func (mr *MockReservationRepositoryMockRecorder) ExpireMany(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireMany", reflect.TypeOf((*MockReservationRepository)(nil).ExpireMany), ctx, input)
+}
+
 // FindAll mocks base method.
 func (m *MockReservationRepository) FindAll(ctx context.Context, filter repository.FindAllReservationsFilter) (*entity.Reservations, int64, error) {
 	m.ctrl.T.Helper()
@@ -68,6 +84,21 @@ func (mr *MockReservationRepositoryMockRecorder) FindAll(ctx, filter interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReservationRepository)(nil).FindAll), ctx, filter)
 }
 
+// FindOne mocks base method.
+func (m *MockReservationRepository) FindOne(ctx context.Context, id uuid.UUID) (*entity.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOne", ctx, id)
+	ret0, _ := ret[0].(*entity.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOne indicates an expected call of FindOne.
+func (mr *MockReservationRepositoryMockRecorder) FindOne(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockReservationRepository)(nil).FindOne), ctx, id)
+}
+
 // UpdateOne mocks base method.
 func (m *MockReservationRepository) UpdateOne(ctx context.Context, input repository.UpdateReservationInput) (*entity.Reservation, error) {
 	m.ctrl.T.Helper()
@@ -38,6 +38,51 @@ func (m *MockSeatRepository) EXPECT() *MockSeatRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateMany mocks base method.
+func (m *MockSeatRepository) CreateMany(ctx context.Context, seats entity.Seats) (*entity.Seats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", ctx, seats)
+	ret0, _ := ret[0].(*entity.Seats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockSeatRepositoryMockRecorder) CreateMany(ctx, seats interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockSeatRepository)(nil).CreateMany), ctx, seats)
+}
+
+// FindAllByZone mocks base method.
+func (m *MockSeatRepository) FindAllByZone(ctx context.Context, zoneID uuid.UUID) (*entity.Seats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByZone", ctx, zoneID)
+	ret0, _ := ret[0].(*entity.Seats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByZone indicates an expected call of FindAllByZone.
+func (mr *MockSeatRepositoryMockRecorder) FindAllByZone(ctx, zoneID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByZone", reflect.TypeOf((*MockSeatRepository)(nil).FindAllByZone), ctx, zoneID)
+}
+
+// FindMany mocks base method.
+func (m *MockSeatRepository) FindMany(ctx context.Context, ids []uuid.UUID) (*entity.Seats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMany", ctx, ids)
+	ret0, _ := ret[0].(*entity.Seats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMany indicates an expected call of FindMany.
+func (mr *MockSeatRepositoryMockRecorder) FindMany(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockSeatRepository)(nil).FindMany), ctx, ids)
+}
+
 // FindOne mocks base method.
 func (m *MockSeatRepository) FindOne(ctx context.Context, id uuid.UUID) (*entity.Seat, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +98,21 @@ func (mr *MockSeatRepositoryMockRecorder) FindOne(ctx, id interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockSeatRepository)(nil).FindOne), ctx, id)
 }
 
+// ReleaseManyExpired mocks base method.
+func (m *MockSeatRepository) ReleaseManyExpired(ctx context.Context, input repository.ReleaseManyExpiredSeatsInput) (*entity.Seats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseManyExpired", ctx, input)
+	ret0, _ := ret[0].(*entity.Seats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseManyExpired indicates an expected call of ReleaseManyExpired.
+func (mr *MockSeatRepositoryMockRecorder) ReleaseManyExpired(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseManyExpired", reflect.TypeOf((*MockSeatRepository)(nil).ReleaseManyExpired), ctx, input)
+}
+
 // UpdateOne mocks base method.
 func (m *MockSeatRepository) UpdateOne(ctx context.Context, input repository.UpdateSeatInput) (*entity.Seat, error) {
 	m.ctrl.T.Helper()
@@ -38,6 +38,37 @@ func (m *MockZoneRepository) EXPECT() *MockZoneRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateOne mocks base method.
+func (m *MockZoneRepository) CreateOne(ctx context.Context, zone *entity.Zone) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOne", ctx, zone)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOne indicates an expected call of CreateOne.
+func (mr *MockZoneRepositoryMockRecorder) CreateOne(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOne", reflect.TypeOf((*MockZoneRepository)(nil).CreateOne), ctx, zone)
+}
+
+// FindAll mocks base method.
+func (m *MockZoneRepository) FindAll(ctx context.Context, filter repository.FindAllZonesFilter) (*entity.Zones, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].(*entity.Zones)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockZoneRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockZoneRepository)(nil).FindAll), ctx, filter)
+}
+
 // FindOne mocks base method.
 func (m *MockZoneRepository) FindOne(ctx context.Context, id uuid.UUID) (*entity.Zone, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +84,21 @@ func (mr *MockZoneRepositoryMockRecorder) FindOne(ctx, id interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockZoneRepository)(nil).FindOne), ctx, id)
 }
 
+// UpdateOne mocks base method.
+func (m *MockZoneRepository) UpdateOne(ctx context.Context, input repository.UpdateZoneInput) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOne", ctx, input)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOne indicates an expected call of UpdateOne.
+func (mr *MockZoneRepositoryMockRecorder) UpdateOne(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOne", reflect.TypeOf((*MockZoneRepository)(nil).UpdateOne), ctx, input)
+}
+
 // WithTx mocks base method.
 func (m *MockZoneRepository) WithTx(tx db.SqlExecer) repository.ZoneRepository {
 	m.ctrl.T.Helper()
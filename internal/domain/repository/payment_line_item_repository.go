@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./payment_line_item_repository.go -destination=./mocks/payment_line_item_repository.go -package=repository_mocks
+type PaymentLineItemRepository interface {
+	CreateMany(ctx context.Context, lineItems entity.PaymentLineItems) (*entity.PaymentLineItems, error)
+	FindAllByPaymentID(ctx context.Context, paymentID uuid.UUID) (*entity.PaymentLineItems, error) // Ordered by line number
+	WithTx(tx db.SqlExecer) PaymentLineItemRepository                                              // Optional: WithTx if you want to use a transaction
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./payment_repository.go -destination=./mocks/payment_repository.go -package=repository_mocks
+type PaymentRepository interface {
+	CreateOne(ctx context.Context, payment *entity.Payment) (*entity.Payment, error)
+	FindOne(ctx context.Context, id uuid.UUID) (*entity.Payment, error)
+	FindAll(ctx context.Context, filter FindAllPaymentsFilter) (*entity.Payments, error)
+	UpdateOne(ctx context.Context, input UpdatePaymentInput) (*entity.Payment, error)
+	WithTx(tx db.SqlExecer) PaymentRepository // Optional: WithTx if you want to use a transaction
+}
+
+type FindAllPaymentsFilter struct {
+	ReservationID *uuid.UUID
+	Status        *entity.PaymentStatus
+}
+
+type UpdatePaymentInput struct {
+	ID                uuid.UUID
+	Status            *entity.PaymentStatus
+	PaidAt            *time.Time
+	ProviderReference *string
+	QRPayload         *string
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+)
+
+//go:generate mockgen -source=./payment_webhook_event_repository.go -destination=./mocks/payment_webhook_event_repository.go -package=repository_mocks
+type PaymentWebhookEventRepository interface {
+	// CreateOne records a processed webhook event. It returns a ConflictError when the provider already delivered the event.
+	CreateOne(ctx context.Context, event *entity.PaymentWebhookEvent) (*entity.PaymentWebhookEvent, error)
+	WithTx(tx db.SqlExecer) PaymentWebhookEventRepository // Optional: WithTx if you want to use a transaction
+}
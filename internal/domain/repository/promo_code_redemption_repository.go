@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./promo_code_redemption_repository.go -destination=./mocks/promo_code_redemption_repository.go -package=repository_mocks
+type PromoCodeRedemptionRepository interface {
+	CreateOne(ctx context.Context, redemption *entity.PromoCodeRedemption) (*entity.PromoCodeRedemption, error)
+	// Count counts the redemptions of a promo code whose payment has not failed, a declined charge gives the use back.
+	Count(ctx context.Context, filter CountPromoCodeRedemptionsFilter) (int64, error)
+	WithTx(tx db.SqlExecer) PromoCodeRedemptionRepository // Optional: WithTx if you want to use a transaction
+}
+
+type CountPromoCodeRedemptionsFilter struct {
+	PromoCodeID uuid.UUID
+	SessionID   *string
+}
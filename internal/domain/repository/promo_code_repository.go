@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+)
+
+//go:generate mockgen -source=./promo_code_repository.go -destination=./mocks/promo_code_repository.go -package=repository_mocks
+type PromoCodeRepository interface {
+	// CreateOne creates a promo code. It returns a ConflictError when the code is already taken.
+	CreateOne(ctx context.Context, promoCode *entity.PromoCode) (*entity.PromoCode, error)
+	// FindOneByCode finds a promo code by its normalized code and locks the row, so that redemptions of a code are serialized.
+	FindOneByCode(ctx context.Context, code string) (*entity.PromoCode, error)
+	WithTx(tx db.SqlExecer) PromoCodeRepository // Optional: WithTx if you want to use a transaction
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./receipt_repository.go -destination=./mocks/receipt_repository.go -package=repository_mocks
+type ReceiptRepository interface {
+	// NextReceiptNumber takes the next running number of the seller. The counter row stays locked until the transaction ends,
+	// and a rolled back transaction gives the number back, so the numbers of committed receipts have no gaps.
+	NextReceiptNumber(ctx context.Context, sellerTaxID string) (int64, error)
+	CreateOne(ctx context.Context, receipt *entity.Receipt) (*entity.Receipt, error)
+	FindOneByPaymentID(ctx context.Context, paymentID uuid.UUID) (*entity.Receipt, error)
+	WithTx(tx db.SqlExecer) ReceiptRepository // Optional: WithTx if you want to use a transaction
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./refund_repository.go -destination=./mocks/refund_repository.go -package=repository_mocks
+type RefundRepository interface {
+	CreateOne(ctx context.Context, refund *entity.Refund) (*entity.Refund, error)
+	FindAll(ctx context.Context, filter FindAllRefundsFilter) (*entity.Refunds, error)
+	UpdateOne(ctx context.Context, input UpdateRefundInput) (*entity.Refund, error)
+	WithTx(tx db.SqlExecer) RefundRepository // Optional: WithTx if you want to use a transaction
+}
+
+type FindAllRefundsFilter struct {
+	PaymentID *uuid.UUID
+	Status    *entity.RefundStatus
+}
+
+type UpdateRefundInput struct {
+	ID                uuid.UUID
+	Status            *entity.RefundStatus
+	ProviderReference *string
+}
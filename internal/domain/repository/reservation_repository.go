@@ -12,8 +12,10 @@ import (
 //go:generate mockgen -source=./reservation_repository.go -destination=./mocks/reservation_repository.go -package=repository_mocks
 type ReservationRepository interface {
 	CreateOne(ctx context.Context, reservation *entity.Reservation) (*entity.Reservation, error)
+	FindOne(ctx context.Context, id uuid.UUID) (*entity.Reservation, error)
 	FindAll(ctx context.Context, filter FindAllReservationsFilter) (*entity.Reservations, int64, error)
 	UpdateOne(ctx context.Context, input UpdateReservationInput) (*entity.Reservation, error)
+	ExpireMany(ctx context.Context, input ExpireManyReservationsInput) (*entity.Reservations, error)
 	WithTx(tx db.SqlExecer) ReservationRepository // Optional: WithTx if you want to use a transaction
 }
 
@@ -30,3 +32,10 @@ type UpdateReservationInput struct {
 	Status    *entity.ReservationStatus
 	ExpiresAt *time.Time
 }
+
+// ExpireManyReservationsInput selects at most Limit pending reservations whose expires_at is before ExpiresBefore.
+// Rows locked by another transaction are skipped so that several sweepers can run concurrently.
+type ExpireManyReservationsInput struct {
+	ExpiresBefore time.Time
+	Limit         int64
+}
@@ -11,8 +11,12 @@ import (
 
 //go:generate mockgen -source=./seat_repository.go -destination=./mocks/seat_repository.go -package=repository_mocks
 type SeatRepository interface {
+	CreateMany(ctx context.Context, seats entity.Seats) (*entity.Seats, error)
 	FindOne(ctx context.Context, id uuid.UUID) (*entity.Seat, error)
+	FindMany(ctx context.Context, ids []uuid.UUID) (*entity.Seats, error) // Locks the found rows FOR UPDATE in id order
+	FindAllByZone(ctx context.Context, zoneID uuid.UUID) (*entity.Seats, error)
 	UpdateOne(ctx context.Context, input UpdateSeatInput) (*entity.Seat, error)
+	ReleaseManyExpired(ctx context.Context, input ReleaseManyExpiredSeatsInput) (*entity.Seats, error)
 	WithTx(tx db.SqlExecer) SeatRepository // Optional: WithTx if you want to use a transaction
 }
 
@@ -21,4 +25,12 @@ type UpdateSeatInput struct {
 	Status            *entity.SeatStatus
 	LockedBySessionID *string
 	LockedUntil       *time.Time
+	ClearLock         bool // Sets locked_until and locked_by_session_id to NULL; takes precedence over LockedUntil/LockedBySessionID
+}
+
+// ReleaseManyExpiredSeatsInput selects at most Limit pending seats whose locked_until is before LockedBefore.
+// Rows locked by another transaction are skipped so that several sweepers can run concurrently.
+type ReleaseManyExpiredSeatsInput struct {
+	LockedBefore time.Time
+	Limit        int64
 }
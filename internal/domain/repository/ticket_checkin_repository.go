@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./ticket_checkin_repository.go -destination=./mocks/ticket_checkin_repository.go -package=repository_mocks
+type TicketCheckinRepository interface {
+	// CreateOne records the admission of a ticket, it returns a ConflictError when the ticket was already admitted.
+	CreateOne(ctx context.Context, checkin *entity.TicketCheckin) (*entity.TicketCheckin, error)
+	FindOneByTicketID(ctx context.Context, ticketID uuid.UUID) (*entity.TicketCheckin, error)
+	FindAllByConcertID(ctx context.Context, concertID uuid.UUID) (*entity.TicketCheckins, error)
+	WithTx(tx db.SqlExecer) TicketCheckinRepository // Optional: WithTx if you want to use a transaction
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./ticket_repository.go -destination=./mocks/ticket_repository.go -package=repository_mocks
+type TicketRepository interface {
+	CreateOne(ctx context.Context, ticket *entity.Ticket) (*entity.Ticket, error)
+	FindOne(ctx context.Context, id uuid.UUID) (*entity.Ticket, error)
+	FindMany(ctx context.Context, ids []uuid.UUID) (*entity.Tickets, error)
+	FindAllByReservationID(ctx context.Context, reservationID uuid.UUID) (*entity.Tickets, error)
+	// FindAllConfirmedByConcertID returns the tickets of a concert whose reservation is still confirmed.
+	FindAllConfirmedByConcertID(ctx context.Context, concertID uuid.UUID) (*entity.Tickets, error)
+	WithTx(tx db.SqlExecer) TicketRepository // Optional: WithTx if you want to use a transaction
+}
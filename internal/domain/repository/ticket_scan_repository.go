@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./ticket_scan_repository.go -destination=./mocks/ticket_scan_repository.go -package=repository_mocks
+type TicketScanRepository interface {
+	// CreateMany records uploaded scans and returns the ones that were not recorded before.
+	CreateMany(ctx context.Context, scans entity.TicketScans) (*entity.TicketScans, error)
+	FindAllByConcertID(ctx context.Context, concertID uuid.UUID) (*entity.TicketScans, error)
+	WithTx(tx db.SqlExecer) TicketScanRepository // Optional: WithTx if you want to use a transaction
+}
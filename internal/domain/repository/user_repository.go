@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./user_repository.go -destination=./mocks/user_repository.go -package=repository_mocks
+type UserRepository interface {
+	// CreateOne creates a user, it returns a ConflictError when the email is already registered.
+	CreateOne(ctx context.Context, user *entity.User) (*entity.User, error)
+	FindOne(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// FindOneByEmail finds a user by its normalized email, see entity.NormalizeEmail.
+	FindOneByEmail(ctx context.Context, email string) (*entity.User, error)
+	UpdateRole(ctx context.Context, id uuid.UUID, role entity.UserRole) (*entity.User, error)
+	WithTx(tx db.SqlExecer) UserRepository // Optional: WithTx if you want to use a transaction
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./webhook_delivery_repository.go -destination=./mocks/webhook_delivery_repository.go -package=repository_mocks
+type WebhookDeliveryRepository interface {
+	// CreateForEvents creates a pending delivery of every event to every active subscription of its type,
+	// and returns how many were created. It runs in the transaction of the change the events describe,
+	// so that subscribers are notified of every committed change and of nothing else.
+	CreateForEvents(ctx context.Context, events entity.WebhookEvents) (int64, error)
+	// FindAll returns the deliveries matching the filter, the most recent first, with the total number of matches.
+	FindAll(ctx context.Context, filter FindAllWebhookDeliveriesFilter) (*entity.WebhookDeliveries, int64, error)
+	// RecordAttempt counts one more attempt of the delivery and stores its outcome and when it is due again.
+	RecordAttempt(ctx context.Context, input RecordWebhookDeliveryAttemptInput) (*entity.WebhookDelivery, error)
+	ClaimMany(ctx context.Context, input ClaimManyWebhookDeliveriesInput) (*entity.WebhookDeliveries, error)
+	WithTx(tx db.SqlExecer) WebhookDeliveryRepository // Optional: WithTx if you want to use a transaction
+}
+
+type FindAllWebhookDeliveriesFilter struct {
+	SubscriptionID *uuid.UUID
+	Status         *entity.WebhookDeliveryStatus
+	EventType      *entity.WebhookEventType
+	Limit          *int64
+	Offset         *int64
+}
+
+// RecordWebhookDeliveryAttemptInput is the outcome of an attempt, every field is stored as is, nil ones included,
+// so that the delivery always shows the last attempt.
+type RecordWebhookDeliveryAttemptInput struct {
+	ID             uuid.UUID
+	Status         entity.WebhookDeliveryStatus // Pending while attempts are left, delivered or dead-lettered once done
+	AttemptedAt    time.Time
+	ResponseStatus *int    // Nil when no response was received
+	Error          *string // Nil when the attempt succeeded
+	DeliveredAt    *time.Time
+	NextAttemptAt  time.Time // When a pending delivery is due again, the attempt time once it is delivered or dead-lettered
+}
+
+// ClaimManyWebhookDeliveriesInput selects at most Limit pending deliveries due before DueBefore and pushes their
+// next attempt back to LeaseUntil, so that no other instance sends them meanwhile. Rows locked by another transaction
+// are skipped so that several workers can run concurrently; a delivery left pending by a stopped worker is claimed
+// again once its lease has passed.
+type ClaimManyWebhookDeliveriesInput struct {
+	DueBefore  time.Time
+	LeaseUntil time.Time
+	Limit      int64
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=./webhook_subscription_repository.go -destination=./mocks/webhook_subscription_repository.go -package=repository_mocks
+type WebhookSubscriptionRepository interface {
+	CreateOne(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+	FindOne(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	// FindAll returns every subscription, inactive ones included, the most recent first.
+	FindAll(ctx context.Context) (*entity.WebhookSubscriptions, error)
+	UpdateOne(ctx context.Context, input UpdateWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+	WithTx(tx db.SqlExecer) WebhookSubscriptionRepository // Optional: WithTx if you want to use a transaction
+}
+
+// UpdateWebhookSubscriptionInput updates the non-nil fields of a subscription.
+type UpdateWebhookSubscriptionInput struct {
+	ID         uuid.UUID
+	Name       *string
+	URL        *string
+	Secret     *string
+	EventTypes []entity.WebhookEventType // Replaces the event types when not nil
+	IsActive   *bool
+}
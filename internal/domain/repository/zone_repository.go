@@ -6,10 +6,28 @@ import (
 	"ticket-reservation/internal/infra/db"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 //go:generate mockgen -source=./zone_repository.go -destination=./mocks/zone_repository.go -package=repository_mocks
 type ZoneRepository interface {
+	CreateOne(ctx context.Context, zone *entity.Zone) (*entity.Zone, error)
 	FindOne(ctx context.Context, id uuid.UUID) (*entity.Zone, error)
+	FindAll(ctx context.Context, filter FindAllZonesFilter) (*entity.Zones, int64, error)
+	UpdateOne(ctx context.Context, input UpdateZoneInput) (*entity.Zone, error)
 	WithTx(tx db.SqlExecer) ZoneRepository // Optional: WithTx if you want to use a transaction
 }
+
+type FindAllZonesFilter struct {
+	ConcertID *uuid.UUID
+	Limit     *int64
+	Offset    *int64
+}
+
+type UpdateZoneInput struct {
+	ID          uuid.UUID
+	Name        *string
+	Description *string
+	Price       *decimal.Decimal
+	Currency    *string
+}
@@ -13,10 +13,12 @@ import (
 )
 
 type Concerts struct {
-	ID        uuid.UUID `sql:"primary_key" db:"concerts.id"`
-	Name      string    `db:"concerts.name"`
-	Date      time.Time `db:"concerts.date"`
-	Venue     string    `db:"concerts.venue"`
-	CreatedAt time.Time `db:"concerts.created_at"`
-	UpdatedAt time.Time `db:"concerts.updated_at"`
+	ID                 uuid.UUID  `sql:"primary_key" db:"concerts.id"`
+	Name               string     `db:"concerts.name"`
+	Date               time.Time  `db:"concerts.date"`
+	Venue              string     `db:"concerts.venue"`
+	CreatedAt          time.Time  `db:"concerts.created_at"`
+	UpdatedAt          time.Time  `db:"concerts.updated_at"`
+	OrganizerID        *uuid.UUID `db:"concerts.organizer_id"`
+	WaitingRoomEnabled bool       `db:"concerts.waiting_room_enabled"`
 }
@@ -14,12 +14,17 @@ import (
 )
 
 type Payments struct {
-	ID            uuid.UUID        `sql:"primary_key" db:"payments.id"`
-	ReservationID uuid.UUID        `db:"payments.reservation_id"`
-	Status        string           `db:"payments.status"`
-	Amount        *decimal.Decimal `db:"payments.amount"`
-	PaidAt        *time.Time       `db:"payments.paid_at"`
-	PaymentMethod *string          `db:"payments.payment_method"`
-	CreatedAt     time.Time        `db:"payments.created_at"`
-	UpdatedAt     time.Time        `db:"payments.updated_at"`
+	ID                uuid.UUID        `sql:"primary_key" db:"payments.id"`
+	ReservationID     uuid.UUID        `db:"payments.reservation_id"`
+	Status            string           `db:"payments.status"`
+	Amount            *decimal.Decimal `db:"payments.amount"`
+	PaidAt            *time.Time       `db:"payments.paid_at"`
+	PaymentMethod     *string          `db:"payments.payment_method"`
+	CreatedAt         time.Time        `db:"payments.created_at"`
+	UpdatedAt         time.Time        `db:"payments.updated_at"`
+	Provider          *string          `db:"payments.provider"`
+	ProviderReference *string          `db:"payments.provider_reference"`
+	PromoCodeID       *uuid.UUID       `db:"payments.promo_code_id"`
+	DiscountAmount    *decimal.Decimal `db:"payments.discount_amount"`
+	QrPayload         *string          `db:"payments.qr_payload"`
 }
@@ -9,16 +9,20 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"time"
 )
 
 type Reservations struct {
-	ID         uuid.UUID `sql:"primary_key" db:"reservations.id"`
-	SeatID     uuid.UUID `db:"reservations.seat_id"`
-	SessionID  string    `db:"reservations.session_id"`
-	Status     string    `db:"reservations.status"`
-	ReservedAt time.Time `db:"reservations.reserved_at"`
-	ExpiresAt  time.Time `db:"reservations.expires_at"`
-	CreatedAt  time.Time `db:"reservations.created_at"`
-	UpdatedAt  time.Time `db:"reservations.updated_at"`
+	ID         uuid.UUID        `sql:"primary_key" db:"reservations.id"`
+	SeatID     uuid.UUID        `db:"reservations.seat_id"`
+	SessionID  string           `db:"reservations.session_id"`
+	Status     string           `db:"reservations.status"`
+	ReservedAt time.Time        `db:"reservations.reserved_at"`
+	ExpiresAt  time.Time        `db:"reservations.expires_at"`
+	CreatedAt  time.Time        `db:"reservations.created_at"`
+	UpdatedAt  time.Time        `db:"reservations.updated_at"`
+	GroupID    *uuid.UUID       `db:"reservations.group_id"`
+	Price      *decimal.Decimal `db:"reservations.price"`
+	Currency   *string          `db:"reservations.currency"`
 }
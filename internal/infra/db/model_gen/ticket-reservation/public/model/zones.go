@@ -9,14 +9,17 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"time"
 )
 
 type Zones struct {
-	ID          uuid.UUID `sql:"primary_key" db:"zones.id"`
-	ConcertID   uuid.UUID `db:"zones.concert_id"`
-	Name        string    `db:"zones.name"`
-	Description *string   `db:"zones.description"`
-	CreatedAt   time.Time `db:"zones.created_at"`
-	UpdatedAt   time.Time `db:"zones.updated_at"`
+	ID          uuid.UUID        `sql:"primary_key" db:"zones.id"`
+	ConcertID   uuid.UUID        `db:"zones.concert_id"`
+	Name        string           `db:"zones.name"`
+	Description *string          `db:"zones.description"`
+	CreatedAt   time.Time        `db:"zones.created_at"`
+	UpdatedAt   time.Time        `db:"zones.updated_at"`
+	Price       *decimal.Decimal `db:"zones.price"`
+	Currency    string           `db:"zones.currency"`
 }
@@ -17,12 +17,14 @@ type concertsTable struct {
 	postgres.Table
 
 	// Columns
-	ID        postgres.ColumnString
-	Name      postgres.ColumnString
-	Date      postgres.ColumnTimestampz
-	Venue     postgres.ColumnString
-	CreatedAt postgres.ColumnTimestampz
-	UpdatedAt postgres.ColumnTimestampz
+	ID                 postgres.ColumnString
+	Name               postgres.ColumnString
+	Date               postgres.ColumnTimestampz
+	Venue              postgres.ColumnString
+	CreatedAt          postgres.ColumnTimestampz
+	UpdatedAt          postgres.ColumnTimestampz
+	OrganizerID        postgres.ColumnString
+	WaitingRoomEnabled postgres.ColumnBool
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -64,27 +66,31 @@ func newConcertsTable(schemaName, tableName, alias string) *ConcertsTable {
 
 func newConcertsTableImpl(schemaName, tableName, alias string) concertsTable {
 	var (
-		IDColumn        = postgres.StringColumn("id")
-		NameColumn      = postgres.StringColumn("name")
-		DateColumn      = postgres.TimestampzColumn("date")
-		VenueColumn     = postgres.StringColumn("venue")
-		CreatedAtColumn = postgres.TimestampzColumn("created_at")
-		UpdatedAtColumn = postgres.TimestampzColumn("updated_at")
-		allColumns      = postgres.ColumnList{IDColumn, NameColumn, DateColumn, VenueColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns  = postgres.ColumnList{NameColumn, DateColumn, VenueColumn, CreatedAtColumn, UpdatedAtColumn}
-		defaultColumns  = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn}
+		IDColumn                 = postgres.StringColumn("id")
+		NameColumn               = postgres.StringColumn("name")
+		DateColumn               = postgres.TimestampzColumn("date")
+		VenueColumn              = postgres.StringColumn("venue")
+		CreatedAtColumn          = postgres.TimestampzColumn("created_at")
+		UpdatedAtColumn          = postgres.TimestampzColumn("updated_at")
+		OrganizerIDColumn        = postgres.StringColumn("organizer_id")
+		WaitingRoomEnabledColumn = postgres.BoolColumn("waiting_room_enabled")
+		allColumns               = postgres.ColumnList{IDColumn, NameColumn, DateColumn, VenueColumn, CreatedAtColumn, UpdatedAtColumn, OrganizerIDColumn, WaitingRoomEnabledColumn}
+		mutableColumns           = postgres.ColumnList{NameColumn, DateColumn, VenueColumn, CreatedAtColumn, UpdatedAtColumn, OrganizerIDColumn, WaitingRoomEnabledColumn}
+		defaultColumns           = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, WaitingRoomEnabledColumn}
 	)
 
 	return concertsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:        IDColumn,
-		Name:      NameColumn,
-		Date:      DateColumn,
-		Venue:     VenueColumn,
-		CreatedAt: CreatedAtColumn,
-		UpdatedAt: UpdatedAtColumn,
+		ID:                 IDColumn,
+		Name:               NameColumn,
+		Date:               DateColumn,
+		Venue:              VenueColumn,
+		CreatedAt:          CreatedAtColumn,
+		UpdatedAt:          UpdatedAtColumn,
+		OrganizerID:        OrganizerIDColumn,
+		WaitingRoomEnabled: WaitingRoomEnabledColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -17,14 +17,19 @@ type paymentsTable struct {
 	postgres.Table
 
 	// Columns
-	ID            postgres.ColumnString
-	ReservationID postgres.ColumnString
-	Status        postgres.ColumnString
-	Amount        postgres.ColumnFloat
-	PaidAt        postgres.ColumnTimestampz
-	PaymentMethod postgres.ColumnString
-	CreatedAt     postgres.ColumnTimestampz
-	UpdatedAt     postgres.ColumnTimestampz
+	ID                postgres.ColumnString
+	ReservationID     postgres.ColumnString
+	Status            postgres.ColumnString
+	Amount            postgres.ColumnFloat
+	PaidAt            postgres.ColumnTimestampz
+	PaymentMethod     postgres.ColumnString
+	CreatedAt         postgres.ColumnTimestampz
+	UpdatedAt         postgres.ColumnTimestampz
+	Provider          postgres.ColumnString
+	ProviderReference postgres.ColumnString
+	PromoCodeID       postgres.ColumnString
+	DiscountAmount    postgres.ColumnFloat
+	QrPayload         postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -66,31 +71,41 @@ func newPaymentsTable(schemaName, tableName, alias string) *PaymentsTable {
 
 func newPaymentsTableImpl(schemaName, tableName, alias string) paymentsTable {
 	var (
-		IDColumn            = postgres.StringColumn("id")
-		ReservationIDColumn = postgres.StringColumn("reservation_id")
-		StatusColumn        = postgres.StringColumn("status")
-		AmountColumn        = postgres.FloatColumn("amount")
-		PaidAtColumn        = postgres.TimestampzColumn("paid_at")
-		PaymentMethodColumn = postgres.StringColumn("payment_method")
-		CreatedAtColumn     = postgres.TimestampzColumn("created_at")
-		UpdatedAtColumn     = postgres.TimestampzColumn("updated_at")
-		allColumns          = postgres.ColumnList{IDColumn, ReservationIDColumn, StatusColumn, AmountColumn, PaidAtColumn, PaymentMethodColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns      = postgres.ColumnList{ReservationIDColumn, StatusColumn, AmountColumn, PaidAtColumn, PaymentMethodColumn, CreatedAtColumn, UpdatedAtColumn}
-		defaultColumns      = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn}
+		IDColumn                = postgres.StringColumn("id")
+		ReservationIDColumn     = postgres.StringColumn("reservation_id")
+		StatusColumn            = postgres.StringColumn("status")
+		AmountColumn            = postgres.FloatColumn("amount")
+		PaidAtColumn            = postgres.TimestampzColumn("paid_at")
+		PaymentMethodColumn     = postgres.StringColumn("payment_method")
+		CreatedAtColumn         = postgres.TimestampzColumn("created_at")
+		UpdatedAtColumn         = postgres.TimestampzColumn("updated_at")
+		ProviderColumn          = postgres.StringColumn("provider")
+		ProviderReferenceColumn = postgres.StringColumn("provider_reference")
+		PromoCodeIDColumn       = postgres.StringColumn("promo_code_id")
+		DiscountAmountColumn    = postgres.FloatColumn("discount_amount")
+		QrPayloadColumn         = postgres.StringColumn("qr_payload")
+		allColumns              = postgres.ColumnList{IDColumn, ReservationIDColumn, StatusColumn, AmountColumn, PaidAtColumn, PaymentMethodColumn, CreatedAtColumn, UpdatedAtColumn, ProviderColumn, ProviderReferenceColumn, PromoCodeIDColumn, DiscountAmountColumn, QrPayloadColumn}
+		mutableColumns          = postgres.ColumnList{ReservationIDColumn, StatusColumn, AmountColumn, PaidAtColumn, PaymentMethodColumn, CreatedAtColumn, UpdatedAtColumn, ProviderColumn, ProviderReferenceColumn, PromoCodeIDColumn, DiscountAmountColumn, QrPayloadColumn}
+		defaultColumns          = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn}
 	)
 
 	return paymentsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:            IDColumn,
-		ReservationID: ReservationIDColumn,
-		Status:        StatusColumn,
-		Amount:        AmountColumn,
-		PaidAt:        PaidAtColumn,
-		PaymentMethod: PaymentMethodColumn,
-		CreatedAt:     CreatedAtColumn,
-		UpdatedAt:     UpdatedAtColumn,
+		ID:                IDColumn,
+		ReservationID:     ReservationIDColumn,
+		Status:            StatusColumn,
+		Amount:            AmountColumn,
+		PaidAt:            PaidAtColumn,
+		PaymentMethod:     PaymentMethodColumn,
+		CreatedAt:         CreatedAtColumn,
+		UpdatedAt:         UpdatedAtColumn,
+		Provider:          ProviderColumn,
+		ProviderReference: ProviderReferenceColumn,
+		PromoCodeID:       PromoCodeIDColumn,
+		DiscountAmount:    DiscountAmountColumn,
+		QrPayload:         QrPayloadColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -25,6 +25,9 @@ type reservationsTable struct {
 	ExpiresAt  postgres.ColumnTimestampz
 	CreatedAt  postgres.ColumnTimestampz
 	UpdatedAt  postgres.ColumnTimestampz
+	GroupID    postgres.ColumnString
+	Price      postgres.ColumnFloat
+	Currency   postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -74,8 +77,11 @@ func newReservationsTableImpl(schemaName, tableName, alias string) reservationsT
 		ExpiresAtColumn  = postgres.TimestampzColumn("expires_at")
 		CreatedAtColumn  = postgres.TimestampzColumn("created_at")
 		UpdatedAtColumn  = postgres.TimestampzColumn("updated_at")
-		allColumns       = postgres.ColumnList{IDColumn, SeatIDColumn, SessionIDColumn, StatusColumn, ReservedAtColumn, ExpiresAtColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns   = postgres.ColumnList{SeatIDColumn, SessionIDColumn, StatusColumn, ReservedAtColumn, ExpiresAtColumn, CreatedAtColumn, UpdatedAtColumn}
+		GroupIDColumn    = postgres.StringColumn("group_id")
+		PriceColumn      = postgres.FloatColumn("price")
+		CurrencyColumn   = postgres.StringColumn("currency")
+		allColumns       = postgres.ColumnList{IDColumn, SeatIDColumn, SessionIDColumn, StatusColumn, ReservedAtColumn, ExpiresAtColumn, CreatedAtColumn, UpdatedAtColumn, GroupIDColumn, PriceColumn, CurrencyColumn}
+		mutableColumns   = postgres.ColumnList{SeatIDColumn, SessionIDColumn, StatusColumn, ReservedAtColumn, ExpiresAtColumn, CreatedAtColumn, UpdatedAtColumn, GroupIDColumn, PriceColumn, CurrencyColumn}
 		defaultColumns   = postgres.ColumnList{IDColumn, ReservedAtColumn, CreatedAtColumn, UpdatedAtColumn}
 	)
 
@@ -91,6 +97,9 @@ func newReservationsTableImpl(schemaName, tableName, alias string) reservationsT
 		ExpiresAt:  ExpiresAtColumn,
 		CreatedAt:  CreatedAtColumn,
 		UpdatedAt:  UpdatedAtColumn,
+		GroupID:    GroupIDColumn,
+		Price:      PriceColumn,
+		Currency:   CurrencyColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -25,6 +25,8 @@ type seatsTable struct {
 	LockedBySessionID postgres.ColumnString
 	CreatedAt         postgres.ColumnTimestampz
 	UpdatedAt         postgres.ColumnTimestampz
+	Price             postgres.ColumnFloat
+	Position          postgres.ColumnInteger
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -74,8 +76,10 @@ func newSeatsTableImpl(schemaName, tableName, alias string) seatsTable {
 		LockedBySessionIDColumn = postgres.StringColumn("locked_by_session_id")
 		CreatedAtColumn         = postgres.TimestampzColumn("created_at")
 		UpdatedAtColumn         = postgres.TimestampzColumn("updated_at")
-		allColumns              = postgres.ColumnList{IDColumn, ZoneIDColumn, SeatNumberColumn, StatusColumn, LockedUntilColumn, LockedBySessionIDColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns          = postgres.ColumnList{ZoneIDColumn, SeatNumberColumn, StatusColumn, LockedUntilColumn, LockedBySessionIDColumn, CreatedAtColumn, UpdatedAtColumn}
+		PriceColumn             = postgres.FloatColumn("price")
+		PositionColumn          = postgres.IntegerColumn("position")
+		allColumns              = postgres.ColumnList{IDColumn, ZoneIDColumn, SeatNumberColumn, StatusColumn, LockedUntilColumn, LockedBySessionIDColumn, CreatedAtColumn, UpdatedAtColumn, PriceColumn, PositionColumn}
+		mutableColumns          = postgres.ColumnList{ZoneIDColumn, SeatNumberColumn, StatusColumn, LockedUntilColumn, LockedBySessionIDColumn, CreatedAtColumn, UpdatedAtColumn, PriceColumn, PositionColumn}
 		defaultColumns          = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn}
 	)
 
@@ -91,6 +95,8 @@ func newSeatsTableImpl(schemaName, tableName, alias string) seatsTable {
 		LockedBySessionID: LockedBySessionIDColumn,
 		CreatedAt:         CreatedAtColumn,
 		UpdatedAt:         UpdatedAtColumn,
+		Price:             PriceColumn,
+		Position:          PositionColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
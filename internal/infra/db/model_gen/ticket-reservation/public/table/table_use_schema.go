@@ -10,10 +10,24 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	APIKeys = APIKeys.FromSchema(schema)
 	Concerts = Concerts.FromSchema(schema)
+	PaymentLineItems = PaymentLineItems.FromSchema(schema)
+	PaymentWebhookEvents = PaymentWebhookEvents.FromSchema(schema)
 	Payments = Payments.FromSchema(schema)
+	PromoCodeRedemptions = PromoCodeRedemptions.FromSchema(schema)
+	PromoCodes = PromoCodes.FromSchema(schema)
+	ReceiptNumberSequences = ReceiptNumberSequences.FromSchema(schema)
+	Receipts = Receipts.FromSchema(schema)
+	Refunds = Refunds.FromSchema(schema)
 	Reservations = Reservations.FromSchema(schema)
 	SchemaMigrations = SchemaMigrations.FromSchema(schema)
 	Seats = Seats.FromSchema(schema)
+	TicketCheckins = TicketCheckins.FromSchema(schema)
+	TicketScans = TicketScans.FromSchema(schema)
+	Tickets = Tickets.FromSchema(schema)
+	Users = Users.FromSchema(schema)
+	WebhookDeliveries = WebhookDeliveries.FromSchema(schema)
+	WebhookSubscriptions = WebhookSubscriptions.FromSchema(schema)
 	Zones = Zones.FromSchema(schema)
 }
@@ -23,6 +23,8 @@ type zonesTable struct {
 	Description postgres.ColumnString
 	CreatedAt   postgres.ColumnTimestampz
 	UpdatedAt   postgres.ColumnTimestampz
+	Price       postgres.ColumnFloat
+	Currency    postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -70,9 +72,11 @@ func newZonesTableImpl(schemaName, tableName, alias string) zonesTable {
 		DescriptionColumn = postgres.StringColumn("description")
 		CreatedAtColumn   = postgres.TimestampzColumn("created_at")
 		UpdatedAtColumn   = postgres.TimestampzColumn("updated_at")
-		allColumns        = postgres.ColumnList{IDColumn, ConcertIDColumn, NameColumn, DescriptionColumn, CreatedAtColumn, UpdatedAtColumn}
-		mutableColumns    = postgres.ColumnList{ConcertIDColumn, NameColumn, DescriptionColumn, CreatedAtColumn, UpdatedAtColumn}
-		defaultColumns    = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn}
+		PriceColumn       = postgres.FloatColumn("price")
+		CurrencyColumn    = postgres.StringColumn("currency")
+		allColumns        = postgres.ColumnList{IDColumn, ConcertIDColumn, NameColumn, DescriptionColumn, CreatedAtColumn, UpdatedAtColumn, PriceColumn, CurrencyColumn}
+		mutableColumns    = postgres.ColumnList{ConcertIDColumn, NameColumn, DescriptionColumn, CreatedAtColumn, UpdatedAtColumn, PriceColumn, CurrencyColumn}
+		defaultColumns    = postgres.ColumnList{IDColumn, CreatedAtColumn, UpdatedAtColumn, CurrencyColumn}
 	)
 
 	return zonesTable{
@@ -85,6 +89,8 @@ func newZonesTableImpl(schemaName, tableName, alias string) zonesTable {
 		Description: DescriptionColumn,
 		CreatedAt:   CreatedAtColumn,
 		UpdatedAt:   UpdatedAtColumn,
+		Price:       PriceColumn,
+		Currency:    CurrencyColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
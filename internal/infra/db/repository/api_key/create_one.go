@@ -0,0 +1,42 @@
+package apikeyrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *apiKeyRepositoryImpl) CreateOne(ctx context.Context, input *entity.APIKey) (apiKey *entity.APIKey, err error) {
+	const errLocation = "[repository api_key/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	apiKeysTable := table.APIKeys
+	// SQL statement
+	stmt := apiKeysTable.INSERT(
+		apiKeysTable.AllColumns.Except(apiKeysTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.APIKeys{
+		Name:       input.Name,
+		Prefix:     input.Prefix,
+		KeyHash:    input.KeyHash,
+		Scopes:     formatScopes(input.Scopes),
+		ExpiresAt:  input.ExpiresAt,
+		LastUsedAt: input.LastUsedAt,
+		RevokedAt:  input.RevokedAt,
+	}).RETURNING(apiKeysTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model APIKey
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating api key", err.Error()))
+	}
+
+	apiKey = model.ToEntity()
+	if apiKey == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert api key model to entity", nil)
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,125 @@
+package apikeyrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var apiKeyColumns = []string{
+	"api_keys.id", "api_keys.name", "api_keys.prefix", "api_keys.key_hash", "api_keys.scopes",
+	"api_keys.expires_at", "api_keys.last_used_at", "api_keys.revoked_at", "api_keys.created_at", "api_keys.updated_at",
+}
+
+const apiKeyReturningColumns = `api_keys\.id AS "api_keys\.id", api_keys\.name AS "api_keys\.name", api_keys\.prefix AS "api_keys\.prefix", api_keys\.key_hash AS "api_keys\.key_hash", api_keys\.scopes AS "api_keys\.scopes", api_keys\.expires_at AS "api_keys\.expires_at", api_keys\.last_used_at AS "api_keys\.last_used_at", api_keys\.revoked_at AS "api_keys\.revoked_at", api_keys\.created_at AS "api_keys\.created_at", api_keys\.updated_at AS "api_keys\.updated_at"`
+
+func TestAPIKeyRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testExpiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `INSERT INTO public\.api_keys \(name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING ` + apiKeyReturningColumns
+
+	input := &entity.APIKey{
+		Name:      "Partner",
+		Prefix:    "a1b2c3d4e5f6",
+		KeyHash:   "hash",
+		Scopes:    []entity.APIKeyScope{entity.APIKeyScopeConcertsRead, entity.APIKeyScopeReservationsWrite},
+		ExpiresAt: &testExpiresAt,
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedAPIKey *entity.APIKey
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "successful creation",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).AddRow(
+					testID, "Partner", "a1b2c3d4e5f6", "hash", "concerts:read reservations:write",
+					testExpiresAt, nil, nil, testCreatedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("Partner", "a1b2c3d4e5f6", "hash", "concerts:read reservations:write", &testExpiresAt, nil, nil).
+					WillReturnRows(rows)
+			},
+			expectedAPIKey: &entity.APIKey{
+				ID:        testID,
+				Name:      "Partner",
+				Prefix:    "a1b2c3d4e5f6",
+				KeyHash:   "hash",
+				Scopes:    []entity.APIKeyScope{entity.APIKeyScopeConcertsRead, entity.APIKeyScopeReservationsWrite},
+				ExpiresAt: &testExpiresAt,
+				CreatedAt: testCreatedAt,
+				UpdatedAt: testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid stored scope",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).AddRow(
+					testID, "Partner", "a1b2c3d4e5f6", "hash", "concerts:write",
+					testExpiresAt, nil, nil, testCreatedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WillReturnRows(rows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			apiKey, err := h.Repository.CreateOne(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository api_key/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, apiKey)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedAPIKey, apiKey)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
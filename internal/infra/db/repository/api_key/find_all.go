@@ -0,0 +1,35 @@
+package apikeyrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *apiKeyRepositoryImpl) FindAll(ctx context.Context) (apiKeys *entity.APIKeys, err error) {
+	const errLocation = "[repository api_key/find_all FindAll] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	apiKeysTable := table.APIKeys
+	// SQL statement
+	stmt := postgres.SELECT(
+		apiKeysTable.AllColumns,
+	).FROM(
+		apiKeysTable,
+	).ORDER_BY(
+		apiKeysTable.CreatedAt.DESC(),
+	)
+
+	query, args := stmt.Sql()
+
+	var models APIKeys
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting api keys", err.Error()))
+	}
+
+	apiKeys = models.ToEntities()
+	return apiKeys, nil
+}
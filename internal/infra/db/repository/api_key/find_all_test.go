@@ -0,0 +1,107 @@
+package apikeyrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAPIKeyRepositoryImpl_FindAll(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testRevokedAt := time.Date(2025, 1, 3, 10, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + apiKeyReturningColumns + ` FROM public\.api_keys ORDER BY api_keys\.created_at DESC`
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedAPIKeys *entity.APIKeys
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).
+					AddRow(testID1, "Partner", "a1b2c3d4e5f6", "hash1", "concerts:read", nil, nil, nil, testCreatedAt, testCreatedAt).
+					AddRow(testID2, "Reseller", "f6e5d4c3b2a1", "hash2", "reservations:write admin", nil, nil, testRevokedAt, testCreatedAt, testRevokedAt)
+				mock.ExpectQuery(expectedQuery).
+					WillReturnRows(rows)
+			},
+			expectedAPIKeys: &entity.APIKeys{
+				{ID: testID1, Prefix: "a1b2c3d4e5f6", Scopes: []entity.APIKeyScope{entity.APIKeyScopeConcertsRead}},
+				{ID: testID2, Prefix: "f6e5d4c3b2a1", Scopes: []entity.APIKeyScope{entity.APIKeyScopeReservationsWrite, entity.APIKeyScopeAdmin}, RevokedAt: &testRevokedAt},
+			},
+			expectedError: false,
+		},
+		{
+			name: "no api keys",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnRows(sqlmock.NewRows(apiKeyColumns))
+			},
+			expectedAPIKeys: &entity.APIKeys{},
+			expectedError:   false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedAPIKeys: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			apiKeys, err := h.Repository.FindAll(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository api_key/find_all FindAll]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, apiKeys)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, apiKeys)
+				require.Len(t, *apiKeys, len(*tt.expectedAPIKeys))
+				for i, expected := range *tt.expectedAPIKeys {
+					assert.Equal(t, expected.ID, (*apiKeys)[i].ID)
+					assert.Equal(t, expected.Prefix, (*apiKeys)[i].Prefix)
+					assert.Equal(t, expected.Scopes, (*apiKeys)[i].Scopes)
+					assert.Equal(t, expected.RevokedAt, (*apiKeys)[i].RevokedAt)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package apikeyrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *apiKeyRepositoryImpl) FindOne(ctx context.Context, id uuid.UUID) (apiKey *entity.APIKey, err error) {
+	const errLocation = "[repository api_key/find_one FindOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	apiKeysTable := table.APIKeys
+	// SQL statement
+	stmt := postgres.SELECT(
+		apiKeysTable.AllColumns,
+	).FROM(
+		apiKeysTable,
+	).WHERE(
+		apiKeysTable.ID.EQ(postgres.UUID(id)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model APIKey
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("api key not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting api key", err.Error()))
+	}
+
+	apiKey = model.ToEntity()
+	if apiKey == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert api key model to entity", nil)
+	}
+	return apiKey, nil
+}
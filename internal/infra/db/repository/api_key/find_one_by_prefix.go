@@ -0,0 +1,43 @@
+package apikeyrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *apiKeyRepositoryImpl) FindOneByPrefix(ctx context.Context, prefix string) (apiKey *entity.APIKey, err error) {
+	const errLocation = "[repository api_key/find_one_by_prefix FindOneByPrefix] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	apiKeysTable := table.APIKeys
+	// SQL statement
+	stmt := postgres.SELECT(
+		apiKeysTable.AllColumns,
+	).FROM(
+		apiKeysTable,
+	).WHERE(
+		apiKeysTable.Prefix.EQ(postgres.String(prefix)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model APIKey
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("api key not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting api key", err.Error()))
+	}
+
+	apiKey = model.ToEntity()
+	if apiKey == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert api key model to entity", nil)
+	}
+	return apiKey, nil
+}
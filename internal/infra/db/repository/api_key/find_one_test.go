@@ -0,0 +1,108 @@
+package apikeyrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAPIKeyRepositoryImpl_FindOne(t *testing.T) {
+	testID := uuid.New()
+	testLastUsedAt := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + apiKeyReturningColumns + ` FROM public\.api_keys WHERE api_keys\.id = \$1`
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedAPIKey *entity.APIKey
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).AddRow(
+					testID, "Partner", "a1b2c3d4e5f6", "hash", "admin",
+					nil, testLastUsedAt, nil, testCreatedAt, testLastUsedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnRows(rows)
+			},
+			expectedAPIKey: &entity.APIKey{
+				ID:         testID,
+				Name:       "Partner",
+				Prefix:     "a1b2c3d4e5f6",
+				KeyHash:    "hash",
+				Scopes:     []entity.APIKeyScope{entity.APIKeyScopeAdmin},
+				LastUsedAt: &testLastUsedAt,
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testLastUsedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "api key not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			apiKey, err := h.Repository.FindOne(context.Background(), testID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository api_key/find_one FindOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, apiKey)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedAPIKey, apiKey)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
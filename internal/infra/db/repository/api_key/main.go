@@ -0,0 +1,19 @@
+package apikeyrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type apiKeyRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewAPIKeyRepository(execer db.SqlExecer) repository.APIKeyRepository {
+	return &apiKeyRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *apiKeyRepositoryImpl) WithTx(tx db.SqlExecer) repository.APIKeyRepository {
+	return &apiKeyRepositoryImpl{execer: tx}
+}
@@ -0,0 +1,68 @@
+package apikeyrepo
+
+import (
+	"strings"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type APIKey struct {
+	model.APIKeys
+}
+
+func (k *APIKey) ToEntity() *entity.APIKey {
+	scopes, err := parseScopes(k.Scopes)
+	if err != nil {
+		return nil
+	}
+	return &entity.APIKey{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		KeyHash:    k.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+}
+
+type APIKeys []APIKey
+
+func (ks APIKeys) ToEntities() *entity.APIKeys {
+	apiKeys := make(entity.APIKeys, 0, len(ks))
+	for _, k := range ks {
+		apiKey := k.ToEntity()
+		if apiKey == nil {
+			continue
+		}
+		apiKeys = append(apiKeys, pointer.GetValue(apiKey))
+	}
+	return pointer.ToPointer(apiKeys)
+}
+
+// formatScopes returns the space-separated form the scopes are stored in.
+func formatScopes(scopes []entity.APIKeyScope) string {
+	values := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		values = append(values, scope.String())
+	}
+	return strings.Join(values, " ")
+}
+
+func parseScopes(value string) ([]entity.APIKeyScope, error) {
+	fields := strings.Fields(value)
+	scopes := make([]entity.APIKeyScope, 0, len(fields))
+	for _, field := range fields {
+		scope, err := new(entity.APIKeyScope).Parse(field)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
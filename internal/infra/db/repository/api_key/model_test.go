@@ -0,0 +1,93 @@
+package apikeyrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	apikeyrepo "ticket-reservation/internal/infra/db/repository/api_key"
+)
+
+func TestAPIKey_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testExpiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    apikeyrepo.APIKey
+		expected *entity.APIKey
+	}{
+		{
+			name: "valid api key",
+			input: apikeyrepo.APIKey{
+				APIKeys: model.APIKeys{
+					ID:        testID,
+					Name:      "Partner",
+					Prefix:    "a1b2c3d4e5f6",
+					KeyHash:   "hash",
+					Scopes:    "concerts:read  reservations:write",
+					ExpiresAt: &testExpiresAt,
+					CreatedAt: testCreatedAt,
+					UpdatedAt: testCreatedAt,
+				},
+			},
+			expected: &entity.APIKey{
+				ID:        testID,
+				Name:      "Partner",
+				Prefix:    "a1b2c3d4e5f6",
+				KeyHash:   "hash",
+				Scopes:    []entity.APIKeyScope{entity.APIKeyScopeConcertsRead, entity.APIKeyScopeReservationsWrite},
+				ExpiresAt: &testExpiresAt,
+				CreatedAt: testCreatedAt,
+				UpdatedAt: testCreatedAt,
+			},
+		},
+		{
+			name: "invalid scope returns nil",
+			input: apikeyrepo.APIKey{
+				APIKeys: model.APIKeys{
+					ID:        testID,
+					Name:      "Partner",
+					Prefix:    "a1b2c3d4e5f6",
+					KeyHash:   "hash",
+					Scopes:    "concerts:read concerts:write",
+					CreatedAt: testCreatedAt,
+					UpdatedAt: testCreatedAt,
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			result := tt.input.ToEntity()
+
+			// Assert
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAPIKeys_ToEntities(t *testing.T) {
+	testID := uuid.New()
+
+	models := apikeyrepo.APIKeys{
+		{APIKeys: model.APIKeys{ID: testID, Scopes: "admin"}},
+		{APIKeys: model.APIKeys{ID: uuid.New(), Scopes: "superuser"}},
+	}
+
+	// Execute
+	result := models.ToEntities()
+
+	// Assert
+	assert.Equal(t, &entity.APIKeys{
+		{ID: testID, Scopes: []entity.APIKeyScope{entity.APIKeyScopeAdmin}},
+	}, result)
+}
@@ -0,0 +1,69 @@
+package apikeyrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *apiKeyRepositoryImpl) UpdateOne(ctx context.Context, input repository.UpdateAPIKeyInput) (apiKey *entity.APIKey, err error) {
+	const errLocation = "[repository api_key/update_one UpdateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	apiKeysTable := table.APIKeys
+
+	var updateModel APIKey
+	columns := make(postgres.ColumnList, 0)
+
+	// build the update model
+	if input.Prefix != nil {
+		updateModel.Prefix = *input.Prefix
+		columns = append(columns, apiKeysTable.Prefix)
+	}
+	if input.KeyHash != nil {
+		updateModel.KeyHash = *input.KeyHash
+		columns = append(columns, apiKeysTable.KeyHash)
+	}
+	if input.LastUsedAt != nil {
+		updateModel.LastUsedAt = input.LastUsedAt
+		columns = append(columns, apiKeysTable.LastUsedAt)
+	}
+	if input.RevokedAt != nil {
+		updateModel.RevokedAt = input.RevokedAt
+		columns = append(columns, apiKeysTable.RevokedAt)
+	}
+	if len(columns) == 0 {
+		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+	}
+
+	// SQL statement
+	stmt := apiKeysTable.
+		UPDATE(columns).
+		MODEL(updateModel).
+		WHERE(apiKeysTable.ID.EQ(postgres.UUID(input.ID))).
+		RETURNING(apiKeysTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model APIKey
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("api key not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating api key", err.Error()))
+	}
+
+	apiKey = model.ToEntity()
+	if apiKey == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert api key model to entity", nil)
+	}
+
+	return
+}
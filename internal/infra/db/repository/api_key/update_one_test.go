@@ -0,0 +1,159 @@
+package apikeyrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestAPIKeyRepositoryImpl_UpdateOne(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          repository.UpdateAPIKeyInput
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedAPIKey *entity.APIKey
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "successful rotation",
+			input: repository.UpdateAPIKeyInput{
+				ID:      testID,
+				Prefix:  pointer.ToPointer("f6e5d4c3b2a1"),
+				KeyHash: pointer.ToPointer("new_hash"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).AddRow(
+					testID, "Partner", "f6e5d4c3b2a1", "new_hash", "concerts:read",
+					nil, nil, nil, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.api_keys SET \(prefix, key_hash\) = \(\$1, \$2\) WHERE api_keys\.id = \$3 RETURNING `+apiKeyReturningColumns).
+					WithArgs("f6e5d4c3b2a1", "new_hash", testID).
+					WillReturnRows(rows)
+			},
+			expectedAPIKey: &entity.APIKey{
+				ID:      testID,
+				Prefix:  "f6e5d4c3b2a1",
+				KeyHash: "new_hash",
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful revocation",
+			input: repository.UpdateAPIKeyInput{
+				ID:        testID,
+				RevokedAt: &testUpdatedAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(apiKeyColumns).AddRow(
+					testID, "Partner", "a1b2c3d4e5f6", "hash", "concerts:read",
+					nil, nil, testUpdatedAt, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.api_keys SET revoked_at = \$1 WHERE api_keys\.id = \$2 RETURNING `+apiKeyReturningColumns).
+					WithArgs(&testUpdatedAt, testID).
+					WillReturnRows(rows)
+			},
+			expectedAPIKey: &entity.APIKey{
+				ID:        testID,
+				Prefix:    "a1b2c3d4e5f6",
+				KeyHash:   "hash",
+				RevokedAt: &testUpdatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "no fields provided to update",
+			input: repository.UpdateAPIKeyInput{
+				ID: testID,
+			},
+			setupMock:      func(mock sqlmock.Sqlmock) {},
+			expectedAPIKey: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.BadRequestError{},
+		},
+		{
+			name: "api key not found",
+			input: repository.UpdateAPIKeyInput{
+				ID:         testID,
+				LastUsedAt: &testUpdatedAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.api_keys SET last_used_at = \$1 WHERE api_keys\.id = \$2 RETURNING `+apiKeyReturningColumns).
+					WithArgs(&testUpdatedAt, testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedAPIKey: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.UpdateAPIKeyInput{
+				ID:         testID,
+				LastUsedAt: &testUpdatedAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.api_keys SET last_used_at = \$1 WHERE api_keys\.id = \$2 RETURNING `+apiKeyReturningColumns).
+					WithArgs(&testUpdatedAt, testID).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedAPIKey: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			apiKey, err := h.Repository.UpdateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository api_key/update_one UpdateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, apiKey)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, apiKey)
+				assert.Equal(t, tt.expectedAPIKey.ID, apiKey.ID)
+				assert.Equal(t, tt.expectedAPIKey.Prefix, apiKey.Prefix)
+				assert.Equal(t, tt.expectedAPIKey.KeyHash, apiKey.KeyHash)
+				assert.Equal(t, tt.expectedAPIKey.RevokedAt, apiKey.RevokedAt)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
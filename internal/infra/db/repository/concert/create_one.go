@@ -18,9 +18,10 @@ func (r *concertRepositoryImpl) CreateOne(ctx context.Context, input *entity.Con
 	stmt := concertsTable.INSERT(
 		concertsTable.AllColumns.Except(concertsTable.DefaultColumns), // Exclude columns with default values
 	).MODEL(model.Concerts{
-		Name:  input.Name,
-		Date:  input.Date,
-		Venue: input.Venue,
+		Name:        input.Name,
+		Date:        input.Date,
+		Venue:       input.Venue,
+		OrganizerID: input.OrganizerID,
 	}).RETURNING(concertsTable.AllColumns)
 
 	query, args := stmt.Sql()
@@ -22,6 +22,7 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
 	updatedAt := time.Date(2025, 1, 2, 11, 0, 0, 0, time.UTC)
 	testID := uuid.New()
+	testOrganizerID := uuid.New()
 
 	tests := []struct {
 		name            string
@@ -47,8 +48,8 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 					input.Venue, createdAt, updatedAt,
 				)
 
-				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue\) VALUES \(\$1, \$2, \$3\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at"`).
-					WithArgs(input.Name, input.Date, input.Venue).
+				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`).
+					WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
 					WillReturnRows(rows)
 			},
 			expectedConcert: &entity.Concert{
@@ -77,8 +78,8 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 					input.Venue, createdAt, updatedAt,
 				)
 
-				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue\) VALUES \(\$1, \$2, \$3\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at"`).
-					WithArgs(input.Name, input.Date, input.Venue).
+				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`).
+					WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
 					WillReturnRows(rows)
 			},
 			expectedConcert: &entity.Concert{
@@ -91,6 +92,40 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "successful creation by an organizer",
+			input: &entity.Concert{
+				Name:        "Test Concert",
+				Venue:       "Test Venue",
+				Date:        testDate,
+				OrganizerID: &testOrganizerID,
+			},
+			setupMock: func(mock sqlmock.Sqlmock, input *entity.Concert) {
+				rows := sqlmock.NewRows([]string{
+					"concerts.id", "concerts.name", "concerts.date",
+					"concerts.venue", "concerts.created_at", "concerts.updated_at",
+					"concerts.organizer_id",
+				}).AddRow(
+					testID, input.Name, input.Date,
+					input.Venue, createdAt, updatedAt,
+					testOrganizerID,
+				)
+
+				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`).
+					WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
+					WillReturnRows(rows)
+			},
+			expectedConcert: &entity.Concert{
+				ID:          testID,
+				Name:        "Test Concert",
+				Venue:       "Test Venue",
+				Date:        testDate,
+				OrganizerID: &testOrganizerID,
+				CreatedAt:   createdAt,
+				UpdatedAt:   updatedAt,
+			},
+			expectedError: false,
+		},
 		{
 			name: "database constraint violation",
 			input: &entity.Concert{
@@ -99,8 +134,8 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 				Date:  testDate,
 			},
 			setupMock: func(mock sqlmock.Sqlmock, input *entity.Concert) {
-				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue\) VALUES \(\$1, \$2, \$3\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at"`).
-					WithArgs(input.Name, input.Date, input.Venue).
+				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`).
+					WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
 					WillReturnError(errors.New("pq: duplicate key value violates unique constraint"))
 			},
 			expectedConcert: nil,
@@ -115,8 +150,8 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 				Date:  testDate,
 			},
 			setupMock: func(mock sqlmock.Sqlmock, input *entity.Concert) {
-				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue\) VALUES \(\$1, \$2, \$3\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at"`).
-					WithArgs(input.Name, input.Date, input.Venue).
+				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`).
+					WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedConcert: nil,
@@ -131,8 +166,8 @@ func TestConcertRepositoryImpl_CreateOne(t *testing.T) {
 				Date:  testDate,
 			},
 			setupMock: func(mock sqlmock.Sqlmock, input *entity.Concert) {
-				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue\) VALUES \(\$1, \$2, \$3\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at"`).
-					WithArgs(input.Name, input.Date, input.Venue).
+				mock.ExpectQuery(`INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`).
+					WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
 					WillReturnError(context.DeadlineExceeded)
 			},
 			expectedConcert: nil,
@@ -206,10 +241,10 @@ func TestConcertRepositoryImpl_CreateOne_QueryValidation(t *testing.T) {
 	)
 
 	// The query should be an INSERT with RETURNING clause
-	expectedQuery := `INSERT INTO public\.concerts \(name, date, venue\) VALUES \(\$1, \$2, \$3\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at"`
+	expectedQuery := `INSERT INTO public\.concerts \(name, date, venue, organizer_id\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`
 
 	h.Mock.ExpectQuery(expectedQuery).
-		WithArgs(input.Name, input.Date, input.Venue).
+		WithArgs(input.Name, input.Date, input.Venue, input.OrganizerID).
 		WillReturnRows(rows)
 
 	ctx := context.Background()
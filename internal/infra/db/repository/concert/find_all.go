@@ -27,6 +27,9 @@ func (r *concertRepositoryImpl) FindAll(ctx context.Context, filter repository.F
 	if filter.Venue != nil && *filter.Venue != "" {
 		whereClauses = append(whereClauses, table.Concerts.Venue.LIKE(postgres.String("%"+*filter.Venue+"%")))
 	}
+	if filter.WaitingRoomEnabled != nil {
+		whereClauses = append(whereClauses, table.Concerts.WaitingRoomEnabled.EQ(postgres.Bool(*filter.WaitingRoomEnabled)))
+	}
 
 	// Get total count of concerts matching the filter
 	countStmt := postgres.SELECT(
@@ -53,7 +53,7 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 					AddRow(testID1, "Concert 1", testDate1, "Venue 1", createdAt, updatedAt).
 					AddRow(testID2, "Concert 2", testDate2, "Venue 2", createdAt, updatedAt)
 
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts`).
 					WillReturnRows(rows)
 			},
 			expectedConcerts: &entity.Concerts{
@@ -81,7 +81,7 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 					"concerts.venue", "concerts.created_at", "concerts.updated_at",
 				}).AddRow(testID1, "Concert 1", testDate1, "Test Venue", createdAt, updatedAt)
 
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE \(concerts\.venue LIKE \$1::text\)`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE \(concerts\.venue LIKE \$1::text\)`).
 					WithArgs("%Test Venue%").
 					WillReturnRows(rows)
 			},
@@ -91,6 +91,35 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 			expectedTotal: 1,
 			expectedError: false,
 		},
+		{
+			name: "successful retrieval with waiting room filter",
+			filter: repository.FindAllConcertsFilter{
+				WaitingRoomEnabled: pointer.ToPointer(true),
+			},
+			setupMock: func(mock sqlmock.Sqlmock, filter repository.FindAllConcertsFilter) {
+				// Count query with WHERE clause
+				countRows := sqlmock.NewRows([]string{"total"}).AddRow(1)
+				mock.ExpectQuery(`SELECT COUNT\(concerts\.id\) AS "total" FROM public\.concerts WHERE \(concerts\.waiting_room_enabled = \$1::boolean\)`).
+					WithArgs(true).
+					WillReturnRows(countRows)
+
+				// Main query with WHERE clause
+				rows := sqlmock.NewRows([]string{
+					"concerts.id", "concerts.name", "concerts.date",
+					"concerts.venue", "concerts.created_at", "concerts.updated_at",
+					"concerts.organizer_id", "concerts.waiting_room_enabled",
+				}).AddRow(testID1, "Concert 1", testDate1, "Venue 1", createdAt, updatedAt, nil, true)
+
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE \(concerts\.waiting_room_enabled = \$1::boolean\)`).
+					WithArgs(true).
+					WillReturnRows(rows)
+			},
+			expectedConcerts: &entity.Concerts{
+				{ID: testID1, Name: "Concert 1", Venue: "Venue 1", Date: testDate1, WaitingRoomEnabled: true, CreatedAt: createdAt, UpdatedAt: updatedAt},
+			},
+			expectedTotal: 1,
+			expectedError: false,
+		},
 		{
 			name: "successful retrieval with date range filter",
 			filter: repository.FindAllConcertsFilter{
@@ -112,7 +141,7 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 					AddRow(testID1, "Concert 1", testDate1, "Venue 1", createdAt, updatedAt).
 					AddRow(testID2, "Concert 2", testDate2, "Venue 2", createdAt, updatedAt)
 
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE \( \(concerts\.date >= \$1::timestamp with time zone\) AND \(concerts\.date <= \$2::timestamp with time zone\) \)`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE \( \(concerts\.date >= \$1::timestamp with time zone\) AND \(concerts\.date <= \$2::timestamp with time zone\) \)`).
 					WithArgs(*filter.StartDate, *filter.EndDate).
 					WillReturnRows(rows)
 			},
@@ -145,7 +174,7 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 					AddRow(testID1, "Concert 1", testDate1, "Venue 1", createdAt, updatedAt).
 					AddRow(testID2, "Concert 2", testDate2, "Venue 2", createdAt, updatedAt)
 
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts ORDER BY concerts\.name ASC LIMIT \$1 OFFSET \$2`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts ORDER BY concerts\.name ASC LIMIT \$1 OFFSET \$2`).
 					WithArgs(*filter.Limit, *filter.Offset).
 					WillReturnRows(rows)
 			},
@@ -179,7 +208,7 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 					WillReturnRows(countRows)
 
 				// Main query fails
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts`).
 					WillReturnError(errors.New("database connection failed"))
 			},
 			expectedConcerts: nil,
@@ -202,7 +231,7 @@ func TestConcertRepositoryImpl_FindAll(t *testing.T) {
 					"concerts.venue", "concerts.created_at", "concerts.updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts`).
 					WillReturnRows(rows)
 			},
 			expectedConcerts: &entity.Concerts{},
@@ -328,7 +357,7 @@ func TestConcertRepositoryImpl_FindAll_SortingOptions(t *testing.T) {
 				"concerts.venue", "concerts.created_at", "concerts.updated_at",
 			}).AddRow(testID, "Test Concert", testDate, "Test Venue", createdAt, updatedAt)
 
-			expectedQuery := `SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts ` + tt.expectedOrderBy
+			expectedQuery := `SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts ` + tt.expectedOrderBy
 			h.Mock.ExpectQuery(expectedQuery).WillReturnRows(rows)
 
 			_, _, err := h.Repository.FindAll(context.Background(), filter)
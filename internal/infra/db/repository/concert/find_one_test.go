@@ -43,7 +43,7 @@ func TestConcertRepositoryImpl_FindOne(t *testing.T) {
 					testTime, createdAt, updatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE concerts\.id = \$1`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE concerts\.id = \$1`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -61,7 +61,7 @@ func TestConcertRepositoryImpl_FindOne(t *testing.T) {
 			name:      "concert not found",
 			concertID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE concerts\.id = \$1`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE concerts\.id = \$1`).
 					WithArgs(id).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -73,7 +73,7 @@ func TestConcertRepositoryImpl_FindOne(t *testing.T) {
 			name:      "database connection error",
 			concertID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE concerts\.id = \$1`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE concerts\.id = \$1`).
 					WithArgs(id).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -85,7 +85,7 @@ func TestConcertRepositoryImpl_FindOne(t *testing.T) {
 			name:      "database timeout error",
 			concertID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE concerts\.id = \$1`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE concerts\.id = \$1`).
 					WithArgs(id).
 					WillReturnError(context.DeadlineExceeded)
 			},
@@ -97,7 +97,7 @@ func TestConcertRepositoryImpl_FindOne(t *testing.T) {
 			name:      "generic database error",
 			concertID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE concerts\.id = \$1`).
+				mock.ExpectQuery(`SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE concerts\.id = \$1`).
 					WithArgs(id).
 					WillReturnError(errors.New("database connection failed"))
 			},
@@ -166,7 +166,7 @@ func TestConcertRepositoryImpl_FindOne_QueryValidation(t *testing.T) {
 	)
 
 	// The query should include all columns and proper WHERE clause
-	expectedQuery := `SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at" FROM public\.concerts WHERE concerts\.id = \$1`
+	expectedQuery := `SELECT concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled" FROM public\.concerts WHERE concerts\.id = \$1`
 
 	h.Mock.ExpectQuery(expectedQuery).
 		WithArgs(testID).
@@ -13,12 +13,14 @@ type Concert struct {
 
 func (c *Concert) ToEntity() *entity.Concert {
 	return &entity.Concert{
-		ID:        c.ID,
-		Name:      c.Name,
-		Venue:     c.Venue,
-		Date:      c.Date,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+		ID:                 c.ID,
+		Name:               c.Name,
+		Venue:              c.Venue,
+		Date:               c.Date,
+		OrganizerID:        c.OrganizerID,
+		WaitingRoomEnabled: c.WaitingRoomEnabled,
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
 	}
 }
 
@@ -30,21 +30,23 @@ func TestConcert_ToEntity(t *testing.T) {
 			name: "successful conversion with all fields",
 			concert: concertrepo.Concert{
 				Concerts: model.Concerts{
-					ID:        testID,
-					Name:      testName,
-					Venue:     testVenue,
-					Date:      testDate,
-					CreatedAt: testCreatedAt,
-					UpdatedAt: testUpdatedAt,
+					ID:                 testID,
+					Name:               testName,
+					Venue:              testVenue,
+					Date:               testDate,
+					WaitingRoomEnabled: true,
+					CreatedAt:          testCreatedAt,
+					UpdatedAt:          testUpdatedAt,
 				},
 			},
 			expected: &entity.Concert{
-				ID:        testID,
-				Name:      testName,
-				Venue:     testVenue,
-				Date:      testDate,
-				CreatedAt: testCreatedAt,
-				UpdatedAt: testUpdatedAt,
+				ID:                 testID,
+				Name:               testName,
+				Venue:              testVenue,
+				Date:               testDate,
+				WaitingRoomEnabled: true,
+				CreatedAt:          testCreatedAt,
+				UpdatedAt:          testUpdatedAt,
 			},
 		},
 		{
@@ -0,0 +1,57 @@
+package concertrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *concertRepositoryImpl) UpdateOne(ctx context.Context, input repository.UpdateConcertInput) (concert *entity.Concert, err error) {
+	const errLocation = "[repository concert/update_one UpdateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	concertsTable := table.Concerts
+
+	var updateModel Concert
+	columns := make(postgres.ColumnList, 0)
+
+	// build the update model
+	if input.WaitingRoomEnabled != nil {
+		updateModel.WaitingRoomEnabled = *input.WaitingRoomEnabled
+		columns = append(columns, concertsTable.WaitingRoomEnabled)
+	}
+	if len(columns) == 0 {
+		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+	}
+
+	// SQL statement
+	stmt := concertsTable.
+		UPDATE(columns).
+		MODEL(updateModel).
+		WHERE(concertsTable.ID.EQ(postgres.UUID(input.ID))).
+		RETURNING(concertsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Concert
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("concert not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating concert", err.Error()))
+	}
+
+	concert = model.ToEntity()
+	if concert == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert concert model to entity", nil)
+	}
+
+	return
+}
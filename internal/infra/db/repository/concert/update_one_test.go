@@ -0,0 +1,139 @@
+package concertrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestConcertRepositoryImpl_UpdateOne(t *testing.T) {
+	testID := uuid.New()
+	testDate := time.Date(2025, 12, 25, 20, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 11, 0, 0, 0, time.UTC)
+
+	concertColumns := []string{
+		"concerts.id", "concerts.name", "concerts.date", "concerts.venue",
+		"concerts.created_at", "concerts.updated_at", "concerts.organizer_id", "concerts.waiting_room_enabled",
+	}
+	const expectedQuery = `UPDATE public\.concerts SET waiting_room_enabled = \$1 WHERE concerts\.id = \$2 RETURNING concerts\.id AS "concerts\.id", concerts\.name AS "concerts\.name", concerts\.date AS "concerts\.date", concerts\.venue AS "concerts\.venue", concerts\.created_at AS "concerts\.created_at", concerts\.updated_at AS "concerts\.updated_at", concerts\.organizer_id AS "concerts\.organizer_id", concerts\.waiting_room_enabled AS "concerts\.waiting_room_enabled"`
+
+	tests := []struct {
+		name            string
+		input           repository.UpdateConcertInput
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedConcert *entity.Concert
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful waiting room update",
+			input: repository.UpdateConcertInput{
+				ID:                 testID,
+				WaitingRoomEnabled: pointer.ToPointer(true),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(concertColumns).
+					AddRow(testID, "Test Concert", testDate, "Test Venue", createdAt, updatedAt, nil, true)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(true, testID).
+					WillReturnRows(rows)
+			},
+			expectedConcert: &entity.Concert{
+				ID:                 testID,
+				Name:               "Test Concert",
+				Venue:              "Test Venue",
+				Date:               testDate,
+				WaitingRoomEnabled: true,
+				CreatedAt:          createdAt,
+				UpdatedAt:          updatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "no fields to update",
+			input: repository.UpdateConcertInput{
+				ID: testID,
+			},
+			setupMock:       func(mock sqlmock.Sqlmock) {},
+			expectedConcert: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.BadRequestError{},
+		},
+		{
+			name: "concert not found",
+			input: repository.UpdateConcertInput{
+				ID:                 testID,
+				WaitingRoomEnabled: pointer.ToPointer(false),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(false, testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedConcert: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.UpdateConcertInput{
+				ID:                 testID,
+				WaitingRoomEnabled: pointer.ToPointer(true),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(true, testID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedConcert: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+
+			// Execute
+			result, err := h.Repository.UpdateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), "[repository concert/update_one UpdateOne]")
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedConcert.ID, result.ID)
+				assert.Equal(t, tt.expectedConcert.Name, result.Name)
+				assert.Equal(t, tt.expectedConcert.WaitingRoomEnabled, result.WaitingRoomEnabled)
+				assert.Equal(t, tt.expectedConcert.CreatedAt.UTC(), result.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedConcert.UpdatedAt.UTC(), result.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package paymentrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentRepositoryImpl) CreateOne(ctx context.Context, input *entity.Payment) (payment *entity.Payment, err error) {
+	const errLocation = "[repository payment/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	paymentsTable := table.Payments
+	// SQL statement
+	stmt := paymentsTable.INSERT(
+		paymentsTable.AllColumns.Except(paymentsTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.Payments{
+		ReservationID:     input.ReservationID,
+		Status:            input.Status.String(),
+		Amount:            input.Amount,
+		PaidAt:            input.PaidAt,
+		PaymentMethod:     input.PaymentMethod,
+		Provider:          input.Provider,
+		ProviderReference: input.ProviderReference,
+		PromoCodeID:       input.PromoCodeID,
+		DiscountAmount:    input.DiscountAmount,
+		QrPayload:         input.QRPayload,
+	}).RETURNING(paymentsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Payment
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating payment", err.Error()))
+	}
+
+	res := model.ToEntity()
+	if res == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert payment model to entity", nil)
+	}
+
+	return res, nil
+}
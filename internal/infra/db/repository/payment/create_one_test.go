@@ -0,0 +1,163 @@
+package paymentrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testAmount := decimal.RequireFromString("1500.00")
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	inputPayment := &entity.Payment{
+		ReservationID: testReservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &testAmount,
+		PaymentMethod: pointer.ToPointer("credit_card"),
+		Provider:      pointer.ToPointer("fake"),
+	}
+
+	paymentColumns := []string{
+		"payments.id", "payments.reservation_id", "payments.status", "payments.amount",
+		"payments.paid_at", "payments.payment_method", "payments.created_at", "payments.updated_at",
+		"payments.provider", "payments.provider_reference", "payments.promo_code_id", "payments.discount_amount",
+		"payments.qr_payload",
+	}
+	expectedQuery := `INSERT INTO public\.payments \(reservation_id, status, amount, paid_at, payment_method, provider, provider_reference, promo_code_id, discount_amount, qr_payload\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10\) RETURNING payments\.id AS "payments\.id", payments\.reservation_id AS "payments\.reservation_id", payments\.status AS "payments\.status", payments\.amount AS "payments\.amount", payments\.paid_at AS "payments\.paid_at", payments\.payment_method AS "payments\.payment_method", payments\.created_at AS "payments\.created_at", payments\.updated_at AS "payments\.updated_at", payments\.provider AS "payments\.provider", payments\.provider_reference AS "payments\.provider_reference", payments\.promo_code_id AS "payments\.promo_code_id", payments\.discount_amount AS "payments\.discount_amount", payments\.qr_payload AS "payments\.qr_payload"`
+
+	tests := []struct {
+		name            string
+		input           *entity.Payment
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedPayment *entity.Payment
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name:  "successful payment creation",
+			input: inputPayment,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, entity.PaymentStatusInitiated.String(), "1500.00",
+					nil, "credit_card", testCreatedAt, testUpdatedAt,
+					"fake", nil, nil, nil, nil,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testReservationID, entity.PaymentStatusInitiated.String(), testAmount, nil, "credit_card", "fake", nil, nil, nil, nil).
+					WillReturnRows(rows)
+			},
+			expectedPayment: &entity.Payment{
+				ID:            testID,
+				ReservationID: testReservationID,
+				Status:        entity.PaymentStatusInitiated,
+				Amount:        &testAmount,
+				PaymentMethod: pointer.ToPointer("credit_card"),
+				Provider:      pointer.ToPointer("fake"),
+				CreatedAt:     testCreatedAt,
+				UpdatedAt:     testUpdatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name:  "invalid status returned from database",
+			input: inputPayment,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, "invalid_status", "1500.00",
+					nil, "credit_card", testCreatedAt, testUpdatedAt,
+					"fake", nil, nil, nil, nil,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testReservationID, entity.PaymentStatusInitiated.String(), testAmount, nil, "credit_card", "fake", nil, nil, nil, nil).
+					WillReturnRows(rows)
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.InternalServerError{},
+		},
+		{
+			name:  "database connection error",
+			input: inputPayment,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testReservationID, entity.PaymentStatusInitiated.String(), testAmount, nil, "credit_card", "fake", nil, nil, nil, nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+		{
+			name:  "constraint violation error",
+			input: inputPayment,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testReservationID, entity.PaymentStatusInitiated.String(), testAmount, nil, "credit_card", "fake", nil, nil, nil, nil).
+					WillReturnError(errors.New("duplicate key value violates unique constraint"))
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			payment, err := h.Repository.CreateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, payment)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, payment)
+				assert.Equal(t, tt.expectedPayment.ID, payment.ID)
+				assert.Equal(t, tt.expectedPayment.ReservationID, payment.ReservationID)
+				assert.Equal(t, tt.expectedPayment.Status, payment.Status)
+				assert.True(t, tt.expectedPayment.Amount.Equal(*payment.Amount))
+				assert.Equal(t, tt.expectedPayment.PaidAt, payment.PaidAt)
+				assert.Equal(t, tt.expectedPayment.PaymentMethod, payment.PaymentMethod)
+				assert.Equal(t, tt.expectedPayment.Provider, payment.Provider)
+				assert.Equal(t, tt.expectedPayment.ProviderReference, payment.ProviderReference)
+				assert.Equal(t, tt.expectedPayment.CreatedAt.UTC(), payment.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedPayment.UpdatedAt.UTC(), payment.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package paymentrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentRepositoryImpl) FindAll(ctx context.Context, filter repository.FindAllPaymentsFilter) (payments *entity.Payments, err error) {
+	const errLocation = "[repository payment/find_all FindAll] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	paymentsTable := table.Payments
+
+	// Build WHERE conditions for filtering
+	whereClauses := []postgres.BoolExpression{}
+	if filter.ReservationID != nil {
+		whereClauses = append(whereClauses, paymentsTable.ReservationID.EQ(postgres.UUID(*filter.ReservationID)))
+	}
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, paymentsTable.Status.EQ(postgres.String(filter.Status.String())))
+	}
+
+	// SQL statement
+	stmt := postgres.SELECT(
+		paymentsTable.AllColumns,
+	).FROM(paymentsTable)
+
+	if len(whereClauses) > 0 {
+		stmt = stmt.WHERE(postgres.AND(whereClauses...))
+	}
+	stmt = stmt.ORDER_BY(paymentsTable.CreatedAt.ASC())
+
+	query, args := stmt.Sql()
+
+	var models Payments
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting payments", err.Error()))
+	}
+
+	payments = models.ToEntities()
+	return payments, nil
+}
@@ -0,0 +1,140 @@
+package paymentrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentRepositoryImpl_FindAll(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testReservationID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	paymentColumns := []string{
+		"payments.id", "payments.reservation_id", "payments.status", "payments.amount",
+		"payments.paid_at", "payments.payment_method", "payments.created_at", "payments.updated_at",
+		"payments.provider", "payments.provider_reference", "payments.promo_code_id", "payments.discount_amount",
+		"payments.qr_payload",
+	}
+	selectColumns := `SELECT payments\.id AS "payments\.id", payments\.reservation_id AS "payments\.reservation_id", payments\.status AS "payments\.status", payments\.amount AS "payments\.amount", payments\.paid_at AS "payments\.paid_at", payments\.payment_method AS "payments\.payment_method", payments\.created_at AS "payments\.created_at", payments\.updated_at AS "payments\.updated_at", payments\.provider AS "payments\.provider", payments\.provider_reference AS "payments\.provider_reference", payments\.promo_code_id AS "payments\.promo_code_id", payments\.discount_amount AS "payments\.discount_amount", payments\.qr_payload AS "payments\.qr_payload" FROM public\.payments`
+
+	tests := []struct {
+		name             string
+		filter           repository.FindAllPaymentsFilter
+		setupMock        func(mock sqlmock.Sqlmock)
+		expectedPayments *entity.Payments
+		expectedError    bool
+		errorType        error
+	}{
+		{
+			name:   "successful retrieval without filters",
+			filter: repository.FindAllPaymentsFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).
+					AddRow(testID1, testReservationID, entity.PaymentStatusFailed.String(), "1500.00", nil, "credit_card", testCreatedAt, testUpdatedAt, "fake", nil, nil, nil, nil).
+					AddRow(testID2, testReservationID, entity.PaymentStatusInitiated.String(), "1500.00", nil, "credit_card", testCreatedAt, testUpdatedAt, "fake", nil, nil, nil, nil)
+				mock.ExpectQuery(selectColumns + ` ORDER BY payments\.created_at ASC`).
+					WillReturnRows(rows)
+			},
+			expectedPayments: &entity.Payments{
+				{ID: testID1, ReservationID: testReservationID, Status: entity.PaymentStatusFailed},
+				{ID: testID2, ReservationID: testReservationID, Status: entity.PaymentStatusInitiated},
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful retrieval with reservation and status filters",
+			filter: repository.FindAllPaymentsFilter{
+				ReservationID: &testReservationID,
+				Status:        pointer.ToPointer(entity.PaymentStatusInitiated),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).
+					AddRow(testID2, testReservationID, entity.PaymentStatusInitiated.String(), "1500.00", nil, "credit_card", testCreatedAt, testUpdatedAt, "fake", nil, nil, nil, nil)
+				mock.ExpectQuery(selectColumns+` WHERE \( \(payments\.reservation_id = \$1\) AND \(payments\.status = \$2::text\) \) ORDER BY payments\.created_at ASC`).
+					WithArgs(testReservationID, entity.PaymentStatusInitiated.String()).
+					WillReturnRows(rows)
+			},
+			expectedPayments: &entity.Payments{
+				{ID: testID2, ReservationID: testReservationID, Status: entity.PaymentStatusInitiated},
+			},
+			expectedError: false,
+		},
+		{
+			name: "no payments found",
+			filter: repository.FindAllPaymentsFilter{
+				ReservationID: &testReservationID,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectColumns + ` WHERE \(payments\.reservation_id = \$1\) ORDER BY payments\.created_at ASC`).
+					WithArgs(testReservationID).
+					WillReturnRows(sqlmock.NewRows(paymentColumns))
+			},
+			expectedPayments: &entity.Payments{},
+			expectedError:    false,
+		},
+		{
+			name:   "database error",
+			filter: repository.FindAllPaymentsFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectColumns + ` ORDER BY payments\.created_at ASC`).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedPayments: nil,
+			expectedError:    true,
+			errorType:        &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			payments, err := h.Repository.FindAll(context.Background(), tt.filter)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment/find_all FindAll]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, payments)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, payments)
+				require.Len(t, *payments, len(*tt.expectedPayments))
+				for i, expected := range *tt.expectedPayments {
+					assert.Equal(t, expected.ID, (*payments)[i].ID)
+					assert.Equal(t, expected.ReservationID, (*payments)[i].ReservationID)
+					assert.Equal(t, expected.Status, (*payments)[i].Status)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
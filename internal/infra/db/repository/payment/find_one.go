@@ -0,0 +1,45 @@
+package paymentrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentRepositoryImpl) FindOne(ctx context.Context, id uuid.UUID) (payment *entity.Payment, err error) {
+	const errLocation = "[repository payment/find_one FindOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	paymentsTable := table.Payments
+	// SQL statement
+	stmt := postgres.SELECT(
+		paymentsTable.AllColumns,
+	).FROM(
+		paymentsTable,
+	).WHERE(
+		paymentsTable.ID.EQ(postgres.UUID(id)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model Payment
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("payment not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting payment", err.Error()))
+	}
+
+	payment = model.ToEntity()
+	if payment == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert payment model to entity", nil)
+	}
+
+	return
+}
@@ -0,0 +1,153 @@
+package paymentrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentRepositoryImpl_FindOne(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testAmount := decimal.RequireFromString("1500.00")
+	testPaidAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	paymentColumns := []string{
+		"payments.id", "payments.reservation_id", "payments.status", "payments.amount",
+		"payments.paid_at", "payments.payment_method", "payments.created_at", "payments.updated_at",
+		"payments.provider", "payments.provider_reference", "payments.promo_code_id", "payments.discount_amount",
+		"payments.qr_payload",
+	}
+	expectedQuery := `SELECT payments\.id AS "payments\.id", payments\.reservation_id AS "payments\.reservation_id", payments\.status AS "payments\.status", payments\.amount AS "payments\.amount", payments\.paid_at AS "payments\.paid_at", payments\.payment_method AS "payments\.payment_method", payments\.created_at AS "payments\.created_at", payments\.updated_at AS "payments\.updated_at", payments\.provider AS "payments\.provider", payments\.provider_reference AS "payments\.provider_reference", payments\.promo_code_id AS "payments\.promo_code_id", payments\.discount_amount AS "payments\.discount_amount", payments\.qr_payload AS "payments\.qr_payload" FROM public\.payments WHERE payments\.id = \$1`
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedPayment *entity.Payment
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful payment retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, entity.PaymentStatusPaid.String(), "1500.00",
+					testPaidAt, "credit_card", testCreatedAt, testUpdatedAt,
+					"fake", "fake_ch_123", nil, nil, nil,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnRows(rows)
+			},
+			expectedPayment: &entity.Payment{
+				ID:                testID,
+				ReservationID:     testReservationID,
+				Status:            entity.PaymentStatusPaid,
+				Amount:            &testAmount,
+				PaidAt:            &testPaidAt,
+				PaymentMethod:     pointer.ToPointer("credit_card"),
+				Provider:          pointer.ToPointer("fake"),
+				ProviderReference: pointer.ToPointer("fake_ch_123"),
+				CreatedAt:         testCreatedAt,
+				UpdatedAt:         testUpdatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "payment not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.NotFoundError{},
+		},
+		{
+			name: "invalid status in database",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, "unknown", "1500.00",
+					nil, "credit_card", testCreatedAt, testUpdatedAt,
+					"fake", nil, nil, nil, nil,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnRows(rows)
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.InternalServerError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			payment, err := h.Repository.FindOne(context.Background(), testID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment/find_one FindOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, payment)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, payment)
+				assert.Equal(t, tt.expectedPayment.ID, payment.ID)
+				assert.Equal(t, tt.expectedPayment.ReservationID, payment.ReservationID)
+				assert.Equal(t, tt.expectedPayment.Status, payment.Status)
+				assert.True(t, tt.expectedPayment.Amount.Equal(*payment.Amount))
+				assert.Equal(t, tt.expectedPayment.PaidAt.UTC(), payment.PaidAt.UTC())
+				assert.Equal(t, tt.expectedPayment.PaymentMethod, payment.PaymentMethod)
+				assert.Equal(t, tt.expectedPayment.Provider, payment.Provider)
+				assert.Equal(t, tt.expectedPayment.ProviderReference, payment.ProviderReference)
+				assert.Equal(t, tt.expectedPayment.CreatedAt.UTC(), payment.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedPayment.UpdatedAt.UTC(), payment.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package paymentrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type paymentRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewPaymentRepository(execer db.SqlExecer) repository.PaymentRepository {
+	return &paymentRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *paymentRepositoryImpl) WithTx(tx db.SqlExecer) repository.PaymentRepository {
+	return &paymentRepositoryImpl{execer: tx}
+}
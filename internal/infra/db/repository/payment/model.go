@@ -0,0 +1,48 @@
+package paymentrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type Payment struct {
+	model.Payments
+}
+
+func (p *Payment) ToEntity() *entity.Payment {
+	paymentStatus, err := new(entity.PaymentStatus).Parse(p.Status)
+	if err != nil {
+		return nil
+	}
+	return &entity.Payment{
+		ID:                p.ID,
+		ReservationID:     p.ReservationID,
+		Status:            paymentStatus,
+		Amount:            p.Amount,
+		PaidAt:            p.PaidAt,
+		PaymentMethod:     p.PaymentMethod,
+		Provider:          p.Provider,
+		ProviderReference: p.ProviderReference,
+		PromoCodeID:       p.PromoCodeID,
+		DiscountAmount:    p.DiscountAmount,
+		QRPayload:         p.QrPayload,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+type Payments []Payment
+
+func (ps Payments) ToEntities() *entity.Payments {
+	payments := make(entity.Payments, 0, len(ps))
+	for _, p := range ps {
+		payment := p.ToEntity()
+		if payment == nil {
+			continue
+		}
+		payments = append(payments, pointer.GetValue(payment))
+	}
+	return pointer.ToPointer(payments)
+}
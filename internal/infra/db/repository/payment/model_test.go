@@ -0,0 +1,203 @@
+package paymentrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	paymentrepo "ticket-reservation/internal/infra/db/repository/payment"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPayment_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testAmount := decimal.RequireFromString("1500.00")
+	testPaidAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+	testPromoCodeID := uuid.New()
+	testDiscountAmount := decimal.RequireFromString("150.00")
+
+	tests := []struct {
+		name           string
+		input          paymentrepo.Payment
+		expectedEntity *entity.Payment
+		expectedNil    bool
+	}{
+		{
+			name: "successful conversion with initiated status",
+			input: paymentrepo.Payment{
+				Payments: model.Payments{
+					ID:            testID,
+					ReservationID: testReservationID,
+					Status:        entity.PaymentStatusInitiated.String(),
+					Amount:        &testAmount,
+					PaymentMethod: pointer.ToPointer("credit_card"),
+					Provider:      pointer.ToPointer("fake"),
+					CreatedAt:     testCreatedAt,
+					UpdatedAt:     testUpdatedAt,
+				},
+			},
+			expectedEntity: &entity.Payment{
+				ID:            testID,
+				ReservationID: testReservationID,
+				Status:        entity.PaymentStatusInitiated,
+				Amount:        &testAmount,
+				PaymentMethod: pointer.ToPointer("credit_card"),
+				Provider:      pointer.ToPointer("fake"),
+				CreatedAt:     testCreatedAt,
+				UpdatedAt:     testUpdatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "successful conversion with paid status",
+			input: paymentrepo.Payment{
+				Payments: model.Payments{
+					ID:                testID,
+					ReservationID:     testReservationID,
+					Status:            entity.PaymentStatusPaid.String(),
+					Amount:            &testAmount,
+					PaidAt:            &testPaidAt,
+					PaymentMethod:     pointer.ToPointer("credit_card"),
+					Provider:          pointer.ToPointer("fake"),
+					ProviderReference: pointer.ToPointer("fake_ch_123"),
+					CreatedAt:         testCreatedAt,
+					UpdatedAt:         testUpdatedAt,
+				},
+			},
+			expectedEntity: &entity.Payment{
+				ID:                testID,
+				ReservationID:     testReservationID,
+				Status:            entity.PaymentStatusPaid,
+				Amount:            &testAmount,
+				PaidAt:            &testPaidAt,
+				PaymentMethod:     pointer.ToPointer("credit_card"),
+				Provider:          pointer.ToPointer("fake"),
+				ProviderReference: pointer.ToPointer("fake_ch_123"),
+				CreatedAt:         testCreatedAt,
+				UpdatedAt:         testUpdatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "successful conversion with promo code",
+			input: paymentrepo.Payment{
+				Payments: model.Payments{
+					ID:             testID,
+					ReservationID:  testReservationID,
+					Status:         entity.PaymentStatusInitiated.String(),
+					Amount:         &testAmount,
+					PaymentMethod:  pointer.ToPointer("credit_card"),
+					Provider:       pointer.ToPointer("fake"),
+					PromoCodeID:    &testPromoCodeID,
+					DiscountAmount: &testDiscountAmount,
+					CreatedAt:      testCreatedAt,
+					UpdatedAt:      testUpdatedAt,
+				},
+			},
+			expectedEntity: &entity.Payment{
+				ID:             testID,
+				ReservationID:  testReservationID,
+				Status:         entity.PaymentStatusInitiated,
+				Amount:         &testAmount,
+				PaymentMethod:  pointer.ToPointer("credit_card"),
+				Provider:       pointer.ToPointer("fake"),
+				PromoCodeID:    &testPromoCodeID,
+				DiscountAmount: &testDiscountAmount,
+				CreatedAt:      testCreatedAt,
+				UpdatedAt:      testUpdatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "successful conversion with QR payload",
+			input: paymentrepo.Payment{
+				Payments: model.Payments{
+					ID:            testID,
+					ReservationID: testReservationID,
+					Status:        entity.PaymentStatusInitiated.String(),
+					Amount:        &testAmount,
+					PaymentMethod: pointer.ToPointer("promptpay"),
+					Provider:      pointer.ToPointer("promptpay"),
+					QrPayload:     pointer.ToPointer("00020101021230490016A000000677010112"),
+					CreatedAt:     testCreatedAt,
+					UpdatedAt:     testUpdatedAt,
+				},
+			},
+			expectedEntity: &entity.Payment{
+				ID:            testID,
+				ReservationID: testReservationID,
+				Status:        entity.PaymentStatusInitiated,
+				Amount:        &testAmount,
+				PaymentMethod: pointer.ToPointer("promptpay"),
+				Provider:      pointer.ToPointer("promptpay"),
+				QRPayload:     pointer.ToPointer("00020101021230490016A000000677010112"),
+				CreatedAt:     testCreatedAt,
+				UpdatedAt:     testUpdatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "invalid status returns nil",
+			input: paymentrepo.Payment{
+				Payments: model.Payments{
+					ID:            testID,
+					ReservationID: testReservationID,
+					Status:        "invalid_status",
+					CreatedAt:     testCreatedAt,
+					UpdatedAt:     testUpdatedAt,
+				},
+			},
+			expectedEntity: nil,
+			expectedNil:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			result := tt.input.ToEntity()
+
+			// Assert
+			if tt.expectedNil {
+				assert.Nil(t, result)
+			} else {
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
+
+func TestPayments_ToEntities(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testReservationID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	input := paymentrepo.Payments{
+		{Payments: model.Payments{ID: testID1, ReservationID: testReservationID, Status: entity.PaymentStatusFailed.String(), CreatedAt: testCreatedAt}},
+		{Payments: model.Payments{ID: uuid.New(), ReservationID: testReservationID, Status: "invalid_status", CreatedAt: testCreatedAt}},
+		{Payments: model.Payments{ID: testID2, ReservationID: testReservationID, Status: entity.PaymentStatusPaid.String(), CreatedAt: testCreatedAt}},
+	}
+
+	// Execute
+	result := input.ToEntities()
+
+	// Assert - invalid rows are skipped
+	require.NotNil(t, result)
+	require.Len(t, *result, 2)
+	assert.Equal(t, testID1, (*result)[0].ID)
+	assert.Equal(t, entity.PaymentStatusFailed, (*result)[0].Status)
+	assert.Equal(t, testID2, (*result)[1].ID)
+	assert.Equal(t, entity.PaymentStatusPaid, (*result)[1].Status)
+}
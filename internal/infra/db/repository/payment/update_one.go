@@ -0,0 +1,69 @@
+package paymentrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentRepositoryImpl) UpdateOne(ctx context.Context, input repository.UpdatePaymentInput) (payment *entity.Payment, err error) {
+	const errLocation = "[repository payment/update_one UpdateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	paymentsTable := table.Payments
+
+	var updateModel Payment
+	columns := make(postgres.ColumnList, 0)
+
+	// build the update model
+	if input.Status != nil {
+		updateModel.Status = input.Status.String()
+		columns = append(columns, paymentsTable.Status)
+	}
+	if input.PaidAt != nil {
+		updateModel.PaidAt = input.PaidAt
+		columns = append(columns, paymentsTable.PaidAt)
+	}
+	if input.ProviderReference != nil {
+		updateModel.ProviderReference = input.ProviderReference
+		columns = append(columns, paymentsTable.ProviderReference)
+	}
+	if input.QRPayload != nil {
+		updateModel.QrPayload = input.QRPayload
+		columns = append(columns, paymentsTable.QrPayload)
+	}
+	if len(columns) == 0 {
+		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+	}
+
+	// SQL statement
+	stmt := paymentsTable.
+		UPDATE(columns).
+		MODEL(updateModel).
+		WHERE(paymentsTable.ID.EQ(postgres.UUID(input.ID))).
+		RETURNING(paymentsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Payment
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("payment not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating payment", err.Error()))
+	}
+
+	payment = model.ToEntity()
+	if payment == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert payment model to entity", nil)
+	}
+
+	return
+}
@@ -0,0 +1,205 @@
+package paymentrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentRepositoryImpl_UpdateOne(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testPaidAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	paymentColumns := []string{
+		"payments.id", "payments.reservation_id", "payments.status", "payments.amount",
+		"payments.paid_at", "payments.payment_method", "payments.created_at", "payments.updated_at",
+		"payments.provider", "payments.provider_reference", "payments.promo_code_id", "payments.discount_amount",
+		"payments.qr_payload",
+	}
+	returningColumns := ` RETURNING payments\.id AS "payments\.id", payments\.reservation_id AS "payments\.reservation_id", payments\.status AS "payments\.status", payments\.amount AS "payments\.amount", payments\.paid_at AS "payments\.paid_at", payments\.payment_method AS "payments\.payment_method", payments\.created_at AS "payments\.created_at", payments\.updated_at AS "payments\.updated_at", payments\.provider AS "payments\.provider", payments\.provider_reference AS "payments\.provider_reference", payments\.promo_code_id AS "payments\.promo_code_id", payments\.discount_amount AS "payments\.discount_amount", payments\.qr_payload AS "payments\.qr_payload"`
+
+	tests := []struct {
+		name            string
+		input           repository.UpdatePaymentInput
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedPayment *entity.Payment
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful update to paid",
+			input: repository.UpdatePaymentInput{
+				ID:                testID,
+				Status:            pointer.ToPointer(entity.PaymentStatusPaid),
+				PaidAt:            &testPaidAt,
+				ProviderReference: pointer.ToPointer("fake_ch_123"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, entity.PaymentStatusPaid.String(), "1500.00",
+					testPaidAt, "credit_card", testCreatedAt, testUpdatedAt,
+					"fake", "fake_ch_123", nil, nil, nil,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.payments SET \(status, paid_at, provider_reference\) = \(\$1, \$2, \$3\) WHERE payments\.id = \$4`+returningColumns).
+					WithArgs(entity.PaymentStatusPaid.String(), testPaidAt, "fake_ch_123", testID).
+					WillReturnRows(rows)
+			},
+			expectedPayment: &entity.Payment{
+				ID:                testID,
+				ReservationID:     testReservationID,
+				Status:            entity.PaymentStatusPaid,
+				PaidAt:            &testPaidAt,
+				ProviderReference: pointer.ToPointer("fake_ch_123"),
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful update to failed",
+			input: repository.UpdatePaymentInput{
+				ID:     testID,
+				Status: pointer.ToPointer(entity.PaymentStatusFailed),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, entity.PaymentStatusFailed.String(), "1500.00",
+					nil, "credit_card", testCreatedAt, testUpdatedAt,
+					"fake", nil, nil, nil, nil,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.payments SET status = \$1 WHERE payments\.id = \$2`+returningColumns).
+					WithArgs(entity.PaymentStatusFailed.String(), testID).
+					WillReturnRows(rows)
+			},
+			expectedPayment: &entity.Payment{
+				ID:            testID,
+				ReservationID: testReservationID,
+				Status:        entity.PaymentStatusFailed,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful update of the QR payload",
+			input: repository.UpdatePaymentInput{
+				ID:        testID,
+				QRPayload: pointer.ToPointer("00020101021230490016A000000677010112"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(paymentColumns).AddRow(
+					testID, testReservationID, entity.PaymentStatusInitiated.String(), "1500.00",
+					nil, "promptpay", testCreatedAt, testUpdatedAt,
+					"promptpay", nil, nil, nil, "00020101021230490016A000000677010112",
+				)
+
+				mock.ExpectQuery(`UPDATE public\.payments SET qr_payload = \$1 WHERE payments\.id = \$2`+returningColumns).
+					WithArgs("00020101021230490016A000000677010112", testID).
+					WillReturnRows(rows)
+			},
+			expectedPayment: &entity.Payment{
+				ID:            testID,
+				ReservationID: testReservationID,
+				Status:        entity.PaymentStatusInitiated,
+				QRPayload:     pointer.ToPointer("00020101021230490016A000000677010112"),
+			},
+			expectedError: false,
+		},
+		{
+			name: "no fields to update",
+			input: repository.UpdatePaymentInput{
+				ID: testID,
+			},
+			setupMock:       func(mock sqlmock.Sqlmock) {},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.BadRequestError{},
+		},
+		{
+			name: "payment not found",
+			input: repository.UpdatePaymentInput{
+				ID:     testID,
+				Status: pointer.ToPointer(entity.PaymentStatusFailed),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.payments SET status = \$1 WHERE payments\.id = \$2`+returningColumns).
+					WithArgs(entity.PaymentStatusFailed.String(), testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.UpdatePaymentInput{
+				ID:     testID,
+				Status: pointer.ToPointer(entity.PaymentStatusFailed),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.payments SET status = \$1 WHERE payments\.id = \$2`+returningColumns).
+					WithArgs(entity.PaymentStatusFailed.String(), testID).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedPayment: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			payment, err := h.Repository.UpdateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment/update_one UpdateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, payment)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, payment)
+				assert.Equal(t, tt.expectedPayment.ID, payment.ID)
+				assert.Equal(t, tt.expectedPayment.ReservationID, payment.ReservationID)
+				assert.Equal(t, tt.expectedPayment.Status, payment.Status)
+				assert.Equal(t, tt.expectedPayment.ProviderReference, payment.ProviderReference)
+				assert.Equal(t, tt.expectedPayment.QRPayload, payment.QRPayload)
+				if tt.expectedPayment.PaidAt != nil {
+					require.NotNil(t, payment.PaidAt)
+					assert.Equal(t, tt.expectedPayment.PaidAt.UTC(), payment.PaidAt.UTC())
+				} else {
+					assert.Nil(t, payment.PaidAt)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
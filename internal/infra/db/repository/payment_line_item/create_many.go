@@ -0,0 +1,46 @@
+package paymentlineitemrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentLineItemRepositoryImpl) CreateMany(ctx context.Context, input entity.PaymentLineItems) (lineItems *entity.PaymentLineItems, err error) {
+	const errLocation = "[repository payment_line_item/create_many CreateMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(input) == 0 {
+		return &entity.PaymentLineItems{}, nil
+	}
+
+	models := make([]model.PaymentLineItems, 0, len(input))
+	for _, lineItem := range input {
+		models = append(models, model.PaymentLineItems{
+			PaymentID:  lineItem.PaymentID,
+			LineNumber: int32(lineItem.LineNumber),
+			Type:       lineItem.Type.String(),
+			Rate:       lineItem.Rate,
+			Amount:     lineItem.Amount,
+		})
+	}
+
+	lineItemsTable := table.PaymentLineItems
+	// SQL statement (a single multi-row INSERT)
+	stmt := lineItemsTable.INSERT(
+		lineItemsTable.AllColumns.Except(lineItemsTable.DefaultColumns), // Exclude columns with default values
+	).MODELS(models).RETURNING(lineItemsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var created PaymentLineItems
+	if err := r.execer.SelectContext(ctx, &created, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating payment line items", err.Error()))
+	}
+
+	lineItems = created.ToEntities()
+	return lineItems, nil
+}
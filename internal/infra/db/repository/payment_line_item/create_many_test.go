@@ -0,0 +1,121 @@
+package paymentlineitemrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var lineItemColumns = []string{
+	"payment_line_items.id", "payment_line_items.payment_id", "payment_line_items.line_number", "payment_line_items.type",
+	"payment_line_items.rate", "payment_line_items.amount", "payment_line_items.created_at",
+}
+
+const lineItemReturningColumns = `payment_line_items\.id AS "payment_line_items\.id", payment_line_items\.payment_id AS "payment_line_items\.payment_id", payment_line_items\.line_number AS "payment_line_items\.line_number", payment_line_items\.type AS "payment_line_items\.type", payment_line_items\.rate AS "payment_line_items\.rate", payment_line_items\.amount AS "payment_line_items\.amount", payment_line_items\.created_at AS "payment_line_items\.created_at"`
+
+func TestPaymentLineItemRepositoryImpl_CreateMany(t *testing.T) {
+	testPaymentID := uuid.New()
+	testLineItemID1 := uuid.New()
+	testLineItemID2 := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testTicket := decimal.RequireFromString("1500.00")
+	testVAT := decimal.RequireFromString("105.00")
+	testVATRate := decimal.RequireFromString("7.00")
+
+	expectedQuery := `INSERT INTO public\.payment_line_items \(payment_id, line_number, type, rate, amount\) VALUES \(\$1, \$2, \$3, \$4, \$5\), \(\$6, \$7, \$8, \$9, \$10\) RETURNING ` + lineItemReturningColumns
+
+	input := entity.PaymentLineItems{
+		{PaymentID: testPaymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: testTicket},
+		{PaymentID: testPaymentID, LineNumber: 2, Type: entity.LineItemTypeVAT, Rate: &testVATRate, Amount: testVAT},
+	}
+
+	tests := []struct {
+		name              string
+		input             entity.PaymentLineItems
+		setupMock         func(mock sqlmock.Sqlmock)
+		expectedLineItems *entity.PaymentLineItems
+		expectedError     bool
+		errorType         error
+	}{
+		{
+			name:  "successful creation",
+			input: input,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(lineItemColumns).
+					AddRow(testLineItemID1, testPaymentID, 1, "ticket", nil, "1500.00", testCreatedAt).
+					AddRow(testLineItemID2, testPaymentID, 2, "vat", "7.00", "105.00", testCreatedAt)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(
+						testPaymentID, int32(1), "ticket", nil, testTicket,
+						testPaymentID, int32(2), "vat", testVATRate, testVAT,
+					).
+					WillReturnRows(rows)
+			},
+			expectedLineItems: &entity.PaymentLineItems{
+				{ID: testLineItemID1, PaymentID: testPaymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: testTicket, CreatedAt: testCreatedAt},
+				{ID: testLineItemID2, PaymentID: testPaymentID, LineNumber: 2, Type: entity.LineItemTypeVAT, Rate: &testVATRate, Amount: testVAT, CreatedAt: testCreatedAt},
+			},
+			expectedError: false,
+		},
+		{
+			name:              "empty input does not hit the database",
+			input:             entity.PaymentLineItems{},
+			setupMock:         func(mock sqlmock.Sqlmock) {},
+			expectedLineItems: &entity.PaymentLineItems{},
+			expectedError:     false,
+		},
+		{
+			name:  "database connection error",
+			input: input,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedLineItems: nil,
+			expectedError:     true,
+			errorType:         &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			lineItems, err := h.Repository.CreateMany(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment_line_item/create_many CreateMany]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, lineItems)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedLineItems, lineItems)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
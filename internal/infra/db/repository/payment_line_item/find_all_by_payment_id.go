@@ -0,0 +1,36 @@
+package paymentlineitemrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentLineItemRepositoryImpl) FindAllByPaymentID(ctx context.Context, paymentID uuid.UUID) (lineItems *entity.PaymentLineItems, err error) {
+	const errLocation = "[repository payment_line_item/find_all_by_payment_id FindAllByPaymentID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	lineItemsTable := table.PaymentLineItems
+	// SQL statement
+	stmt := postgres.SELECT(
+		lineItemsTable.AllColumns,
+	).FROM(
+		lineItemsTable,
+	).WHERE(
+		lineItemsTable.PaymentID.EQ(postgres.UUID(paymentID)),
+	).ORDER_BY(lineItemsTable.LineNumber.ASC())
+
+	query, args := stmt.Sql()
+
+	var models PaymentLineItems
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting payment line items", err.Error()))
+	}
+
+	lineItems = models.ToEntities()
+	return lineItems, nil
+}
@@ -0,0 +1,104 @@
+package paymentlineitemrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestPaymentLineItemRepositoryImpl_FindAllByPaymentID(t *testing.T) {
+	testPaymentID := uuid.New()
+	testLineItemID1 := uuid.New()
+	testLineItemID2 := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + lineItemReturningColumns + ` FROM public\.payment_line_items WHERE payment_line_items\.payment_id = \$1 ORDER BY payment_line_items\.line_number ASC`
+
+	tests := []struct {
+		name              string
+		setupMock         func(mock sqlmock.Sqlmock)
+		expectedLineItems *entity.PaymentLineItems
+		expectedError     bool
+		errorType         error
+	}{
+		{
+			name: "successful retrieval skips unknown line types",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(lineItemColumns).
+					AddRow(testLineItemID1, testPaymentID, 1, "ticket", nil, "1500.00", testCreatedAt).
+					AddRow(uuid.New(), testPaymentID, 2, "bogus", nil, "1.00", testCreatedAt).
+					AddRow(testLineItemID2, testPaymentID, 3, "discount", nil, "-300.00", testCreatedAt)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID).
+					WillReturnRows(rows)
+			},
+			expectedLineItems: &entity.PaymentLineItems{
+				{ID: testLineItemID1, PaymentID: testPaymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1500.00"), CreatedAt: testCreatedAt},
+				{ID: testLineItemID2, PaymentID: testPaymentID, LineNumber: 3, Type: entity.LineItemTypeDiscount, Amount: decimal.RequireFromString("-300.00"), CreatedAt: testCreatedAt},
+			},
+			expectedError: false,
+		},
+		{
+			name: "payment without line items",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID).
+					WillReturnRows(sqlmock.NewRows(lineItemColumns))
+			},
+			expectedLineItems: &entity.PaymentLineItems{},
+			expectedError:     false,
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			lineItems, err := h.Repository.FindAllByPaymentID(context.Background(), testPaymentID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment_line_item/find_all_by_payment_id FindAllByPaymentID]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, lineItems)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedLineItems, lineItems)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package paymentlineitemrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type paymentLineItemRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewPaymentLineItemRepository(execer db.SqlExecer) repository.PaymentLineItemRepository {
+	return &paymentLineItemRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *paymentLineItemRepositoryImpl) WithTx(tx db.SqlExecer) repository.PaymentLineItemRepository {
+	return &paymentLineItemRepositoryImpl{execer: tx}
+}
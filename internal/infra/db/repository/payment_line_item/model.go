@@ -0,0 +1,42 @@
+package paymentlineitemrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type PaymentLineItem struct {
+	model.PaymentLineItems
+}
+
+func (l *PaymentLineItem) ToEntity() *entity.PaymentLineItem {
+	lineItemType, err := new(entity.LineItemType).Parse(l.Type)
+	if err != nil {
+		return nil
+	}
+	return &entity.PaymentLineItem{
+		ID:         l.ID,
+		PaymentID:  l.PaymentID,
+		LineNumber: int(l.LineNumber),
+		Type:       lineItemType,
+		Rate:       l.Rate,
+		Amount:     l.Amount,
+		CreatedAt:  l.CreatedAt,
+	}
+}
+
+type PaymentLineItems []PaymentLineItem
+
+func (ls PaymentLineItems) ToEntities() *entity.PaymentLineItems {
+	lineItems := make(entity.PaymentLineItems, 0, len(ls))
+	for _, l := range ls {
+		lineItem := l.ToEntity()
+		if lineItem == nil {
+			continue
+		}
+		lineItems = append(lineItems, pointer.GetValue(lineItem))
+	}
+	return pointer.ToPointer(lineItems)
+}
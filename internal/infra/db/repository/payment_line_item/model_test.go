@@ -0,0 +1,75 @@
+package paymentlineitemrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	paymentlineitemrepo "ticket-reservation/internal/infra/db/repository/payment_line_item"
+)
+
+func TestPaymentLineItem_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testRate := decimal.RequireFromString("7.00")
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          paymentlineitemrepo.PaymentLineItem
+		expectedEntity *entity.PaymentLineItem
+		expectedNil    bool
+	}{
+		{
+			name: "successful conversion with rate",
+			input: paymentlineitemrepo.PaymentLineItem{
+				PaymentLineItems: model.PaymentLineItems{
+					ID:         testID,
+					PaymentID:  testPaymentID,
+					LineNumber: 4,
+					Type:       entity.LineItemTypeVAT.String(),
+					Rate:       &testRate,
+					Amount:     decimal.RequireFromString("105.00"),
+					CreatedAt:  testCreatedAt,
+				},
+			},
+			expectedEntity: &entity.PaymentLineItem{
+				ID:         testID,
+				PaymentID:  testPaymentID,
+				LineNumber: 4,
+				Type:       entity.LineItemTypeVAT,
+				Rate:       &testRate,
+				Amount:     decimal.RequireFromString("105.00"),
+				CreatedAt:  testCreatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "invalid type returns nil",
+			input: paymentlineitemrepo.PaymentLineItem{
+				PaymentLineItems: model.PaymentLineItems{
+					ID:   testID,
+					Type: "bogus",
+				},
+			},
+			expectedNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.input.ToEntity()
+
+			if tt.expectedNil {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package paymentwebhookeventrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *paymentWebhookEventRepositoryImpl) CreateOne(ctx context.Context, input *entity.PaymentWebhookEvent) (event *entity.PaymentWebhookEvent, err error) {
+	const errLocation = "[repository payment_webhook_event/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	eventsTable := table.PaymentWebhookEvents
+	// SQL statement, a redelivered event inserts nothing and returns no row
+	stmt := eventsTable.INSERT(
+		eventsTable.AllColumns.Except(eventsTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.PaymentWebhookEvents{
+		Provider:  input.Provider,
+		EventID:   input.EventID,
+		EventType: input.EventType.String(),
+		PaymentID: input.PaymentID,
+	}).ON_CONFLICT(
+		eventsTable.Provider, eventsTable.EventID,
+	).DO_NOTHING().RETURNING(eventsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model PaymentWebhookEvent
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewConflictError("payment webhook event already processed", map[string]string{"event_id": input.EventID})
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating payment webhook event", err.Error()))
+	}
+
+	return model.ToEntity(), nil
+}
@@ -0,0 +1,129 @@
+package paymentwebhookeventrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestPaymentWebhookEventRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	inputEvent := &entity.PaymentWebhookEvent{
+		Provider:  "fake",
+		EventID:   "evt_123",
+		EventType: entity.PaymentWebhookEventTypeSucceeded,
+		PaymentID: &testPaymentID,
+	}
+
+	eventColumns := []string{
+		"payment_webhook_events.id", "payment_webhook_events.provider", "payment_webhook_events.event_id",
+		"payment_webhook_events.event_type", "payment_webhook_events.payment_id", "payment_webhook_events.created_at",
+	}
+	expectedQuery := `INSERT INTO public\.payment_webhook_events \(provider, event_id, event_type, payment_id\) VALUES \(\$1, \$2, \$3, \$4\) ON CONFLICT \(provider, event_id\) DO NOTHING RETURNING payment_webhook_events\.id AS "payment_webhook_events\.id", payment_webhook_events\.provider AS "payment_webhook_events\.provider", payment_webhook_events\.event_id AS "payment_webhook_events\.event_id", payment_webhook_events\.event_type AS "payment_webhook_events\.event_type", payment_webhook_events\.payment_id AS "payment_webhook_events\.payment_id", payment_webhook_events\.created_at AS "payment_webhook_events\.created_at"`
+
+	tests := []struct {
+		name          string
+		input         *entity.PaymentWebhookEvent
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedEvent *entity.PaymentWebhookEvent
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:  "successful event creation",
+			input: inputEvent,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(eventColumns).AddRow(
+					testID, "fake", "evt_123", entity.PaymentWebhookEventTypeSucceeded.String(), testPaymentID, testCreatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fake", "evt_123", entity.PaymentWebhookEventTypeSucceeded.String(), testPaymentID).
+					WillReturnRows(rows)
+			},
+			expectedEvent: &entity.PaymentWebhookEvent{
+				ID:        testID,
+				Provider:  "fake",
+				EventID:   "evt_123",
+				EventType: entity.PaymentWebhookEventTypeSucceeded,
+				PaymentID: &testPaymentID,
+				CreatedAt: testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name:  "event already processed",
+			input: inputEvent,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fake", "evt_123", entity.PaymentWebhookEventTypeSucceeded.String(), testPaymentID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedEvent: nil,
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+		},
+		{
+			name:  "database connection error",
+			input: inputEvent,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fake", "evt_123", entity.PaymentWebhookEventTypeSucceeded.String(), testPaymentID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedEvent: nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			event, err := h.Repository.CreateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository payment_webhook_event/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, event)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, event)
+				assert.Equal(t, tt.expectedEvent.ID, event.ID)
+				assert.Equal(t, tt.expectedEvent.Provider, event.Provider)
+				assert.Equal(t, tt.expectedEvent.EventID, event.EventID)
+				assert.Equal(t, tt.expectedEvent.EventType, event.EventType)
+				assert.Equal(t, tt.expectedEvent.PaymentID, event.PaymentID)
+				assert.Equal(t, tt.expectedEvent.CreatedAt.UTC(), event.CreatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
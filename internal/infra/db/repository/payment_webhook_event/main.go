@@ -0,0 +1,19 @@
+package paymentwebhookeventrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type paymentWebhookEventRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewPaymentWebhookEventRepository(execer db.SqlExecer) repository.PaymentWebhookEventRepository {
+	return &paymentWebhookEventRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *paymentWebhookEventRepositoryImpl) WithTx(tx db.SqlExecer) repository.PaymentWebhookEventRepository {
+	return &paymentWebhookEventRepositoryImpl{execer: tx}
+}
@@ -0,0 +1,21 @@
+package paymentwebhookeventrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+)
+
+type PaymentWebhookEvent struct {
+	model.PaymentWebhookEvents
+}
+
+func (e *PaymentWebhookEvent) ToEntity() *entity.PaymentWebhookEvent {
+	return &entity.PaymentWebhookEvent{
+		ID:        e.ID,
+		Provider:  e.Provider,
+		EventID:   e.EventID,
+		EventType: entity.PaymentWebhookEventType(e.EventType),
+		PaymentID: e.PaymentID,
+		CreatedAt: e.CreatedAt,
+	}
+}
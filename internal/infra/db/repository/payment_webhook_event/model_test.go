@@ -0,0 +1,73 @@
+package paymentwebhookeventrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	paymentwebhookeventrepo "ticket-reservation/internal/infra/db/repository/payment_webhook_event"
+)
+
+func TestPaymentWebhookEvent_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          paymentwebhookeventrepo.PaymentWebhookEvent
+		expectedEntity *entity.PaymentWebhookEvent
+	}{
+		{
+			name: "successful conversion with payment ID",
+			input: paymentwebhookeventrepo.PaymentWebhookEvent{
+				PaymentWebhookEvents: model.PaymentWebhookEvents{
+					ID:        testID,
+					Provider:  "fake",
+					EventID:   "evt_123",
+					EventType: entity.PaymentWebhookEventTypeSucceeded.String(),
+					PaymentID: &testPaymentID,
+					CreatedAt: testCreatedAt,
+				},
+			},
+			expectedEntity: &entity.PaymentWebhookEvent{
+				ID:        testID,
+				Provider:  "fake",
+				EventID:   "evt_123",
+				EventType: entity.PaymentWebhookEventTypeSucceeded,
+				PaymentID: &testPaymentID,
+				CreatedAt: testCreatedAt,
+			},
+		},
+		{
+			name: "successful conversion of an event type the service does not handle",
+			input: paymentwebhookeventrepo.PaymentWebhookEvent{
+				PaymentWebhookEvents: model.PaymentWebhookEvents{
+					ID:        testID,
+					Provider:  "fake",
+					EventID:   "evt_456",
+					EventType: "charge.dispute.created",
+					CreatedAt: testCreatedAt,
+				},
+			},
+			expectedEntity: &entity.PaymentWebhookEvent{
+				ID:        testID,
+				Provider:  "fake",
+				EventID:   "evt_456",
+				EventType: entity.PaymentWebhookEventType("charge.dispute.created"),
+				CreatedAt: testCreatedAt,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.input.ToEntity()
+			assert.Equal(t, tt.expectedEntity, result)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package promocoderepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *promoCodeRepositoryImpl) CreateOne(ctx context.Context, input *entity.PromoCode) (promoCode *entity.PromoCode, err error) {
+	const errLocation = "[repository promo_code/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	promoCodesTable := table.PromoCodes
+	// SQL statement, a code that is already taken inserts nothing and returns no row
+	stmt := promoCodesTable.INSERT(
+		promoCodesTable.AllColumns.Except(promoCodesTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.PromoCodes{
+		Code:                     input.Code,
+		DiscountType:             input.DiscountType.String(),
+		DiscountValue:            input.DiscountValue,
+		MaxRedemptions:           toInt32(input.MaxRedemptions),
+		MaxRedemptionsPerSession: toInt32(input.MaxRedemptionsPerSession),
+		ValidFrom:                input.ValidFrom,
+		ValidUntil:               input.ValidUntil,
+		ConcertID:                input.ConcertID,
+		ZoneID:                   input.ZoneID,
+	}).ON_CONFLICT(
+		promoCodesTable.Code,
+	).DO_NOTHING().RETURNING(promoCodesTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model PromoCode
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewConflictError("promo code already exists", map[string]string{"code": input.Code})
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating promo code", err.Error()))
+	}
+
+	res := model.ToEntity()
+	if res == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert promo code model to entity", nil)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package promocoderepo_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+var promoCodeColumns = []string{
+	"promo_codes.id", "promo_codes.code", "promo_codes.discount_type", "promo_codes.discount_value",
+	"promo_codes.max_redemptions", "promo_codes.max_redemptions_per_session", "promo_codes.valid_from", "promo_codes.valid_until",
+	"promo_codes.concert_id", "promo_codes.zone_id", "promo_codes.created_at", "promo_codes.updated_at",
+}
+
+const promoCodeReturningColumns = `promo_codes\.id AS "promo_codes\.id", promo_codes\.code AS "promo_codes\.code", promo_codes\.discount_type AS "promo_codes\.discount_type", promo_codes\.discount_value AS "promo_codes\.discount_value", promo_codes\.max_redemptions AS "promo_codes\.max_redemptions", promo_codes\.max_redemptions_per_session AS "promo_codes\.max_redemptions_per_session", promo_codes\.valid_from AS "promo_codes\.valid_from", promo_codes\.valid_until AS "promo_codes\.valid_until", promo_codes\.concert_id AS "promo_codes\.concert_id", promo_codes\.zone_id AS "promo_codes\.zone_id", promo_codes\.created_at AS "promo_codes\.created_at", promo_codes\.updated_at AS "promo_codes\.updated_at"`
+
+func TestPromoCodeRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testConcertID := uuid.New()
+	testValidUntil := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	inputPromoCode := &entity.PromoCode{
+		Code:           "EARLYBIRD",
+		DiscountType:   entity.PromoCodeDiscountTypePercentage,
+		DiscountValue:  decimal.RequireFromString("20"),
+		MaxRedemptions: pointer.ToPointer(int64(100)),
+		ValidUntil:     &testValidUntil,
+		ConcertID:      &testConcertID,
+	}
+
+	expectedQuery := `INSERT INTO public\.promo_codes \(code, discount_type, discount_value, max_redemptions, max_redemptions_per_session, valid_from, valid_until, concert_id, zone_id\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\) ON CONFLICT \(code\) DO NOTHING RETURNING ` + promoCodeReturningColumns
+	expectedArgs := []driver.Value{"EARLYBIRD", entity.PromoCodeDiscountTypePercentage.String(), decimal.RequireFromString("20"), int32(100), nil, nil, testValidUntil, testConcertID, nil}
+
+	tests := []struct {
+		name              string
+		input             *entity.PromoCode
+		setupMock         func(mock sqlmock.Sqlmock)
+		expectedPromoCode *entity.PromoCode
+		expectedError     bool
+		errorType         error
+	}{
+		{
+			name:  "successful promo code creation",
+			input: inputPromoCode,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(promoCodeColumns).AddRow(
+					testID, "EARLYBIRD", entity.PromoCodeDiscountTypePercentage.String(), "20.00",
+					100, nil, nil, testValidUntil,
+					testConcertID, nil, testCreatedAt, testCreatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(expectedArgs...).
+					WillReturnRows(rows)
+			},
+			expectedPromoCode: &entity.PromoCode{
+				ID:             testID,
+				Code:           "EARLYBIRD",
+				DiscountType:   entity.PromoCodeDiscountTypePercentage,
+				DiscountValue:  decimal.RequireFromString("20.00"),
+				MaxRedemptions: pointer.ToPointer(int64(100)),
+				ValidUntil:     &testValidUntil,
+				ConcertID:      &testConcertID,
+				CreatedAt:      testCreatedAt,
+				UpdatedAt:      testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name:  "code already exists",
+			input: inputPromoCode,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(expectedArgs...).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+		},
+		{
+			name:  "invalid discount type returned from database",
+			input: inputPromoCode,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(promoCodeColumns).AddRow(
+					testID, "EARLYBIRD", "bogus", "20.00",
+					100, nil, nil, testValidUntil,
+					testConcertID, nil, testCreatedAt, testCreatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(expectedArgs...).
+					WillReturnRows(rows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+		},
+		{
+			name:  "database connection error",
+			input: inputPromoCode,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(expectedArgs...).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			promoCode, err := h.Repository.CreateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository promo_code/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, promoCode)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, promoCode)
+				assert.Equal(t, tt.expectedPromoCode.ID, promoCode.ID)
+				assert.Equal(t, tt.expectedPromoCode.Code, promoCode.Code)
+				assert.Equal(t, tt.expectedPromoCode.DiscountType, promoCode.DiscountType)
+				assert.True(t, tt.expectedPromoCode.DiscountValue.Equal(promoCode.DiscountValue))
+				assert.Equal(t, tt.expectedPromoCode.MaxRedemptions, promoCode.MaxRedemptions)
+				assert.Equal(t, tt.expectedPromoCode.MaxRedemptionsPerSession, promoCode.MaxRedemptionsPerSession)
+				assert.Equal(t, tt.expectedPromoCode.ValidUntil, promoCode.ValidUntil)
+				assert.Equal(t, tt.expectedPromoCode.ConcertID, promoCode.ConcertID)
+				assert.Equal(t, tt.expectedPromoCode.ZoneID, promoCode.ZoneID)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
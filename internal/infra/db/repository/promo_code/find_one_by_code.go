@@ -0,0 +1,46 @@
+package promocoderepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *promoCodeRepositoryImpl) FindOneByCode(ctx context.Context, code string) (promoCode *entity.PromoCode, err error) {
+	const errLocation = "[repository promo_code/find_one_by_code FindOneByCode] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	promoCodesTable := table.PromoCodes
+	// SQL statement
+	stmt := postgres.SELECT(
+		promoCodesTable.AllColumns,
+	).FROM(
+		promoCodesTable,
+	).WHERE(
+		promoCodesTable.Code.EQ(postgres.String(code)),
+	).FOR(
+		postgres.UPDATE(),
+	)
+
+	query, args := stmt.Sql()
+
+	var model PromoCode
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("promo code not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting promo code", err.Error()))
+	}
+
+	promoCode = model.ToEntity()
+	if promoCode == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert promo code model to entity", nil)
+	}
+
+	return
+}
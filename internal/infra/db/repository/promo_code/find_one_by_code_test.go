@@ -0,0 +1,118 @@
+package promocoderepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPromoCodeRepositoryImpl_FindOneByCode(t *testing.T) {
+	testID := uuid.New()
+	testZoneID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + promoCodeReturningColumns + ` FROM public\.promo_codes WHERE promo_codes\.code = \$1::text FOR UPDATE`
+
+	tests := []struct {
+		name              string
+		code              string
+		setupMock         func(mock sqlmock.Sqlmock)
+		expectedPromoCode *entity.PromoCode
+		expectedError     bool
+		errorType         error
+	}{
+		{
+			name: "successful promo code retrieval",
+			code: "PARTNER",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(promoCodeColumns).AddRow(
+					testID, "PARTNER", entity.PromoCodeDiscountTypeFixed.String(), "300.00",
+					nil, 1, nil, nil,
+					nil, testZoneID, testCreatedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("PARTNER").
+					WillReturnRows(rows)
+			},
+			expectedPromoCode: &entity.PromoCode{
+				ID:                       testID,
+				Code:                     "PARTNER",
+				DiscountType:             entity.PromoCodeDiscountTypeFixed,
+				MaxRedemptionsPerSession: pointer.ToPointer(int64(1)),
+				ZoneID:                   &testZoneID,
+			},
+			expectedError: false,
+		},
+		{
+			name: "promo code not found",
+			code: "UNKNOWN",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("UNKNOWN").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database connection error",
+			code: "PARTNER",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("PARTNER").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			promoCode, err := h.Repository.FindOneByCode(context.Background(), tt.code)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository promo_code/find_one_by_code FindOneByCode]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, promoCode)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, promoCode)
+				assert.Equal(t, tt.expectedPromoCode.ID, promoCode.ID)
+				assert.Equal(t, tt.expectedPromoCode.Code, promoCode.Code)
+				assert.Equal(t, tt.expectedPromoCode.DiscountType, promoCode.DiscountType)
+				assert.Equal(t, "300.00", promoCode.DiscountValue.StringFixed(2))
+				assert.Nil(t, promoCode.MaxRedemptions)
+				assert.Equal(t, tt.expectedPromoCode.MaxRedemptionsPerSession, promoCode.MaxRedemptionsPerSession)
+				assert.Equal(t, tt.expectedPromoCode.ZoneID, promoCode.ZoneID)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package promocoderepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type promoCodeRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewPromoCodeRepository(execer db.SqlExecer) repository.PromoCodeRepository {
+	return &promoCodeRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *promoCodeRepositoryImpl) WithTx(tx db.SqlExecer) repository.PromoCodeRepository {
+	return &promoCodeRepositoryImpl{execer: tx}
+}
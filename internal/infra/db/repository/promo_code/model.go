@@ -0,0 +1,47 @@
+package promocoderepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+)
+
+type PromoCode struct {
+	model.PromoCodes
+}
+
+func (p *PromoCode) ToEntity() *entity.PromoCode {
+	discountType, err := new(entity.PromoCodeDiscountType).Parse(p.DiscountType)
+	if err != nil {
+		return nil
+	}
+	return &entity.PromoCode{
+		ID:                       p.ID,
+		Code:                     p.Code,
+		DiscountType:             discountType,
+		DiscountValue:            p.DiscountValue,
+		MaxRedemptions:           toInt64(p.MaxRedemptions),
+		MaxRedemptionsPerSession: toInt64(p.MaxRedemptionsPerSession),
+		ValidFrom:                p.ValidFrom,
+		ValidUntil:               p.ValidUntil,
+		ConcertID:                p.ConcertID,
+		ZoneID:                   p.ZoneID,
+		CreatedAt:                p.CreatedAt,
+		UpdatedAt:                p.UpdatedAt,
+	}
+}
+
+func toInt64(value *int32) *int64 {
+	if value == nil {
+		return nil
+	}
+	converted := int64(*value)
+	return &converted
+}
+
+func toInt32(value *int64) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
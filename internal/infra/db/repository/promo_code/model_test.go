@@ -0,0 +1,106 @@
+package promocoderepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	promocoderepo "ticket-reservation/internal/infra/db/repository/promo_code"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPromoCode_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testConcertID := uuid.New()
+	testValidFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2024, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          promocoderepo.PromoCode
+		expectedEntity *entity.PromoCode
+		expectedNil    bool
+	}{
+		{
+			name: "successful conversion with caps and restriction",
+			input: promocoderepo.PromoCode{
+				PromoCodes: model.PromoCodes{
+					ID:                       testID,
+					Code:                     "EARLYBIRD",
+					DiscountType:             entity.PromoCodeDiscountTypePercentage.String(),
+					DiscountValue:            decimal.RequireFromString("15"),
+					MaxRedemptions:           pointer.ToPointer(int32(500)),
+					MaxRedemptionsPerSession: pointer.ToPointer(int32(2)),
+					ValidFrom:                &testValidFrom,
+					ConcertID:                &testConcertID,
+					CreatedAt:                testCreatedAt,
+					UpdatedAt:                testCreatedAt,
+				},
+			},
+			expectedEntity: &entity.PromoCode{
+				ID:                       testID,
+				Code:                     "EARLYBIRD",
+				DiscountType:             entity.PromoCodeDiscountTypePercentage,
+				DiscountValue:            decimal.RequireFromString("15"),
+				MaxRedemptions:           pointer.ToPointer(int64(500)),
+				MaxRedemptionsPerSession: pointer.ToPointer(int64(2)),
+				ValidFrom:                &testValidFrom,
+				ConcertID:                &testConcertID,
+				CreatedAt:                testCreatedAt,
+				UpdatedAt:                testCreatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "successful conversion without caps",
+			input: promocoderepo.PromoCode{
+				PromoCodes: model.PromoCodes{
+					ID:            testID,
+					Code:          "PARTNER",
+					DiscountType:  entity.PromoCodeDiscountTypeFixed.String(),
+					DiscountValue: decimal.RequireFromString("300"),
+					CreatedAt:     testCreatedAt,
+					UpdatedAt:     testCreatedAt,
+				},
+			},
+			expectedEntity: &entity.PromoCode{
+				ID:            testID,
+				Code:          "PARTNER",
+				DiscountType:  entity.PromoCodeDiscountTypeFixed,
+				DiscountValue: decimal.RequireFromString("300"),
+				CreatedAt:     testCreatedAt,
+				UpdatedAt:     testCreatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "invalid discount type returns nil",
+			input: promocoderepo.PromoCode{
+				PromoCodes: model.PromoCodes{
+					ID:           testID,
+					Code:         "BROKEN",
+					DiscountType: "bogus",
+				},
+			},
+			expectedNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.input.ToEntity()
+
+			if tt.expectedNil {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
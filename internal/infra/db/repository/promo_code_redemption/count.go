@@ -0,0 +1,43 @@
+package promocoderedemptionrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *promoCodeRedemptionRepositoryImpl) Count(ctx context.Context, filter repository.CountPromoCodeRedemptionsFilter) (total int64, err error) {
+	const errLocation = "[repository promo_code_redemption/count Count] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	redemptionsTable := table.PromoCodeRedemptions
+	paymentsTable := table.Payments
+
+	// Build WHERE conditions for filtering, redemptions of failed payments no longer count towards the caps
+	whereClauses := []postgres.BoolExpression{
+		redemptionsTable.PromoCodeID.EQ(postgres.UUID(filter.PromoCodeID)),
+		paymentsTable.Status.NOT_EQ(postgres.String(entity.PaymentStatusFailed.String())),
+	}
+	if filter.SessionID != nil {
+		whereClauses = append(whereClauses, redemptionsTable.SessionID.EQ(postgres.String(*filter.SessionID)))
+	}
+
+	// SQL statement
+	stmt := postgres.SELECT(
+		postgres.COUNT(redemptionsTable.ID).AS("total"),
+	).FROM(
+		redemptionsTable.INNER_JOIN(paymentsTable, paymentsTable.ID.EQ(redemptionsTable.PaymentID)),
+	).WHERE(postgres.AND(whereClauses...))
+
+	query, args := stmt.Sql()
+
+	if err := r.execer.GetContext(ctx, &total, query, args...); err != nil {
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while counting promo code redemptions", err.Error()))
+	}
+
+	return total, nil
+}
@@ -0,0 +1,100 @@
+package promocoderedemptionrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPromoCodeRedemptionRepositoryImpl_Count(t *testing.T) {
+	testPromoCodeID := uuid.New()
+
+	expectedQuery := `SELECT COUNT\(promo_code_redemptions\.id\) AS "total" FROM public\.promo_code_redemptions INNER JOIN public\.payments ON \(payments\.id = promo_code_redemptions\.payment_id\) WHERE \( \(promo_code_redemptions\.promo_code_id = \$1\) AND \(payments\.status != \$2::text\)`
+
+	tests := []struct {
+		name          string
+		filter        repository.CountPromoCodeRedemptionsFilter
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedTotal int64
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:   "count across every session",
+			filter: repository.CountPromoCodeRedemptionsFilter{PromoCodeID: testPromoCodeID},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery+` \)`).
+					WithArgs(testPromoCodeID, "failed").
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(7))
+			},
+			expectedTotal: 7,
+			expectedError: false,
+		},
+		{
+			name: "count for a single session",
+			filter: repository.CountPromoCodeRedemptionsFilter{
+				PromoCodeID: testPromoCodeID,
+				SessionID:   pointer.ToPointer("session-1"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery+` AND \(promo_code_redemptions\.session_id = \$3::text\) \)`).
+					WithArgs(testPromoCodeID, "failed", "session-1").
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(1))
+			},
+			expectedTotal: 1,
+			expectedError: false,
+		},
+		{
+			name:   "database connection error",
+			filter: repository.CountPromoCodeRedemptionsFilter{PromoCodeID: testPromoCodeID},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPromoCodeID, "failed").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			total, err := h.Repository.Count(context.Background(), tt.filter)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository promo_code_redemption/count Count]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Zero(t, total)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTotal, total)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package promocoderedemptionrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *promoCodeRedemptionRepositoryImpl) CreateOne(ctx context.Context, input *entity.PromoCodeRedemption) (redemption *entity.PromoCodeRedemption, err error) {
+	const errLocation = "[repository promo_code_redemption/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	redemptionsTable := table.PromoCodeRedemptions
+	// SQL statement
+	stmt := redemptionsTable.INSERT(
+		redemptionsTable.AllColumns.Except(redemptionsTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.PromoCodeRedemptions{
+		PromoCodeID:    input.PromoCodeID,
+		PaymentID:      input.PaymentID,
+		SessionID:      input.SessionID,
+		DiscountAmount: input.DiscountAmount,
+	}).RETURNING(redemptionsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model PromoCodeRedemption
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating promo code redemption", err.Error()))
+	}
+
+	return model.ToEntity(), nil
+}
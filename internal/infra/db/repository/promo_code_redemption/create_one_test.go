@@ -0,0 +1,118 @@
+package promocoderedemptionrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestPromoCodeRedemptionRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testPromoCodeID := uuid.New()
+	testPaymentID := uuid.New()
+	testDiscount := decimal.RequireFromString("150.00")
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	inputRedemption := &entity.PromoCodeRedemption{
+		PromoCodeID:    testPromoCodeID,
+		PaymentID:      testPaymentID,
+		SessionID:      "session-1",
+		DiscountAmount: testDiscount,
+	}
+
+	expectedQuery := `INSERT INTO public\.promo_code_redemptions \(promo_code_id, payment_id, session_id, discount_amount\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING promo_code_redemptions\.id AS "promo_code_redemptions\.id", promo_code_redemptions\.promo_code_id AS "promo_code_redemptions\.promo_code_id", promo_code_redemptions\.payment_id AS "promo_code_redemptions\.payment_id", promo_code_redemptions\.session_id AS "promo_code_redemptions\.session_id", promo_code_redemptions\.discount_amount AS "promo_code_redemptions\.discount_amount", promo_code_redemptions\.created_at AS "promo_code_redemptions\.created_at"`
+	columns := []string{
+		"promo_code_redemptions.id", "promo_code_redemptions.promo_code_id", "promo_code_redemptions.payment_id",
+		"promo_code_redemptions.session_id", "promo_code_redemptions.discount_amount", "promo_code_redemptions.created_at",
+	}
+
+	tests := []struct {
+		name               string
+		input              *entity.PromoCodeRedemption
+		setupMock          func(mock sqlmock.Sqlmock)
+		expectedRedemption *entity.PromoCodeRedemption
+		expectedError      bool
+		errorType          error
+	}{
+		{
+			name:  "successful redemption creation",
+			input: inputRedemption,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(
+					testID, testPromoCodeID, testPaymentID, "session-1", "150.00", testCreatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPromoCodeID, testPaymentID, "session-1", testDiscount).
+					WillReturnRows(rows)
+			},
+			expectedRedemption: &entity.PromoCodeRedemption{
+				ID:             testID,
+				PromoCodeID:    testPromoCodeID,
+				PaymentID:      testPaymentID,
+				SessionID:      "session-1",
+				DiscountAmount: testDiscount,
+				CreatedAt:      testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name:  "database connection error",
+			input: inputRedemption,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPromoCodeID, testPaymentID, "session-1", testDiscount).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			redemption, err := h.Repository.CreateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository promo_code_redemption/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, redemption)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, redemption)
+				assert.Equal(t, tt.expectedRedemption.ID, redemption.ID)
+				assert.Equal(t, tt.expectedRedemption.PromoCodeID, redemption.PromoCodeID)
+				assert.Equal(t, tt.expectedRedemption.PaymentID, redemption.PaymentID)
+				assert.Equal(t, tt.expectedRedemption.SessionID, redemption.SessionID)
+				assert.True(t, tt.expectedRedemption.DiscountAmount.Equal(redemption.DiscountAmount))
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package promocoderedemptionrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type promoCodeRedemptionRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewPromoCodeRedemptionRepository(execer db.SqlExecer) repository.PromoCodeRedemptionRepository {
+	return &promoCodeRedemptionRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *promoCodeRedemptionRepositoryImpl) WithTx(tx db.SqlExecer) repository.PromoCodeRedemptionRepository {
+	return &promoCodeRedemptionRepositoryImpl{execer: tx}
+}
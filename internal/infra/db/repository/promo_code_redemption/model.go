@@ -0,0 +1,21 @@
+package promocoderedemptionrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+)
+
+type PromoCodeRedemption struct {
+	model.PromoCodeRedemptions
+}
+
+func (r *PromoCodeRedemption) ToEntity() *entity.PromoCodeRedemption {
+	return &entity.PromoCodeRedemption{
+		ID:             r.ID,
+		PromoCodeID:    r.PromoCodeID,
+		PaymentID:      r.PaymentID,
+		SessionID:      r.SessionID,
+		DiscountAmount: r.DiscountAmount,
+		CreatedAt:      r.CreatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package promocoderedemptionrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	promocoderedemptionrepo "ticket-reservation/internal/infra/db/repository/promo_code_redemption"
+)
+
+func TestPromoCodeRedemption_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testPromoCodeID := uuid.New()
+	testPaymentID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	input := promocoderedemptionrepo.PromoCodeRedemption{
+		PromoCodeRedemptions: model.PromoCodeRedemptions{
+			ID:             testID,
+			PromoCodeID:    testPromoCodeID,
+			PaymentID:      testPaymentID,
+			SessionID:      "session-1",
+			DiscountAmount: decimal.RequireFromString("150.00"),
+			CreatedAt:      testCreatedAt,
+		},
+	}
+
+	expected := &entity.PromoCodeRedemption{
+		ID:             testID,
+		PromoCodeID:    testPromoCodeID,
+		PaymentID:      testPaymentID,
+		SessionID:      "session-1",
+		DiscountAmount: decimal.RequireFromString("150.00"),
+		CreatedAt:      testCreatedAt,
+	}
+
+	assert.Equal(t, expected, input.ToEntity())
+}
@@ -0,0 +1,42 @@
+package receiptrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *receiptRepositoryImpl) CreateOne(ctx context.Context, input *entity.Receipt) (receipt *entity.Receipt, err error) {
+	const errLocation = "[repository receipt/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	receiptsTable := table.Receipts
+	// SQL statement
+	stmt := receiptsTable.INSERT(
+		receiptsTable.AllColumns.Except(receiptsTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.Receipts{
+		PaymentID:      input.PaymentID,
+		SellerTaxID:    input.Seller.TaxID,
+		SellerName:     input.Seller.Name,
+		SellerAddress:  input.Seller.Address,
+		ReceiptNumber:  input.ReceiptNumber,
+		DocumentNumber: input.DocumentNumber,
+		Description:    input.Description,
+		Currency:       input.Currency,
+		Amount:         input.Amount,
+		IssuedAt:       input.IssuedAt,
+	}).RETURNING(receiptsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Receipt
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating receipt", err.Error()))
+	}
+
+	receipt = model.ToEntity()
+	return receipt, nil
+}
@@ -0,0 +1,123 @@
+package receiptrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var receiptColumns = []string{
+	"receipts.id", "receipts.payment_id", "receipts.seller_tax_id", "receipts.seller_name", "receipts.seller_address",
+	"receipts.receipt_number", "receipts.document_number", "receipts.description", "receipts.currency",
+	"receipts.amount", "receipts.issued_at", "receipts.created_at",
+}
+
+const receiptReturningColumns = `receipts\.id AS "receipts\.id", receipts\.payment_id AS "receipts\.payment_id", receipts\.seller_tax_id AS "receipts\.seller_tax_id", receipts\.seller_name AS "receipts\.seller_name", receipts\.seller_address AS "receipts\.seller_address", receipts\.receipt_number AS "receipts\.receipt_number", receipts\.document_number AS "receipts\.document_number", receipts\.description AS "receipts\.description", receipts\.currency AS "receipts\.currency", receipts\.amount AS "receipts\.amount", receipts\.issued_at AS "receipts\.issued_at", receipts\.created_at AS "receipts\.created_at"`
+
+func TestReceiptRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 3, 1, 0, time.UTC)
+	testAmount := decimal.RequireFromString("1605.00")
+	testSeller := entity.Seller{TaxID: "0105555000001", Name: "Ticket Co., Ltd.", Address: "Bangkok"}
+
+	expectedQuery := `INSERT INTO public\.receipts \(payment_id, seller_tax_id, seller_name, seller_address, receipt_number, document_number, description, currency, amount, issued_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10\) RETURNING ` + receiptReturningColumns
+
+	input := &entity.Receipt{
+		PaymentID:      testPaymentID,
+		Seller:         testSeller,
+		ReceiptNumber:  42,
+		DocumentNumber: "RC00000042",
+		Description:    "VIP A1",
+		Currency:       "THB",
+		Amount:         testAmount,
+		IssuedAt:       testIssuedAt,
+	}
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedReceipt *entity.Receipt
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful creation",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(receiptColumns).AddRow(
+					testID, testPaymentID, testSeller.TaxID, testSeller.Name, testSeller.Address,
+					42, "RC00000042", "VIP A1", "THB",
+					"1605.00", testIssuedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID, testSeller.TaxID, testSeller.Name, testSeller.Address, int64(42), "RC00000042", "VIP A1", "THB", testAmount, testIssuedAt).
+					WillReturnRows(rows)
+			},
+			expectedReceipt: &entity.Receipt{
+				ID:             testID,
+				PaymentID:      testPaymentID,
+				Seller:         testSeller,
+				ReceiptNumber:  42,
+				DocumentNumber: "RC00000042",
+				Description:    "VIP A1",
+				Currency:       "THB",
+				Amount:         testAmount,
+				IssuedAt:       testIssuedAt,
+				CreatedAt:      testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			receipt, err := h.Repository.CreateOne(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository receipt/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, receipt)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedReceipt, receipt)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
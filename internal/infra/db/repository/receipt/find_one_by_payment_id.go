@@ -0,0 +1,41 @@
+package receiptrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *receiptRepositoryImpl) FindOneByPaymentID(ctx context.Context, paymentID uuid.UUID) (receipt *entity.Receipt, err error) {
+	const errLocation = "[repository receipt/find_one_by_payment_id FindOneByPaymentID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	receiptsTable := table.Receipts
+	// SQL statement
+	stmt := postgres.SELECT(
+		receiptsTable.AllColumns,
+	).FROM(
+		receiptsTable,
+	).WHERE(
+		receiptsTable.PaymentID.EQ(postgres.UUID(paymentID)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model Receipt
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("receipt not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting receipt", err.Error()))
+	}
+
+	receipt = model.ToEntity()
+	return receipt, nil
+}
@@ -0,0 +1,112 @@
+package receiptrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestReceiptRepositoryImpl_FindOneByPaymentID(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + receiptReturningColumns + ` FROM public\.receipts WHERE receipts\.payment_id = \$1`
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedReceipt *entity.Receipt
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful receipt retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(receiptColumns).AddRow(
+					testID, testPaymentID, "0105555000001", "Ticket Co., Ltd.", "",
+					7, "RC00000007", "VIP A1", "THB",
+					"1500.00", testIssuedAt, testIssuedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID).
+					WillReturnRows(rows)
+			},
+			expectedReceipt: &entity.Receipt{
+				ID:             testID,
+				PaymentID:      testPaymentID,
+				Seller:         entity.Seller{TaxID: "0105555000001", Name: "Ticket Co., Ltd."},
+				ReceiptNumber:  7,
+				DocumentNumber: "RC00000007",
+				Description:    "VIP A1",
+				Currency:       "THB",
+				Amount:         decimal.RequireFromString("1500.00"),
+				IssuedAt:       testIssuedAt,
+				CreatedAt:      testIssuedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "receipt not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			receipt, err := h.Repository.FindOneByPaymentID(context.Background(), testPaymentID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository receipt/find_one_by_payment_id FindOneByPaymentID]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, receipt)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedReceipt, receipt)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
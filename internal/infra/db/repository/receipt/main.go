@@ -0,0 +1,19 @@
+package receiptrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type receiptRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewReceiptRepository(execer db.SqlExecer) repository.ReceiptRepository {
+	return &receiptRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *receiptRepositoryImpl) WithTx(tx db.SqlExecer) repository.ReceiptRepository {
+	return &receiptRepositoryImpl{execer: tx}
+}
@@ -0,0 +1,29 @@
+package receiptrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+)
+
+type Receipt struct {
+	model.Receipts
+}
+
+func (r *Receipt) ToEntity() *entity.Receipt {
+	return &entity.Receipt{
+		ID:        r.ID,
+		PaymentID: r.PaymentID,
+		Seller: entity.Seller{
+			TaxID:   r.SellerTaxID,
+			Name:    r.SellerName,
+			Address: r.SellerAddress,
+		},
+		ReceiptNumber:  r.ReceiptNumber,
+		DocumentNumber: r.DocumentNumber,
+		Description:    r.Description,
+		Currency:       r.Currency,
+		Amount:         r.Amount,
+		IssuedAt:       r.IssuedAt,
+		CreatedAt:      r.CreatedAt,
+	}
+}
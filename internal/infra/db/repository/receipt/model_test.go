@@ -0,0 +1,54 @@
+package receiptrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	receiptrepo "ticket-reservation/internal/infra/db/repository/receipt"
+)
+
+func TestReceipt_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	input := receiptrepo.Receipt{
+		Receipts: model.Receipts{
+			ID:             testID,
+			PaymentID:      testPaymentID,
+			SellerTaxID:    "0105555000001",
+			SellerName:     "Ticket Co., Ltd.",
+			SellerAddress:  "Bangkok",
+			ReceiptNumber:  42,
+			DocumentNumber: "RC00000042",
+			Description:    "VIP A1",
+			Currency:       "THB",
+			Amount:         decimal.RequireFromString("1605.00"),
+			IssuedAt:       testIssuedAt,
+			CreatedAt:      testIssuedAt,
+		},
+	}
+
+	// Execute
+	result := input.ToEntity()
+
+	// Assert
+	assert.Equal(t, &entity.Receipt{
+		ID:             testID,
+		PaymentID:      testPaymentID,
+		Seller:         entity.Seller{TaxID: "0105555000001", Name: "Ticket Co., Ltd.", Address: "Bangkok"},
+		ReceiptNumber:  42,
+		DocumentNumber: "RC00000042",
+		Description:    "VIP A1",
+		Currency:       "THB",
+		Amount:         decimal.RequireFromString("1605.00"),
+		IssuedAt:       testIssuedAt,
+		CreatedAt:      testIssuedAt,
+	}, result)
+}
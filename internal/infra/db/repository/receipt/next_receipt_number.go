@@ -0,0 +1,40 @@
+package receiptrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *receiptRepositoryImpl) NextReceiptNumber(ctx context.Context, sellerTaxID string) (receiptNumber int64, err error) {
+	const errLocation = "[repository receipt/next_receipt_number NextReceiptNumber] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	sequencesTable := table.ReceiptNumberSequences
+	// SQL statement, the first receipt of a seller starts the counter at 1, later ones increment the locked row
+	stmt := sequencesTable.INSERT(
+		sequencesTable.SellerTaxID,
+		sequencesTable.LastNumber,
+	).VALUES(
+		sellerTaxID,
+		1,
+	).ON_CONFLICT(
+		sequencesTable.SellerTaxID,
+	).DO_UPDATE(
+		postgres.SET(
+			sequencesTable.LastNumber.SET(sequencesTable.LastNumber.ADD(postgres.Int(1))),
+		),
+	).RETURNING(sequencesTable.LastNumber)
+
+	query, args := stmt.Sql()
+
+	var sequence model.ReceiptNumberSequences
+	if err := r.execer.GetContext(ctx, &sequence, query, args...); err != nil {
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while taking the next receipt number", err.Error()))
+	}
+
+	return sequence.LastNumber, nil
+}
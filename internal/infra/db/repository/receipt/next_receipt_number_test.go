@@ -0,0 +1,88 @@
+package receiptrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestReceiptRepositoryImpl_NextReceiptNumber(t *testing.T) {
+	testSellerTaxID := "0105555000001"
+
+	expectedQuery := `INSERT INTO public\.receipt_number_sequences \(seller_tax_id, last_number\) VALUES \(\$1, \$2\) ON CONFLICT \(seller_tax_id\) DO UPDATE SET last_number = \(receipt_number_sequences\.last_number \+ \$3\) RETURNING receipt_number_sequences\.last_number AS "receipt_number_sequences\.last_number"`
+
+	tests := []struct {
+		name                  string
+		setupMock             func(mock sqlmock.Sqlmock)
+		expectedReceiptNumber int64
+		expectedError         bool
+		errorType             error
+	}{
+		{
+			name: "first receipt of the seller",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testSellerTaxID, int64(1), int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"receipt_number_sequences.last_number"}).AddRow(1))
+			},
+			expectedReceiptNumber: 1,
+			expectedError:         false,
+		},
+		{
+			name: "next receipt of the seller",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testSellerTaxID, int64(1), int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"receipt_number_sequences.last_number"}).AddRow(43))
+			},
+			expectedReceiptNumber: 43,
+			expectedError:         false,
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			receiptNumber, err := h.Repository.NextReceiptNumber(context.Background(), testSellerTaxID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository receipt/next_receipt_number NextReceiptNumber]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Zero(t, receiptNumber)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedReceiptNumber, receiptNumber)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package refundrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *refundRepositoryImpl) CreateOne(ctx context.Context, input *entity.Refund) (refund *entity.Refund, err error) {
+	const errLocation = "[repository refund/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	refundsTable := table.Refunds
+	// SQL statement
+	stmt := refundsTable.INSERT(
+		refundsTable.AllColumns.Except(refundsTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.Refunds{
+		PaymentID:         input.PaymentID,
+		Status:            input.Status.String(),
+		Amount:            input.Amount,
+		Reason:            input.Reason,
+		ProviderReference: input.ProviderReference,
+	}).RETURNING(refundsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Refund
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating refund", err.Error()))
+	}
+
+	res := model.ToEntity()
+	if res == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert refund model to entity", nil)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,158 @@
+package refundrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+var refundColumns = []string{
+	"refunds.id", "refunds.payment_id", "refunds.status", "refunds.amount",
+	"refunds.reason", "refunds.provider_reference", "refunds.created_at", "refunds.updated_at",
+}
+
+const refundReturningColumns = `refunds\.id AS "refunds\.id", refunds\.payment_id AS "refunds\.payment_id", refunds\.status AS "refunds\.status", refunds\.amount AS "refunds\.amount", refunds\.reason AS "refunds\.reason", refunds\.provider_reference AS "refunds\.provider_reference", refunds\.created_at AS "refunds\.created_at", refunds\.updated_at AS "refunds\.updated_at"`
+
+func TestRefundRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testAmount := decimal.RequireFromString("500.00")
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	inputRefund := &entity.Refund{
+		PaymentID: testPaymentID,
+		Status:    entity.RefundStatusPending,
+		Amount:    testAmount,
+		Reason:    pointer.ToPointer("concert cancelled"),
+	}
+
+	expectedQuery := `INSERT INTO public\.refunds \(payment_id, status, amount, reason, provider_reference\) VALUES \(\$1, \$2, \$3, \$4, \$5\) RETURNING ` + refundReturningColumns
+
+	tests := []struct {
+		name           string
+		input          *entity.Refund
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedRefund *entity.Refund
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name:  "successful refund creation",
+			input: inputRefund,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(refundColumns).AddRow(
+					testID, testPaymentID, entity.RefundStatusPending.String(), "500.00",
+					"concert cancelled", nil, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID, entity.RefundStatusPending.String(), testAmount, "concert cancelled", nil).
+					WillReturnRows(rows)
+			},
+			expectedRefund: &entity.Refund{
+				ID:        testID,
+				PaymentID: testPaymentID,
+				Status:    entity.RefundStatusPending,
+				Amount:    testAmount,
+				Reason:    pointer.ToPointer("concert cancelled"),
+				CreatedAt: testCreatedAt,
+				UpdatedAt: testUpdatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name:  "invalid status returned from database",
+			input: inputRefund,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(refundColumns).AddRow(
+					testID, testPaymentID, "invalid_status", "500.00",
+					"concert cancelled", nil, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID, entity.RefundStatusPending.String(), testAmount, "concert cancelled", nil).
+					WillReturnRows(rows)
+			},
+			expectedRefund: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.InternalServerError{},
+		},
+		{
+			name:  "database connection error",
+			input: inputRefund,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID, entity.RefundStatusPending.String(), testAmount, "concert cancelled", nil).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedRefund: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.DatabaseError{},
+		},
+		{
+			name:  "constraint violation error",
+			input: inputRefund,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testPaymentID, entity.RefundStatusPending.String(), testAmount, "concert cancelled", nil).
+					WillReturnError(errors.New("insert or update on table \"refunds\" violates foreign key constraint"))
+			},
+			expectedRefund: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			refund, err := h.Repository.CreateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository refund/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, refund)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, refund)
+				assert.Equal(t, tt.expectedRefund.ID, refund.ID)
+				assert.Equal(t, tt.expectedRefund.PaymentID, refund.PaymentID)
+				assert.Equal(t, tt.expectedRefund.Status, refund.Status)
+				assert.True(t, tt.expectedRefund.Amount.Equal(refund.Amount))
+				assert.Equal(t, tt.expectedRefund.Reason, refund.Reason)
+				assert.Equal(t, tt.expectedRefund.ProviderReference, refund.ProviderReference)
+				assert.Equal(t, tt.expectedRefund.CreatedAt.UTC(), refund.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedRefund.UpdatedAt.UTC(), refund.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
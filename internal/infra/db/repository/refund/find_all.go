@@ -0,0 +1,47 @@
+package refundrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *refundRepositoryImpl) FindAll(ctx context.Context, filter repository.FindAllRefundsFilter) (refunds *entity.Refunds, err error) {
+	const errLocation = "[repository refund/find_all FindAll] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	refundsTable := table.Refunds
+
+	// Build WHERE conditions for filtering
+	whereClauses := []postgres.BoolExpression{}
+	if filter.PaymentID != nil {
+		whereClauses = append(whereClauses, refundsTable.PaymentID.EQ(postgres.UUID(*filter.PaymentID)))
+	}
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, refundsTable.Status.EQ(postgres.String(filter.Status.String())))
+	}
+
+	// SQL statement
+	stmt := postgres.SELECT(
+		refundsTable.AllColumns,
+	).FROM(refundsTable)
+
+	if len(whereClauses) > 0 {
+		stmt = stmt.WHERE(postgres.AND(whereClauses...))
+	}
+	stmt = stmt.ORDER_BY(refundsTable.CreatedAt.ASC())
+
+	query, args := stmt.Sql()
+
+	var models Refunds
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting refunds", err.Error()))
+	}
+
+	refunds = models.ToEntities()
+	return refunds, nil
+}
@@ -0,0 +1,134 @@
+package refundrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestRefundRepositoryImpl_FindAll(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testPaymentID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	selectColumns := `SELECT ` + refundReturningColumns + ` FROM public\.refunds`
+
+	tests := []struct {
+		name            string
+		filter          repository.FindAllRefundsFilter
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedRefunds *entity.Refunds
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name:   "successful retrieval without filters",
+			filter: repository.FindAllRefundsFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(refundColumns).
+					AddRow(testID1, testPaymentID, entity.RefundStatusSucceeded.String(), "500.00", nil, "fake_re_1", testCreatedAt, testUpdatedAt).
+					AddRow(testID2, testPaymentID, entity.RefundStatusPending.String(), "250.00", nil, nil, testCreatedAt, testUpdatedAt)
+				mock.ExpectQuery(selectColumns + ` ORDER BY refunds\.created_at ASC`).
+					WillReturnRows(rows)
+			},
+			expectedRefunds: &entity.Refunds{
+				{ID: testID1, PaymentID: testPaymentID, Status: entity.RefundStatusSucceeded},
+				{ID: testID2, PaymentID: testPaymentID, Status: entity.RefundStatusPending},
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful retrieval with payment and status filters",
+			filter: repository.FindAllRefundsFilter{
+				PaymentID: &testPaymentID,
+				Status:    pointer.ToPointer(entity.RefundStatusPending),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(refundColumns).
+					AddRow(testID2, testPaymentID, entity.RefundStatusPending.String(), "250.00", nil, nil, testCreatedAt, testUpdatedAt)
+				mock.ExpectQuery(selectColumns+` WHERE \( \(refunds\.payment_id = \$1\) AND \(refunds\.status = \$2::text\) \) ORDER BY refunds\.created_at ASC`).
+					WithArgs(testPaymentID, entity.RefundStatusPending.String()).
+					WillReturnRows(rows)
+			},
+			expectedRefunds: &entity.Refunds{
+				{ID: testID2, PaymentID: testPaymentID, Status: entity.RefundStatusPending},
+			},
+			expectedError: false,
+		},
+		{
+			name: "no refunds found",
+			filter: repository.FindAllRefundsFilter{
+				PaymentID: &testPaymentID,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectColumns + ` WHERE \(refunds\.payment_id = \$1\) ORDER BY refunds\.created_at ASC`).
+					WithArgs(testPaymentID).
+					WillReturnRows(sqlmock.NewRows(refundColumns))
+			},
+			expectedRefunds: &entity.Refunds{},
+			expectedError:   false,
+		},
+		{
+			name:   "database error",
+			filter: repository.FindAllRefundsFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectColumns + ` ORDER BY refunds\.created_at ASC`).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedRefunds: nil,
+			expectedError:   true,
+			errorType:       &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			refunds, err := h.Repository.FindAll(context.Background(), tt.filter)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository refund/find_all FindAll]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, refunds)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, refunds)
+				require.Len(t, *refunds, len(*tt.expectedRefunds))
+				for i, expected := range *tt.expectedRefunds {
+					assert.Equal(t, expected.ID, (*refunds)[i].ID)
+					assert.Equal(t, expected.PaymentID, (*refunds)[i].PaymentID)
+					assert.Equal(t, expected.Status, (*refunds)[i].Status)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package refundrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type refundRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewRefundRepository(execer db.SqlExecer) repository.RefundRepository {
+	return &refundRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *refundRepositoryImpl) WithTx(tx db.SqlExecer) repository.RefundRepository {
+	return &refundRepositoryImpl{execer: tx}
+}
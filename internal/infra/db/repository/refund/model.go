@@ -0,0 +1,43 @@
+package refundrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type Refund struct {
+	model.Refunds
+}
+
+func (r *Refund) ToEntity() *entity.Refund {
+	refundStatus, err := new(entity.RefundStatus).Parse(r.Status)
+	if err != nil {
+		return nil
+	}
+	return &entity.Refund{
+		ID:                r.ID,
+		PaymentID:         r.PaymentID,
+		Status:            refundStatus,
+		Amount:            r.Amount,
+		Reason:            r.Reason,
+		ProviderReference: r.ProviderReference,
+		CreatedAt:         r.CreatedAt,
+		UpdatedAt:         r.UpdatedAt,
+	}
+}
+
+type Refunds []Refund
+
+func (rs Refunds) ToEntities() *entity.Refunds {
+	refunds := make(entity.Refunds, 0, len(rs))
+	for _, r := range rs {
+		refund := r.ToEntity()
+		if refund == nil {
+			continue
+		}
+		refunds = append(refunds, pointer.GetValue(refund))
+	}
+	return pointer.ToPointer(refunds)
+}
@@ -0,0 +1,123 @@
+package refundrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	refundrepo "ticket-reservation/internal/infra/db/repository/refund"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestRefund_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testAmount := decimal.RequireFromString("500.00")
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 2, 10, 0, 1, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          refundrepo.Refund
+		expectedEntity *entity.Refund
+		expectedNil    bool
+	}{
+		{
+			name: "successful conversion with succeeded status",
+			input: refundrepo.Refund{
+				Refunds: model.Refunds{
+					ID:                testID,
+					PaymentID:         testPaymentID,
+					Status:            entity.RefundStatusSucceeded.String(),
+					Amount:            testAmount,
+					Reason:            pointer.ToPointer("concert cancelled"),
+					ProviderReference: pointer.ToPointer("fake_re_123"),
+					CreatedAt:         testCreatedAt,
+					UpdatedAt:         testUpdatedAt,
+				},
+			},
+			expectedEntity: &entity.Refund{
+				ID:                testID,
+				PaymentID:         testPaymentID,
+				Status:            entity.RefundStatusSucceeded,
+				Amount:            testAmount,
+				Reason:            pointer.ToPointer("concert cancelled"),
+				ProviderReference: pointer.ToPointer("fake_re_123"),
+				CreatedAt:         testCreatedAt,
+				UpdatedAt:         testUpdatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "successful conversion of a pending refund without reason",
+			input: refundrepo.Refund{
+				Refunds: model.Refunds{
+					ID:        testID,
+					PaymentID: testPaymentID,
+					Status:    entity.RefundStatusPending.String(),
+					Amount:    testAmount,
+					CreatedAt: testCreatedAt,
+					UpdatedAt: testUpdatedAt,
+				},
+			},
+			expectedEntity: &entity.Refund{
+				ID:        testID,
+				PaymentID: testPaymentID,
+				Status:    entity.RefundStatusPending,
+				Amount:    testAmount,
+				CreatedAt: testCreatedAt,
+				UpdatedAt: testUpdatedAt,
+			},
+			expectedNil: false,
+		},
+		{
+			name: "invalid status returns nil",
+			input: refundrepo.Refund{
+				Refunds: model.Refunds{
+					ID:        testID,
+					PaymentID: testPaymentID,
+					Status:    "invalid_status",
+					Amount:    testAmount,
+				},
+			},
+			expectedNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.input.ToEntity()
+			if tt.expectedNil {
+				assert.Nil(t, result)
+			} else {
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
+
+func TestRefunds_ToEntities(t *testing.T) {
+	testPaymentID := uuid.New()
+	testAmount := decimal.RequireFromString("500.00")
+
+	input := refundrepo.Refunds{
+		{Refunds: model.Refunds{ID: uuid.New(), PaymentID: testPaymentID, Status: entity.RefundStatusSucceeded.String(), Amount: testAmount}},
+		{Refunds: model.Refunds{ID: uuid.New(), PaymentID: testPaymentID, Status: "invalid_status", Amount: testAmount}},
+		{Refunds: model.Refunds{ID: uuid.New(), PaymentID: testPaymentID, Status: entity.RefundStatusFailed.String(), Amount: testAmount}},
+	}
+
+	// Execute
+	result := input.ToEntities()
+
+	// Assert, refunds with an invalid status are skipped
+	assert.NotNil(t, result)
+	assert.Len(t, *result, 2)
+	assert.Equal(t, entity.RefundStatusSucceeded, (*result)[0].Status)
+	assert.Equal(t, entity.RefundStatusFailed, (*result)[1].Status)
+}
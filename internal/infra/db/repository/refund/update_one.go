@@ -0,0 +1,61 @@
+package refundrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *refundRepositoryImpl) UpdateOne(ctx context.Context, input repository.UpdateRefundInput) (refund *entity.Refund, err error) {
+	const errLocation = "[repository refund/update_one UpdateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	refundsTable := table.Refunds
+
+	var updateModel Refund
+	columns := make(postgres.ColumnList, 0)
+
+	// build the update model
+	if input.Status != nil {
+		updateModel.Status = input.Status.String()
+		columns = append(columns, refundsTable.Status)
+	}
+	if input.ProviderReference != nil {
+		updateModel.ProviderReference = input.ProviderReference
+		columns = append(columns, refundsTable.ProviderReference)
+	}
+	if len(columns) == 0 {
+		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+	}
+
+	// SQL statement
+	stmt := refundsTable.
+		UPDATE(columns).
+		MODEL(updateModel).
+		WHERE(refundsTable.ID.EQ(postgres.UUID(input.ID))).
+		RETURNING(refundsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Refund
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("refund not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating refund", err.Error()))
+	}
+
+	refund = model.ToEntity()
+	if refund == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert refund model to entity", nil)
+	}
+
+	return
+}
@@ -0,0 +1,160 @@
+package refundrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestRefundRepositoryImpl_UpdateOne(t *testing.T) {
+	testID := uuid.New()
+	testPaymentID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 2, 10, 0, 1, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          repository.UpdateRefundInput
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedRefund *entity.Refund
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "successful update to succeeded",
+			input: repository.UpdateRefundInput{
+				ID:                testID,
+				Status:            pointer.ToPointer(entity.RefundStatusSucceeded),
+				ProviderReference: pointer.ToPointer("fake_re_123"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(refundColumns).AddRow(
+					testID, testPaymentID, entity.RefundStatusSucceeded.String(), "500.00",
+					nil, "fake_re_123", testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.refunds SET \(status, provider_reference\) = \(\$1, \$2\) WHERE refunds\.id = \$3 RETURNING `+refundReturningColumns).
+					WithArgs(entity.RefundStatusSucceeded.String(), "fake_re_123", testID).
+					WillReturnRows(rows)
+			},
+			expectedRefund: &entity.Refund{
+				ID:                testID,
+				PaymentID:         testPaymentID,
+				Status:            entity.RefundStatusSucceeded,
+				ProviderReference: pointer.ToPointer("fake_re_123"),
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful update to failed",
+			input: repository.UpdateRefundInput{
+				ID:     testID,
+				Status: pointer.ToPointer(entity.RefundStatusFailed),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(refundColumns).AddRow(
+					testID, testPaymentID, entity.RefundStatusFailed.String(), "500.00",
+					nil, nil, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.refunds SET status = \$1 WHERE refunds\.id = \$2 RETURNING `+refundReturningColumns).
+					WithArgs(entity.RefundStatusFailed.String(), testID).
+					WillReturnRows(rows)
+			},
+			expectedRefund: &entity.Refund{
+				ID:        testID,
+				PaymentID: testPaymentID,
+				Status:    entity.RefundStatusFailed,
+			},
+			expectedError: false,
+		},
+		{
+			name: "no fields provided to update",
+			input: repository.UpdateRefundInput{
+				ID: testID,
+			},
+			setupMock:      func(mock sqlmock.Sqlmock) {},
+			expectedRefund: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.BadRequestError{},
+		},
+		{
+			name: "refund not found",
+			input: repository.UpdateRefundInput{
+				ID:     testID,
+				Status: pointer.ToPointer(entity.RefundStatusFailed),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.refunds SET status = \$1 WHERE refunds\.id = \$2 RETURNING `+refundReturningColumns).
+					WithArgs(entity.RefundStatusFailed.String(), testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedRefund: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.UpdateRefundInput{
+				ID:     testID,
+				Status: pointer.ToPointer(entity.RefundStatusFailed),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.refunds SET status = \$1 WHERE refunds\.id = \$2 RETURNING `+refundReturningColumns).
+					WithArgs(entity.RefundStatusFailed.String(), testID).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedRefund: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			refund, err := h.Repository.UpdateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository refund/update_one UpdateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, refund)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, refund)
+				assert.Equal(t, tt.expectedRefund.ID, refund.ID)
+				assert.Equal(t, tt.expectedRefund.PaymentID, refund.PaymentID)
+				assert.Equal(t, tt.expectedRefund.Status, refund.Status)
+				assert.Equal(t, tt.expectedRefund.ProviderReference, refund.ProviderReference)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
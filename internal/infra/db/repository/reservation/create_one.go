@@ -19,8 +19,11 @@ func (r *reservationRepositoryImpl) CreateOne(ctx context.Context, input *entity
 		reservationsTable.AllColumns.Except(reservationsTable.DefaultColumns), // Exclude columns with default values
 	).MODEL(model.Reservations{
 		SeatID:     input.SeatID,
+		GroupID:    input.GroupID,
 		SessionID:  input.SessionID,
 		Status:     input.Status.String(),
+		Price:      input.Price,
+		Currency:   input.Currency,
 		ReservedAt: input.ReservedAt,
 		ExpiresAt:  input.ExpiresAt,
 	}).RETURNING(reservationsTable.AllColumns)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -26,11 +27,15 @@ func TestReservationRepositoryImpl_CreateOne(t *testing.T) {
 	testExpiresAt := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
 	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
 	testUpdatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testPrice := decimal.RequireFromString("1500.00")
+	testCurrency := "THB"
 
 	inputReservation := &entity.Reservation{
 		SeatID:     testSeatID,
 		SessionID:  testSessionID,
 		Status:     testStatus,
+		Price:      &testPrice,
+		Currency:   &testCurrency,
 		ReservedAt: testReservedAt,
 		ExpiresAt:  testExpiresAt,
 	}
@@ -50,14 +55,16 @@ func TestReservationRepositoryImpl_CreateOne(t *testing.T) {
 				rows := sqlmock.NewRows([]string{
 					"reservations.id", "reservations.seat_id", "reservations.session_id",
 					"reservations.status", "reservations.reserved_at", "reservations.expires_at",
-					"reservations.created_at", "reservations.updated_at",
+					"reservations.created_at", "reservations.updated_at", "reservations.group_id",
+					"reservations.price", "reservations.currency",
 				}).AddRow(
 					testID, testSeatID, testSessionID, testStatus.String(),
-					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
+					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt, nil,
+					"1500.00", testCurrency,
 				)
 
-				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
-					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt).
+				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at, group_id, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
+					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt, nil, testPrice, testCurrency).
 					WillReturnRows(rows)
 			},
 			expectedReservation: &entity.Reservation{
@@ -65,6 +72,8 @@ func TestReservationRepositoryImpl_CreateOne(t *testing.T) {
 				SeatID:     testSeatID,
 				SessionID:  testSessionID,
 				Status:     testStatus,
+				Price:      &testPrice,
+				Currency:   &testCurrency,
 				ReservedAt: testReservedAt,
 				ExpiresAt:  testExpiresAt,
 				CreatedAt:  testCreatedAt,
@@ -76,8 +85,8 @@ func TestReservationRepositoryImpl_CreateOne(t *testing.T) {
 			name:  "database connection error",
 			input: inputReservation,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
-					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt).
+				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at, group_id, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
+					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt, nil, testPrice, testCurrency).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedReservation: nil,
@@ -88,8 +97,8 @@ func TestReservationRepositoryImpl_CreateOne(t *testing.T) {
 			name:  "constraint violation error",
 			input: inputReservation,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
-					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt).
+				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at, group_id, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
+					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt, nil, testPrice, testCurrency).
 					WillReturnError(errors.New("duplicate key value violates unique constraint"))
 			},
 			expectedReservation: nil,
@@ -100,8 +109,8 @@ func TestReservationRepositoryImpl_CreateOne(t *testing.T) {
 			name:  "database timeout error",
 			input: inputReservation,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
-					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt).
+				mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at, group_id, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
+					WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt, nil, testPrice, testCurrency).
 					WillReturnError(context.DeadlineExceeded)
 			},
 			expectedReservation: nil,
@@ -184,10 +193,10 @@ func TestReservationRepositoryImpl_CreateOne_QueryValidation(t *testing.T) {
 	)
 
 	// The query should exclude default columns and return all columns
-	expectedQuery := `INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`
+	expectedQuery := `INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at, group_id, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`
 
 	h.Mock.ExpectQuery(expectedQuery).
-		WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt).
+		WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt, nil, nil, nil).
 		WillReturnRows(rows)
 
 	ctx := context.Background()
@@ -238,8 +247,8 @@ func TestReservationRepositoryImpl_CreateOne_ModelConversionError(t *testing.T)
 		testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 	)
 
-	h.Mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
-		WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt).
+	h.Mock.ExpectQuery(`INSERT INTO public\.reservations \(seat_id, session_id, status, expires_at, group_id, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
+		WithArgs(testSeatID, testSessionID, testStatus.String(), testExpiresAt, nil, nil, nil).
 		WillReturnRows(rows)
 
 	ctx := context.Background()
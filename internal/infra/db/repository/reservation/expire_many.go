@@ -0,0 +1,52 @@
+package reservationrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *reservationRepositoryImpl) ExpireMany(ctx context.Context, input repository.ExpireManyReservationsInput) (reservations *entity.Reservations, err error) {
+	const errLocation = "[repository reservation/expire_many ExpireMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	reservationsTable := table.Reservations
+
+	// Pick the stale pending reservations, skipping rows held by concurrent transactions
+	expiredReservations := postgres.SELECT(
+		reservationsTable.ID,
+	).FROM(
+		reservationsTable,
+	).WHERE(
+		reservationsTable.Status.EQ(postgres.String(entity.ReservationStatusPending.String())).
+			AND(reservationsTable.ExpiresAt.LT(postgres.TimestampzT(input.ExpiresBefore))),
+	).ORDER_BY(
+		reservationsTable.ExpiresAt.ASC(),
+	).LIMIT(
+		input.Limit,
+	).FOR(
+		postgres.UPDATE().SKIP_LOCKED(),
+	).AsTable("expired_reservations")
+
+	// SQL statement
+	stmt := reservationsTable.
+		UPDATE(reservationsTable.Status).
+		SET(postgres.String(entity.ReservationStatusExpired.String())).
+		FROM(expiredReservations).
+		WHERE(reservationsTable.ID.EQ(reservationsTable.ID.From(expiredReservations))).
+		RETURNING(reservationsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var models Reservations
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while expiring reservations", err.Error()))
+	}
+
+	reservations = models.ToEntities()
+	return reservations, nil
+}
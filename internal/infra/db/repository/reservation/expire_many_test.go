@@ -0,0 +1,116 @@
+package reservationrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestReservationRepositoryImpl_ExpireMany(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testSeatID1 := uuid.New()
+	testSeatID2 := uuid.New()
+	testNow := time.Date(2025, 1, 1, 10, 10, 0, 0, time.UTC)
+	testReservedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testExpiresAt := time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC)
+
+	reservationColumns := []string{
+		"reservations.id", "reservations.seat_id", "reservations.session_id",
+		"reservations.status", "reservations.reserved_at", "reservations.expires_at",
+		"reservations.created_at", "reservations.updated_at",
+	}
+	expectedQuery := `UPDATE public\.reservations SET status = \$1::text FROM \( SELECT reservations\.id AS "reservations\.id" FROM public\.reservations WHERE \(reservations\.status = \$2::text\) AND \(reservations\.expires_at < \$3::timestamp with time zone\) ORDER BY reservations\.expires_at ASC LIMIT \$4 FOR UPDATE SKIP LOCKED \) AS expired_reservations WHERE reservations\.id = expired_reservations\."reservations\.id" RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`
+
+	input := repository.ExpireManyReservationsInput{
+		ExpiresBefore: testNow,
+		Limit:         100,
+	}
+
+	tests := []struct {
+		name                 string
+		setupMock            func(mock sqlmock.Sqlmock)
+		expectedReservations *entity.Reservations
+		expectedError        bool
+		errorType            error
+	}{
+		{
+			name: "successful expiration",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reservationColumns).
+					AddRow(testID1, testSeatID1, "session-1", entity.ReservationStatusExpired.String(), testReservedAt, testExpiresAt, testReservedAt, testNow).
+					AddRow(testID2, testSeatID2, "session-2", entity.ReservationStatusExpired.String(), testReservedAt, testExpiresAt, testReservedAt, testNow)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("expired", "pending", testNow, int64(100)).
+					WillReturnRows(rows)
+			},
+			expectedReservations: &entity.Reservations{
+				{ID: testID1, SeatID: testSeatID1, SessionID: "session-1", Status: entity.ReservationStatusExpired, ReservedAt: testReservedAt, ExpiresAt: testExpiresAt, CreatedAt: testReservedAt, UpdatedAt: testNow},
+				{ID: testID2, SeatID: testSeatID2, SessionID: "session-2", Status: entity.ReservationStatusExpired, ReservedAt: testReservedAt, ExpiresAt: testExpiresAt, CreatedAt: testReservedAt, UpdatedAt: testNow},
+			},
+			expectedError: false,
+		},
+		{
+			name: "nothing to expire",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("expired", "pending", testNow, int64(100)).
+					WillReturnRows(sqlmock.NewRows(reservationColumns))
+			},
+			expectedReservations: &entity.Reservations{},
+			expectedError:        false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedReservations: nil,
+			expectedError:        true,
+			errorType:            &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			reservations, err := h.Repository.ExpireMany(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository reservation/expire_many ExpireMany]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, reservations)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedReservations, reservations)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -61,7 +61,7 @@ func TestReservationRepositoryImpl_FindAll(t *testing.T) {
 					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at" FROM public\.reservations`).
+				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency" FROM public\.reservations`).
 					WillReturnRows(dataRows)
 			},
 			expectedReservations: &entity.Reservations{
@@ -115,7 +115,7 @@ func TestReservationRepositoryImpl_FindAll(t *testing.T) {
 					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at" FROM public\.reservations WHERE \( \(reservations\.seat_id = \$1\) AND \(reservations\.session_id = \$2::text\) AND \(reservations\.status = \$3::text\) \) LIMIT \$4 OFFSET \$5`).
+				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency" FROM public\.reservations WHERE \( \(reservations\.seat_id = \$1\) AND \(reservations\.session_id = \$2::text\) AND \(reservations\.status = \$3::text\) \) LIMIT \$4 OFFSET \$5`).
 					WithArgs(testSeatID1, testSessionID, testStatus.String(), int64(10), int64(0)).
 					WillReturnRows(dataRows)
 			},
@@ -156,7 +156,7 @@ func TestReservationRepositoryImpl_FindAll(t *testing.T) {
 					WillReturnRows(countRows)
 
 				// Data query fails
-				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at" FROM public\.reservations`).
+				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency" FROM public\.reservations`).
 					WillReturnError(context.DeadlineExceeded)
 			},
 			expectedReservations: nil,
@@ -180,7 +180,7 @@ func TestReservationRepositoryImpl_FindAll(t *testing.T) {
 					"reservations.created_at", "reservations.updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at" FROM public\.reservations`).
+				mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency" FROM public\.reservations`).
 					WillReturnRows(dataRows)
 			},
 			expectedReservations: &entity.Reservations{},
@@ -264,7 +264,7 @@ func TestReservationRepositoryImpl_FindAll_ModelConversionError(t *testing.T) {
 		testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 	)
 
-	h.Mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at" FROM public\.reservations`).
+	h.Mock.ExpectQuery(`SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency" FROM public\.reservations`).
 		WillReturnRows(dataRows)
 
 	ctx := context.Background()
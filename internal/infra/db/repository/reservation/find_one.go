@@ -0,0 +1,47 @@
+package reservationrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *reservationRepositoryImpl) FindOne(ctx context.Context, id uuid.UUID) (reservation *entity.Reservation, err error) {
+	const errLocation = "[repository reservation/find_one FindOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	reservationsTable := table.Reservations
+	// SQL statement
+	stmt := postgres.SELECT(
+		reservationsTable.AllColumns,
+	).FROM(
+		reservationsTable,
+	).WHERE(
+		reservationsTable.ID.EQ(postgres.UUID(id)),
+	).FOR(
+		postgres.UPDATE(),
+	)
+
+	query, args := stmt.Sql()
+
+	var model Reservation
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("reservation not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting reservation", err.Error()))
+	}
+
+	reservation = model.ToEntity()
+	if reservation == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert reservation model to entity", nil)
+	}
+
+	return
+}
@@ -0,0 +1,153 @@
+package reservationrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestReservationRepositoryImpl_FindOne(t *testing.T) {
+	testID := uuid.New()
+	testSeatID := uuid.New()
+	testSessionID := "session-123"
+	testReservedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testExpiresAt := time.Date(2025, 1, 1, 10, 5, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency" FROM public\.reservations WHERE reservations\.id = \$1 FOR UPDATE`
+
+	tests := []struct {
+		name                string
+		reservationID       uuid.UUID
+		setupMock           func(mock sqlmock.Sqlmock, id uuid.UUID)
+		expectedReservation *entity.Reservation
+		expectedError       bool
+		errorType           error
+	}{
+		{
+			name:          "successful reservation retrieval",
+			reservationID: testID,
+			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
+				rows := sqlmock.NewRows([]string{
+					"reservations.id", "reservations.seat_id", "reservations.session_id",
+					"reservations.status", "reservations.reserved_at", "reservations.expires_at",
+					"reservations.created_at", "reservations.updated_at",
+				}).AddRow(
+					id, testSeatID, testSessionID, entity.ReservationStatusPending.String(),
+					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(id).
+					WillReturnRows(rows)
+			},
+			expectedReservation: &entity.Reservation{
+				ID:         testID,
+				SeatID:     testSeatID,
+				SessionID:  testSessionID,
+				Status:     entity.ReservationStatusPending,
+				ReservedAt: testReservedAt,
+				ExpiresAt:  testExpiresAt,
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testUpdatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name:          "reservation not found",
+			reservationID: testID,
+			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(id).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedReservation: nil,
+			expectedError:       true,
+			errorType:           &errsFramework.NotFoundError{},
+		},
+		{
+			name:          "invalid status in database",
+			reservationID: testID,
+			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
+				rows := sqlmock.NewRows([]string{
+					"reservations.id", "reservations.seat_id", "reservations.session_id",
+					"reservations.status", "reservations.reserved_at", "reservations.expires_at",
+					"reservations.created_at", "reservations.updated_at",
+				}).AddRow(
+					id, testSeatID, testSessionID, "unknown",
+					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(id).
+					WillReturnRows(rows)
+			},
+			expectedReservation: nil,
+			expectedError:       true,
+			errorType:           &errsFramework.InternalServerError{},
+		},
+		{
+			name:          "database connection error",
+			reservationID: testID,
+			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(id).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedReservation: nil,
+			expectedError:       true,
+			errorType:           &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock, tt.reservationID)
+			reservation, err := h.Repository.FindOne(context.Background(), tt.reservationID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository reservation/find_one FindOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, reservation)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, reservation)
+				assert.Equal(t, tt.expectedReservation.ID, reservation.ID)
+				assert.Equal(t, tt.expectedReservation.SeatID, reservation.SeatID)
+				assert.Equal(t, tt.expectedReservation.SessionID, reservation.SessionID)
+				assert.Equal(t, tt.expectedReservation.Status, reservation.Status)
+				assert.Equal(t, tt.expectedReservation.ReservedAt.UTC(), reservation.ReservedAt.UTC())
+				assert.Equal(t, tt.expectedReservation.ExpiresAt.UTC(), reservation.ExpiresAt.UTC())
+				assert.Equal(t, tt.expectedReservation.CreatedAt.UTC(), reservation.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedReservation.UpdatedAt.UTC(), reservation.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
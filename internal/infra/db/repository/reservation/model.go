@@ -19,8 +19,11 @@ func (r *Reservation) ToEntity() *entity.Reservation {
 	return &entity.Reservation{
 		ID:         r.ID,
 		SeatID:     r.SeatID,
+		GroupID:    r.GroupID,
 		SessionID:  r.SessionID,
 		Status:     reservationStatus,
+		Price:      r.Price,
+		Currency:   r.Currency,
 		ReservedAt: r.ReservedAt,
 		ExpiresAt:  r.ExpiresAt,
 		CreatedAt:  r.CreatedAt,
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -56,6 +57,36 @@ func TestReservation_ToEntity(t *testing.T) {
 			},
 			expectedNil: false,
 		},
+		{
+			name: "successful conversion with price snapshot",
+			input: reservationrepo.Reservation{
+				Reservations: model.Reservations{
+					ID:         testID,
+					SeatID:     testSeatID,
+					SessionID:  testSessionID,
+					Status:     entity.ReservationStatusPending.String(),
+					ReservedAt: testReservedAt,
+					ExpiresAt:  testExpiresAt,
+					CreatedAt:  testCreatedAt,
+					UpdatedAt:  testUpdatedAt,
+					Price:      pointer.ToPointer(decimal.RequireFromString("1500.00")),
+					Currency:   pointer.ToPointer("THB"),
+				},
+			},
+			expectedEntity: &entity.Reservation{
+				ID:         testID,
+				SeatID:     testSeatID,
+				SessionID:  testSessionID,
+				Status:     entity.ReservationStatusPending,
+				ReservedAt: testReservedAt,
+				ExpiresAt:  testExpiresAt,
+				Price:      pointer.ToPointer(decimal.RequireFromString("1500.00")),
+				Currency:   pointer.ToPointer("THB"),
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testUpdatedAt,
+			},
+			expectedNil: false,
+		},
 		{
 			name: "successful conversion with confirmed status",
 			input: reservationrepo.Reservation{
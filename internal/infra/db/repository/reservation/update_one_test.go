@@ -52,7 +52,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(entity.ReservationStatusConfirmed.String(), testID).
 					WillReturnRows(rows)
 			},
@@ -84,7 +84,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.reservations SET expires_at = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET expires_at = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(testExpiresAt, testID).
 					WillReturnRows(rows)
 			},
@@ -117,7 +117,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 					testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.reservations SET \(status, expires_at\) = \(\$1, \$2\) WHERE reservations\.id = \$3 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET \(status, expires_at\) = \(\$1, \$2\) WHERE reservations\.id = \$3 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(entity.ReservationStatusConfirmed.String(), testExpiresAt, testID).
 					WillReturnRows(rows)
 			},
@@ -140,7 +140,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(entity.ReservationStatusConfirmed.String(), testID).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -155,7 +155,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(entity.ReservationStatusConfirmed.String(), testID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -170,7 +170,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(entity.ReservationStatusConfirmed.String(), testID).
 					WillReturnError(context.DeadlineExceeded)
 			},
@@ -185,7 +185,7 @@ func TestReservationRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 					WithArgs(entity.ReservationStatusConfirmed.String(), testID).
 					WillReturnError(errors.New("database connection failed"))
 			},
@@ -296,7 +296,7 @@ func TestReservationRepositoryImpl_UpdateOne_ModelConversionError(t *testing.T)
 		testReservedAt, testExpiresAt, testCreatedAt, testUpdatedAt,
 	)
 
-	h.Mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at"`).
+	h.Mock.ExpectQuery(`UPDATE public\.reservations SET status = \$1 WHERE reservations\.id = \$2 RETURNING reservations\.id AS "reservations\.id", reservations\.seat_id AS "reservations\.seat_id", reservations\.session_id AS "reservations\.session_id", reservations\.status AS "reservations\.status", reservations\.reserved_at AS "reservations\.reserved_at", reservations\.expires_at AS "reservations\.expires_at", reservations\.created_at AS "reservations\.created_at", reservations\.updated_at AS "reservations\.updated_at", reservations\.group_id AS "reservations\.group_id", reservations\.price AS "reservations\.price", reservations\.currency AS "reservations\.currency"`).
 		WithArgs(entity.ReservationStatusConfirmed.String(), testID).
 		WillReturnRows(rows)
 
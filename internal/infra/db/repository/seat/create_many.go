@@ -0,0 +1,48 @@
+package seatrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *seatRepositoryImpl) CreateMany(ctx context.Context, input entity.Seats) (seats *entity.Seats, err error) {
+	const errLocation = "[repository seat/create_many CreateMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(input) == 0 {
+		return &entity.Seats{}, nil
+	}
+
+	models := make([]model.Seats, 0, len(input))
+	for _, seat := range input {
+		models = append(models, model.Seats{
+			ZoneID:            seat.ZoneID,
+			SeatNumber:        seat.SeatNumber,
+			Status:            seat.Status.String(),
+			LockedUntil:       seat.LockedUntil,
+			LockedBySessionID: seat.LockedBySessionID,
+			Price:             seat.Price,
+			Position:          toInt32(seat.Position),
+		})
+	}
+
+	seatsTable := table.Seats
+	// SQL statement (a single multi-row INSERT)
+	stmt := seatsTable.INSERT(
+		seatsTable.AllColumns.Except(seatsTable.DefaultColumns), // Exclude columns with default values
+	).MODELS(models).RETURNING(seatsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var created Seats
+	if err := r.execer.SelectContext(ctx, &created, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating seats", err.Error()))
+	}
+
+	seats = created.ToEntities()
+	return seats, nil
+}
@@ -0,0 +1,118 @@
+package seatrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatRepositoryImpl_CreateMany(t *testing.T) {
+	testZoneID := uuid.New()
+	testSeatID1 := uuid.New()
+	testSeatID2 := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testPrice := decimal.RequireFromString("2500.00")
+
+	seatColumns := []string{
+		"seats.id", "seats.zone_id", "seats.seat_number", "seats.status",
+		"seats.locked_until", "seats.locked_by_session_id",
+		"seats.created_at", "seats.updated_at", "seats.price", "seats.position",
+	}
+	expectedQuery := `INSERT INTO public\.seats \(zone_id, seat_number, status, locked_until, locked_by_session_id, price, position\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\), \(\$8, \$9, \$10, \$11, \$12, \$13, \$14\) RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`
+
+	input := entity.Seats{
+		{ZoneID: testZoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable, Position: pointer.ToPointer(1)},
+		{ZoneID: testZoneID, SeatNumber: "A2", Status: entity.SeatStatusAvailable, Price: &testPrice, Position: pointer.ToPointer(3)},
+	}
+
+	tests := []struct {
+		name          string
+		input         entity.Seats
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedSeats *entity.Seats
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:  "successful creation",
+			input: input,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(seatColumns).
+					AddRow(testSeatID1, testZoneID, "A1", entity.SeatStatusAvailable.String(), nil, nil, testCreatedAt, testCreatedAt, nil, 1).
+					AddRow(testSeatID2, testZoneID, "A2", entity.SeatStatusAvailable.String(), nil, nil, testCreatedAt, testCreatedAt, "2500.00", 3)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(
+						testZoneID, "A1", "available", nil, nil, nil, int32(1),
+						testZoneID, "A2", "available", nil, nil, testPrice, int32(3),
+					).
+					WillReturnRows(rows)
+			},
+			expectedSeats: &entity.Seats{
+				{ID: testSeatID1, ZoneID: testZoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable, Position: pointer.ToPointer(1), CreatedAt: testCreatedAt, UpdatedAt: testCreatedAt},
+				{ID: testSeatID2, ZoneID: testZoneID, SeatNumber: "A2", Status: entity.SeatStatusAvailable, Price: &testPrice, Position: pointer.ToPointer(3), CreatedAt: testCreatedAt, UpdatedAt: testCreatedAt},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "empty input does not hit the database",
+			input:         entity.Seats{},
+			setupMock:     func(mock sqlmock.Sqlmock) {},
+			expectedSeats: &entity.Seats{},
+			expectedError: false,
+		},
+		{
+			name:  "unique constraint violation",
+			input: input,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "seats_zone_id_seat_number_key"`))
+			},
+			expectedSeats: nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			seats, err := h.Repository.CreateMany(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository seat/create_many CreateMany]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, seats)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedSeats, seats)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package seatrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *seatRepositoryImpl) FindAllByZone(ctx context.Context, zoneID uuid.UUID) (seats *entity.Seats, err error) {
+	const errLocation = "[repository seat/find_all_by_zone FindAllByZone] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	seatsTable := table.Seats
+	// SQL statement
+	stmt := postgres.SELECT(
+		seatsTable.AllColumns,
+	).FROM(
+		seatsTable,
+	).WHERE(
+		seatsTable.ZoneID.EQ(postgres.UUID(zoneID)),
+	).ORDER_BY(
+		seatsTable.SeatNumber.ASC(),
+	)
+
+	query, args := stmt.Sql()
+
+	var models Seats
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while querying seats", err.Error()))
+	}
+
+	seats = models.ToEntities()
+	return seats, nil
+}
@@ -0,0 +1,49 @@
+package seatrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *seatRepositoryImpl) FindMany(ctx context.Context, ids []uuid.UUID) (seats *entity.Seats, err error) {
+	const errLocation = "[repository seat/find_many FindMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(ids) == 0 {
+		return &entity.Seats{}, nil
+	}
+
+	seatIDs := make([]postgres.Expression, 0, len(ids))
+	for _, id := range ids {
+		seatIDs = append(seatIDs, postgres.UUID(id))
+	}
+
+	seatsTable := table.Seats
+	// SQL statement; rows are locked in id order so that concurrent callers cannot deadlock
+	stmt := postgres.SELECT(
+		seatsTable.AllColumns,
+	).FROM(
+		seatsTable,
+	).WHERE(
+		seatsTable.ID.IN(seatIDs...),
+	).ORDER_BY(
+		seatsTable.ID.ASC(),
+	).FOR(
+		postgres.UPDATE(),
+	)
+
+	query, args := stmt.Sql()
+
+	var models Seats
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while querying seats", err.Error()))
+	}
+
+	seats = models.ToEntities()
+	return seats, nil
+}
@@ -0,0 +1,150 @@
+package seatrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestSeatRepositoryImpl_FindMany(t *testing.T) {
+	testZoneID := uuid.New()
+	testSeatID1 := uuid.New()
+	testSeatID2 := uuid.New()
+	testSessionID := "session-123"
+	testLockedUntil := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	seatColumns := []string{
+		"seats.id", "seats.zone_id", "seats.seat_number", "seats.status",
+		"seats.locked_until", "seats.locked_by_session_id",
+		"seats.created_at", "seats.updated_at",
+	}
+	expectedQuery := `SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id IN \(\$1, \$2\) ORDER BY seats\.id ASC FOR UPDATE`
+
+	tests := []struct {
+		name          string
+		ids           []uuid.UUID
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedSeats *entity.Seats
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful retrieval",
+			ids:  []uuid.UUID{testSeatID1, testSeatID2},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(seatColumns).
+					AddRow(testSeatID1, testZoneID, "A1", entity.SeatStatusAvailable.String(), nil, nil, testCreatedAt, testUpdatedAt).
+					AddRow(testSeatID2, testZoneID, "A2", entity.SeatStatusPending.String(), testLockedUntil, testSessionID, testCreatedAt, testUpdatedAt)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testSeatID1, testSeatID2).
+					WillReturnRows(rows)
+			},
+			expectedSeats: &entity.Seats{
+				{
+					ID:         testSeatID1,
+					ZoneID:     testZoneID,
+					SeatNumber: "A1",
+					Status:     entity.SeatStatusAvailable,
+					CreatedAt:  testCreatedAt,
+					UpdatedAt:  testUpdatedAt,
+				},
+				{
+					ID:                testSeatID2,
+					ZoneID:            testZoneID,
+					SeatNumber:        "A2",
+					Status:            entity.SeatStatusPending,
+					LockedUntil:       &testLockedUntil,
+					LockedBySessionID: &testSessionID,
+					CreatedAt:         testCreatedAt,
+					UpdatedAt:         testUpdatedAt,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "missing seats are not returned",
+			ids:  []uuid.UUID{testSeatID1, testSeatID2},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(seatColumns).
+					AddRow(testSeatID1, testZoneID, "A1", entity.SeatStatusAvailable.String(), nil, nil, testCreatedAt, testUpdatedAt)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testSeatID1, testSeatID2).
+					WillReturnRows(rows)
+			},
+			expectedSeats: &entity.Seats{
+				{
+					ID:         testSeatID1,
+					ZoneID:     testZoneID,
+					SeatNumber: "A1",
+					Status:     entity.SeatStatusAvailable,
+					CreatedAt:  testCreatedAt,
+					UpdatedAt:  testUpdatedAt,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "no ids skips the query",
+			ids:           []uuid.UUID{},
+			setupMock:     func(mock sqlmock.Sqlmock) {},
+			expectedSeats: &entity.Seats{},
+			expectedError: false,
+		},
+		{
+			name: "database error",
+			ids:  []uuid.UUID{testSeatID1, testSeatID2},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testSeatID1, testSeatID2).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedSeats: nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			seats, err := h.Repository.FindMany(context.Background(), tt.ids)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository seat/find_many FindMany]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, seats)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, seats)
+				assert.Equal(t, tt.expectedSeats, seats)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -47,7 +47,7 @@ func TestSeatRepositoryImpl_FindOne(t *testing.T) {
 					nil, nil, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -76,7 +76,7 @@ func TestSeatRepositoryImpl_FindOne(t *testing.T) {
 					testLockedUntil, testSessionID, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -96,7 +96,7 @@ func TestSeatRepositoryImpl_FindOne(t *testing.T) {
 			name:   "seat not found",
 			seatID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -108,7 +108,7 @@ func TestSeatRepositoryImpl_FindOne(t *testing.T) {
 			name:   "database connection error",
 			seatID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -120,7 +120,7 @@ func TestSeatRepositoryImpl_FindOne(t *testing.T) {
 			name:   "database timeout error",
 			seatID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnError(context.DeadlineExceeded)
 			},
@@ -132,7 +132,7 @@ func TestSeatRepositoryImpl_FindOne(t *testing.T) {
 			name:   "generic database error",
 			seatID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnError(errors.New("database connection failed"))
 			},
@@ -218,7 +218,7 @@ func TestSeatRepositoryImpl_FindOne_QueryValidation(t *testing.T) {
 	)
 
 	// The query should include all columns, FOR UPDATE clause, and proper WHERE clause
-	expectedQuery := `SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`
+	expectedQuery := `SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`
 
 	h.Mock.ExpectQuery(expectedQuery).
 		WithArgs(testID).
@@ -261,7 +261,7 @@ func TestSeatRepositoryImpl_FindOne_ModelConversionError(t *testing.T) {
 		nil, nil, testCreatedAt, testUpdatedAt,
 	)
 
-	h.Mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
+	h.Mock.ExpectQuery(`SELECT seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position" FROM public\.seats WHERE seats\.id = \$1 FOR UPDATE`).
 		WithArgs(testID).
 		WillReturnRows(rows)
 
@@ -23,6 +23,8 @@ func (s *Seat) ToEntity() *entity.Seat {
 		Status:            seatStatus,
 		LockedUntil:       s.LockedUntil,
 		LockedBySessionID: s.LockedBySessionID,
+		Price:             s.Price,
+		Position:          toInt(s.Position),
 		CreatedAt:         s.CreatedAt,
 		UpdatedAt:         s.UpdatedAt,
 	}
@@ -41,3 +43,19 @@ func (ss Seats) ToEntities() *entity.Seats {
 	}
 	return pointer.ToPointer(seats)
 }
+
+func toInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+func toInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -56,6 +57,30 @@ func TestSeat_ToEntity(t *testing.T) {
 			},
 			expectedNil: false,
 		},
+		{
+			name: "successful conversion with price override",
+			input: seatrepo.Seat{
+				Seats: model.Seats{
+					ID:         testID,
+					ZoneID:     testZoneID,
+					SeatNumber: testSeatNumber,
+					Status:     entity.SeatStatusAvailable.String(),
+					CreatedAt:  testCreatedAt,
+					UpdatedAt:  testUpdatedAt,
+					Price:      pointer.ToPointer(decimal.RequireFromString("2500.00")),
+				},
+			},
+			expectedEntity: &entity.Seat{
+				ID:         testID,
+				ZoneID:     testZoneID,
+				SeatNumber: testSeatNumber,
+				Status:     entity.SeatStatusAvailable,
+				Price:      pointer.ToPointer(decimal.RequireFromString("2500.00")),
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testUpdatedAt,
+			},
+			expectedNil: false,
+		},
 		{
 			name: "successful conversion with pending status",
 			input: seatrepo.Seat{
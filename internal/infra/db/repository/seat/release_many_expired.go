@@ -0,0 +1,52 @@
+package seatrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *seatRepositoryImpl) ReleaseManyExpired(ctx context.Context, input repository.ReleaseManyExpiredSeatsInput) (seats *entity.Seats, err error) {
+	const errLocation = "[repository seat/release_many_expired ReleaseManyExpired] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	seatsTable := table.Seats
+
+	// Pick the pending seats whose lock has passed, skipping rows held by concurrent transactions
+	expiredSeats := postgres.SELECT(
+		seatsTable.ID,
+	).FROM(
+		seatsTable,
+	).WHERE(
+		seatsTable.Status.EQ(postgres.String(entity.SeatStatusPending.String())).
+			AND(seatsTable.LockedUntil.LT(postgres.TimestampzT(input.LockedBefore))),
+	).ORDER_BY(
+		seatsTable.LockedUntil.ASC(),
+	).LIMIT(
+		input.Limit,
+	).FOR(
+		postgres.UPDATE().SKIP_LOCKED(),
+	).AsTable("expired_seats")
+
+	// SQL statement
+	stmt := seatsTable.
+		UPDATE(seatsTable.Status, seatsTable.LockedUntil, seatsTable.LockedBySessionID).
+		SET(postgres.String(entity.SeatStatusAvailable.String()), postgres.NULL, postgres.NULL).
+		FROM(expiredSeats).
+		WHERE(seatsTable.ID.EQ(seatsTable.ID.From(expiredSeats))).
+		RETURNING(seatsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var models Seats
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while releasing expired seats", err.Error()))
+	}
+
+	seats = models.ToEntities()
+	return seats, nil
+}
@@ -0,0 +1,114 @@
+package seatrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestSeatRepositoryImpl_ReleaseManyExpired(t *testing.T) {
+	testZoneID := uuid.New()
+	testSeatID1 := uuid.New()
+	testSeatID2 := uuid.New()
+	testNow := time.Date(2025, 1, 1, 10, 10, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	seatColumns := []string{
+		"seats.id", "seats.zone_id", "seats.seat_number", "seats.status",
+		"seats.locked_until", "seats.locked_by_session_id",
+		"seats.created_at", "seats.updated_at",
+	}
+	expectedQuery := `UPDATE public\.seats SET \(status, locked_until, locked_by_session_id\) = \(\$1::text, NULL, NULL\) FROM \( SELECT seats\.id AS "seats\.id" FROM public\.seats WHERE \(seats\.status = \$2::text\) AND \(seats\.locked_until < \$3::timestamp with time zone\) ORDER BY seats\.locked_until ASC LIMIT \$4 FOR UPDATE SKIP LOCKED \) AS expired_seats WHERE seats\.id = expired_seats\."seats\.id" RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`
+
+	input := repository.ReleaseManyExpiredSeatsInput{
+		LockedBefore: testNow,
+		Limit:        100,
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedSeats *entity.Seats
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful release",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(seatColumns).
+					AddRow(testSeatID1, testZoneID, "A1", entity.SeatStatusAvailable.String(), nil, nil, testCreatedAt, testNow).
+					AddRow(testSeatID2, testZoneID, "A2", entity.SeatStatusAvailable.String(), nil, nil, testCreatedAt, testNow)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("available", "pending", testNow, int64(100)).
+					WillReturnRows(rows)
+			},
+			expectedSeats: &entity.Seats{
+				{ID: testSeatID1, ZoneID: testZoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable, CreatedAt: testCreatedAt, UpdatedAt: testNow},
+				{ID: testSeatID2, ZoneID: testZoneID, SeatNumber: "A2", Status: entity.SeatStatusAvailable, CreatedAt: testCreatedAt, UpdatedAt: testNow},
+			},
+			expectedError: false,
+		},
+		{
+			name: "nothing to release",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("available", "pending", testNow, int64(100)).
+					WillReturnRows(sqlmock.NewRows(seatColumns))
+			},
+			expectedSeats: &entity.Seats{},
+			expectedError: false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedSeats: nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			seats, err := h.Repository.ReleaseManyExpired(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository seat/release_many_expired ReleaseManyExpired]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, seats)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedSeats, seats)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -26,13 +26,18 @@ func (r *seatRepositoryImpl) UpdateOne(ctx context.Context, input repository.Upd
 		updateModel.Status = input.Status.String()
 		columns = append(columns, seatsTable.Status)
 	}
-	if input.LockedUntil != nil {
-		updateModel.LockedUntil = input.LockedUntil
-		columns = append(columns, seatsTable.LockedUntil)
-	}
-	if input.LockedBySessionID != nil {
-		updateModel.LockedBySessionID = input.LockedBySessionID
-		columns = append(columns, seatsTable.LockedBySessionID)
+	if input.ClearLock {
+		// Leave the model fields nil so both lock columns are set to NULL
+		columns = append(columns, seatsTable.LockedUntil, seatsTable.LockedBySessionID)
+	} else {
+		if input.LockedUntil != nil {
+			updateModel.LockedUntil = input.LockedUntil
+			columns = append(columns, seatsTable.LockedUntil)
+		}
+		if input.LockedBySessionID != nil {
+			updateModel.LockedBySessionID = input.LockedBySessionID
+			columns = append(columns, seatsTable.LockedBySessionID)
+		}
 	}
 	if len(columns) == 0 {
 		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
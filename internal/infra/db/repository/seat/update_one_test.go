@@ -52,7 +52,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 					nil, nil, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(entity.SeatStatusPending.String(), testID).
 					WillReturnRows(rows)
 			},
@@ -84,7 +84,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 					testLockedUntil, nil, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.seats SET locked_until = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET locked_until = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(testLockedUntil, testID).
 					WillReturnRows(rows)
 			},
@@ -116,7 +116,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 					nil, testSessionID, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.seats SET locked_by_session_id = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET locked_by_session_id = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(testSessionID, testID).
 					WillReturnRows(rows)
 			},
@@ -150,7 +150,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 					testLockedUntil, testSessionID, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`UPDATE public\.seats SET \(status, locked_until, locked_by_session_id\) = \(\$1, \$2, \$3\) WHERE seats\.id = \$4 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET \(status, locked_until, locked_by_session_id\) = \(\$1, \$2, \$3\) WHERE seats\.id = \$4 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(entity.SeatStatusPending.String(), testLockedUntil, testSessionID, testID).
 					WillReturnRows(rows)
 			},
@@ -166,6 +166,41 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "successful status update with lock cleared",
+			input: repository.UpdateSeatInput{
+				ID:                testID,
+				Status:            pointer.ToPointer(entity.SeatStatusAvailable),
+				LockedUntil:       &testLockedUntil,
+				LockedBySessionID: &testSessionID,
+				ClearLock:         true,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{
+					"seats.id", "seats.zone_id", "seats.seat_number", "seats.status",
+					"seats.locked_until", "seats.locked_by_session_id",
+					"seats.created_at", "seats.updated_at",
+				}).AddRow(
+					testID, testZoneID, testSeatNumber, entity.SeatStatusAvailable.String(),
+					nil, nil, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.seats SET \(status, locked_until, locked_by_session_id\) = \(\$1, \$2, \$3\) WHERE seats\.id = \$4 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
+					WithArgs(entity.SeatStatusAvailable.String(), nil, nil, testID).
+					WillReturnRows(rows)
+			},
+			expectedSeat: &entity.Seat{
+				ID:                testID,
+				ZoneID:            testZoneID,
+				SeatNumber:        testSeatNumber,
+				Status:            entity.SeatStatusAvailable,
+				LockedUntil:       nil,
+				LockedBySessionID: nil,
+				CreatedAt:         testCreatedAt,
+				UpdatedAt:         testUpdatedAt,
+			},
+			expectedError: false,
+		},
 		{
 			name: "seat not found",
 			input: repository.UpdateSeatInput{
@@ -173,7 +208,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.SeatStatusPending),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(entity.SeatStatusPending.String(), testID).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -188,7 +223,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.SeatStatusPending),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(entity.SeatStatusPending.String(), testID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -203,7 +238,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.SeatStatusPending),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(entity.SeatStatusPending.String(), testID).
 					WillReturnError(context.DeadlineExceeded)
 			},
@@ -218,7 +253,7 @@ func TestSeatRepositoryImpl_UpdateOne(t *testing.T) {
 				Status: pointer.ToPointer(entity.SeatStatusPending),
 			},
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+				mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 					WithArgs(entity.SeatStatusPending.String(), testID).
 					WillReturnError(errors.New("database connection failed"))
 			},
@@ -336,7 +371,7 @@ func TestSeatRepositoryImpl_UpdateOne_ModelConversionError(t *testing.T) {
 		nil, nil, testCreatedAt, testUpdatedAt,
 	)
 
-	h.Mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at"`).
+	h.Mock.ExpectQuery(`UPDATE public\.seats SET status = \$1 WHERE seats\.id = \$2 RETURNING seats\.id AS "seats\.id", seats\.zone_id AS "seats\.zone_id", seats\.seat_number AS "seats\.seat_number", seats\.status AS "seats\.status", seats\.locked_until AS "seats\.locked_until", seats\.locked_by_session_id AS "seats\.locked_by_session_id", seats\.created_at AS "seats\.created_at", seats\.updated_at AS "seats\.updated_at", seats\.price AS "seats\.price", seats\.position AS "seats\.position"`).
 		WithArgs(entity.SeatStatusPending.String(), testID).
 		WillReturnRows(rows)
 
@@ -0,0 +1,42 @@
+package ticketrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketRepositoryImpl) CreateOne(ctx context.Context, input *entity.Ticket) (ticket *entity.Ticket, err error) {
+	const errLocation = "[repository ticket/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	ticketsTable := table.Tickets
+	// SQL statement, the ID is given because it is signed into the payload
+	stmt := ticketsTable.INSERT(
+		ticketsTable.AllColumns.Except(ticketsTable.CreatedAt),
+	).MODEL(model.Tickets{
+		ID:            input.ID,
+		ReservationID: input.ReservationID,
+		ConcertID:     input.ConcertID,
+		ZoneID:        input.ZoneID,
+		SeatID:        input.SeatID,
+		ZoneName:      input.ZoneName,
+		SeatNumber:    input.SeatNumber,
+		Holder:        input.Holder,
+		Payload:       input.Payload,
+		IssuedAt:      input.IssuedAt,
+	}).RETURNING(ticketsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Ticket
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating ticket", err.Error()))
+	}
+
+	ticket = model.ToEntity()
+	return ticket, nil
+}
@@ -0,0 +1,124 @@
+package ticketrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var ticketColumns = []string{
+	"tickets.id", "tickets.reservation_id", "tickets.concert_id", "tickets.zone_id", "tickets.seat_id",
+	"tickets.zone_name", "tickets.seat_number", "tickets.holder", "tickets.payload", "tickets.issued_at", "tickets.created_at",
+}
+
+const ticketReturningColumns = `tickets\.id AS "tickets\.id", tickets\.reservation_id AS "tickets\.reservation_id", tickets\.concert_id AS "tickets\.concert_id", tickets\.zone_id AS "tickets\.zone_id", tickets\.seat_id AS "tickets\.seat_id", tickets\.zone_name AS "tickets\.zone_name", tickets\.seat_number AS "tickets\.seat_number", tickets\.holder AS "tickets\.holder", tickets\.payload AS "tickets\.payload", tickets\.issued_at AS "tickets\.issued_at", tickets\.created_at AS "tickets\.created_at"`
+
+func TestTicketRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testConcertID := uuid.New()
+	testZoneID := uuid.New()
+	testSeatID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+	testCreatedAt := time.Date(2025, 1, 1, 10, 3, 1, 0, time.UTC)
+
+	expectedQuery := `INSERT INTO public\.tickets \(id, reservation_id, concert_id, zone_id, seat_id, zone_name, seat_number, holder, payload, issued_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10\) RETURNING ` + ticketReturningColumns
+
+	input := &entity.Ticket{
+		ID:            testID,
+		ReservationID: testReservationID,
+		ConcertID:     testConcertID,
+		ZoneID:        testZoneID,
+		SeatID:        testSeatID,
+		ZoneName:      "VIP",
+		SeatNumber:    "A1",
+		Holder:        "2bb80d537b1da3e3",
+		Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+		IssuedAt:      testIssuedAt,
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedTicket *entity.Ticket
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "successful creation keeps the signed ID",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(ticketColumns).AddRow(
+					testID, testReservationID, testConcertID, testZoneID, testSeatID,
+					"VIP", "A1", "2bb80d537b1da3e3", "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl", testIssuedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID, testReservationID, testConcertID, testZoneID, testSeatID, "VIP", "A1", "2bb80d537b1da3e3", "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl", testIssuedAt).
+					WillReturnRows(rows)
+			},
+			expectedTicket: &entity.Ticket{
+				ID:            testID,
+				ReservationID: testReservationID,
+				ConcertID:     testConcertID,
+				ZoneID:        testZoneID,
+				SeatID:        testSeatID,
+				ZoneName:      "VIP",
+				SeatNumber:    "A1",
+				Holder:        "2bb80d537b1da3e3",
+				Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+				IssuedAt:      testIssuedAt,
+				CreatedAt:     testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			ticket, err := h.Repository.CreateOne(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, ticket)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTicket, ticket)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
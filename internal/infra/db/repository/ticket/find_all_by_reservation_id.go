@@ -0,0 +1,36 @@
+package ticketrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketRepositoryImpl) FindAllByReservationID(ctx context.Context, reservationID uuid.UUID) (tickets *entity.Tickets, err error) {
+	const errLocation = "[repository ticket/find_all_by_reservation_id FindAllByReservationID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	ticketsTable := table.Tickets
+	// SQL statement
+	stmt := postgres.SELECT(
+		ticketsTable.AllColumns,
+	).FROM(
+		ticketsTable,
+	).WHERE(
+		ticketsTable.ReservationID.EQ(postgres.UUID(reservationID)),
+	).ORDER_BY(ticketsTable.IssuedAt.ASC(), ticketsTable.SeatNumber.ASC())
+
+	query, args := stmt.Sql()
+
+	var models Tickets
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting tickets", err.Error()))
+	}
+
+	tickets = models.ToEntities()
+	return tickets, nil
+}
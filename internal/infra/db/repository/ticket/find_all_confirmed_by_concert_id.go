@@ -0,0 +1,40 @@
+package ticketrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketRepositoryImpl) FindAllConfirmedByConcertID(ctx context.Context, concertID uuid.UUID) (tickets *entity.Tickets, err error) {
+	const errLocation = "[repository ticket/find_all_confirmed_by_concert_id FindAllConfirmedByConcertID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	ticketsTable := table.Tickets
+	reservationsTable := table.Reservations
+	// SQL statement, the tickets of refunded reservations are left out since their reservation is cancelled
+	stmt := postgres.SELECT(
+		ticketsTable.AllColumns,
+	).FROM(
+		ticketsTable.INNER_JOIN(reservationsTable, reservationsTable.ID.EQ(ticketsTable.ReservationID)),
+	).WHERE(postgres.AND(
+		ticketsTable.ConcertID.EQ(postgres.UUID(concertID)),
+		reservationsTable.Status.EQ(postgres.String(entity.ReservationStatusConfirmed.String())),
+	)).ORDER_BY(
+		ticketsTable.ID.ASC(),
+	)
+
+	query, args := stmt.Sql()
+
+	var models Tickets
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting tickets", err.Error()))
+	}
+
+	tickets = models.ToEntities()
+	return tickets, nil
+}
@@ -0,0 +1,116 @@
+package ticketrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestTicketRepositoryImpl_FindAllConfirmedByConcertID(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testConcertID := uuid.New()
+	testZoneID := uuid.New()
+	testSeatID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + ticketReturningColumns + ` FROM public\.tickets INNER JOIN public\.reservations ON \(reservations\.id = tickets\.reservation_id\) WHERE \( \(tickets\.concert_id = \$1\) AND \(reservations\.status = \$2::text\) \) ORDER BY tickets\.id ASC`
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedTickets *entity.Tickets
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(ticketColumns).AddRow(
+					testID, testReservationID, testConcertID, testZoneID, testSeatID,
+					"VIP", "A1", "2bb80d537b1da3e3", "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl", testIssuedAt, testIssuedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testConcertID, "confirmed").
+					WillReturnRows(rows)
+			},
+			expectedTickets: &entity.Tickets{
+				{
+					ID:            testID,
+					ReservationID: testReservationID,
+					ConcertID:     testConcertID,
+					ZoneID:        testZoneID,
+					SeatID:        testSeatID,
+					ZoneName:      "VIP",
+					SeatNumber:    "A1",
+					Holder:        "2bb80d537b1da3e3",
+					Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+					IssuedAt:      testIssuedAt,
+					CreatedAt:     testIssuedAt,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "concert without tickets",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testConcertID, "confirmed").
+					WillReturnRows(sqlmock.NewRows(ticketColumns))
+			},
+			expectedTickets: &entity.Tickets{},
+			expectedError:   false,
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testConcertID, "confirmed").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			tickets, err := h.Repository.FindAllConfirmedByConcertID(context.Background(), testConcertID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket/find_all_confirmed_by_concert_id FindAllConfirmedByConcertID]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, tickets)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTickets, tickets)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ticketrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketRepositoryImpl) FindMany(ctx context.Context, ids []uuid.UUID) (tickets *entity.Tickets, err error) {
+	const errLocation = "[repository ticket/find_many FindMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(ids) == 0 {
+		return &entity.Tickets{}, nil
+	}
+
+	ticketIDs := make([]postgres.Expression, 0, len(ids))
+	for _, id := range ids {
+		ticketIDs = append(ticketIDs, postgres.UUID(id))
+	}
+
+	ticketsTable := table.Tickets
+	// SQL statement
+	stmt := postgres.SELECT(
+		ticketsTable.AllColumns,
+	).FROM(
+		ticketsTable,
+	).WHERE(
+		ticketsTable.ID.IN(ticketIDs...),
+	).ORDER_BY(
+		ticketsTable.ID.ASC(),
+	)
+
+	query, args := stmt.Sql()
+
+	var models Tickets
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting tickets", err.Error()))
+	}
+
+	tickets = models.ToEntities()
+	return tickets, nil
+}
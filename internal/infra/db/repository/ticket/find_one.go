@@ -0,0 +1,41 @@
+package ticketrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketRepositoryImpl) FindOne(ctx context.Context, id uuid.UUID) (ticket *entity.Ticket, err error) {
+	const errLocation = "[repository ticket/find_one FindOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	ticketsTable := table.Tickets
+	// SQL statement
+	stmt := postgres.SELECT(
+		ticketsTable.AllColumns,
+	).FROM(
+		ticketsTable,
+	).WHERE(
+		ticketsTable.ID.EQ(postgres.UUID(id)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model Ticket
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("ticket not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting ticket", err.Error()))
+	}
+
+	ticket = model.ToEntity()
+	return ticket, nil
+}
@@ -0,0 +1,114 @@
+package ticketrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestTicketRepositoryImpl_FindOne(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testConcertID := uuid.New()
+	testZoneID := uuid.New()
+	testSeatID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + ticketReturningColumns + ` FROM public\.tickets WHERE tickets\.id = \$1`
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		expectedTicket *entity.Ticket
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "successful ticket retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(ticketColumns).AddRow(
+					testID, testReservationID, testConcertID, testZoneID, testSeatID,
+					"VIP", "A1", "2bb80d537b1da3e3", "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl", testIssuedAt, testIssuedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnRows(rows)
+			},
+			expectedTicket: &entity.Ticket{
+				ID:            testID,
+				ReservationID: testReservationID,
+				ConcertID:     testConcertID,
+				ZoneID:        testZoneID,
+				SeatID:        testSeatID,
+				ZoneName:      "VIP",
+				SeatNumber:    "A1",
+				Holder:        "2bb80d537b1da3e3",
+				Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+				IssuedAt:      testIssuedAt,
+				CreatedAt:     testIssuedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "ticket not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			ticket, err := h.Repository.FindOne(context.Background(), testID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket/find_one FindOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, ticket)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTicket, ticket)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package ticketrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type ticketRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewTicketRepository(execer db.SqlExecer) repository.TicketRepository {
+	return &ticketRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *ticketRepositoryImpl) WithTx(tx db.SqlExecer) repository.TicketRepository {
+	return &ticketRepositoryImpl{execer: tx}
+}
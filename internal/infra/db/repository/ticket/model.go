@@ -0,0 +1,38 @@
+package ticketrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type Ticket struct {
+	model.Tickets
+}
+
+func (t *Ticket) ToEntity() *entity.Ticket {
+	return &entity.Ticket{
+		ID:            t.ID,
+		ReservationID: t.ReservationID,
+		ConcertID:     t.ConcertID,
+		ZoneID:        t.ZoneID,
+		SeatID:        t.SeatID,
+		ZoneName:      t.ZoneName,
+		SeatNumber:    t.SeatNumber,
+		Holder:        t.Holder,
+		Payload:       t.Payload,
+		IssuedAt:      t.IssuedAt,
+		CreatedAt:     t.CreatedAt,
+	}
+}
+
+type Tickets []Ticket
+
+func (ts Tickets) ToEntities() *entity.Tickets {
+	tickets := make(entity.Tickets, 0, len(ts))
+	for _, t := range ts {
+		tickets = append(tickets, pointer.GetValue(t.ToEntity()))
+	}
+	return pointer.ToPointer(tickets)
+}
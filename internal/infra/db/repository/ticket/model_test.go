@@ -0,0 +1,70 @@
+package ticketrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	ticketrepo "ticket-reservation/internal/infra/db/repository/ticket"
+)
+
+func TestTicket_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testReservationID := uuid.New()
+	testConcertID := uuid.New()
+	testZoneID := uuid.New()
+	testSeatID := uuid.New()
+	testIssuedAt := time.Date(2025, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	input := ticketrepo.Ticket{
+		Tickets: model.Tickets{
+			ID:            testID,
+			ReservationID: testReservationID,
+			ConcertID:     testConcertID,
+			ZoneID:        testZoneID,
+			SeatID:        testSeatID,
+			ZoneName:      "VIP",
+			SeatNumber:    "A1",
+			Holder:        "2bb80d537b1da3e3",
+			Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+			IssuedAt:      testIssuedAt,
+			CreatedAt:     testIssuedAt,
+		},
+	}
+
+	// Execute
+	result := input.ToEntity()
+
+	// Assert
+	assert.Equal(t, &entity.Ticket{
+		ID:            testID,
+		ReservationID: testReservationID,
+		ConcertID:     testConcertID,
+		ZoneID:        testZoneID,
+		SeatID:        testSeatID,
+		ZoneName:      "VIP",
+		SeatNumber:    "A1",
+		Holder:        "2bb80d537b1da3e3",
+		Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+		IssuedAt:      testIssuedAt,
+		CreatedAt:     testIssuedAt,
+	}, result)
+}
+
+func TestTickets_ToEntities(t *testing.T) {
+	testID := uuid.New()
+
+	input := ticketrepo.Tickets{
+		{Tickets: model.Tickets{ID: testID, ZoneName: "VIP", SeatNumber: "A1"}},
+	}
+
+	// Execute
+	result := input.ToEntities()
+
+	// Assert
+	assert.Equal(t, &entity.Tickets{{ID: testID, ZoneName: "VIP", SeatNumber: "A1"}}, result)
+}
@@ -0,0 +1,44 @@
+package ticketcheckinrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketCheckinRepositoryImpl) CreateOne(ctx context.Context, input *entity.TicketCheckin) (checkin *entity.TicketCheckin, err error) {
+	const errLocation = "[repository ticket_checkin/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	checkinsTable := table.TicketCheckins
+	// SQL statement, a ticket that was already admitted inserts nothing and returns no row
+	stmt := checkinsTable.INSERT(
+		checkinsTable.AllColumns.Except(checkinsTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.TicketCheckins{
+		TicketID:   input.TicketID,
+		ConcertID:  input.ConcertID,
+		Gate:       input.Gate,
+		ScannerID:  input.ScannerID,
+		AdmittedAt: input.AdmittedAt,
+	}).ON_CONFLICT(
+		checkinsTable.TicketID,
+	).DO_NOTHING().RETURNING(checkinsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model TicketCheckin
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewConflictError("ticket already admitted", map[string]string{"ticket_id": input.TicketID.String()})
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating ticket checkin", err.Error()))
+	}
+
+	checkin = model.ToEntity()
+	return checkin, nil
+}
@@ -0,0 +1,121 @@
+package ticketcheckinrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var checkinColumns = []string{
+	"ticket_checkins.id", "ticket_checkins.ticket_id", "ticket_checkins.concert_id", "ticket_checkins.gate",
+	"ticket_checkins.scanner_id", "ticket_checkins.admitted_at", "ticket_checkins.created_at",
+}
+
+const checkinReturningColumns = `ticket_checkins\.id AS "ticket_checkins\.id", ticket_checkins\.ticket_id AS "ticket_checkins\.ticket_id", ticket_checkins\.concert_id AS "ticket_checkins\.concert_id", ticket_checkins\.gate AS "ticket_checkins\.gate", ticket_checkins\.scanner_id AS "ticket_checkins\.scanner_id", ticket_checkins\.admitted_at AS "ticket_checkins\.admitted_at", ticket_checkins\.created_at AS "ticket_checkins\.created_at"`
+
+func TestTicketCheckinRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testTicketID := uuid.New()
+	testConcertID := uuid.New()
+	testAdmittedAt := time.Date(2025, 1, 1, 18, 3, 0, 0, time.UTC)
+
+	expectedQuery := `INSERT INTO public\.ticket_checkins \(ticket_id, concert_id, gate, scanner_id, admitted_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\) ON CONFLICT \(ticket_id\) DO NOTHING RETURNING ` + checkinReturningColumns
+
+	input := &entity.TicketCheckin{
+		TicketID:   testTicketID,
+		ConcertID:  testConcertID,
+		Gate:       "A",
+		ScannerID:  "gate-a-1",
+		AdmittedAt: testAdmittedAt,
+	}
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedCheckin *entity.TicketCheckin
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful admission",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(checkinColumns).AddRow(
+					testID, testTicketID, testConcertID, "A", "gate-a-1", testAdmittedAt, testAdmittedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testTicketID, testConcertID, "A", "gate-a-1", testAdmittedAt).
+					WillReturnRows(rows)
+			},
+			expectedCheckin: &entity.TicketCheckin{
+				ID:         testID,
+				TicketID:   testTicketID,
+				ConcertID:  testConcertID,
+				Gate:       "A",
+				ScannerID:  "gate-a-1",
+				AdmittedAt: testAdmittedAt,
+				CreatedAt:  testAdmittedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "ticket already admitted",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testTicketID, testConcertID, "A", "gate-a-1", testAdmittedAt).
+					WillReturnRows(sqlmock.NewRows(checkinColumns))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			checkin, err := h.Repository.CreateOne(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket_checkin/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, checkin)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCheckin, checkin)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
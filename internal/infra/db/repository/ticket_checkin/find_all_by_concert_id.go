@@ -0,0 +1,36 @@
+package ticketcheckinrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketCheckinRepositoryImpl) FindAllByConcertID(ctx context.Context, concertID uuid.UUID) (checkins *entity.TicketCheckins, err error) {
+	const errLocation = "[repository ticket_checkin/find_all_by_concert_id FindAllByConcertID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	checkinsTable := table.TicketCheckins
+	// SQL statement
+	stmt := postgres.SELECT(
+		checkinsTable.AllColumns,
+	).FROM(
+		checkinsTable,
+	).WHERE(
+		checkinsTable.ConcertID.EQ(postgres.UUID(concertID)),
+	).ORDER_BY(checkinsTable.AdmittedAt.ASC())
+
+	query, args := stmt.Sql()
+
+	var models TicketCheckins
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting ticket checkins", err.Error()))
+	}
+
+	checkins = models.ToEntities()
+	return checkins, nil
+}
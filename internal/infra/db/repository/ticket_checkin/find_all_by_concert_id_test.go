@@ -0,0 +1,109 @@
+package ticketcheckinrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestTicketCheckinRepositoryImpl_FindAllByConcertID(t *testing.T) {
+	testID := uuid.New()
+	testTicketID := uuid.New()
+	testConcertID := uuid.New()
+	testAdmittedAt := time.Date(2025, 1, 1, 18, 3, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + checkinReturningColumns + ` FROM public\.ticket_checkins WHERE ticket_checkins\.concert_id = \$1 ORDER BY ticket_checkins\.admitted_at ASC`
+
+	tests := []struct {
+		name             string
+		setupMock        func(mock sqlmock.Sqlmock)
+		expectedCheckins *entity.TicketCheckins
+		expectedError    bool
+		errorType        error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(checkinColumns).AddRow(
+					testID, testTicketID, testConcertID, "A", "gate-a-1", testAdmittedAt, testAdmittedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testConcertID).
+					WillReturnRows(rows)
+			},
+			expectedCheckins: &entity.TicketCheckins{
+				{
+					ID:         testID,
+					TicketID:   testTicketID,
+					ConcertID:  testConcertID,
+					Gate:       "A",
+					ScannerID:  "gate-a-1",
+					AdmittedAt: testAdmittedAt,
+					CreatedAt:  testAdmittedAt,
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "concert without checkins",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testConcertID).
+					WillReturnRows(sqlmock.NewRows(checkinColumns))
+			},
+			expectedCheckins: &entity.TicketCheckins{},
+			expectedError:    false,
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testConcertID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			checkins, err := h.Repository.FindAllByConcertID(context.Background(), testConcertID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket_checkin/find_all_by_concert_id FindAllByConcertID]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, checkins)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCheckins, checkins)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
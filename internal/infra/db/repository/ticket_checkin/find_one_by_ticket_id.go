@@ -0,0 +1,41 @@
+package ticketcheckinrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketCheckinRepositoryImpl) FindOneByTicketID(ctx context.Context, ticketID uuid.UUID) (checkin *entity.TicketCheckin, err error) {
+	const errLocation = "[repository ticket_checkin/find_one_by_ticket_id FindOneByTicketID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	checkinsTable := table.TicketCheckins
+	// SQL statement
+	stmt := postgres.SELECT(
+		checkinsTable.AllColumns,
+	).FROM(
+		checkinsTable,
+	).WHERE(
+		checkinsTable.TicketID.EQ(postgres.UUID(ticketID)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model TicketCheckin
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("ticket checkin not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting ticket checkin", err.Error()))
+	}
+
+	checkin = model.ToEntity()
+	return checkin, nil
+}
@@ -0,0 +1,107 @@
+package ticketcheckinrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestTicketCheckinRepositoryImpl_FindOneByTicketID(t *testing.T) {
+	testID := uuid.New()
+	testTicketID := uuid.New()
+	testConcertID := uuid.New()
+	testAdmittedAt := time.Date(2025, 1, 1, 18, 3, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + checkinReturningColumns + ` FROM public\.ticket_checkins WHERE ticket_checkins\.ticket_id = \$1`
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedCheckin *entity.TicketCheckin
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(checkinColumns).AddRow(
+					testID, testTicketID, testConcertID, "A", "gate-a-1", testAdmittedAt, testAdmittedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testTicketID).
+					WillReturnRows(rows)
+			},
+			expectedCheckin: &entity.TicketCheckin{
+				ID:         testID,
+				TicketID:   testTicketID,
+				ConcertID:  testConcertID,
+				Gate:       "A",
+				ScannerID:  "gate-a-1",
+				AdmittedAt: testAdmittedAt,
+				CreatedAt:  testAdmittedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "ticket not admitted",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testTicketID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testTicketID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			checkin, err := h.Repository.FindOneByTicketID(context.Background(), testTicketID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket_checkin/find_one_by_ticket_id FindOneByTicketID]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, checkin)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCheckin, checkin)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package ticketcheckinrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type ticketCheckinRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewTicketCheckinRepository(execer db.SqlExecer) repository.TicketCheckinRepository {
+	return &ticketCheckinRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *ticketCheckinRepositoryImpl) WithTx(tx db.SqlExecer) repository.TicketCheckinRepository {
+	return &ticketCheckinRepositoryImpl{execer: tx}
+}
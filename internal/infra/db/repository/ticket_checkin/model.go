@@ -0,0 +1,34 @@
+package ticketcheckinrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type TicketCheckin struct {
+	model.TicketCheckins
+}
+
+func (c *TicketCheckin) ToEntity() *entity.TicketCheckin {
+	return &entity.TicketCheckin{
+		ID:         c.ID,
+		TicketID:   c.TicketID,
+		ConcertID:  c.ConcertID,
+		Gate:       c.Gate,
+		ScannerID:  c.ScannerID,
+		AdmittedAt: c.AdmittedAt,
+		CreatedAt:  c.CreatedAt,
+	}
+}
+
+type TicketCheckins []TicketCheckin
+
+func (cs TicketCheckins) ToEntities() *entity.TicketCheckins {
+	checkins := make(entity.TicketCheckins, 0, len(cs))
+	for _, c := range cs {
+		checkins = append(checkins, pointer.GetValue(c.ToEntity()))
+	}
+	return pointer.ToPointer(checkins)
+}
@@ -0,0 +1,60 @@
+package ticketcheckinrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	ticketcheckinrepo "ticket-reservation/internal/infra/db/repository/ticket_checkin"
+)
+
+func TestTicketCheckin_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testTicketID := uuid.New()
+	testConcertID := uuid.New()
+	testAdmittedAt := time.Date(2025, 1, 1, 18, 3, 0, 0, time.UTC)
+
+	input := ticketcheckinrepo.TicketCheckin{
+		TicketCheckins: model.TicketCheckins{
+			ID:         testID,
+			TicketID:   testTicketID,
+			ConcertID:  testConcertID,
+			Gate:       "A",
+			ScannerID:  "gate-a-1",
+			AdmittedAt: testAdmittedAt,
+			CreatedAt:  testAdmittedAt,
+		},
+	}
+
+	// Execute
+	result := input.ToEntity()
+
+	// Assert
+	assert.Equal(t, &entity.TicketCheckin{
+		ID:         testID,
+		TicketID:   testTicketID,
+		ConcertID:  testConcertID,
+		Gate:       "A",
+		ScannerID:  "gate-a-1",
+		AdmittedAt: testAdmittedAt,
+		CreatedAt:  testAdmittedAt,
+	}, result)
+}
+
+func TestTicketCheckins_ToEntities(t *testing.T) {
+	testID := uuid.New()
+
+	input := ticketcheckinrepo.TicketCheckins{
+		{TicketCheckins: model.TicketCheckins{ID: testID, Gate: "A", ScannerID: "gate-a-1"}},
+	}
+
+	// Execute
+	result := input.ToEntities()
+
+	// Assert
+	assert.Equal(t, &entity.TicketCheckins{{ID: testID, Gate: "A", ScannerID: "gate-a-1"}}, result)
+}
@@ -0,0 +1,48 @@
+package ticketscanrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketScanRepositoryImpl) CreateMany(ctx context.Context, input entity.TicketScans) (scans *entity.TicketScans, err error) {
+	const errLocation = "[repository ticket_scan/create_many CreateMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(input) == 0 {
+		return &entity.TicketScans{}, nil
+	}
+
+	models := make([]model.TicketScans, 0, len(input))
+	for _, scan := range input {
+		models = append(models, model.TicketScans{
+			TicketID:  scan.TicketID,
+			ConcertID: scan.ConcertID,
+			Gate:      scan.Gate,
+			ScannerID: scan.ScannerID,
+			ScannedAt: scan.ScannedAt,
+		})
+	}
+
+	scansTable := table.TicketScans
+	// SQL statement (a single multi-row INSERT), scans uploaded before insert nothing and are not returned
+	stmt := scansTable.INSERT(
+		scansTable.AllColumns.Except(scansTable.DefaultColumns), // Exclude columns with default values
+	).MODELS(models).ON_CONFLICT(
+		scansTable.TicketID, scansTable.ScannerID, scansTable.ScannedAt,
+	).DO_NOTHING().RETURNING(scansTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var created TicketScans
+	if err := r.execer.SelectContext(ctx, &created, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating ticket scans", err.Error()))
+	}
+
+	scans = created.ToEntities()
+	return scans, nil
+}
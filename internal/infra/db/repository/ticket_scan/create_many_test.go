@@ -0,0 +1,117 @@
+package ticketscanrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var scanColumns = []string{
+	"ticket_scans.id", "ticket_scans.ticket_id", "ticket_scans.concert_id", "ticket_scans.gate",
+	"ticket_scans.scanner_id", "ticket_scans.scanned_at", "ticket_scans.uploaded_at",
+}
+
+const scanReturningColumns = `ticket_scans\.id AS "ticket_scans\.id", ticket_scans\.ticket_id AS "ticket_scans\.ticket_id", ticket_scans\.concert_id AS "ticket_scans\.concert_id", ticket_scans\.gate AS "ticket_scans\.gate", ticket_scans\.scanner_id AS "ticket_scans\.scanner_id", ticket_scans\.scanned_at AS "ticket_scans\.scanned_at", ticket_scans\.uploaded_at AS "ticket_scans\.uploaded_at"`
+
+func TestTicketScanRepositoryImpl_CreateMany(t *testing.T) {
+	testScanID := uuid.New()
+	testTicketID1 := uuid.New()
+	testTicketID2 := uuid.New()
+	testConcertID := uuid.New()
+	testScannedAt1 := time.Date(2025, 1, 1, 18, 3, 0, 0, time.UTC)
+	testScannedAt2 := time.Date(2025, 1, 1, 18, 4, 0, 0, time.UTC)
+	testUploadedAt := time.Date(2025, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	expectedQuery := `INSERT INTO public\.ticket_scans \(ticket_id, concert_id, gate, scanner_id, scanned_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\), \(\$6, \$7, \$8, \$9, \$10\) ON CONFLICT \(ticket_id, scanner_id, scanned_at\) DO NOTHING RETURNING ` + scanReturningColumns
+
+	input := entity.TicketScans{
+		{TicketID: testTicketID1, ConcertID: testConcertID, Gate: "A", ScannerID: "gate-a-1", ScannedAt: testScannedAt1},
+		{TicketID: testTicketID2, ConcertID: testConcertID, Gate: "A", ScannerID: "gate-a-1", ScannedAt: testScannedAt2},
+	}
+
+	tests := []struct {
+		name          string
+		input         entity.TicketScans
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedScans *entity.TicketScans
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:  "scans uploaded before are not returned",
+			input: input,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(scanColumns).
+					AddRow(testScanID, testTicketID2, testConcertID, "A", "gate-a-1", testScannedAt2, testUploadedAt)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(
+						testTicketID1, testConcertID, "A", "gate-a-1", testScannedAt1,
+						testTicketID2, testConcertID, "A", "gate-a-1", testScannedAt2,
+					).
+					WillReturnRows(rows)
+			},
+			expectedScans: &entity.TicketScans{
+				{ID: testScanID, TicketID: testTicketID2, ConcertID: testConcertID, Gate: "A", ScannerID: "gate-a-1", ScannedAt: testScannedAt2, UploadedAt: testUploadedAt},
+			},
+			expectedError: false,
+		},
+		{
+			name:          "empty input does not hit the database",
+			input:         entity.TicketScans{},
+			setupMock:     func(mock sqlmock.Sqlmock) {},
+			expectedScans: &entity.TicketScans{},
+			expectedError: false,
+		},
+		{
+			name:  "database connection error",
+			input: input,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			scans, err := h.Repository.CreateMany(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository ticket_scan/create_many CreateMany]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, scans)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedScans, scans)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package ticketscanrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *ticketScanRepositoryImpl) FindAllByConcertID(ctx context.Context, concertID uuid.UUID) (scans *entity.TicketScans, err error) {
+	const errLocation = "[repository ticket_scan/find_all_by_concert_id FindAllByConcertID] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	scansTable := table.TicketScans
+	// SQL statement
+	stmt := postgres.SELECT(
+		scansTable.AllColumns,
+	).FROM(
+		scansTable,
+	).WHERE(
+		scansTable.ConcertID.EQ(postgres.UUID(concertID)),
+	).ORDER_BY(scansTable.ScannedAt.ASC())
+
+	query, args := stmt.Sql()
+
+	var models TicketScans
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting ticket scans", err.Error()))
+	}
+
+	scans = models.ToEntities()
+	return scans, nil
+}
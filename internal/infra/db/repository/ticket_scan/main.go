@@ -0,0 +1,19 @@
+package ticketscanrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type ticketScanRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewTicketScanRepository(execer db.SqlExecer) repository.TicketScanRepository {
+	return &ticketScanRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *ticketScanRepositoryImpl) WithTx(tx db.SqlExecer) repository.TicketScanRepository {
+	return &ticketScanRepositoryImpl{execer: tx}
+}
@@ -0,0 +1,34 @@
+package ticketscanrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type TicketScan struct {
+	model.TicketScans
+}
+
+func (s *TicketScan) ToEntity() *entity.TicketScan {
+	return &entity.TicketScan{
+		ID:         s.ID,
+		TicketID:   s.TicketID,
+		ConcertID:  s.ConcertID,
+		Gate:       s.Gate,
+		ScannerID:  s.ScannerID,
+		ScannedAt:  s.ScannedAt,
+		UploadedAt: s.UploadedAt,
+	}
+}
+
+type TicketScans []TicketScan
+
+func (ss TicketScans) ToEntities() *entity.TicketScans {
+	scans := make(entity.TicketScans, 0, len(ss))
+	for _, s := range ss {
+		scans = append(scans, pointer.GetValue(s.ToEntity()))
+	}
+	return pointer.ToPointer(scans)
+}
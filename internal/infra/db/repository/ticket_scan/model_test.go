@@ -0,0 +1,61 @@
+package ticketscanrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	ticketscanrepo "ticket-reservation/internal/infra/db/repository/ticket_scan"
+)
+
+func TestTicketScan_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testTicketID := uuid.New()
+	testConcertID := uuid.New()
+	testScannedAt := time.Date(2025, 1, 1, 18, 3, 0, 0, time.UTC)
+	testUploadedAt := time.Date(2025, 1, 1, 23, 0, 0, 0, time.UTC)
+
+	input := ticketscanrepo.TicketScan{
+		TicketScans: model.TicketScans{
+			ID:         testID,
+			TicketID:   testTicketID,
+			ConcertID:  testConcertID,
+			Gate:       "A",
+			ScannerID:  "gate-a-1",
+			ScannedAt:  testScannedAt,
+			UploadedAt: testUploadedAt,
+		},
+	}
+
+	// Execute
+	result := input.ToEntity()
+
+	// Assert
+	assert.Equal(t, &entity.TicketScan{
+		ID:         testID,
+		TicketID:   testTicketID,
+		ConcertID:  testConcertID,
+		Gate:       "A",
+		ScannerID:  "gate-a-1",
+		ScannedAt:  testScannedAt,
+		UploadedAt: testUploadedAt,
+	}, result)
+}
+
+func TestTicketScans_ToEntities(t *testing.T) {
+	testID := uuid.New()
+
+	input := ticketscanrepo.TicketScans{
+		{TicketScans: model.TicketScans{ID: testID, Gate: "A", ScannerID: "gate-a-1"}},
+	}
+
+	// Execute
+	result := input.ToEntities()
+
+	// Assert
+	assert.Equal(t, &entity.TicketScans{{ID: testID, Gate: "A", ScannerID: "gate-a-1"}}, result)
+}
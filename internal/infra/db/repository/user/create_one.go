@@ -0,0 +1,44 @@
+package userrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *userRepositoryImpl) CreateOne(ctx context.Context, input *entity.User) (user *entity.User, err error) {
+	const errLocation = "[repository user/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	usersTable := table.Users
+	// SQL statement, a registered email inserts nothing and returns no row
+	stmt := usersTable.INSERT(
+		usersTable.AllColumns.Except(usersTable.DefaultColumns), // Exclude columns with default values
+	).MODEL(model.Users{
+		Email:        input.Email,
+		PasswordHash: input.PasswordHash,
+	}).ON_CONFLICT(
+		usersTable.Email,
+	).DO_NOTHING().RETURNING(usersTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model User
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewConflictError("email already registered", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating user", err.Error()))
+	}
+
+	user = model.ToEntity()
+	if user == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert user model to entity", nil)
+	}
+	return user, nil
+}
@@ -0,0 +1,114 @@
+package userrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var userColumns = []string{
+	"users.id", "users.email", "users.password_hash", "users.created_at", "users.updated_at", "users.role",
+}
+
+const userReturningColumns = `users\.id AS "users\.id", users\.email AS "users\.email", users\.password_hash AS "users\.password_hash", users\.created_at AS "users\.created_at", users\.updated_at AS "users\.updated_at", users\.role AS "users\.role"`
+
+func TestUserRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `INSERT INTO public\.users \(email, password_hash\) VALUES \(\$1, \$2\) ON CONFLICT \(email\) DO NOTHING RETURNING ` + userReturningColumns
+
+	input := &entity.User{
+		Email:        "fan@example.com",
+		PasswordHash: "$2a$10$hash",
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedUser  *entity.User
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful creation",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(userColumns).AddRow(
+					testID, "fan@example.com", "$2a$10$hash", testCreatedAt, testCreatedAt, "customer",
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fan@example.com", "$2a$10$hash").
+					WillReturnRows(rows)
+			},
+			expectedUser: &entity.User{
+				ID:           testID,
+				Email:        "fan@example.com",
+				PasswordHash: "$2a$10$hash",
+				Role:         entity.UserRoleCustomer,
+				CreatedAt:    testCreatedAt,
+				UpdatedAt:    testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "email already registered",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fan@example.com", "$2a$10$hash").
+					WillReturnRows(sqlmock.NewRows(userColumns))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			user, err := h.Repository.CreateOne(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository user/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, user)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
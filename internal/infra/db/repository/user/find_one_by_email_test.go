@@ -0,0 +1,104 @@
+package userrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestUserRepositoryImpl_FindOneByEmail(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + userReturningColumns + ` FROM public\.users WHERE users\.email = \$1::text`
+
+	tests := []struct {
+		name          string
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedUser  *entity.User
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(userColumns).AddRow(
+					testID, "fan@example.com", "$2a$10$hash", testCreatedAt, testCreatedAt, "customer",
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fan@example.com").
+					WillReturnRows(rows)
+			},
+			expectedUser: &entity.User{
+				ID:           testID,
+				Email:        "fan@example.com",
+				PasswordHash: "$2a$10$hash",
+				Role:         entity.UserRoleCustomer,
+				CreatedAt:    testCreatedAt,
+				UpdatedAt:    testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "email not registered",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fan@example.com").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("fan@example.com").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			user, err := h.Repository.FindOneByEmail(context.Background(), "fan@example.com")
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository user/find_one_by_email FindOneByEmail]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, user)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package userrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+)
+
+type User struct {
+	model.Users
+}
+
+func (u *User) ToEntity() *entity.User {
+	role, err := new(entity.UserRole).Parse(u.Role)
+	if err != nil {
+		return nil
+	}
+	return &entity.User{
+		ID:           u.ID,
+		Email:        u.Email,
+		PasswordHash: u.PasswordHash,
+		Role:         role,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}
+}
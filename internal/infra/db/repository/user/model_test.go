@@ -0,0 +1,70 @@
+package userrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	userrepo "ticket-reservation/internal/infra/db/repository/user"
+)
+
+func TestUser_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    userrepo.User
+		expected *entity.User
+	}{
+		{
+			name: "valid user",
+			input: userrepo.User{
+				Users: model.Users{
+					ID:           testID,
+					Email:        "fan@example.com",
+					PasswordHash: "$2a$10$hash",
+					Role:         "organizer",
+					CreatedAt:    testCreatedAt,
+					UpdatedAt:    testCreatedAt,
+				},
+			},
+			expected: &entity.User{
+				ID:           testID,
+				Email:        "fan@example.com",
+				PasswordHash: "$2a$10$hash",
+				Role:         entity.UserRoleOrganizer,
+				CreatedAt:    testCreatedAt,
+				UpdatedAt:    testCreatedAt,
+			},
+		},
+		{
+			name: "invalid role returns nil",
+			input: userrepo.User{
+				Users: model.Users{
+					ID:           testID,
+					Email:        "fan@example.com",
+					PasswordHash: "$2a$10$hash",
+					Role:         "invalid_role",
+					CreatedAt:    testCreatedAt,
+					UpdatedAt:    testCreatedAt,
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			result := tt.input.ToEntity()
+
+			// Assert
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package userrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *userRepositoryImpl) UpdateRole(ctx context.Context, id uuid.UUID, role entity.UserRole) (user *entity.User, err error) {
+	const errLocation = "[repository user/update_role UpdateRole] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	usersTable := table.Users
+	// SQL statement
+	stmt := usersTable.UPDATE(
+		usersTable.Role,
+	).MODEL(model.Users{
+		Role: role.String(),
+	}).WHERE(
+		usersTable.ID.EQ(postgres.UUID(id)),
+	).RETURNING(usersTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model User
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("user not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating user role", err.Error()))
+	}
+
+	user = model.ToEntity()
+	if user == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert user model to entity", nil)
+	}
+	return user, nil
+}
@@ -0,0 +1,52 @@
+package webhookdeliveryrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookDeliveryRepositoryImpl) ClaimMany(ctx context.Context, input repository.ClaimManyWebhookDeliveriesInput) (deliveries *entity.WebhookDeliveries, err error) {
+	const errLocation = "[repository webhook_delivery/claim_many ClaimMany] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	deliveriesTable := table.WebhookDeliveries
+
+	// Pick the due pending deliveries, skipping rows held by concurrent transactions
+	dueDeliveries := postgres.SELECT(
+		deliveriesTable.ID,
+	).FROM(
+		deliveriesTable,
+	).WHERE(
+		deliveriesTable.Status.EQ(postgres.String(entity.WebhookDeliveryStatusPending.String())).
+			AND(deliveriesTable.NextAttemptAt.LT_EQ(postgres.TimestampzT(input.DueBefore))),
+	).ORDER_BY(
+		deliveriesTable.NextAttemptAt.ASC(),
+	).LIMIT(
+		input.Limit,
+	).FOR(
+		postgres.UPDATE().SKIP_LOCKED(),
+	).AsTable("due_deliveries")
+
+	// SQL statement
+	stmt := deliveriesTable.
+		UPDATE(deliveriesTable.NextAttemptAt).
+		SET(postgres.TimestampzT(input.LeaseUntil)).
+		FROM(dueDeliveries).
+		WHERE(deliveriesTable.ID.EQ(deliveriesTable.ID.From(dueDeliveries))).
+		RETURNING(deliveriesTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var models WebhookDeliveries
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while claiming webhook deliveries", err.Error()))
+	}
+
+	deliveries = models.ToEntities()
+	return deliveries, nil
+}
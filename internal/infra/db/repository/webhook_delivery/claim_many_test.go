@@ -0,0 +1,127 @@
+package webhookdeliveryrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var webhookDeliveryColumns = []string{
+	"webhook_deliveries.id", "webhook_deliveries.subscription_id", "webhook_deliveries.event_id", "webhook_deliveries.event_type",
+	"webhook_deliveries.payload", "webhook_deliveries.status", "webhook_deliveries.attempts", "webhook_deliveries.next_attempt_at",
+	"webhook_deliveries.last_attempt_at", "webhook_deliveries.last_response_status", "webhook_deliveries.last_error",
+	"webhook_deliveries.delivered_at", "webhook_deliveries.created_at", "webhook_deliveries.updated_at",
+}
+
+const webhookDeliveryReturningColumns = `webhook_deliveries\.id AS "webhook_deliveries\.id", webhook_deliveries\.subscription_id AS "webhook_deliveries\.subscription_id", webhook_deliveries\.event_id AS "webhook_deliveries\.event_id", webhook_deliveries\.event_type AS "webhook_deliveries\.event_type", webhook_deliveries\.payload AS "webhook_deliveries\.payload", webhook_deliveries\.status AS "webhook_deliveries\.status", webhook_deliveries\.attempts AS "webhook_deliveries\.attempts", webhook_deliveries\.next_attempt_at AS "webhook_deliveries\.next_attempt_at", webhook_deliveries\.last_attempt_at AS "webhook_deliveries\.last_attempt_at", webhook_deliveries\.last_response_status AS "webhook_deliveries\.last_response_status", webhook_deliveries\.last_error AS "webhook_deliveries\.last_error", webhook_deliveries\.delivered_at AS "webhook_deliveries\.delivered_at", webhook_deliveries\.created_at AS "webhook_deliveries\.created_at", webhook_deliveries\.updated_at AS "webhook_deliveries\.updated_at"`
+
+func TestWebhookDeliveryRepositoryImpl_ClaimMany(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testSubscriptionID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testNow := time.Date(2025, 1, 1, 10, 1, 0, 0, time.UTC)
+	testLeaseUntil := testNow.Add(15 * time.Minute)
+
+	expectedQuery := `UPDATE public\.webhook_deliveries SET next_attempt_at = \$1::timestamp with time zone FROM \( SELECT webhook_deliveries\.id AS "webhook_deliveries\.id" FROM public\.webhook_deliveries WHERE \(webhook_deliveries\.status = \$2::text\) AND \(webhook_deliveries\.next_attempt_at <= \$3::timestamp with time zone\) ORDER BY webhook_deliveries\.next_attempt_at ASC LIMIT \$4 FOR UPDATE SKIP LOCKED \) AS due_deliveries WHERE webhook_deliveries\.id = due_deliveries\."webhook_deliveries\.id" RETURNING ` + webhookDeliveryReturningColumns
+
+	input := repository.ClaimManyWebhookDeliveriesInput{
+		DueBefore:  testNow,
+		LeaseUntil: testLeaseUntil,
+		Limit:      20,
+	}
+
+	tests := []struct {
+		name               string
+		setupMock          func(mock sqlmock.Sqlmock)
+		expectedDeliveries *entity.WebhookDeliveries
+		expectedError      bool
+		errorType          error
+	}{
+		{
+			name: "successful claim",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookDeliveryColumns).
+					AddRow(testID1, testSubscriptionID, testID1, "reservation.created", `{}`, "pending", 0, testLeaseUntil, nil, nil, nil, nil, testCreatedAt, testNow).
+					AddRow(testID2, testSubscriptionID, testID2, "reservation.expired", `{}`, "pending", 3, testLeaseUntil, testCreatedAt, 500, "unexpected response status 500", nil, testCreatedAt, testNow)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testLeaseUntil, "pending", testNow, int64(20)).
+					WillReturnRows(rows)
+			},
+			expectedDeliveries: &entity.WebhookDeliveries{
+				{ID: testID1, Attempts: 0, NextAttemptAt: testLeaseUntil},
+				{ID: testID2, Attempts: 3, NextAttemptAt: testLeaseUntil},
+			},
+			expectedError: false,
+		},
+		{
+			name: "no due deliveries",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testLeaseUntil, "pending", testNow, int64(20)).
+					WillReturnRows(sqlmock.NewRows(webhookDeliveryColumns))
+			},
+			expectedDeliveries: &entity.WebhookDeliveries{},
+			expectedError:      false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testLeaseUntil, "pending", testNow, int64(20)).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedDeliveries: nil,
+			expectedError:      true,
+			errorType:          &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			deliveries, err := h.Repository.ClaimMany(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_delivery/claim_many ClaimMany]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, deliveries)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, deliveries)
+				require.Len(t, *deliveries, len(*tt.expectedDeliveries))
+				for i, expected := range *tt.expectedDeliveries {
+					assert.Equal(t, expected.ID, (*deliveries)[i].ID)
+					assert.Equal(t, expected.Attempts, (*deliveries)[i].Attempts)
+					assert.Equal(t, expected.NextAttemptAt, (*deliveries)[i].NextAttemptAt)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package webhookdeliveryrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookDeliveryRepositoryImpl) CreateForEvents(ctx context.Context, events entity.WebhookEvents) (created int64, err error) {
+	const errLocation = "[repository webhook_delivery/create_for_events CreateForEvents] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	rows := make([]postgres.RowExpression, 0, len(events))
+	for _, event := range events {
+		payload, err := event.Payload()
+		if err != nil {
+			return 0, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to encode webhook event payload", nil))
+		}
+		rows = append(rows, postgres.WRAP(
+			postgres.CAST(postgres.UUID(event.ID)).AS("uuid"),
+			postgres.String(event.Type.String()),
+			postgres.String(string(payload)),
+		))
+	}
+
+	deliveriesTable := table.WebhookDeliveries
+	subscriptionsTable := table.WebhookSubscriptions
+
+	eventID := postgres.StringColumn("event_id")
+	eventType := postgres.StringColumn("event_type")
+	payload := postgres.StringColumn("payload")
+	eventsTable := postgres.VALUES(rows...).AS("events", eventID, eventType, payload)
+
+	// One delivery per event and active subscription of its type, event types are stored space-separated
+	stmt := deliveriesTable.INSERT(
+		deliveriesTable.SubscriptionID, deliveriesTable.EventID, deliveriesTable.EventType, deliveriesTable.Payload,
+	).QUERY(
+		postgres.SELECT(
+			subscriptionsTable.ID, eventID, eventType, payload,
+		).FROM(
+			subscriptionsTable.CROSS_JOIN(eventsTable),
+		).WHERE(
+			subscriptionsTable.IsActive.IS_TRUE().
+				AND(postgres.RawBool("events.event_type = ANY(string_to_array(webhook_subscriptions.event_types, ' '))")),
+		),
+	).ON_CONFLICT(
+		deliveriesTable.SubscriptionID, deliveriesTable.EventID,
+	).DO_NOTHING()
+
+	query, args := stmt.Sql()
+
+	result, err := r.execer.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating webhook deliveries", err.Error()))
+	}
+	created, err = result.RowsAffected()
+	if err != nil {
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating webhook deliveries", err.Error()))
+	}
+
+	return created, nil
+}
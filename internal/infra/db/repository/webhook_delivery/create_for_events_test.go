@@ -0,0 +1,102 @@
+package webhookdeliveryrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWebhookDeliveryRepositoryImpl_CreateForEvents(t *testing.T) {
+	testEventID1 := uuid.New()
+	testEventID2 := uuid.New()
+	testReservationID := uuid.New()
+	testOccurredAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	events := entity.WebhookEvents{
+		{ID: testEventID1, Type: entity.WebhookEventTypeReservationConfirmed, OccurredAt: testOccurredAt, Data: map[string]any{"reservation_id": testReservationID}},
+		{ID: testEventID2, Type: entity.WebhookEventTypePaymentSucceeded, OccurredAt: testOccurredAt, Data: map[string]any{"amount": 1500}},
+	}
+	payload1 := `{"data":{"reservation_id":"` + testReservationID.String() + `"},"id":"` + testEventID1.String() + `","occurred_at":"2025-01-01T10:00:00Z","type":"reservation.confirmed"}`
+	payload2 := `{"data":{"amount":1500},"id":"` + testEventID2.String() + `","occurred_at":"2025-01-01T10:00:00Z","type":"payment.succeeded"}`
+
+	expectedQuery := `INSERT INTO public\.webhook_deliveries \(subscription_id, event_id, event_type, payload\) \( SELECT webhook_subscriptions\.id AS "webhook_subscriptions\.id", events\.event_id AS "event_id", events\.event_type AS "event_type", events\.payload AS "payload" FROM public\.webhook_subscriptions CROSS JOIN \( VALUES \(\$1::uuid, \$2::text, \$3::text\), \(\$4::uuid, \$5::text, \$6::text\) \) AS events \(event_id, event_type, payload\) WHERE webhook_subscriptions\.is_active IS TRUE AND \(events\.event_type = ANY\(string_to_array\(webhook_subscriptions\.event_types, ' '\)\)\) \) ON CONFLICT \(subscription_id, event_id\) DO NOTHING`
+
+	tests := []struct {
+		name            string
+		events          entity.WebhookEvents
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedCreated int64
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name:   "successful creation",
+			events: events,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(expectedQuery).
+					WithArgs(testEventID1, "reservation.confirmed", payload1, testEventID2, "payment.succeeded", payload2).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			expectedCreated: 3,
+			expectedError:   false,
+		},
+		{
+			name:            "no events",
+			events:          entity.WebhookEvents{},
+			setupMock:       func(mock sqlmock.Sqlmock) {},
+			expectedCreated: 0,
+			expectedError:   false,
+		},
+		{
+			name:   "database error",
+			events: events,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(expectedQuery).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			created, err := h.Repository.CreateForEvents(context.Background(), tt.events)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_delivery/create_for_events CreateForEvents]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Zero(t, created)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCreated, created)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
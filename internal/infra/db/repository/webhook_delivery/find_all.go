@@ -0,0 +1,69 @@
+package webhookdeliveryrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookDeliveryRepositoryImpl) FindAll(ctx context.Context, filter repository.FindAllWebhookDeliveriesFilter) (deliveries *entity.WebhookDeliveries, total int64, err error) {
+	const errLocation = "[repository webhook_delivery/find_all FindAll] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	// Build WHERE conditions for filtering
+	whereClauses := []postgres.BoolExpression{}
+	if filter.SubscriptionID != nil {
+		whereClauses = append(whereClauses, table.WebhookDeliveries.SubscriptionID.EQ(postgres.UUID(*filter.SubscriptionID)))
+	}
+	if filter.Status != nil {
+		whereClauses = append(whereClauses, table.WebhookDeliveries.Status.EQ(postgres.String(filter.Status.String())))
+	}
+	if filter.EventType != nil {
+		whereClauses = append(whereClauses, table.WebhookDeliveries.EventType.EQ(postgres.String(filter.EventType.String())))
+	}
+
+	// Get total count of webhook deliveries matching the filter
+	countStmt := postgres.SELECT(
+		postgres.COUNT(table.WebhookDeliveries.ID).AS("total"),
+	).FROM(table.WebhookDeliveries)
+	if len(whereClauses) > 0 {
+		countStmt = countStmt.WHERE(postgres.AND(whereClauses...))
+	}
+
+	countQuery, countArgs := countStmt.Sql()
+
+	if err := r.execer.GetContext(ctx, &total, countQuery, countArgs...); err != nil {
+		return nil, 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while counting webhook deliveries", err.Error()))
+	}
+
+	// Get webhook deliveries with the same filter, the most recent first
+	stmt := postgres.SELECT(
+		table.WebhookDeliveries.AllColumns,
+	).FROM(table.WebhookDeliveries)
+
+	if len(whereClauses) > 0 {
+		stmt = stmt.WHERE(postgres.AND(whereClauses...))
+	}
+	stmt = stmt.ORDER_BY(table.WebhookDeliveries.CreatedAt.DESC())
+	// Apply pagination
+	if filter.Limit != nil {
+		stmt = stmt.LIMIT(*filter.Limit)
+	}
+	if filter.Offset != nil {
+		stmt = stmt.OFFSET(*filter.Offset)
+	}
+
+	query, args := stmt.Sql()
+
+	var models WebhookDeliveries
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting webhook deliveries", err.Error()))
+	}
+
+	deliveries = models.ToEntities()
+	return deliveries, total, nil
+}
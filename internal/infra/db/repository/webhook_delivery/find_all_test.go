@@ -0,0 +1,139 @@
+package webhookdeliveryrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookDeliveryRepositoryImpl_FindAll(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testSubscriptionID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		filter             repository.FindAllWebhookDeliveriesFilter
+		setupMock          func(mock sqlmock.Sqlmock)
+		expectedDeliveries *entity.WebhookDeliveries
+		expectedTotal      int64
+		expectedError      bool
+		errorType          error
+	}{
+		{
+			name: "successful retrieval with all filters",
+			filter: repository.FindAllWebhookDeliveriesFilter{
+				SubscriptionID: &testSubscriptionID,
+				Status:         pointer.ToPointer(entity.WebhookDeliveryStatusDeadLettered),
+				EventType:      pointer.ToPointer(entity.WebhookEventTypeReservationCreated),
+				Limit:          pointer.ToPointer(int64(10)),
+				Offset:         pointer.ToPointer(int64(0)),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(webhook_deliveries\.id\) AS "total" FROM public\.webhook_deliveries WHERE \( \(webhook_deliveries\.subscription_id = \$1\) AND \(webhook_deliveries\.status = \$2::text\) AND \(webhook_deliveries\.event_type = \$3::text\) \)`).
+					WithArgs(testSubscriptionID, "dead_lettered", "reservation.created").
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(2))
+
+				rows := sqlmock.NewRows(webhookDeliveryColumns).
+					AddRow(testID1, testSubscriptionID, uuid.New(), "reservation.created", `{}`, "dead_lettered", 8, testCreatedAt, testCreatedAt, 500, "unexpected response status 500", nil, testCreatedAt, testCreatedAt).
+					AddRow(testID2, testSubscriptionID, uuid.New(), "reservation.created", `{}`, "dead_lettered", 8, testCreatedAt, testCreatedAt, nil, "connection refused", nil, testCreatedAt, testCreatedAt)
+				mock.ExpectQuery(`SELECT `+webhookDeliveryReturningColumns+` FROM public\.webhook_deliveries WHERE \( \(webhook_deliveries\.subscription_id = \$1\) AND \(webhook_deliveries\.status = \$2::text\) AND \(webhook_deliveries\.event_type = \$3::text\) \) ORDER BY webhook_deliveries\.created_at DESC LIMIT \$4 OFFSET \$5`).
+					WithArgs(testSubscriptionID, "dead_lettered", "reservation.created", int64(10), int64(0)).
+					WillReturnRows(rows)
+			},
+			expectedDeliveries: &entity.WebhookDeliveries{
+				{ID: testID1, Status: entity.WebhookDeliveryStatusDeadLettered, LastResponseStatus: pointer.ToPointer(500)},
+				{ID: testID2, Status: entity.WebhookDeliveryStatusDeadLettered},
+			},
+			expectedTotal: 2,
+			expectedError: false,
+		},
+		{
+			name:   "successful retrieval without filters",
+			filter: repository.FindAllWebhookDeliveriesFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(webhook_deliveries\.id\) AS "total" FROM public\.webhook_deliveries`).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(0))
+				mock.ExpectQuery(`SELECT ` + webhookDeliveryReturningColumns + ` FROM public\.webhook_deliveries ORDER BY webhook_deliveries\.created_at DESC`).
+					WillReturnRows(sqlmock.NewRows(webhookDeliveryColumns))
+			},
+			expectedDeliveries: &entity.WebhookDeliveries{},
+			expectedTotal:      0,
+			expectedError:      false,
+		},
+		{
+			name:   "count query error",
+			filter: repository.FindAllWebhookDeliveriesFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(webhook_deliveries\.id\) AS "total" FROM public\.webhook_deliveries`).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name:   "select query error",
+			filter: repository.FindAllWebhookDeliveriesFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(webhook_deliveries\.id\) AS "total" FROM public\.webhook_deliveries`).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(1))
+				mock.ExpectQuery(`SELECT ` + webhookDeliveryReturningColumns + ` FROM public\.webhook_deliveries ORDER BY webhook_deliveries\.created_at DESC`).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			deliveries, total, err := h.Repository.FindAll(context.Background(), tt.filter)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_delivery/find_all FindAll]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, deliveries)
+				assert.Zero(t, total)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, deliveries)
+				assert.Equal(t, tt.expectedTotal, total)
+				require.Len(t, *deliveries, len(*tt.expectedDeliveries))
+				for i, expected := range *tt.expectedDeliveries {
+					assert.Equal(t, expected.ID, (*deliveries)[i].ID)
+					assert.Equal(t, expected.Status, (*deliveries)[i].Status)
+					assert.Equal(t, expected.LastResponseStatus, (*deliveries)[i].LastResponseStatus)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
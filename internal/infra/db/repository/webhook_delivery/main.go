@@ -0,0 +1,19 @@
+package webhookdeliveryrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type webhookDeliveryRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewWebhookDeliveryRepository(execer db.SqlExecer) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *webhookDeliveryRepositoryImpl) WithTx(tx db.SqlExecer) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepositoryImpl{execer: tx}
+}
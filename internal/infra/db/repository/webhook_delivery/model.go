@@ -0,0 +1,57 @@
+package webhookdeliveryrepo
+
+import (
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type WebhookDelivery struct {
+	model.WebhookDeliveries
+}
+
+func (d *WebhookDelivery) ToEntity() *entity.WebhookDelivery {
+	eventType, err := new(entity.WebhookEventType).Parse(d.EventType)
+	if err != nil {
+		return nil
+	}
+	status, err := new(entity.WebhookDeliveryStatus).Parse(d.Status)
+	if err != nil {
+		return nil
+	}
+	var lastResponseStatus *int
+	if d.LastResponseStatus != nil {
+		lastResponseStatus = pointer.ToPointer(int(*d.LastResponseStatus))
+	}
+	return &entity.WebhookDelivery{
+		ID:                 d.ID,
+		SubscriptionID:     d.SubscriptionID,
+		EventID:            d.EventID,
+		EventType:          eventType,
+		Payload:            d.Payload,
+		Status:             status,
+		Attempts:           int(d.Attempts),
+		NextAttemptAt:      d.NextAttemptAt,
+		LastAttemptAt:      d.LastAttemptAt,
+		LastResponseStatus: lastResponseStatus,
+		LastError:          d.LastError,
+		DeliveredAt:        d.DeliveredAt,
+		CreatedAt:          d.CreatedAt,
+		UpdatedAt:          d.UpdatedAt,
+	}
+}
+
+type WebhookDeliveries []WebhookDelivery
+
+func (ds WebhookDeliveries) ToEntities() *entity.WebhookDeliveries {
+	deliveries := make(entity.WebhookDeliveries, 0, len(ds))
+	for _, d := range ds {
+		delivery := d.ToEntity()
+		if delivery == nil {
+			continue
+		}
+		deliveries = append(deliveries, pointer.GetValue(delivery))
+	}
+	return pointer.ToPointer(deliveries)
+}
@@ -0,0 +1,114 @@
+package webhookdeliveryrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	webhookdeliveryrepo "ticket-reservation/internal/infra/db/repository/webhook_delivery"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookDelivery_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testSubscriptionID := uuid.New()
+	testEventID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testAttemptedAt := time.Date(2025, 1, 1, 10, 0, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    webhookdeliveryrepo.WebhookDelivery
+		expected *entity.WebhookDelivery
+	}{
+		{
+			name: "valid webhook delivery",
+			input: webhookdeliveryrepo.WebhookDelivery{
+				WebhookDeliveries: model.WebhookDeliveries{
+					ID:                 testID,
+					SubscriptionID:     testSubscriptionID,
+					EventID:            testEventID,
+					EventType:          "reservation.created",
+					Payload:            `{"id":"1"}`,
+					Status:             "pending",
+					Attempts:           2,
+					NextAttemptAt:      testAttemptedAt,
+					LastAttemptAt:      &testAttemptedAt,
+					LastResponseStatus: pointer.ToPointer(int32(503)),
+					LastError:          pointer.ToPointer("unexpected response status 503"),
+					CreatedAt:          testCreatedAt,
+					UpdatedAt:          testAttemptedAt,
+				},
+			},
+			expected: &entity.WebhookDelivery{
+				ID:                 testID,
+				SubscriptionID:     testSubscriptionID,
+				EventID:            testEventID,
+				EventType:          entity.WebhookEventTypeReservationCreated,
+				Payload:            `{"id":"1"}`,
+				Status:             entity.WebhookDeliveryStatusPending,
+				Attempts:           2,
+				NextAttemptAt:      testAttemptedAt,
+				LastAttemptAt:      &testAttemptedAt,
+				LastResponseStatus: pointer.ToPointer(503),
+				LastError:          pointer.ToPointer("unexpected response status 503"),
+				CreatedAt:          testCreatedAt,
+				UpdatedAt:          testAttemptedAt,
+			},
+		},
+		{
+			name: "invalid event type returns nil",
+			input: webhookdeliveryrepo.WebhookDelivery{
+				WebhookDeliveries: model.WebhookDeliveries{
+					ID:        testID,
+					EventType: "seat.released",
+					Status:    "pending",
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "invalid status returns nil",
+			input: webhookdeliveryrepo.WebhookDelivery{
+				WebhookDeliveries: model.WebhookDeliveries{
+					ID:        testID,
+					EventType: "reservation.created",
+					Status:    "failed",
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			result := tt.input.ToEntity()
+
+			// Assert
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestWebhookDeliveries_ToEntities(t *testing.T) {
+	testID := uuid.New()
+
+	models := webhookdeliveryrepo.WebhookDeliveries{
+		{WebhookDeliveries: model.WebhookDeliveries{ID: testID, EventType: "payment.succeeded", Status: "delivered"}},
+		{WebhookDeliveries: model.WebhookDeliveries{ID: uuid.New(), EventType: "payment.succeeded", Status: "failed"}},
+	}
+
+	// Execute
+	result := models.ToEntities()
+
+	// Assert
+	assert.Equal(t, &entity.WebhookDeliveries{
+		{ID: testID, EventType: entity.WebhookEventTypePaymentSucceeded, Status: entity.WebhookDeliveryStatusDelivered},
+	}, result)
+}
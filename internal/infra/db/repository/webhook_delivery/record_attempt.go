@@ -0,0 +1,77 @@
+package webhookdeliveryrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookDeliveryRepositoryImpl) RecordAttempt(ctx context.Context, input repository.RecordWebhookDeliveryAttemptInput) (delivery *entity.WebhookDelivery, err error) {
+	const errLocation = "[repository webhook_delivery/record_attempt RecordAttempt] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	deliveriesTable := table.WebhookDeliveries
+
+	var lastResponseStatus postgres.Expression = postgres.NULL
+	if input.ResponseStatus != nil {
+		lastResponseStatus = postgres.Int32(int32(*input.ResponseStatus))
+	}
+	var lastError postgres.Expression = postgres.NULL
+	if input.Error != nil {
+		lastError = postgres.String(*input.Error)
+	}
+	var deliveredAt postgres.Expression = postgres.NULL
+	if input.DeliveredAt != nil {
+		deliveredAt = postgres.TimestampzT(*input.DeliveredAt)
+	}
+
+	// SQL statement, only a pending delivery records attempts
+	stmt := deliveriesTable.
+		UPDATE(
+			deliveriesTable.Status,
+			deliveriesTable.Attempts,
+			deliveriesTable.LastAttemptAt,
+			deliveriesTable.LastResponseStatus,
+			deliveriesTable.LastError,
+			deliveriesTable.DeliveredAt,
+			deliveriesTable.NextAttemptAt,
+		).
+		SET(
+			postgres.String(input.Status.String()),
+			deliveriesTable.Attempts.ADD(postgres.Int32(1)),
+			postgres.TimestampzT(input.AttemptedAt),
+			lastResponseStatus,
+			lastError,
+			deliveredAt,
+			postgres.TimestampzT(input.NextAttemptAt),
+		).
+		WHERE(
+			deliveriesTable.ID.EQ(postgres.UUID(input.ID)).
+				AND(deliveriesTable.Status.EQ(postgres.String(entity.WebhookDeliveryStatusPending.String()))),
+		).
+		RETURNING(deliveriesTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model WebhookDelivery
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("pending webhook delivery not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while recording webhook delivery attempt", err.Error()))
+	}
+
+	delivery = model.ToEntity()
+	if delivery == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert webhook delivery model to entity", nil)
+	}
+
+	return
+}
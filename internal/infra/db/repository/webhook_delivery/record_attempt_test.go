@@ -0,0 +1,170 @@
+package webhookdeliveryrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookDeliveryRepositoryImpl_RecordAttempt(t *testing.T) {
+	testID := uuid.New()
+	testSubscriptionID := uuid.New()
+	testEventID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testAttemptedAt := time.Date(2025, 1, 1, 10, 1, 0, 0, time.UTC)
+	testNextAttemptAt := time.Date(2025, 1, 1, 10, 1, 2, 0, time.UTC)
+
+	const (
+		setAttempt = `UPDATE public\.webhook_deliveries SET \(status, attempts, last_attempt_at, last_response_status, last_error, delivered_at, next_attempt_at\) = `
+		whereID    = ` WHERE \(webhook_deliveries\.id = \$\d+\) AND \(webhook_deliveries\.status = \$\d+::text\) RETURNING `
+	)
+
+	tests := []struct {
+		name             string
+		input            repository.RecordWebhookDeliveryAttemptInput
+		setupMock        func(mock sqlmock.Sqlmock)
+		expectedDelivery *entity.WebhookDelivery
+		expectedError    bool
+		errorType        error
+	}{
+		{
+			name: "successful delivery",
+			input: repository.RecordWebhookDeliveryAttemptInput{
+				ID:             testID,
+				Status:         entity.WebhookDeliveryStatusDelivered,
+				AttemptedAt:    testAttemptedAt,
+				ResponseStatus: pointer.ToPointer(204),
+				DeliveredAt:    &testAttemptedAt,
+				NextAttemptAt:  testAttemptedAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookDeliveryColumns).AddRow(
+					testID, testSubscriptionID, testEventID, "reservation.created", `{}`, "delivered", 1, testAttemptedAt,
+					testAttemptedAt, 204, nil, testAttemptedAt, testCreatedAt, testAttemptedAt,
+				)
+				mock.ExpectQuery(setAttempt+`\(\$1::text, \(webhook_deliveries\.attempts \+ \$2::integer\), \$3::timestamp with time zone, \$4::integer, NULL, \$5::timestamp with time zone, \$6::timestamp with time zone\)`+whereID+webhookDeliveryReturningColumns).
+					WithArgs("delivered", int64(1), testAttemptedAt, int64(204), testAttemptedAt, testAttemptedAt, testID, "pending").
+					WillReturnRows(rows)
+			},
+			expectedDelivery: &entity.WebhookDelivery{
+				ID:                 testID,
+				Status:             entity.WebhookDeliveryStatusDelivered,
+				Attempts:           1,
+				LastResponseStatus: pointer.ToPointer(204),
+				DeliveredAt:        &testAttemptedAt,
+				NextAttemptAt:      testAttemptedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "failed attempt without response",
+			input: repository.RecordWebhookDeliveryAttemptInput{
+				ID:            testID,
+				Status:        entity.WebhookDeliveryStatusPending,
+				AttemptedAt:   testAttemptedAt,
+				Error:         pointer.ToPointer("connection refused"),
+				NextAttemptAt: testNextAttemptAt,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookDeliveryColumns).AddRow(
+					testID, testSubscriptionID, testEventID, "reservation.created", `{}`, "pending", 2, testNextAttemptAt,
+					testAttemptedAt, nil, "connection refused", nil, testCreatedAt, testAttemptedAt,
+				)
+				mock.ExpectQuery(setAttempt+`\(\$1::text, \(webhook_deliveries\.attempts \+ \$2::integer\), \$3::timestamp with time zone, NULL, \$4::text, NULL, \$5::timestamp with time zone\)`+whereID+webhookDeliveryReturningColumns).
+					WithArgs("pending", int64(1), testAttemptedAt, "connection refused", testNextAttemptAt, testID, "pending").
+					WillReturnRows(rows)
+			},
+			expectedDelivery: &entity.WebhookDelivery{
+				ID:            testID,
+				Status:        entity.WebhookDeliveryStatusPending,
+				Attempts:      2,
+				LastError:     pointer.ToPointer("connection refused"),
+				NextAttemptAt: testNextAttemptAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "pending webhook delivery not found",
+			input: repository.RecordWebhookDeliveryAttemptInput{
+				ID:          testID,
+				Status:      entity.WebhookDeliveryStatusPending,
+				AttemptedAt: testAttemptedAt,
+				Error:       pointer.ToPointer("connection refused"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(setAttempt).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedDelivery: nil,
+			expectedError:    true,
+			errorType:        &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.RecordWebhookDeliveryAttemptInput{
+				ID:          testID,
+				Status:      entity.WebhookDeliveryStatusPending,
+				AttemptedAt: testAttemptedAt,
+				Error:       pointer.ToPointer("connection refused"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(setAttempt).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedDelivery: nil,
+			expectedError:    true,
+			errorType:        &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			delivery, err := h.Repository.RecordAttempt(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_delivery/record_attempt RecordAttempt]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, delivery)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, delivery)
+				assert.Equal(t, tt.expectedDelivery.ID, delivery.ID)
+				assert.Equal(t, tt.expectedDelivery.Status, delivery.Status)
+				assert.Equal(t, tt.expectedDelivery.Attempts, delivery.Attempts)
+				assert.Equal(t, tt.expectedDelivery.LastResponseStatus, delivery.LastResponseStatus)
+				assert.Equal(t, tt.expectedDelivery.LastError, delivery.LastError)
+				assert.Equal(t, tt.expectedDelivery.DeliveredAt, delivery.DeliveredAt)
+				assert.Equal(t, tt.expectedDelivery.NextAttemptAt, delivery.NextAttemptAt)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
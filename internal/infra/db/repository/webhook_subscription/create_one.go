@@ -0,0 +1,39 @@
+package webhooksubscriptionrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookSubscriptionRepositoryImpl) CreateOne(ctx context.Context, input *entity.WebhookSubscription) (subscription *entity.WebhookSubscription, err error) {
+	const errLocation = "[repository webhook_subscription/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	subscriptionsTable := table.WebhookSubscriptions
+	// SQL statement
+	stmt := subscriptionsTable.INSERT(
+		subscriptionsTable.AllColumns.Except(subscriptionsTable.DefaultColumns), // Exclude columns with default values, subscriptions start active
+	).MODEL(model.WebhookSubscriptions{
+		Name:       input.Name,
+		URL:        input.URL,
+		Secret:     input.Secret,
+		EventTypes: formatEventTypes(input.EventTypes),
+	}).RETURNING(subscriptionsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model WebhookSubscription
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating webhook subscription", err.Error()))
+	}
+
+	subscription = model.ToEntity()
+	if subscription == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert webhook subscription model to entity", nil)
+	}
+	return subscription, nil
+}
@@ -0,0 +1,123 @@
+package webhooksubscriptionrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+var webhookSubscriptionColumns = []string{
+	"webhook_subscriptions.id", "webhook_subscriptions.name", "webhook_subscriptions.url", "webhook_subscriptions.secret",
+	"webhook_subscriptions.event_types", "webhook_subscriptions.is_active", "webhook_subscriptions.created_at", "webhook_subscriptions.updated_at",
+}
+
+const webhookSubscriptionReturningColumns = `webhook_subscriptions\.id AS "webhook_subscriptions\.id", webhook_subscriptions\.name AS "webhook_subscriptions\.name", webhook_subscriptions\.url AS "webhook_subscriptions\.url", webhook_subscriptions\.secret AS "webhook_subscriptions\.secret", webhook_subscriptions\.event_types AS "webhook_subscriptions\.event_types", webhook_subscriptions\.is_active AS "webhook_subscriptions\.is_active", webhook_subscriptions\.created_at AS "webhook_subscriptions\.created_at", webhook_subscriptions\.updated_at AS "webhook_subscriptions\.updated_at"`
+
+func TestWebhookSubscriptionRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `INSERT INTO public\.webhook_subscriptions \(name, url, secret, event_types\) VALUES \(\$1, \$2, \$3, \$4\) RETURNING ` + webhookSubscriptionReturningColumns
+
+	input := &entity.WebhookSubscription{
+		Name:       "CRM",
+		URL:        "https://crm.example.com/webhooks",
+		Secret:     "whsec_0123456789abcdef",
+		EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated, entity.WebhookEventTypePaymentSucceeded},
+	}
+
+	tests := []struct {
+		name                 string
+		setupMock            func(mock sqlmock.Sqlmock)
+		expectedSubscription *entity.WebhookSubscription
+		expectedError        bool
+		errorType            error
+	}{
+		{
+			name: "successful creation",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookSubscriptionColumns).AddRow(
+					testID, "CRM", "https://crm.example.com/webhooks", "whsec_0123456789abcdef",
+					"reservation.created payment.succeeded", true, testCreatedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("CRM", "https://crm.example.com/webhooks", "whsec_0123456789abcdef", "reservation.created payment.succeeded").
+					WillReturnRows(rows)
+			},
+			expectedSubscription: &entity.WebhookSubscription{
+				ID:         testID,
+				Name:       "CRM",
+				URL:        "https://crm.example.com/webhooks",
+				Secret:     "whsec_0123456789abcdef",
+				EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated, entity.WebhookEventTypePaymentSucceeded},
+				IsActive:   true,
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "invalid stored event type",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookSubscriptionColumns).AddRow(
+					testID, "CRM", "https://crm.example.com/webhooks", "whsec_0123456789abcdef",
+					"reservation.deleted", true, testCreatedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WillReturnRows(rows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+		},
+		{
+			name: "database connection error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			subscription, err := h.Repository.CreateOne(context.Background(), input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_subscription/create_one CreateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, subscription)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedSubscription, subscription)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package webhooksubscriptionrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookSubscriptionRepositoryImpl) FindAll(ctx context.Context) (subscriptions *entity.WebhookSubscriptions, err error) {
+	const errLocation = "[repository webhook_subscription/find_all FindAll] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	subscriptionsTable := table.WebhookSubscriptions
+	// SQL statement
+	stmt := postgres.SELECT(
+		subscriptionsTable.AllColumns,
+	).FROM(
+		subscriptionsTable,
+	).ORDER_BY(
+		subscriptionsTable.CreatedAt.DESC(),
+	)
+
+	query, args := stmt.Sql()
+
+	var models WebhookSubscriptions
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting webhook subscriptions", err.Error()))
+	}
+
+	subscriptions = models.ToEntities()
+	return subscriptions, nil
+}
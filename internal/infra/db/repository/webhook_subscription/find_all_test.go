@@ -0,0 +1,105 @@
+package webhooksubscriptionrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWebhookSubscriptionRepositoryImpl_FindAll(t *testing.T) {
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + webhookSubscriptionReturningColumns + ` FROM public\.webhook_subscriptions ORDER BY webhook_subscriptions\.created_at DESC`
+
+	tests := []struct {
+		name                  string
+		setupMock             func(mock sqlmock.Sqlmock)
+		expectedSubscriptions *entity.WebhookSubscriptions
+		expectedError         bool
+		errorType             error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookSubscriptionColumns).
+					AddRow(testID1, "CRM", "https://crm.example.com/webhooks", "secret1", "reservation.created reservation.confirmed", true, testCreatedAt, testCreatedAt).
+					AddRow(testID2, "Analytics", "https://analytics.example.com/hook", "secret2", "payment.succeeded", false, testCreatedAt, testCreatedAt)
+				mock.ExpectQuery(expectedQuery).
+					WillReturnRows(rows)
+			},
+			expectedSubscriptions: &entity.WebhookSubscriptions{
+				{ID: testID1, EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated, entity.WebhookEventTypeReservationConfirmed}, IsActive: true},
+				{ID: testID2, EventTypes: []entity.WebhookEventType{entity.WebhookEventTypePaymentSucceeded}, IsActive: false},
+			},
+			expectedError: false,
+		},
+		{
+			name: "no webhook subscriptions",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnRows(sqlmock.NewRows(webhookSubscriptionColumns))
+			},
+			expectedSubscriptions: &entity.WebhookSubscriptions{},
+			expectedError:         false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedSubscriptions: nil,
+			expectedError:         true,
+			errorType:             &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			subscriptions, err := h.Repository.FindAll(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_subscription/find_all FindAll]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, subscriptions)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, subscriptions)
+				require.Len(t, *subscriptions, len(*tt.expectedSubscriptions))
+				for i, expected := range *tt.expectedSubscriptions {
+					assert.Equal(t, expected.ID, (*subscriptions)[i].ID)
+					assert.Equal(t, expected.EventTypes, (*subscriptions)[i].EventTypes)
+					assert.Equal(t, expected.IsActive, (*subscriptions)[i].IsActive)
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
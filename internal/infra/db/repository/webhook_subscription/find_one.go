@@ -0,0 +1,44 @@
+package webhooksubscriptionrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookSubscriptionRepositoryImpl) FindOne(ctx context.Context, id uuid.UUID) (subscription *entity.WebhookSubscription, err error) {
+	const errLocation = "[repository webhook_subscription/find_one FindOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	subscriptionsTable := table.WebhookSubscriptions
+	// SQL statement
+	stmt := postgres.SELECT(
+		subscriptionsTable.AllColumns,
+	).FROM(
+		subscriptionsTable,
+	).WHERE(
+		subscriptionsTable.ID.EQ(postgres.UUID(id)),
+	)
+
+	query, args := stmt.Sql()
+
+	var model WebhookSubscription
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("webhook subscription not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while getting webhook subscription", err.Error()))
+	}
+
+	subscription = model.ToEntity()
+	if subscription == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert webhook subscription model to entity", nil)
+	}
+	return subscription, nil
+}
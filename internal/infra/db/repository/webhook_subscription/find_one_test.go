@@ -0,0 +1,108 @@
+package webhooksubscriptionrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWebhookSubscriptionRepositoryImpl_FindOne(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	expectedQuery := `SELECT ` + webhookSubscriptionReturningColumns + ` FROM public\.webhook_subscriptions WHERE webhook_subscriptions\.id = \$1`
+
+	tests := []struct {
+		name                 string
+		setupMock            func(mock sqlmock.Sqlmock)
+		expectedSubscription *entity.WebhookSubscription
+		expectedError        bool
+		errorType            error
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookSubscriptionColumns).AddRow(
+					testID, "Analytics", "https://analytics.example.com/hook", "whsec_0123456789abcdef",
+					"reservation.expired", false, testCreatedAt, testCreatedAt,
+				)
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnRows(rows)
+			},
+			expectedSubscription: &entity.WebhookSubscription{
+				ID:         testID,
+				Name:       "Analytics",
+				URL:        "https://analytics.example.com/hook",
+				Secret:     "whsec_0123456789abcdef",
+				EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationExpired},
+				IsActive:   false,
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testCreatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "webhook subscription not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(testID).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			subscription, err := h.Repository.FindOne(context.Background(), testID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_subscription/find_one FindOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, subscription)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedSubscription, subscription)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package webhooksubscriptionrepo
+
+import (
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+type webhookSubscriptionRepositoryImpl struct {
+	execer db.SqlExecer
+}
+
+func NewWebhookSubscriptionRepository(execer db.SqlExecer) repository.WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepositoryImpl{execer: execer}
+}
+
+// WithTx returns a new repository using the provided transaction.
+func (r *webhookSubscriptionRepositoryImpl) WithTx(tx db.SqlExecer) repository.WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepositoryImpl{execer: tx}
+}
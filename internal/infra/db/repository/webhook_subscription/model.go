@@ -0,0 +1,66 @@
+package webhooksubscriptionrepo
+
+import (
+	"strings"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type WebhookSubscription struct {
+	model.WebhookSubscriptions
+}
+
+func (s *WebhookSubscription) ToEntity() *entity.WebhookSubscription {
+	eventTypes, err := parseEventTypes(s.EventTypes)
+	if err != nil {
+		return nil
+	}
+	return &entity.WebhookSubscription{
+		ID:         s.ID,
+		Name:       s.Name,
+		URL:        s.URL,
+		Secret:     s.Secret,
+		EventTypes: eventTypes,
+		IsActive:   s.IsActive,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+type WebhookSubscriptions []WebhookSubscription
+
+func (ss WebhookSubscriptions) ToEntities() *entity.WebhookSubscriptions {
+	subscriptions := make(entity.WebhookSubscriptions, 0, len(ss))
+	for _, s := range ss {
+		subscription := s.ToEntity()
+		if subscription == nil {
+			continue
+		}
+		subscriptions = append(subscriptions, pointer.GetValue(subscription))
+	}
+	return pointer.ToPointer(subscriptions)
+}
+
+// formatEventTypes returns the space-separated form the event types are stored in.
+func formatEventTypes(eventTypes []entity.WebhookEventType) string {
+	values := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		values = append(values, eventType.String())
+	}
+	return strings.Join(values, " ")
+}
+
+func parseEventTypes(value string) ([]entity.WebhookEventType, error) {
+	fields := strings.Fields(value)
+	eventTypes := make([]entity.WebhookEventType, 0, len(fields))
+	for _, field := range fields {
+		eventType, err := new(entity.WebhookEventType).Parse(field)
+		if err != nil {
+			return nil, err
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes, nil
+}
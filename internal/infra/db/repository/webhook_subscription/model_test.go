@@ -0,0 +1,92 @@
+package webhooksubscriptionrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	webhooksubscriptionrepo "ticket-reservation/internal/infra/db/repository/webhook_subscription"
+)
+
+func TestWebhookSubscription_ToEntity(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    webhooksubscriptionrepo.WebhookSubscription
+		expected *entity.WebhookSubscription
+	}{
+		{
+			name: "valid webhook subscription",
+			input: webhooksubscriptionrepo.WebhookSubscription{
+				WebhookSubscriptions: model.WebhookSubscriptions{
+					ID:         testID,
+					Name:       "CRM",
+					URL:        "https://crm.example.com/webhooks",
+					Secret:     "whsec_0123456789abcdef",
+					EventTypes: "reservation.created  payment.succeeded",
+					IsActive:   true,
+					CreatedAt:  testCreatedAt,
+					UpdatedAt:  testCreatedAt,
+				},
+			},
+			expected: &entity.WebhookSubscription{
+				ID:         testID,
+				Name:       "CRM",
+				URL:        "https://crm.example.com/webhooks",
+				Secret:     "whsec_0123456789abcdef",
+				EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated, entity.WebhookEventTypePaymentSucceeded},
+				IsActive:   true,
+				CreatedAt:  testCreatedAt,
+				UpdatedAt:  testCreatedAt,
+			},
+		},
+		{
+			name: "invalid event type returns nil",
+			input: webhooksubscriptionrepo.WebhookSubscription{
+				WebhookSubscriptions: model.WebhookSubscriptions{
+					ID:         testID,
+					Name:       "CRM",
+					URL:        "https://crm.example.com/webhooks",
+					Secret:     "whsec_0123456789abcdef",
+					EventTypes: "reservation.created seat.released",
+					CreatedAt:  testCreatedAt,
+					UpdatedAt:  testCreatedAt,
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			result := tt.input.ToEntity()
+
+			// Assert
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestWebhookSubscriptions_ToEntities(t *testing.T) {
+	testID := uuid.New()
+
+	models := webhooksubscriptionrepo.WebhookSubscriptions{
+		{WebhookSubscriptions: model.WebhookSubscriptions{ID: testID, EventTypes: "concert.created"}},
+		{WebhookSubscriptions: model.WebhookSubscriptions{ID: uuid.New(), EventTypes: "concert.deleted"}},
+	}
+
+	// Execute
+	result := models.ToEntities()
+
+	// Assert
+	assert.Equal(t, &entity.WebhookSubscriptions{
+		{ID: testID, EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeConcertCreated}},
+	}, result)
+}
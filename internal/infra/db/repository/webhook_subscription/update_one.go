@@ -0,0 +1,73 @@
+package webhooksubscriptionrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *webhookSubscriptionRepositoryImpl) UpdateOne(ctx context.Context, input repository.UpdateWebhookSubscriptionInput) (subscription *entity.WebhookSubscription, err error) {
+	const errLocation = "[repository webhook_subscription/update_one UpdateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	subscriptionsTable := table.WebhookSubscriptions
+
+	var updateModel WebhookSubscription
+	columns := make(postgres.ColumnList, 0)
+
+	// build the update model
+	if input.Name != nil {
+		updateModel.Name = *input.Name
+		columns = append(columns, subscriptionsTable.Name)
+	}
+	if input.URL != nil {
+		updateModel.URL = *input.URL
+		columns = append(columns, subscriptionsTable.URL)
+	}
+	if input.Secret != nil {
+		updateModel.Secret = *input.Secret
+		columns = append(columns, subscriptionsTable.Secret)
+	}
+	if input.EventTypes != nil {
+		updateModel.EventTypes = formatEventTypes(input.EventTypes)
+		columns = append(columns, subscriptionsTable.EventTypes)
+	}
+	if input.IsActive != nil {
+		updateModel.IsActive = *input.IsActive
+		columns = append(columns, subscriptionsTable.IsActive)
+	}
+	if len(columns) == 0 {
+		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+	}
+
+	// SQL statement
+	stmt := subscriptionsTable.
+		UPDATE(columns).
+		MODEL(updateModel).
+		WHERE(subscriptionsTable.ID.EQ(postgres.UUID(input.ID))).
+		RETURNING(subscriptionsTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model WebhookSubscription
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("webhook subscription not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating webhook subscription", err.Error()))
+	}
+
+	subscription = model.ToEntity()
+	if subscription == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert webhook subscription model to entity", nil)
+	}
+
+	return
+}
@@ -0,0 +1,160 @@
+package webhooksubscriptionrepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookSubscriptionRepositoryImpl_UpdateOne(t *testing.T) {
+	testID := uuid.New()
+	testCreatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	testUpdatedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		input                repository.UpdateWebhookSubscriptionInput
+		setupMock            func(mock sqlmock.Sqlmock)
+		expectedSubscription *entity.WebhookSubscription
+		expectedError        bool
+		errorType            error
+	}{
+		{
+			name: "successful update of the endpoint and event types",
+			input: repository.UpdateWebhookSubscriptionInput{
+				ID:         testID,
+				URL:        pointer.ToPointer("https://crm.example.com/v2/webhooks"),
+				EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationExpired},
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookSubscriptionColumns).AddRow(
+					testID, "CRM", "https://crm.example.com/v2/webhooks", "whsec_0123456789abcdef",
+					"reservation.expired", true, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.webhook_subscriptions SET \(url, event_types\) = \(\$1, \$2\) WHERE webhook_subscriptions\.id = \$3 RETURNING `+webhookSubscriptionReturningColumns).
+					WithArgs("https://crm.example.com/v2/webhooks", "reservation.expired", testID).
+					WillReturnRows(rows)
+			},
+			expectedSubscription: &entity.WebhookSubscription{
+				ID:         testID,
+				URL:        "https://crm.example.com/v2/webhooks",
+				EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationExpired},
+				IsActive:   true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful deactivation",
+			input: repository.UpdateWebhookSubscriptionInput{
+				ID:       testID,
+				IsActive: pointer.ToPointer(false),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(webhookSubscriptionColumns).AddRow(
+					testID, "CRM", "https://crm.example.com/webhooks", "whsec_0123456789abcdef",
+					"reservation.created", false, testCreatedAt, testUpdatedAt,
+				)
+
+				mock.ExpectQuery(`UPDATE public\.webhook_subscriptions SET is_active = \$1 WHERE webhook_subscriptions\.id = \$2 RETURNING `+webhookSubscriptionReturningColumns).
+					WithArgs(false, testID).
+					WillReturnRows(rows)
+			},
+			expectedSubscription: &entity.WebhookSubscription{
+				ID:         testID,
+				URL:        "https://crm.example.com/webhooks",
+				EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated},
+				IsActive:   false,
+			},
+			expectedError: false,
+		},
+		{
+			name: "no fields provided to update",
+			input: repository.UpdateWebhookSubscriptionInput{
+				ID: testID,
+			},
+			setupMock:            func(mock sqlmock.Sqlmock) {},
+			expectedSubscription: nil,
+			expectedError:        true,
+			errorType:            &errsFramework.BadRequestError{},
+		},
+		{
+			name: "webhook subscription not found",
+			input: repository.UpdateWebhookSubscriptionInput{
+				ID:   testID,
+				Name: pointer.ToPointer("CRM"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.webhook_subscriptions SET name = \$1 WHERE webhook_subscriptions\.id = \$2 RETURNING `+webhookSubscriptionReturningColumns).
+					WithArgs("CRM", testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedSubscription: nil,
+			expectedError:        true,
+			errorType:            &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.UpdateWebhookSubscriptionInput{
+				ID:     testID,
+				Secret: pointer.ToPointer("whsec_fedcba9876543210"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.webhook_subscriptions SET secret = \$1 WHERE webhook_subscriptions\.id = \$2 RETURNING `+webhookSubscriptionReturningColumns).
+					WithArgs("whsec_fedcba9876543210", testID).
+					WillReturnError(errors.New("database connection failed"))
+			},
+			expectedSubscription: nil,
+			expectedError:        true,
+			errorType:            &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+			subscription, err := h.Repository.UpdateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// Verify it's wrapped with the expected error prefix
+				assert.Contains(t, err.Error(), "[repository webhook_subscription/update_one UpdateOne]")
+
+				// Verify it's the expected error type
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, subscription)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, subscription)
+				assert.Equal(t, tt.expectedSubscription.ID, subscription.ID)
+				assert.Equal(t, tt.expectedSubscription.URL, subscription.URL)
+				assert.Equal(t, tt.expectedSubscription.EventTypes, subscription.EventTypes)
+				assert.Equal(t, tt.expectedSubscription.IsActive, subscription.IsActive)
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package zonerepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/model"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *zoneRepositoryImpl) CreateOne(ctx context.Context, input *entity.Zone) (zone *entity.Zone, err error) {
+	const errLocation = "[repository zone/create_one CreateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	zonesTable := table.Zones
+	// SQL statement
+	stmt := zonesTable.INSERT(
+		zonesTable.AllColumns.Except(zonesTable.DefaultColumns), // Exclude columns with default values
+		zonesTable.Currency,
+	).MODEL(model.Zones{
+		ConcertID:   input.ConcertID,
+		Name:        input.Name,
+		Description: input.Description,
+		Price:       input.Price,
+		Currency:    input.Currency,
+	}).RETURNING(zonesTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Zone
+	if err := r.execer.GetContext(ctx, &model, query, args...); err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while creating zone", err.Error()))
+	}
+
+	zone = model.ToEntity()
+	if zone == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert zone model to entity", nil)
+	}
+
+	return
+}
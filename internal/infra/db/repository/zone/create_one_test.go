@@ -0,0 +1,169 @@
+package zonerepo_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneRepositoryImpl_CreateOne(t *testing.T) {
+	testID := uuid.New()
+	testConcertID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	price := decimal.RequireFromString("3500.00")
+
+	const insertQuery = `INSERT INTO public\.zones \(concert_id, name, description, price, currency\) VALUES \(\$1, \$2, \$3, \$4, \$5\) RETURNING zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency"`
+
+	tests := []struct {
+		name          string
+		input         *entity.Zone
+		setupMock     func(mock sqlmock.Sqlmock, input *entity.Zone)
+		expectedZone  *entity.Zone
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful zone creation",
+			input: &entity.Zone{
+				ConcertID:   testConcertID,
+				Name:        "VIP",
+				Description: pointer.ToPointer("Front row seating"),
+				Price:       &price,
+				Currency:    "THB",
+			},
+			setupMock: func(mock sqlmock.Sqlmock, input *entity.Zone) {
+				rows := sqlmock.NewRows([]string{
+					"zones.id", "zones.concert_id", "zones.name", "zones.description",
+					"zones.created_at", "zones.updated_at", "zones.price", "zones.currency",
+				}).AddRow(
+					testID, input.ConcertID, input.Name, input.Description, createdAt, updatedAt, "3500.00", "THB",
+				)
+
+				mock.ExpectQuery(insertQuery).
+					WithArgs(input.ConcertID, input.Name, input.Description, price, "THB").
+					WillReturnRows(rows)
+			},
+			expectedZone: &entity.Zone{
+				ID:          testID,
+				ConcertID:   testConcertID,
+				Name:        "VIP",
+				Description: pointer.ToPointer("Front row seating"),
+				Price:       &price,
+				Currency:    "THB",
+				CreatedAt:   createdAt,
+				UpdatedAt:   updatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful zone creation without description",
+			input: &entity.Zone{
+				ConcertID: testConcertID,
+				Name:      "General",
+				Currency:  "THB",
+			},
+			setupMock: func(mock sqlmock.Sqlmock, input *entity.Zone) {
+				rows := sqlmock.NewRows([]string{
+					"zones.id", "zones.concert_id", "zones.name", "zones.description",
+					"zones.created_at", "zones.updated_at", "zones.price", "zones.currency",
+				}).AddRow(
+					testID, input.ConcertID, input.Name, nil, createdAt, updatedAt, nil, "THB",
+				)
+
+				mock.ExpectQuery(insertQuery).
+					WithArgs(input.ConcertID, input.Name, nil, nil, "THB").
+					WillReturnRows(rows)
+			},
+			expectedZone: &entity.Zone{
+				ID:          testID,
+				ConcertID:   testConcertID,
+				Name:        "General",
+				Description: nil,
+				Currency:    "THB",
+				CreatedAt:   createdAt,
+				UpdatedAt:   updatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "foreign key violation",
+			input: &entity.Zone{
+				ConcertID: testConcertID,
+				Name:      "VIP",
+				Currency:  "THB",
+			},
+			setupMock: func(mock sqlmock.Sqlmock, input *entity.Zone) {
+				mock.ExpectQuery(insertQuery).
+					WithArgs(input.ConcertID, input.Name, nil, nil, "THB").
+					WillReturnError(errors.New("pq: insert or update on table \"zones\" violates foreign key constraint"))
+			},
+			expectedZone:  nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name: "database connection error",
+			input: &entity.Zone{
+				ConcertID: testConcertID,
+				Name:      "VIP",
+				Currency:  "THB",
+			},
+			setupMock: func(mock sqlmock.Sqlmock, input *entity.Zone) {
+				mock.ExpectQuery(insertQuery).
+					WithArgs(input.ConcertID, input.Name, nil, nil, "THB").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedZone:  nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock, tt.input)
+
+			// Execute
+			result, err := h.Repository.CreateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), "[repository zone/create_one CreateOne]")
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedZone.ID, result.ID)
+				assert.Equal(t, tt.expectedZone.ConcertID, result.ConcertID)
+				assert.Equal(t, tt.expectedZone.Name, result.Name)
+				assert.Equal(t, tt.expectedZone.Description, result.Description)
+				assert.Equal(t, tt.expectedZone.CreatedAt.UTC(), result.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedZone.UpdatedAt.UTC(), result.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
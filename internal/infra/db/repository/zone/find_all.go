@@ -0,0 +1,64 @@
+package zonerepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *zoneRepositoryImpl) FindAll(ctx context.Context, filter repository.FindAllZonesFilter) (zones *entity.Zones, total int64, err error) {
+	const errLocation = "[repository zone/find_all FindAll] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	// Build WHERE conditions for filtering
+	whereClauses := []postgres.BoolExpression{}
+	if filter.ConcertID != nil {
+		whereClauses = append(whereClauses, table.Zones.ConcertID.EQ(postgres.UUID(*filter.ConcertID)))
+	}
+
+	// Get total count of zones matching the filter
+	countStmt := postgres.SELECT(
+		postgres.COUNT(table.Zones.ID).AS("total"),
+	).FROM(table.Zones)
+	if len(whereClauses) > 0 {
+		countStmt = countStmt.WHERE(postgres.AND(whereClauses...))
+	}
+
+	countQuery, countArgs := countStmt.Sql()
+
+	if err := r.execer.GetContext(ctx, &total, countQuery, countArgs...); err != nil {
+		return nil, 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while counting zones", err.Error()))
+	}
+
+	// Get zones with the same filter
+	stmt := postgres.SELECT(
+		table.Zones.AllColumns,
+	).FROM(table.Zones)
+
+	if len(whereClauses) > 0 {
+		stmt = stmt.WHERE(postgres.AND(whereClauses...))
+	}
+	// Keep a stable order so that pagination is deterministic
+	stmt = stmt.ORDER_BY(table.Zones.Name.ASC(), table.Zones.ID.ASC())
+	// Apply pagination
+	if filter.Limit != nil {
+		stmt = stmt.LIMIT(*filter.Limit)
+	}
+	if filter.Offset != nil {
+		stmt = stmt.OFFSET(*filter.Offset)
+	}
+
+	query, args := stmt.Sql()
+
+	var models Zones
+	if err := r.execer.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while querying zones", err.Error()))
+	}
+
+	zones = models.ToEntities()
+	return zones, total, nil
+}
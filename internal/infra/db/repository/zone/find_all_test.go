@@ -0,0 +1,168 @@
+package zonerepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneRepositoryImpl_FindAll(t *testing.T) {
+	testConcertID := uuid.New()
+	testID1 := uuid.New()
+	testID2 := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 11, 0, 0, 0, time.UTC)
+
+	zoneColumns := []string{
+		"zones.id", "zones.concert_id", "zones.name", "zones.description",
+		"zones.created_at", "zones.updated_at",
+	}
+
+	tests := []struct {
+		name          string
+		filter        repository.FindAllZonesFilter
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedZones *entity.Zones
+		expectedTotal int64
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:   "successful retrieval with no filter",
+			filter: repository.FindAllZonesFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(zones\.id\) AS "total" FROM public\.zones`).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(2))
+
+				rows := sqlmock.NewRows(zoneColumns).
+					AddRow(testID1, testConcertID, "General", nil, createdAt, updatedAt).
+					AddRow(testID2, testConcertID, "VIP", "Front row", createdAt, updatedAt)
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones ORDER BY zones\.name ASC, zones\.id ASC`).
+					WillReturnRows(rows)
+			},
+			expectedZones: &entity.Zones{
+				{ID: testID1, ConcertID: testConcertID, Name: "General", CreatedAt: createdAt, UpdatedAt: updatedAt},
+				{ID: testID2, ConcertID: testConcertID, Name: "VIP", Description: pointer.ToPointer("Front row"), CreatedAt: createdAt, UpdatedAt: updatedAt},
+			},
+			expectedTotal: 2,
+			expectedError: false,
+		},
+		{
+			name: "successful retrieval with concert filter and pagination",
+			filter: repository.FindAllZonesFilter{
+				ConcertID: pointer.ToPointer(testConcertID),
+				Limit:     pointer.ToPointer(int64(1)),
+				Offset:    pointer.ToPointer(int64(1)),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(zones\.id\) AS "total" FROM public\.zones WHERE \(zones\.concert_id = \$1\)`).
+					WithArgs(testConcertID).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(2))
+
+				rows := sqlmock.NewRows(zoneColumns).
+					AddRow(testID2, testConcertID, "VIP", "Front row", createdAt, updatedAt)
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones WHERE \(zones\.concert_id = \$1\) ORDER BY zones\.name ASC, zones\.id ASC LIMIT \$2 OFFSET \$3`).
+					WithArgs(testConcertID, int64(1), int64(1)).
+					WillReturnRows(rows)
+			},
+			expectedZones: &entity.Zones{
+				{ID: testID2, ConcertID: testConcertID, Name: "VIP", Description: pointer.ToPointer("Front row"), CreatedAt: createdAt, UpdatedAt: updatedAt},
+			},
+			expectedTotal: 2,
+			expectedError: false,
+		},
+		{
+			name: "empty result",
+			filter: repository.FindAllZonesFilter{
+				ConcertID: pointer.ToPointer(testConcertID),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(zones\.id\) AS "total" FROM public\.zones WHERE \(zones\.concert_id = \$1\)`).
+					WithArgs(testConcertID).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(0))
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", .* FROM public\.zones WHERE \(zones\.concert_id = \$1\) ORDER BY zones\.name ASC, zones\.id ASC`).
+					WithArgs(testConcertID).
+					WillReturnRows(sqlmock.NewRows(zoneColumns))
+			},
+			expectedZones: &entity.Zones{},
+			expectedTotal: 0,
+			expectedError: false,
+		},
+		{
+			name:   "count query error",
+			filter: repository.FindAllZonesFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(zones\.id\) AS "total" FROM public\.zones`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedZones: nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name:   "select query error",
+			filter: repository.FindAllZonesFilter{},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(zones\.id\) AS "total" FROM public\.zones`).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(2))
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", .* FROM public\.zones ORDER BY zones\.name ASC, zones\.id ASC`).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedZones: nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+
+			// Execute
+			zones, total, err := h.Repository.FindAll(context.Background(), tt.filter)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, zones)
+				assert.Equal(t, int64(0), total)
+				assert.Contains(t, err.Error(), "[repository zone/find_all FindAll]")
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, zones)
+				assert.Equal(t, tt.expectedTotal, total)
+				require.Len(t, *zones, len(*tt.expectedZones))
+				for i, expected := range *tt.expectedZones {
+					actual := (*zones)[i]
+					assert.Equal(t, expected.ID, actual.ID)
+					assert.Equal(t, expected.ConcertID, actual.ConcertID)
+					assert.Equal(t, expected.Name, actual.Name)
+					assert.Equal(t, expected.Description, actual.Description)
+					assert.Equal(t, expected.CreatedAt.UTC(), actual.CreatedAt.UTC())
+					assert.Equal(t, expected.UpdatedAt.UTC(), actual.UpdatedAt.UTC())
+				}
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
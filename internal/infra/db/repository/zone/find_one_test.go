@@ -42,7 +42,7 @@ func TestZoneRepositoryImpl_FindOne(t *testing.T) {
 					id, testConcertID, "VIP", &testDescription, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -60,7 +60,7 @@ func TestZoneRepositoryImpl_FindOne(t *testing.T) {
 			name:   "zone not found",
 			zoneID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -72,7 +72,7 @@ func TestZoneRepositoryImpl_FindOne(t *testing.T) {
 			name:   "database error",
 			zoneID: testID,
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -91,7 +91,7 @@ func TestZoneRepositoryImpl_FindOne(t *testing.T) {
 					id, testConcertID, "General", nil, testCreatedAt, testUpdatedAt,
 				)
 
-				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
+				mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -154,7 +154,7 @@ func TestZoneRepositoryImpl_FindOne_QueryValidation(t *testing.T) {
 		testID, uuid.New(), "VIP", "Front Row", time.Now(), time.Now(),
 	)
 
-	h.Mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
+	h.Mock.ExpectQuery(`SELECT zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency" FROM public\.zones WHERE zones\.id = \$1 FOR UPDATE`).
 		WithArgs(testID).
 		WillReturnRows(rows)
 
@@ -17,6 +17,8 @@ func (z *Zone) ToEntity() *entity.Zone {
 		ConcertID:   z.ConcertID,
 		Name:        z.Name,
 		Description: z.Description,
+		Price:       z.Price,
+		Currency:    z.Currency,
 		CreatedAt:   z.CreatedAt,
 		UpdatedAt:   z.UpdatedAt,
 	}
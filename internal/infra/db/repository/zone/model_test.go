@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -51,6 +52,30 @@ func TestZone_ToEntity(t *testing.T) {
 			},
 			expectedNil: false,
 		},
+		{
+			name: "successful conversion with price and currency",
+			input: zonerepo.Zone{
+				Zones: model.Zones{
+					ID:        testID,
+					ConcertID: testConcertID,
+					Name:      testName,
+					CreatedAt: testCreatedAt,
+					UpdatedAt: testUpdatedAt,
+					Price:     pointer.ToPointer(decimal.RequireFromString("3500.00")),
+					Currency:  "THB",
+				},
+			},
+			expectedEntity: &entity.Zone{
+				ID:        testID,
+				ConcertID: testConcertID,
+				Name:      testName,
+				Price:     pointer.ToPointer(decimal.RequireFromString("3500.00")),
+				Currency:  "THB",
+				CreatedAt: testCreatedAt,
+				UpdatedAt: testUpdatedAt,
+			},
+			expectedNil: false,
+		},
 		{
 			name: "successful conversion with nil description",
 			input: zonerepo.Zone{
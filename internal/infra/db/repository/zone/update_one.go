@@ -0,0 +1,69 @@
+package zonerepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	table "ticket-reservation/internal/infra/db/model_gen/ticket-reservation/public/table"
+
+	postgres "github.com/go-jet/jet/v2/postgres"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *zoneRepositoryImpl) UpdateOne(ctx context.Context, input repository.UpdateZoneInput) (zone *entity.Zone, err error) {
+	const errLocation = "[repository zone/update_one UpdateOne] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	zonesTable := table.Zones
+
+	var updateModel Zone
+	columns := make(postgres.ColumnList, 0)
+
+	// build the update model
+	if input.Name != nil {
+		updateModel.Name = *input.Name
+		columns = append(columns, zonesTable.Name)
+	}
+	if input.Description != nil {
+		updateModel.Description = input.Description
+		columns = append(columns, zonesTable.Description)
+	}
+	if input.Price != nil {
+		updateModel.Price = input.Price
+		columns = append(columns, zonesTable.Price)
+	}
+	if input.Currency != nil {
+		updateModel.Currency = *input.Currency
+		columns = append(columns, zonesTable.Currency)
+	}
+	if len(columns) == 0 {
+		return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+	}
+
+	// SQL statement
+	stmt := zonesTable.
+		UPDATE(columns).
+		MODEL(updateModel).
+		WHERE(zonesTable.ID.EQ(postgres.UUID(input.ID))).
+		RETURNING(zonesTable.AllColumns)
+
+	query, args := stmt.Sql()
+
+	var model Zone
+	err = r.execer.GetContext(ctx, &model, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errsFramework.NewNotFoundError("zone not found", nil)
+		}
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("error while updating zone", err.Error()))
+	}
+
+	zone = model.ToEntity()
+	if zone == nil {
+		return nil, errsFramework.NewInternalServerError("failed to convert zone model to entity", nil)
+	}
+
+	return
+}
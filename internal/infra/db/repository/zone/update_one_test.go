@@ -0,0 +1,188 @@
+package zonerepo_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneRepositoryImpl_UpdateOne(t *testing.T) {
+	testID := uuid.New()
+	testConcertID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 1, 2, 11, 0, 0, 0, time.UTC)
+
+	zoneColumns := []string{
+		"zones.id", "zones.concert_id", "zones.name", "zones.description",
+		"zones.created_at", "zones.updated_at",
+	}
+	const returningClause = ` RETURNING zones\.id AS "zones\.id", zones\.concert_id AS "zones\.concert_id", zones\.name AS "zones\.name", zones\.description AS "zones\.description", zones\.created_at AS "zones\.created_at", zones\.updated_at AS "zones\.updated_at", zones\.price AS "zones\.price", zones\.currency AS "zones\.currency"`
+
+	tests := []struct {
+		name          string
+		input         repository.UpdateZoneInput
+		setupMock     func(mock sqlmock.Sqlmock)
+		expectedZone  *entity.Zone
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful name update",
+			input: repository.UpdateZoneInput{
+				ID:   testID,
+				Name: pointer.ToPointer("Platinum"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(zoneColumns).
+					AddRow(testID, testConcertID, "Platinum", nil, createdAt, updatedAt)
+				mock.ExpectQuery(`UPDATE public\.zones SET name = \$1 WHERE zones\.id = \$2`+returningClause).
+					WithArgs("Platinum", testID).
+					WillReturnRows(rows)
+			},
+			expectedZone: &entity.Zone{
+				ID:        testID,
+				ConcertID: testConcertID,
+				Name:      "Platinum",
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful name and description update",
+			input: repository.UpdateZoneInput{
+				ID:          testID,
+				Name:        pointer.ToPointer("Platinum"),
+				Description: pointer.ToPointer("Closest to the stage"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(zoneColumns).
+					AddRow(testID, testConcertID, "Platinum", "Closest to the stage", createdAt, updatedAt)
+				mock.ExpectQuery(`UPDATE public\.zones SET \(name, description\) = \(\$1, \$2\) WHERE zones\.id = \$3`+returningClause).
+					WithArgs("Platinum", "Closest to the stage", testID).
+					WillReturnRows(rows)
+			},
+			expectedZone: &entity.Zone{
+				ID:          testID,
+				ConcertID:   testConcertID,
+				Name:        "Platinum",
+				Description: pointer.ToPointer("Closest to the stage"),
+				CreatedAt:   createdAt,
+				UpdatedAt:   updatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful price and currency update",
+			input: repository.UpdateZoneInput{
+				ID:       testID,
+				Price:    pointer.ToPointer(decimal.RequireFromString("4200.50")),
+				Currency: pointer.ToPointer("USD"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(append(zoneColumns, "zones.price", "zones.currency")).
+					AddRow(testID, testConcertID, "Platinum", nil, createdAt, updatedAt, "4200.50", "USD")
+				mock.ExpectQuery(`UPDATE public\.zones SET \(price, currency\) = \(\$1, \$2\) WHERE zones\.id = \$3`+returningClause).
+					WithArgs(decimal.RequireFromString("4200.50"), "USD", testID).
+					WillReturnRows(rows)
+			},
+			expectedZone: &entity.Zone{
+				ID:        testID,
+				ConcertID: testConcertID,
+				Name:      "Platinum",
+				Price:     pointer.ToPointer(decimal.RequireFromString("4200.50")),
+				Currency:  "USD",
+				CreatedAt: createdAt,
+				UpdatedAt: updatedAt,
+			},
+			expectedError: false,
+		},
+		{
+			name: "no fields to update",
+			input: repository.UpdateZoneInput{
+				ID: testID,
+			},
+			setupMock:     func(mock sqlmock.Sqlmock) {},
+			expectedZone:  nil,
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+		},
+		{
+			name: "zone not found",
+			input: repository.UpdateZoneInput{
+				ID:   testID,
+				Name: pointer.ToPointer("Platinum"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.zones SET name = \$1 WHERE zones\.id = \$2`+returningClause).
+					WithArgs("Platinum", testID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedZone:  nil,
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "database error",
+			input: repository.UpdateZoneInput{
+				ID:   testID,
+				Name: pointer.ToPointer("Platinum"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`UPDATE public\.zones SET name = \$1 WHERE zones\.id = \$2`+returningClause).
+					WithArgs("Platinum", testID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedZone:  nil,
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			tt.setupMock(h.Mock)
+
+			// Execute
+			result, err := h.Repository.UpdateOne(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, result)
+				assert.Contains(t, err.Error(), "[repository zone/update_one UpdateOne]")
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedZone.ID, result.ID)
+				assert.Equal(t, tt.expectedZone.ConcertID, result.ConcertID)
+				assert.Equal(t, tt.expectedZone.Name, result.Name)
+				assert.Equal(t, tt.expectedZone.Description, result.Description)
+				assert.Equal(t, tt.expectedZone.CreatedAt.UTC(), result.CreatedAt.UTC())
+				assert.Equal(t, tt.expectedZone.UpdatedAt.UTC(), result.UpdatedAt.UTC())
+			}
+
+			// Verify all expectations were met
+			h.AssertExpectationsMet(t)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package eventgateway
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+)
+
+type eventPublisher struct {
+	seatEventRepository       cache.SeatEventRepository
+	webhookDeliveryRepository repository.WebhookDeliveryRepository
+}
+
+// NewEventPublisher returns an EventPublisher sending the seat status events through the seat event repository and
+// queueing the webhook events as deliveries of the webhook delivery repository.
+func NewEventPublisher(seatEventRepository cache.SeatEventRepository, webhookDeliveryRepository repository.WebhookDeliveryRepository) gateway.EventPublisher {
+	return &eventPublisher{
+		seatEventRepository:       seatEventRepository,
+		webhookDeliveryRepository: webhookDeliveryRepository,
+	}
+}
+
+// enqueueWebhookEventsSavepoint is the savepoint the webhook deliveries are created under
+const enqueueWebhookEventsSavepoint = "enqueue_webhook_events"
+
+// zoneKey identifies the seat status stream of a zone.
+type zoneKey struct {
+	concertID uuid.UUID
+	zoneID    uuid.UUID
+}
+
+func (p *eventPublisher) PublishSeatStatus(ctx context.Context, events entity.SeatStatusEvents) {
+	// Each zone has its own stream, keep the order of the events within a zone
+	eventsByZone := make(map[zoneKey]entity.SeatStatusEvents)
+	zones := make([]zoneKey, 0)
+	for _, event := range events {
+		key := zoneKey{concertID: event.ConcertID, zoneID: event.ZoneID}
+		if _, ok := eventsByZone[key]; !ok {
+			zones = append(zones, key)
+		}
+		eventsByZone[key] = append(eventsByZone[key], event)
+	}
+
+	for _, zone := range zones {
+		if _, err := p.seatEventRepository.Publish(ctx, zone.concertID, zone.zoneID, eventsByZone[zone]); err != nil {
+			commonLogger.FromContext(ctx).Error(ctx, "failed to publish seat status events", err, commonLogger.Fields{
+				"concert_id": zone.concertID,
+				"zone_id":    zone.zoneID,
+			})
+		}
+	}
+}
+
+func (p *eventPublisher) EnqueueWebhookEvents(ctx context.Context, tx db.SqlExecer, events entity.WebhookEvents) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	// A failed statement aborts the whole Postgres transaction, roll back to a savepoint instead
+	// so that a caller that must not fail can carry on without the events
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+enqueueWebhookEventsSavepoint); err != nil {
+		return err
+	}
+	if _, err := p.webhookDeliveryRepository.WithTx(tx).CreateForEvents(ctx, events); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+enqueueWebhookEventsSavepoint); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+enqueueWebhookEventsSavepoint)
+	return err
+}
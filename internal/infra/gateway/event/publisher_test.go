@@ -0,0 +1,170 @@
+package eventgateway_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	"ticket-reservation/internal/domain/entity"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	db_mocks "ticket-reservation/internal/infra/db/mocks"
+	eventgateway "ticket-reservation/internal/infra/gateway/event"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestNewEventPublisher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Execute
+	publisher := eventgateway.NewEventPublisher(cache_mocks.NewMockSeatEventRepository(ctrl), repository_mocks.NewMockWebhookDeliveryRepository(ctrl))
+
+	// Assert
+	assert.NotNil(t, publisher)
+}
+
+func TestEventPublisher_PublishSeatStatus(t *testing.T) {
+	concertID := uuid.New()
+	zoneA := uuid.New()
+	zoneB := uuid.New()
+	occurredAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	seatA1 := entity.Seat{ID: uuid.New(), ZoneID: zoneA, SeatNumber: "A1", Status: entity.SeatStatusAvailable}
+	seatA2 := entity.Seat{ID: uuid.New(), ZoneID: zoneA, SeatNumber: "A2", Status: entity.SeatStatusAvailable}
+	seatB1 := entity.Seat{ID: uuid.New(), ZoneID: zoneB, SeatNumber: "B1", Status: entity.SeatStatusAvailable}
+
+	eventsA := entity.NewSeatStatusEvents(concertID, zoneA, entity.Seats{seatA1, seatA2}, occurredAt)
+	eventsB := entity.NewSeatStatusEvents(concertID, zoneB, entity.Seats{seatB1}, occurredAt)
+
+	tests := []struct {
+		name       string
+		events     entity.SeatStatusEvents
+		setupMocks func(mockSeatEventRepository *cache_mocks.MockSeatEventRepository)
+	}{
+		{
+			name:   "events are published to the stream of their zone in order",
+			events: entity.SeatStatusEvents{eventsA[0], eventsB[0], eventsA[1]},
+			setupMocks: func(mockSeatEventRepository *cache_mocks.MockSeatEventRepository) {
+				gomock.InOrder(
+					mockSeatEventRepository.EXPECT().Publish(gomock.Any(), concertID, zoneA, eventsA).Return(eventsA, nil),
+					mockSeatEventRepository.EXPECT().Publish(gomock.Any(), concertID, zoneB, eventsB).Return(eventsB, nil),
+				)
+			},
+		},
+		{
+			name:   "a failed zone does not stop the others",
+			events: append(append(entity.SeatStatusEvents{}, eventsA...), eventsB...),
+			setupMocks: func(mockSeatEventRepository *cache_mocks.MockSeatEventRepository) {
+				mockSeatEventRepository.EXPECT().Publish(gomock.Any(), concertID, zoneA, eventsA).Return(nil, errors.New("redis: connection refused"))
+				mockSeatEventRepository.EXPECT().Publish(gomock.Any(), concertID, zoneB, eventsB).Return(eventsB, nil)
+			},
+		},
+		{
+			name:       "no events",
+			events:     nil,
+			setupMocks: func(mockSeatEventRepository *cache_mocks.MockSeatEventRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSeatEventRepository := cache_mocks.NewMockSeatEventRepository(ctrl)
+			tt.setupMocks(mockSeatEventRepository)
+
+			publisher := eventgateway.NewEventPublisher(mockSeatEventRepository, repository_mocks.NewMockWebhookDeliveryRepository(ctrl))
+
+			// Execute
+			publisher.PublishSeatStatus(logger.NewContext(context.Background(), logger.NewNoopLogger()), tt.events)
+		})
+	}
+}
+
+func TestEventPublisher_EnqueueWebhookEvents(t *testing.T) {
+	occurredAt := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	events := entity.WebhookEvents{
+		entity.NewWebhookEventForConcert(entity.WebhookEventTypeConcertCreated, &entity.Concert{ID: uuid.New(), Name: "Concert"}, occurredAt),
+	}
+
+	tests := []struct {
+		name          string
+		events        entity.WebhookEvents
+		setupMocks    func(mockTx *db_mocks.MockSqlExecer, mockWebhookDeliveryRepository *repository_mocks.MockWebhookDeliveryRepository)
+		expectedError bool
+	}{
+		{
+			name:   "events are queued under a savepoint of the transaction",
+			events: events,
+			setupMocks: func(mockTx *db_mocks.MockSqlExecer, mockWebhookDeliveryRepository *repository_mocks.MockWebhookDeliveryRepository) {
+				gomock.InOrder(
+					mockTx.EXPECT().ExecContext(gomock.Any(), "SAVEPOINT enqueue_webhook_events").Return(driver.ResultNoRows, nil),
+					mockWebhookDeliveryRepository.EXPECT().WithTx(mockTx).Return(mockWebhookDeliveryRepository),
+					mockWebhookDeliveryRepository.EXPECT().CreateForEvents(gomock.Any(), events).Return(int64(2), nil),
+					mockTx.EXPECT().ExecContext(gomock.Any(), "RELEASE SAVEPOINT enqueue_webhook_events").Return(driver.ResultNoRows, nil),
+				)
+			},
+		},
+		{
+			name:   "repository error rolls back to the savepoint",
+			events: events,
+			setupMocks: func(mockTx *db_mocks.MockSqlExecer, mockWebhookDeliveryRepository *repository_mocks.MockWebhookDeliveryRepository) {
+				gomock.InOrder(
+					mockTx.EXPECT().ExecContext(gomock.Any(), "SAVEPOINT enqueue_webhook_events").Return(driver.ResultNoRows, nil),
+					mockWebhookDeliveryRepository.EXPECT().WithTx(mockTx).Return(mockWebhookDeliveryRepository),
+					mockWebhookDeliveryRepository.EXPECT().CreateForEvents(gomock.Any(), events).
+						Return(int64(0), errsFramework.NewDatabaseError("insert failed", "error")),
+					mockTx.EXPECT().ExecContext(gomock.Any(), "ROLLBACK TO SAVEPOINT enqueue_webhook_events").Return(driver.ResultNoRows, nil),
+				)
+			},
+			expectedError: true,
+		},
+		{
+			name:   "savepoint error",
+			events: events,
+			setupMocks: func(mockTx *db_mocks.MockSqlExecer, mockWebhookDeliveryRepository *repository_mocks.MockWebhookDeliveryRepository) {
+				mockTx.EXPECT().ExecContext(gomock.Any(), "SAVEPOINT enqueue_webhook_events").Return(nil, errors.New("connection reset"))
+			},
+			expectedError: true,
+		},
+		{
+			name:   "no events",
+			events: nil,
+			setupMocks: func(mockTx *db_mocks.MockSqlExecer, mockWebhookDeliveryRepository *repository_mocks.MockWebhookDeliveryRepository) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := db_mocks.NewMockSqlExecer(ctrl)
+			mockWebhookDeliveryRepository := repository_mocks.NewMockWebhookDeliveryRepository(ctrl)
+			tt.setupMocks(mockTx, mockWebhookDeliveryRepository)
+
+			publisher := eventgateway.NewEventPublisher(cache_mocks.NewMockSeatEventRepository(ctrl), mockWebhookDeliveryRepository)
+
+			// Execute
+			err := publisher.EnqueueWebhookEvents(context.Background(), mockTx, tt.events)
+
+			// Assert
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package paymentgateway
+
+import (
+	"context"
+	"fmt"
+	"ticket-reservation/internal/domain/gateway"
+)
+
+const (
+	// FakeProvider is the provider name stored with payments processed by the fake gateway.
+	FakeProvider = "fake"
+	// FakeDeclinedPaymentMethod makes the fake gateway decline the charge, to exercise the failure path locally.
+	FakeDeclinedPaymentMethod = "fake_declined"
+)
+
+type fakePaymentGateway struct{}
+
+// NewFakePaymentGateway returns an in-process PaymentGateway for local development and tests.
+// Every charge is approved, except those made with FakeDeclinedPaymentMethod. Every refund is approved.
+func NewFakePaymentGateway() gateway.PaymentGateway {
+	return &fakePaymentGateway{}
+}
+
+func (g *fakePaymentGateway) Provider() string {
+	return FakeProvider
+}
+
+func (g *fakePaymentGateway) Charge(ctx context.Context, input gateway.ChargeInput) (*gateway.ChargeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if input.PaymentMethod == FakeDeclinedPaymentMethod {
+		return nil, gateway.ErrPaymentDeclined
+	}
+	// The payment ID is the idempotency key, so the same payment always gets the same reference
+	return &gateway.ChargeResult{
+		ProviderReference: fmt.Sprintf("fake_ch_%s", input.PaymentID.String()),
+	}, nil
+}
+
+func (g *fakePaymentGateway) Refund(ctx context.Context, input gateway.RefundInput) (*gateway.RefundResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// The refund ID is the idempotency key, so the same refund always gets the same reference
+	return &gateway.RefundResult{
+		ProviderReference: fmt.Sprintf("fake_re_%s", input.RefundID.String()),
+	}, nil
+}
@@ -0,0 +1,130 @@
+package paymentgateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/gateway"
+	paymentgateway "ticket-reservation/internal/infra/gateway/payment"
+)
+
+func TestNewFakePaymentGateway(t *testing.T) {
+	// Execute
+	gw := paymentgateway.NewFakePaymentGateway()
+
+	// Assert
+	assert.NotNil(t, gw)
+	assert.Equal(t, paymentgateway.FakeProvider, gw.Provider())
+}
+
+func TestFakePaymentGateway_Charge(t *testing.T) {
+	paymentID := uuid.New()
+
+	tests := []struct {
+		name              string
+		ctx               func() context.Context
+		paymentMethod     string
+		expectedReference string
+		expectedError     error
+	}{
+		{
+			name:              "charge is approved",
+			ctx:               context.Background,
+			paymentMethod:     "credit_card",
+			expectedReference: "fake_ch_" + paymentID.String(),
+		},
+		{
+			name:          "charge is declined",
+			ctx:           context.Background,
+			paymentMethod: paymentgateway.FakeDeclinedPaymentMethod,
+			expectedError: gateway.ErrPaymentDeclined,
+		},
+		{
+			name: "context is cancelled",
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			paymentMethod: "credit_card",
+			expectedError: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := paymentgateway.NewFakePaymentGateway()
+
+			// Execute
+			result, err := gw.Charge(tt.ctx(), gateway.ChargeInput{
+				PaymentID:     paymentID,
+				Amount:        decimal.RequireFromString("1500.00"),
+				PaymentMethod: tt.paymentMethod,
+			})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedReference, result.ProviderReference)
+			}
+		})
+	}
+}
+
+func TestFakePaymentGateway_Refund(t *testing.T) {
+	refundID := uuid.New()
+
+	tests := []struct {
+		name              string
+		ctx               func() context.Context
+		expectedReference string
+		expectedError     error
+	}{
+		{
+			name:              "refund is approved",
+			ctx:               context.Background,
+			expectedReference: "fake_re_" + refundID.String(),
+		},
+		{
+			name: "context is cancelled",
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			expectedError: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := paymentgateway.NewFakePaymentGateway()
+
+			// Execute
+			result, err := gw.Refund(tt.ctx(), gateway.RefundInput{
+				RefundID:          refundID,
+				ProviderReference: "fake_ch_" + uuid.New().String(),
+				Amount:            decimal.RequireFromString("500.00"),
+			})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedReference, result.ProviderReference)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package webhookgateway
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"ticket-reservation/internal/domain/gateway"
+	"time"
+)
+
+// maxResponseBodySize is how much of a response is read so that the connection can be reused, the body is discarded
+const maxResponseBodySize = 64 << 10
+
+type httpWebhookSender struct {
+	client *http.Client
+}
+
+// NewHTTPWebhookSender returns a WebhookSender posting payloads over HTTP, giving up on a subscriber that does not
+// answer within the timeout. Redirects are not followed: a subscriber that moved must have its URL updated.
+func NewHTTPWebhookSender(timeout time.Duration) gateway.WebhookSender {
+	return &httpWebhookSender{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *httpWebhookSender) Send(ctx context.Context, input gateway.SendWebhookInput) (*gateway.SendWebhookResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, input.URL, bytes.NewReader(input.Payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range input.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	return &gateway.SendWebhookResult{StatusCode: resp.StatusCode}, nil
+}
@@ -0,0 +1,100 @@
+package webhookgateway_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/gateway"
+	webhookgateway "ticket-reservation/internal/infra/gateway/webhook"
+)
+
+func TestNewHTTPWebhookSender(t *testing.T) {
+	// Execute
+	sender := webhookgateway.NewHTTPWebhookSender(time.Second)
+
+	// Assert
+	assert.NotNil(t, sender)
+}
+
+func TestHTTPWebhookSender_Send(t *testing.T) {
+	payload := []byte(`{"id":"1","type":"reservation.created"}`)
+
+	tests := []struct {
+		name               string
+		handler            http.HandlerFunc
+		timeout            time.Duration
+		expectedStatusCode int
+		expectedError      bool
+	}{
+		{
+			name: "subscriber accepts the payload",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" ||
+					r.Header.Get("X-Webhook-Signature") != "signature" || string(body) != string(payload) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+			timeout:            time.Second,
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "subscriber fails",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			},
+			timeout:            time.Second,
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name: "redirect is not followed",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/elsewhere", http.StatusMovedPermanently)
+			},
+			timeout:            time.Second,
+			expectedStatusCode: http.StatusMovedPermanently,
+		},
+		{
+			name: "subscriber does not answer in time",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+			},
+			timeout:       50 * time.Millisecond,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+			sender := webhookgateway.NewHTTPWebhookSender(tt.timeout)
+
+			// Execute
+			result, err := sender.Send(context.Background(), gateway.SendWebhookInput{
+				URL:     server.URL,
+				Headers: map[string]string{"X-Webhook-Signature": "signature"},
+				Payload: payload,
+			})
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedStatusCode, result.StatusCode)
+			}
+		})
+	}
+}
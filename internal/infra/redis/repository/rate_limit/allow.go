@@ -0,0 +1,63 @@
+package ratelimitrepo
+
+import (
+	"context"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// allowScript implements the generic cell rate algorithm, a token bucket that only stores the theoretical arrival time
+// (TAT) of the next request. Every allowed request pushes the TAT by window / limit, and a request is allowed as long as
+// the TAT stays within one window from now. The clock of Redis is used so that all instances share the same time.
+//
+// KEYS[1] is the key of the subject, ARGV[1] the number of requests and ARGV[2] the window in milliseconds.
+// It returns {allowed, remaining, retry after ms, reset after ms}.
+var allowScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local interval = window / limit
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + tonumber(time[2]) / 1000
+
+local tat = tonumber(redis.call('GET', KEYS[1]))
+if not tat or tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - window
+if allow_at > now then
+	return {0, 0, math.ceil(allow_at - now), math.ceil(tat - now)}
+end
+
+redis.call('SET', KEYS[1], string.format('%.3f', new_tat), 'PX', math.ceil(new_tat - now))
+return {1, math.floor((now - allow_at) / interval), 0, math.ceil(new_tat - now)}
+`)
+
+func (r *rateLimiter) Allow(ctx context.Context, group entity.RateLimitGroup, subject string, limit entity.RateLimit) (result *entity.RateLimitResult, err error) {
+	const errLocation = "[repository rate_limit/allow Allow]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	key := domaincache.GetRateLimitKey(group, subject)
+
+	values, err := allowScript.Run(ctx, r.redisClient, []string{key}, limit.Requests, limit.Window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to count request against rate limit", err.Error()))
+	}
+	if len(values) != 4 {
+		return nil, errsFramework.NewDatabaseError("unexpected rate limit script response", values)
+	}
+
+	return &entity.RateLimitResult{
+		Allowed:    values[0] == 1,
+		Limit:      limit.Requests,
+		Remaining:  values[1],
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+		ResetAfter: time.Duration(values[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package ratelimitrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	ratelimitrepo "ticket-reservation/internal/infra/redis/repository/rate_limit"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestRateLimiterRepositoryImpl_Allow(t *testing.T) {
+	subject := "ip:203.0.113.7"
+	expectedKey := "rate_limit:reserve:" + subject
+	limit := entity.RateLimit{Requests: 20, Window: time.Minute}
+	// The script is run by its SHA1 digest, the arguments are the number of requests and the window in milliseconds
+	expectScript := func(mock redismock.ClientMock) *redismock.ExpectedCmd {
+		return mock.Regexp().ExpectEvalSha(`^[0-9a-f]{40}$`, []string{expectedKey}, "20", "60000")
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func(mock redismock.ClientMock)
+		expectedResult *entity.RateLimitResult
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name: "request allowed",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(1), int64(19), int64(0), int64(3000)})
+			},
+			expectedResult: &entity.RateLimitResult{
+				Allowed:    true,
+				Limit:      20,
+				Remaining:  19,
+				RetryAfter: 0,
+				ResetAfter: 3 * time.Second,
+			},
+		},
+		{
+			name: "request rejected",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(0), int64(0), int64(2500), int64(60000)})
+			},
+			expectedResult: &entity.RateLimitResult{
+				Allowed:    false,
+				Limit:      20,
+				Remaining:  0,
+				RetryAfter: 2500 * time.Millisecond,
+				ResetAfter: time.Minute,
+			},
+		},
+		{
+			name: "script fails",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name: "unexpected script response",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(1)})
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := ratelimitrepo.NewRateLimiterRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			result, err := repository.Allow(context.Background(), entity.RateLimitGroupReserve, subject, limit)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository rate_limit/allow Allow]")
+				assert.Nil(t, result)
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package ratelimitrepo
+
+import (
+	domaincache "ticket-reservation/internal/domain/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type rateLimiter struct {
+	redisClient redis.UniversalClient
+}
+
+func NewRateLimiterRepository(redisClient redis.UniversalClient) domaincache.RateLimiterRepository {
+	return &rateLimiter{
+		redisClient: redisClient,
+	}
+}
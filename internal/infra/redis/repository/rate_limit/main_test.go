@@ -0,0 +1,19 @@
+package ratelimitrepo_test
+
+import (
+	"testing"
+	ratelimitrepo "ticket-reservation/internal/infra/redis/repository/rate_limit"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRateLimiterRepository(t *testing.T) {
+	client, _ := redismock.NewClientMock()
+
+	// Execute
+	repo := ratelimitrepo.NewRateLimiterRepository(client)
+
+	// Assert
+	assert.NotNil(t, repo)
+}
@@ -54,6 +54,45 @@ func (r *seatMap) SetSeat(ctx context.Context, concertID, zoneID uuid.UUID, seat
 	return nil
 }
 
+func (r *seatMap) SetSeats(ctx context.Context, concertID, zoneID uuid.UUID, seats entity.Seats, ttl time.Duration) (err error) {
+	const errLocation = "[repository seat/seat_map SetSeats]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(seats) == 0 {
+		return nil
+	}
+
+	key := getSeatMapKey(concertID, zoneID)
+
+	// Serialize seat entities to field/value pairs
+	values := make([]interface{}, 0, len(seats)*2)
+	fields := make([]string, 0, len(seats))
+	for _, seat := range seats {
+		seatJSON, err := json.Marshal(seat)
+		if err != nil {
+			return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to serialize seat entity", nil))
+		}
+		values = append(values, seat.SeatNumber, string(seatJSON))
+		fields = append(fields, seat.SeatNumber)
+	}
+
+	// Set all field values in a single command
+	err = r.redisClient.HSet(ctx, key, values...).Err()
+	if err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to set seat entities", err.Error()))
+	}
+
+	// Set TTL for the fields if provided
+	if ttl > domaincache.SeatMapNoExpiration { // ttl > 0
+		err = r.redisClient.HExpire(ctx, key, ttl, fields...).Err()
+		if err != nil {
+			return errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to set TTL for seat entities", err.Error()))
+		}
+	}
+
+	return nil
+}
+
 func (r *seatMap) GetSeat(ctx context.Context, concertID, zoneID uuid.UUID, seatNumber string) (seat *entity.Seat, err error) {
 	const errLocation = "[repository seat/seat_map GetSeat]"
 	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
@@ -110,6 +149,41 @@ func (r *seatMap) GetAllSeats(ctx context.Context, concertID, zoneID uuid.UUID)
 	return pointer.ToPointer(seatsList), nil
 }
 
+func (r *seatMap) SetSeatCount(ctx context.Context, concertID, zoneID uuid.UUID, count int64) (err error) {
+	const errLocation = "[repository seat/seat_map SetSeatCount]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	key := getSeatMapSizeKey(concertID, zoneID)
+
+	err = r.redisClient.Set(ctx, key, count, domaincache.SeatMapNoExpiration).Err()
+	if err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to set seat count", err.Error()))
+	}
+
+	return nil
+}
+
+func (r *seatMap) GetSeatCount(ctx context.Context, concertID, zoneID uuid.UUID) (count int64, err error) {
+	const errLocation = "[repository seat/seat_map GetSeatCount]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	key := getSeatMapSizeKey(concertID, zoneID)
+
+	count, err = r.redisClient.Get(ctx, key).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, errsFramework.NewNotFoundError("seat count not found in cache", nil)
+		}
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to get seat count", err.Error()))
+	}
+
+	return count, nil
+}
+
 func getSeatMapKey(concertID, zoneID uuid.UUID) string {
 	return domaincache.GetSeatMapKey(concertID, zoneID)
 }
+
+func getSeatMapSizeKey(concertID, zoneID uuid.UUID) string {
+	return domaincache.GetSeatMapSizeKey(concertID, zoneID)
+}
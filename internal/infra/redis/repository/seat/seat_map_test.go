@@ -441,3 +441,227 @@ func TestSeatMapRepositoryImpl_GetAllSeats(t *testing.T) {
 		})
 	}
 }
+
+func TestSeatMapRepositoryImpl_SetSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	expectedKey := "seat_map:concert:" + concertID.String() + ":zone:" + zoneID.String()
+
+	seat1 := entity.Seat{
+		ID:         uuid.New(),
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusAvailable,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	seat2 := entity.Seat{
+		ID:         uuid.New(),
+		ZoneID:     zoneID,
+		SeatNumber: "A2",
+		Status:     entity.SeatStatusBooked,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	seat1JSON, _ := json.Marshal(seat1)
+	seat2JSON, _ := json.Marshal(seat2)
+
+	tests := []struct {
+		name          string
+		seats         entity.Seats
+		ttl           time.Duration
+		setupMock     func(mock redismock.ClientMock)
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name:  "successful set without TTL",
+			seats: entity.Seats{seat1, seat2},
+			ttl:   domaincache.SeatMapNoExpiration,
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectHSet(expectedKey, "A1", string(seat1JSON), "A2", string(seat2JSON)).SetVal(2)
+			},
+			expectedError: false,
+		},
+		{
+			name:  "successful set with TTL",
+			seats: entity.Seats{seat1, seat2},
+			ttl:   5 * time.Minute,
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectHSet(expectedKey, "A1", string(seat1JSON), "A2", string(seat2JSON)).SetVal(2)
+				mock.ExpectDo("HEXPIRE", expectedKey, int64(5*time.Minute/time.Second), "FIELDS", 2, "A1", "A2").SetVal([]int64{1, 1})
+			},
+			expectedError: false,
+		},
+		{
+			name:          "empty seats is a no-op",
+			seats:         entity.Seats{},
+			ttl:           domaincache.SeatMapNoExpiration,
+			setupMock:     func(mock redismock.ClientMock) {},
+			expectedError: false,
+		},
+		{
+			name:  "hset operation fails",
+			seats: entity.Seats{seat1, seat2},
+			ttl:   domaincache.SeatMapNoExpiration,
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectHSet(expectedKey, "A1", string(seat1JSON), "A2", string(seat2JSON)).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name:  "hexpire operation fails",
+			seats: entity.Seats{seat1, seat2},
+			ttl:   5 * time.Minute,
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectHSet(expectedKey, "A1", string(seat1JSON), "A2", string(seat2JSON)).SetVal(2)
+				mock.ExpectDo("HEXPIRE", expectedKey, int64(5*time.Minute/time.Second), "FIELDS", 2, "A1", "A2").SetErr(errors.New("expire command failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := seatrepo.NewSeatMapRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			err := repository.SetSeats(context.Background(), concertID, zoneID, tt.seats, tt.ttl)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository seat/seat_map SetSeats]")
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSeatMapRepositoryImpl_SetSeatCount(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	expectedKey := "seat_map_size:concert:" + concertID.String() + ":zone:" + zoneID.String()
+
+	tests := []struct {
+		name          string
+		setupMock     func(mock redismock.ClientMock)
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful set",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectSet(expectedKey, int64(120), domaincache.SeatMapNoExpiration).SetVal("OK")
+			},
+			expectedError: false,
+		},
+		{
+			name: "set operation fails",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectSet(expectedKey, int64(120), domaincache.SeatMapNoExpiration).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := seatrepo.NewSeatMapRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			err := repository.SetSeatCount(context.Background(), concertID, zoneID, 120)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository seat/seat_map SetSeatCount]")
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSeatMapRepositoryImpl_GetSeatCount(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	expectedKey := "seat_map_size:concert:" + concertID.String() + ":zone:" + zoneID.String()
+
+	tests := []struct {
+		name          string
+		setupMock     func(mock redismock.ClientMock)
+		expectedCount int64
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "successful get",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(expectedKey).SetVal("120")
+			},
+			expectedCount: 120,
+			expectedError: false,
+		},
+		{
+			name: "count not cached",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(expectedKey).RedisNil()
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "redis connection error",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(expectedKey).SetErr(errors.New("connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := seatrepo.NewSeatMapRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			count, err := repository.GetSeatCount(context.Background(), concertID, zoneID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository seat/seat_map GetSeatCount]")
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package seateventrepo
+
+import (
+	"context"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// findSinceScript reads the current version and the kept events after a version in one go, so that no event
+// published in between is missed. The events are truncated when the oldest kept event is not the next one,
+// or when the version is ahead of the current version.
+//
+// KEYS[1] is the version and KEYS[2] the history, ARGV[1] the version to read after.
+// It returns {current version, truncated, events}, where truncated is 1 when the events are truncated.
+var findSinceScript = redis.NewScript(`
+local version = tonumber(redis.call('GET', KEYS[1]) or '0')
+local since = tonumber(ARGV[1])
+if since > version then
+	return {version, 1, {}}
+end
+if since == version then
+	return {version, 0, {}}
+end
+
+local oldest = redis.call('ZRANGE', KEYS[2], 0, 0, 'WITHSCORES')
+if #oldest == 0 or tonumber(oldest[2]) > since + 1 then
+	return {version, 1, {}}
+end
+return {version, 0, redis.call('ZRANGEBYSCORE', KEYS[2], '(' .. since, '+inf')}
+`)
+
+func (r *seatEvent) FindSince(ctx context.Context, concertID, zoneID uuid.UUID, version int64) (backlog *entity.SeatStatusEventBacklog, err error) {
+	const errLocation = "[repository seat_event/find_since FindSince]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	keys := []string{domaincache.GetSeatEventVersionKey(concertID, zoneID), domaincache.GetSeatEventHistoryKey(concertID, zoneID)}
+	values, err := findSinceScript.Run(ctx, r.redisClient, keys, version).Slice()
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to find seat status events", err.Error()))
+	}
+	if len(values) != 3 {
+		return nil, errsFramework.NewDatabaseError("unexpected seat status event script response", values)
+	}
+	currentVersion, versionOK := values[0].(int64)
+	truncated, truncatedOK := values[1].(int64)
+	messages, messagesOK := values[2].([]interface{})
+	if !versionOK || !truncatedOK || !messagesOK {
+		return nil, errsFramework.NewDatabaseError("unexpected seat status event script response", values)
+	}
+
+	backlog = &entity.SeatStatusEventBacklog{
+		Events:    make(entity.SeatStatusEvents, 0, len(messages)),
+		Version:   currentVersion,
+		Truncated: truncated == 1,
+	}
+	for _, message := range messages {
+		data, ok := message.(string)
+		if !ok {
+			return nil, errsFramework.NewDatabaseError("unexpected seat status event script response", values)
+		}
+		event, err := decodeSeatEvent(data)
+		if err != nil {
+			return nil, errsFramework.NewDatabaseError("failed to deserialize seat status event", err.Error())
+		}
+		backlog.Events = append(backlog.Events, event)
+	}
+	return backlog, nil
+}
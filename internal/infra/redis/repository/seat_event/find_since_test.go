@@ -0,0 +1,117 @@
+package seateventrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	seateventrepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestSeatEventRepositoryImpl_FindSince(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	occurredAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	expectedKeys := []string{
+		"seat_events:{concert:" + concertID.String() + ":zone:" + zoneID.String() + "}:version",
+		"seat_events:{concert:" + concertID.String() + ":zone:" + zoneID.String() + "}:history",
+	}
+	// The script is run by its SHA1 digest, the argument is the version to read after
+	expectScript := func(mock redismock.ClientMock) *redismock.ExpectedCmd {
+		return mock.Regexp().ExpectEvalSha(`^[0-9a-f]{40}$`, expectedKeys, "^40$")
+	}
+	message := `{"version":41,"concert_id":"` + concertID.String() + `","zone_id":"` + zoneID.String() + `","seat_id":"` + seatID.String() + `","seat_number":"A1","status":"booked","occurred_at":"2025-01-01T10:00:00Z"}`
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock redismock.ClientMock)
+		expectedBacklog *entity.SeatStatusEventBacklog
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "events found",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(41), int64(0), []interface{}{message}})
+			},
+			expectedBacklog: &entity.SeatStatusEventBacklog{
+				Events: entity.SeatStatusEvents{
+					{Version: 41, ConcertID: concertID, ZoneID: zoneID, SeatID: seatID, SeatNumber: "A1", Status: entity.SeatStatusBooked, OccurredAt: occurredAt},
+				},
+				Version: 41,
+			},
+		},
+		{
+			name: "events no longer kept",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(2000), int64(1), []interface{}{}})
+			},
+			expectedBacklog: &entity.SeatStatusEventBacklog{
+				Events:    entity.SeatStatusEvents{},
+				Version:   2000,
+				Truncated: true,
+			},
+		},
+		{
+			name: "script fails",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name: "unexpected script response",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(41)})
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name: "invalid event",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(41), int64(0), []interface{}{"not json"}})
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := seateventrepo.NewSeatEventRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			backlog, err := repository.FindSince(context.Background(), concertID, zoneID, 40)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository seat_event/find_since FindSince]")
+				assert.Nil(t, backlog)
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedBacklog, backlog)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package seateventrepo
+
+import (
+	"context"
+	"errors"
+	domaincache "ticket-reservation/internal/domain/cache"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+func (r *seatEvent) FindVersion(ctx context.Context, concertID, zoneID uuid.UUID) (version int64, err error) {
+	const errLocation = "[repository seat_event/find_version FindVersion]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	version, err = r.redisClient.Get(ctx, domaincache.GetSeatEventVersionKey(concertID, zoneID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to get seat status event version", err.Error()))
+	}
+	return version, nil
+}
@@ -0,0 +1,79 @@
+package seateventrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	seateventrepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestSeatEventRepositoryImpl_FindVersion(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	expectedKey := "seat_events:{concert:" + concertID.String() + ":zone:" + zoneID.String() + "}:version"
+
+	tests := []struct {
+		name            string
+		setupMock       func(mock redismock.ClientMock)
+		expectedVersion int64
+		expectedError   bool
+		errorType       error
+	}{
+		{
+			name: "version found",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(expectedKey).SetVal("42")
+			},
+			expectedVersion: 42,
+		},
+		{
+			name: "no event published yet",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(expectedKey).RedisNil()
+			},
+			expectedVersion: 0,
+		},
+		{
+			name: "redis error",
+			setupMock: func(mock redismock.ClientMock) {
+				mock.ExpectGet(expectedKey).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := seateventrepo.NewSeatEventRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			version, err := repository.FindVersion(context.Background(), concertID, zoneID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository seat_event/find_version FindVersion]")
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedVersion, version)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package seateventrepo
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/pkg/broadcast"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// historySize is how many events of a zone are kept for clients resuming after a disconnection
+	historySize = 1000
+	// historyTTL is how long the events of a zone are kept after the last one was published
+	historyTTL = 24 * time.Hour
+	// subscriptionBufferSize is how many events a subscriber may fall behind before it is dropped
+	subscriptionBufferSize = 256
+)
+
+type seatEvent struct {
+	redisClient redis.UniversalClient
+	hub         *broadcast.Hub[entity.SeatStatusEvent]
+
+	// mu guards the Redis subscription, which is shared by the subscribers of every zone on this instance
+	// and only made once someone subscribes
+	mu     sync.Mutex
+	pubSub *redis.PubSub
+}
+
+func NewSeatEventRepository(redisClient redis.UniversalClient) domaincache.SeatEventRepository {
+	return &seatEvent{
+		redisClient: redisClient,
+		hub:         broadcast.NewHub[entity.SeatStatusEvent](subscriptionBufferSize),
+	}
+}
+
+// seatEventRecord is how an event is stored and published in Redis.
+type seatEventRecord struct {
+	Version     int64             `json:"version,omitempty"`
+	ConcertID   uuid.UUID         `json:"concert_id"`
+	ZoneID      uuid.UUID         `json:"zone_id"`
+	SeatID      uuid.UUID         `json:"seat_id"`
+	SeatNumber  string            `json:"seat_number"`
+	Status      entity.SeatStatus `json:"status"`
+	LockedUntil *time.Time        `json:"locked_until,omitempty"`
+	OccurredAt  time.Time         `json:"occurred_at"`
+}
+
+func encodeSeatEvent(event entity.SeatStatusEvent) (string, error) {
+	data, err := json.Marshal(seatEventRecord(event))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func decodeSeatEvent(data string) (entity.SeatStatusEvent, error) {
+	var record seatEventRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return entity.SeatStatusEvent{}, err
+	}
+	return entity.SeatStatusEvent(record), nil
+}
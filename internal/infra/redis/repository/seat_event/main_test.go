@@ -0,0 +1,19 @@
+package seateventrepo_test
+
+import (
+	"testing"
+	seateventrepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSeatEventRepository(t *testing.T) {
+	client, _ := redismock.NewClientMock()
+
+	// Execute
+	repo := seateventrepo.NewSeatEventRepository(client)
+
+	// Assert
+	assert.NotNil(t, repo)
+}
@@ -0,0 +1,70 @@
+package seateventrepo
+
+import (
+	"context"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// publishScript numbers the events of a zone, keeps the last ones and publishes them, so that an event is never
+// published without being kept nor numbered out of order by concurrent publishers.
+// The history is a sorted set of the events scored by their version.
+//
+// KEYS[1] is the version and KEYS[2] the history, ARGV[1] the channel, ARGV[2] the number of events to keep,
+// ARGV[3] how long to keep them in seconds and ARGV[4...] the events without their version.
+// It returns the versions of the events.
+var publishScript = redis.NewScript(`
+local versions = {}
+for i = 4, #ARGV do
+	local version = redis.call('INCR', KEYS[1])
+	local event = cjson.decode(ARGV[i])
+	event['version'] = version
+	local message = cjson.encode(event)
+	redis.call('ZADD', KEYS[2], version, message)
+	redis.call('PUBLISH', ARGV[1], message)
+	versions[#versions + 1] = version
+end
+redis.call('ZREMRANGEBYRANK', KEYS[2], 0, -tonumber(ARGV[2]) - 1)
+redis.call('EXPIRE', KEYS[2], ARGV[3])
+return versions
+`)
+
+func (r *seatEvent) Publish(ctx context.Context, concertID, zoneID uuid.UUID, events entity.SeatStatusEvents) (published entity.SeatStatusEvents, err error) {
+	const errLocation = "[repository seat_event/publish Publish]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	args := make([]interface{}, 0, len(events)+3)
+	args = append(args, domaincache.GetSeatEventChannel(concertID, zoneID), historySize, int64(historyTTL.Seconds()))
+	for _, event := range events {
+		event.Version = 0
+		message, err := encodeSeatEvent(event)
+		if err != nil {
+			return nil, errsFramework.NewDatabaseError("failed to serialize seat status event", err.Error())
+		}
+		args = append(args, message)
+	}
+
+	keys := []string{domaincache.GetSeatEventVersionKey(concertID, zoneID), domaincache.GetSeatEventHistoryKey(concertID, zoneID)}
+	versions, err := publishScript.Run(ctx, r.redisClient, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to publish seat status events", err.Error()))
+	}
+	if len(versions) != len(events) {
+		return nil, errsFramework.NewDatabaseError("unexpected seat status event script response", versions)
+	}
+
+	published = make(entity.SeatStatusEvents, len(events))
+	for i, event := range events {
+		event.Version = versions[i]
+		published[i] = event
+	}
+	return published, nil
+}
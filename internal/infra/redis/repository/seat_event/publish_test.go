@@ -0,0 +1,118 @@
+package seateventrepo_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	seateventrepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatEventRepositoryImpl_Publish(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID1 := uuid.New()
+	seatID2 := uuid.New()
+	occurredAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	lockedUntil := occurredAt.Add(5 * time.Minute)
+
+	events := entity.SeatStatusEvents{
+		{ConcertID: concertID, ZoneID: zoneID, SeatID: seatID1, SeatNumber: "A1", Status: entity.SeatStatusPending, LockedUntil: pointer.ToPointer(lockedUntil), OccurredAt: occurredAt},
+		{ConcertID: concertID, ZoneID: zoneID, SeatID: seatID2, SeatNumber: "A2", Status: entity.SeatStatusAvailable, OccurredAt: occurredAt},
+	}
+	expectedKeys := []string{
+		"seat_events:{concert:" + concertID.String() + ":zone:" + zoneID.String() + "}:version",
+		"seat_events:{concert:" + concertID.String() + ":zone:" + zoneID.String() + "}:history",
+	}
+	// The script is run by its SHA1 digest, the arguments are the channel, the history size and TTL, then the events
+	expectScript := func(mock redismock.ClientMock) *redismock.ExpectedCmd {
+		return mock.Regexp().ExpectEvalSha(`^[0-9a-f]{40}$`, expectedKeys,
+			"^seat_events:concert:"+concertID.String()+":zone:"+zoneID.String()+"$", "^1000$", "^86400$",
+			regexp.QuoteMeta(`{"concert_id":"`+concertID.String()+`","zone_id":"`+zoneID.String()+`","seat_id":"`+seatID1.String()+`","seat_number":"A1","status":"pending","locked_until":"2025-01-01T10:05:00Z","occurred_at":"2025-01-01T10:00:00Z"}`),
+			regexp.QuoteMeta(`{"concert_id":"`+concertID.String()+`","zone_id":"`+zoneID.String()+`","seat_id":"`+seatID2.String()+`","seat_number":"A2","status":"available","occurred_at":"2025-01-01T10:00:00Z"}`),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		events         entity.SeatStatusEvents
+		setupMock      func(mock redismock.ClientMock)
+		expectedEvents entity.SeatStatusEvents
+		expectedError  bool
+		errorType      error
+	}{
+		{
+			name:   "events published",
+			events: events,
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(41), int64(42)})
+			},
+			expectedEvents: entity.SeatStatusEvents{
+				{Version: 41, ConcertID: concertID, ZoneID: zoneID, SeatID: seatID1, SeatNumber: "A1", Status: entity.SeatStatusPending, LockedUntil: pointer.ToPointer(lockedUntil), OccurredAt: occurredAt},
+				{Version: 42, ConcertID: concertID, ZoneID: zoneID, SeatID: seatID2, SeatNumber: "A2", Status: entity.SeatStatusAvailable, OccurredAt: occurredAt},
+			},
+		},
+		{
+			name:           "no events",
+			events:         entity.SeatStatusEvents{},
+			setupMock:      func(mock redismock.ClientMock) {},
+			expectedEvents: entity.SeatStatusEvents{},
+		},
+		{
+			name:   "script fails",
+			events: events,
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name:   "unexpected script response",
+			events: events,
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(41)})
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := seateventrepo.NewSeatEventRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			published, err := repository.Publish(context.Background(), concertID, zoneID, tt.events)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository seat_event/publish Publish]")
+				assert.Nil(t, published)
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedEvents, published)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
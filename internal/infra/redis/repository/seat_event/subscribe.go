@@ -0,0 +1,97 @@
+package seateventrepo
+
+import (
+	"context"
+	"errors"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/pkg/broadcast"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// Subscribe shares one Redis subscription between the subscribers of every zone on this instance: the channel of
+// a zone is subscribed to with its first subscriber and unsubscribed from once its last one is closed.
+// The events are decoded once and fanned out to the subscribers, dropping the ones falling behind.
+func (r *seatEvent) Subscribe(ctx context.Context, concertID, zoneID uuid.UUID) (subscription domaincache.SeatEventSubscription, err error) {
+	const errLocation = "[repository seat_event/subscribe Subscribe]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	channel := domaincache.GetSeatEventChannel(concertID, zoneID)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pubSub == nil {
+		// The subscription lives as long as the repository, it is not tied to the request subscribing first
+		r.pubSub = r.redisClient.Subscribe(context.Background())
+		go r.dispatch(r.pubSub)
+	}
+	if r.hub.Len(channel) == 0 {
+		if err := r.pubSub.Subscribe(ctx, channel); err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to subscribe to seat status events", err.Error()))
+		}
+	}
+
+	return &seatEventSubscription{
+		repository:   r,
+		channel:      channel,
+		subscription: r.hub.Subscribe(channel),
+	}, nil
+}
+
+// dispatch fans out the messages of the Redis subscription until it is closed.
+func (r *seatEvent) dispatch(pubSub *redis.PubSub) {
+	ctx := context.Background()
+	for message := range pubSub.Channel() {
+		event, err := decodeSeatEvent(message.Payload)
+		if err != nil {
+			logger.FromContext(ctx).Error(ctx, "failed to deserialize seat status event", err, logger.Fields{
+				"channel": message.Channel,
+			})
+			continue
+		}
+		r.hub.Publish(message.Channel, event)
+	}
+}
+
+// unsubscribe stops listening to the channel once it has no subscribers left on this instance.
+func (r *seatEvent) unsubscribe(channel string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.pubSub == nil || r.hub.Len(channel) > 0 {
+		return
+	}
+	ctx := context.Background()
+	if err := r.pubSub.Unsubscribe(ctx, channel); err != nil {
+		logger.FromContext(ctx).Error(ctx, "failed to unsubscribe from seat status events", err, logger.Fields{
+			"channel": channel,
+		})
+	}
+}
+
+type seatEventSubscription struct {
+	repository   *seatEvent
+	channel      string
+	subscription *broadcast.Subscription[entity.SeatStatusEvent]
+}
+
+func (s *seatEventSubscription) Events() <-chan entity.SeatStatusEvent {
+	return s.subscription.Messages()
+}
+
+func (s *seatEventSubscription) Err() error {
+	if errors.Is(s.subscription.Err(), broadcast.ErrSlowSubscriber) {
+		return entity.ErrSeatEventSubscriberTooSlow
+	}
+	return s.subscription.Err()
+}
+
+func (s *seatEventSubscription) Close() {
+	s.subscription.Close()
+	s.repository.unsubscribe(s.channel)
+}
@@ -0,0 +1,174 @@
+package seateventrepo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	seateventrepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+// Subscriptions need a server speaking the Redis pub/sub protocol, so these tests run against miniredis
+func newSubscribeTest(t *testing.T) (*miniredis.Miniredis, domaincache.SeatEventRepository) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return server, seateventrepo.NewSeatEventRepository(client)
+}
+
+// waitForSubscribers waits until the server sees the given number of connections subscribed to the channel.
+func waitForSubscribers(t *testing.T, server *miniredis.Miniredis, channel string, count int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return server.PubSubNumSub(channel)[channel] == count
+	}, time.Second, 5*time.Millisecond)
+}
+
+func seatEventPayload(concertID, zoneID, seatID uuid.UUID, seatNumber string) string {
+	return fmt.Sprintf(`{"version":1,"concert_id":"%s","zone_id":"%s","seat_id":"%s","seat_number":"%s","status":"booked","occurred_at":"2025-01-01T10:00:00Z"}`,
+		concertID, zoneID, seatID, seatNumber)
+}
+
+func receiveSeatEvent(t *testing.T, subscription domaincache.SeatEventSubscription) entity.SeatStatusEvent {
+	t.Helper()
+	select {
+	case event, ok := <-subscription.Events():
+		require.True(t, ok, "the subscription ended")
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "no seat status event received")
+		return entity.SeatStatusEvent{}
+	}
+}
+
+func TestSeatEventRepositoryImpl_Subscribe_SharedSubscription(t *testing.T) {
+	server, repo := newSubscribeTest(t)
+	ctx := context.Background()
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	channel := domaincache.GetSeatEventChannel(concertID, zoneID)
+
+	// The first subscriber of the zone subscribes to its channel
+	first, err := repo.Subscribe(ctx, concertID, zoneID)
+	require.NoError(t, err)
+	defer first.Close()
+	waitForSubscribers(t, server, channel, 1)
+
+	// The second subscriber shares the subscription instead of subscribing again
+	second, err := repo.Subscribe(ctx, concertID, zoneID)
+	require.NoError(t, err)
+	defer second.Close()
+	// Another zone is subscribed to on the same connection
+	other, err := repo.Subscribe(ctx, concertID, uuid.New())
+	require.NoError(t, err)
+	defer other.Close()
+	waitForSubscribers(t, server, channel, 1)
+	assert.Len(t, server.PubSubChannels(""), 2)
+
+	// An event reaches every subscriber of the zone once, an undecodable one is skipped
+	assert.Equal(t, 1, server.Publish(channel, "not json"))
+	assert.Equal(t, 1, server.Publish(channel, seatEventPayload(concertID, zoneID, seatID, "A1")))
+
+	expectedEvent := entity.SeatStatusEvent{
+		Version:    1,
+		ConcertID:  concertID,
+		ZoneID:     zoneID,
+		SeatID:     seatID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusBooked,
+		OccurredAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+	assert.Equal(t, expectedEvent, receiveSeatEvent(t, first))
+	assert.Equal(t, expectedEvent, receiveSeatEvent(t, second))
+	assert.Empty(t, other.Events())
+	assert.NoError(t, first.Err())
+	assert.NoError(t, second.Err())
+}
+
+func TestSeatEventRepositoryImpl_Subscribe_Unsubscribe(t *testing.T) {
+	server, repo := newSubscribeTest(t)
+	ctx := context.Background()
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	channel := domaincache.GetSeatEventChannel(concertID, zoneID)
+
+	first, err := repo.Subscribe(ctx, concertID, zoneID)
+	require.NoError(t, err)
+	second, err := repo.Subscribe(ctx, concertID, zoneID)
+	require.NoError(t, err)
+	waitForSubscribers(t, server, channel, 1)
+
+	// The channel stays subscribed while a subscriber is left
+	first.Close()
+	_, ok := <-first.Events()
+	assert.False(t, ok)
+	assert.NoError(t, first.Err())
+	assert.Equal(t, 1, server.PubSubNumSub(channel)[channel])
+	assert.Equal(t, 1, server.Publish(channel, seatEventPayload(concertID, zoneID, uuid.New(), "A1")))
+	assert.Equal(t, "A1", receiveSeatEvent(t, second).SeatNumber)
+
+	// Closing the last subscriber unsubscribes from the channel
+	second.Close()
+	waitForSubscribers(t, server, channel, 0)
+
+	// Subscribing again subscribes to the channel again
+	third, err := repo.Subscribe(ctx, concertID, zoneID)
+	require.NoError(t, err)
+	defer third.Close()
+	waitForSubscribers(t, server, channel, 1)
+}
+
+func TestSeatEventRepositoryImpl_Subscribe_SlowSubscriber(t *testing.T) {
+	server, repo := newSubscribeTest(t)
+	ctx := context.Background()
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	channel := domaincache.GetSeatEventChannel(concertID, zoneID)
+
+	slow, err := repo.Subscribe(ctx, concertID, zoneID)
+	require.NoError(t, err)
+	defer slow.Close()
+	waitForSubscribers(t, server, channel, 1)
+
+	// The subscriber reads nothing while more events than it may fall behind are published
+	for i := 1; i <= 300; i++ {
+		server.Publish(channel, seatEventPayload(concertID, zoneID, uuid.New(), fmt.Sprintf("A%d", i)))
+	}
+
+	// The subscription is ended as too slow, the events buffered before are still delivered
+	require.Eventually(t, func() bool {
+		return slow.Err() != nil
+	}, time.Second, 5*time.Millisecond)
+	received := 0
+	for range slow.Events() {
+		received++
+	}
+	assert.Equal(t, 256, received)
+	assert.ErrorIs(t, slow.Err(), entity.ErrSeatEventSubscriberTooSlow)
+}
+
+func TestSeatEventRepositoryImpl_Subscribe_Error(t *testing.T) {
+	server, repo := newSubscribeTest(t)
+	server.Close()
+
+	// Execute
+	subscription, err := repo.Subscribe(context.Background(), uuid.New(), uuid.New())
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, subscription)
+	assert.ErrorAs(t, err, &errsFramework.DatabaseError{})
+	assert.Contains(t, err.Error(), "failed to subscribe to seat status events")
+}
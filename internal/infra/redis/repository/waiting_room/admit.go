@@ -0,0 +1,56 @@
+package waitingroomrepo
+
+import (
+	"context"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// admitScript moves sessions from the head of the queue to the admitted sessions. The tick key only lives for one
+// interval, so that the sessions of a concert are let in once per interval whichever instance gets there first.
+// Admissions that have ended are dropped on the way.
+//
+// KEYS[1] is the queue, KEYS[2] the admitted sessions and KEYS[3] the tick, ARGV[1] the number of sessions to let in,
+// ARGV[2] the interval and ARGV[3] the length of an admission, both in milliseconds.
+// It returns the number of sessions let in.
+var admitScript = redis.NewScript(`
+if not redis.call('SET', KEYS[3], '1', 'NX', 'PX', ARGV[2]) then
+	return 0
+end
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', now)
+
+local popped = redis.call('ZPOPMIN', KEYS[1], ARGV[1])
+if #popped == 0 then
+	return 0
+end
+
+local admitted_until = now + tonumber(ARGV[3])
+for i = 1, #popped, 2 do
+	redis.call('ZADD', KEYS[2], admitted_until, popped[i])
+end
+redis.call('PEXPIRE', KEYS[2], ARGV[3])
+return #popped / 2
+`)
+
+func (r *waitingRoom) Admit(ctx context.Context, concertID uuid.UUID, count int64, interval, ttl time.Duration) (admitted int64, err error) {
+	const errLocation = "[repository waiting_room/admit Admit]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	keys := []string{
+		domaincache.GetWaitingRoomQueueKey(concertID),
+		domaincache.GetWaitingRoomAdmittedKey(concertID),
+		domaincache.GetWaitingRoomAdmitTickKey(concertID),
+	}
+	admitted, err = admitScript.Run(ctx, r.redisClient, keys, count, interval.Milliseconds(), ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to admit waiting room sessions", err.Error()))
+	}
+	return admitted, nil
+}
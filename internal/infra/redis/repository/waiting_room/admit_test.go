@@ -0,0 +1,87 @@
+package waitingroomrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	waitingroomrepo "ticket-reservation/internal/infra/redis/repository/waiting_room"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWaitingRoomRepositoryImpl_Admit(t *testing.T) {
+	concertID := uuid.New()
+	expectedKeys := []string{
+		"waiting_room:concert:{" + concertID.String() + "}:queue",
+		"waiting_room:concert:{" + concertID.String() + "}:admitted",
+		"waiting_room:concert:{" + concertID.String() + "}:admit_tick",
+	}
+	// The script is run by its SHA1 digest, the arguments are the count, the interval and the admission in milliseconds
+	expectScript := func(mock redismock.ClientMock) *redismock.ExpectedCmd {
+		return mock.Regexp().ExpectEvalSha(`^[0-9a-f]{40}$`, expectedKeys, "100", "5000", "600000")
+	}
+
+	tests := []struct {
+		name             string
+		setupMock        func(mock redismock.ClientMock)
+		expectedAdmitted int64
+		expectedError    bool
+		errorType        error
+	}{
+		{
+			name: "sessions admitted",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal(int64(100))
+			},
+			expectedAdmitted: 100,
+		},
+		{
+			name: "already admitted during the interval",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal(int64(0))
+			},
+			expectedAdmitted: 0,
+		},
+		{
+			name: "script fails",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := waitingroomrepo.NewWaitingRoomRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			admitted, err := repository.Admit(context.Background(), concertID, 100, 5*time.Second, 10*time.Minute)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository waiting_room/admit Admit]")
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedAdmitted, admitted)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package waitingroomrepo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	waitingroomrepo "ticket-reservation/internal/infra/redis/repository/waiting_room"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWaitingRoomRepositoryImpl_FindEntry(t *testing.T) {
+	concertID := uuid.New()
+	sessionID := uuid.NewString()
+	admittedUntil := time.Date(2025, 1, 1, 10, 10, 0, 0, time.UTC)
+	expectedKeys := []string{
+		"waiting_room:concert:{" + concertID.String() + "}:queue",
+		"waiting_room:concert:{" + concertID.String() + "}:admitted",
+	}
+	// The script is run by its SHA1 digest, the arguments are the session ID and whether to queue it
+	expectScript := func(mock redismock.ClientMock) *redismock.ExpectedCmd {
+		return mock.Regexp().ExpectEvalSha(`^[0-9a-f]{40}$`, expectedKeys, sessionID, "0")
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(mock redismock.ClientMock)
+		expectedEntry *entity.WaitingRoomEntry
+		expectedError bool
+		errorType     error
+	}{
+		{
+			name: "session waiting",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(0), int64(42), int64(120), int64(0)})
+			},
+			expectedEntry: &entity.WaitingRoomEntry{
+				ConcertID:   concertID,
+				SessionID:   sessionID,
+				Status:      entity.WaitingRoomEntryStatusWaiting,
+				Position:    42,
+				QueueLength: 120,
+			},
+		},
+		{
+			name: "session admitted",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(1), int64(0), int64(80), admittedUntil.UnixMilli()})
+			},
+			expectedEntry: &entity.WaitingRoomEntry{
+				ConcertID:     concertID,
+				SessionID:     sessionID,
+				Status:        entity.WaitingRoomEntryStatusAdmitted,
+				QueueLength:   80,
+				AdmittedUntil: pointer.ToPointer(admittedUntil),
+			},
+		},
+		{
+			name: "session not found",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(-1), int64(0), int64(0), int64(0)})
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+		},
+		{
+			name: "script fails",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetErr(errors.New("redis connection failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+		{
+			name: "unexpected script response",
+			setupMock: func(mock redismock.ClientMock) {
+				expectScript(mock).SetVal([]interface{}{int64(0)})
+			},
+			expectedError: true,
+			errorType:     &errsFramework.DatabaseError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := redismock.NewClientMock()
+			repository := waitingroomrepo.NewWaitingRoomRepository(client)
+
+			tt.setupMock(mock)
+
+			// Execute
+			entry, err := repository.FindEntry(context.Background(), concertID, sessionID)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[repository waiting_room/find_entry FindEntry]")
+				assert.Nil(t, entry)
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedEntry, entry)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package waitingroomrepo
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func (r *waitingRoom) Join(ctx context.Context, concertID uuid.UUID, sessionID string) (entry *entity.WaitingRoomEntry, err error) {
+	const errLocation = "[repository waiting_room/join Join]"
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	entry, err = r.runEntryScript(ctx, concertID, sessionID, true)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, errsFramework.NewInternalServerError("the session was not queued in the waiting room", nil)
+	}
+	return entry, nil
+}
@@ -0,0 +1,93 @@
+package waitingroomrepo
+
+import (
+	"context"
+	"time"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+	"github.com/redis/go-redis/v9"
+)
+
+type waitingRoom struct {
+	redisClient redis.UniversalClient
+}
+
+func NewWaitingRoomRepository(redisClient redis.UniversalClient) domaincache.WaitingRoomRepository {
+	return &waitingRoom{
+		redisClient: redisClient,
+	}
+}
+
+// entryScript returns the entry of a session, queueing it first when ARGV[2] is "1".
+// The queue is a sorted set of session IDs scored by their joining time, the admitted sessions a sorted set
+// scored by the end of their admission; both in milliseconds of the clock of Redis.
+//
+// KEYS[1] is the queue and KEYS[2] the admitted sessions, ARGV[1] the session ID.
+// It returns {status, position, queue length, admitted until}, where status is -1 when the session is not found,
+// 0 while it is waiting and 1 once it is admitted.
+var entryScript = redis.NewScript(`
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local admitted_until = tonumber(redis.call('ZSCORE', KEYS[2], ARGV[1]))
+if admitted_until and admitted_until > now then
+	return {1, 0, redis.call('ZCARD', KEYS[1]), admitted_until}
+end
+
+if ARGV[2] == '1' then
+	redis.call('ZADD', KEYS[1], 'NX', now, ARGV[1])
+end
+local rank = redis.call('ZRANK', KEYS[1], ARGV[1])
+if not rank then
+	return {-1, 0, 0, 0}
+end
+return {0, rank + 1, redis.call('ZCARD', KEYS[1]), 0}
+`)
+
+const (
+	entryStatusNotFound = -1
+	entryStatusAdmitted = 1
+)
+
+// runEntryScript runs entryScript and converts its response, the entry is nil when the session is not found.
+func (r *waitingRoom) runEntryScript(ctx context.Context, concertID uuid.UUID, sessionID string, join bool) (*entity.WaitingRoomEntry, error) {
+	joinArg := "0"
+	if join {
+		joinArg = "1"
+	}
+
+	keys := []string{domaincache.GetWaitingRoomQueueKey(concertID), domaincache.GetWaitingRoomAdmittedKey(concertID)}
+	values, err := entryScript.Run(ctx, r.redisClient, keys, sessionID, joinArg).Int64Slice()
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewDatabaseError("failed to read waiting room entry", err.Error()))
+	}
+	if len(values) != 4 {
+		return nil, errsFramework.NewDatabaseError("unexpected waiting room script response", values)
+	}
+
+	switch values[0] {
+	case entryStatusNotFound:
+		return nil, nil
+	case entryStatusAdmitted:
+		return &entity.WaitingRoomEntry{
+			ConcertID:     concertID,
+			SessionID:     sessionID,
+			Status:        entity.WaitingRoomEntryStatusAdmitted,
+			QueueLength:   values[2],
+			AdmittedUntil: pointer.ToPointer(time.UnixMilli(values[3]).UTC()),
+		}, nil
+	default:
+		return &entity.WaitingRoomEntry{
+			ConcertID:   concertID,
+			SessionID:   sessionID,
+			Status:      entity.WaitingRoomEntryStatusWaiting,
+			Position:    values[1],
+			QueueLength: values[2],
+		}, nil
+	}
+}
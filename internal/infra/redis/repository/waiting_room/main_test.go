@@ -0,0 +1,19 @@
+package waitingroomrepo_test
+
+import (
+	"testing"
+	waitingroomrepo "ticket-reservation/internal/infra/redis/repository/waiting_room"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWaitingRoomRepository(t *testing.T) {
+	client, _ := redismock.NewClientMock()
+
+	// Execute
+	repo := waitingroomrepo.NewWaitingRoomRepository(client)
+
+	// Assert
+	assert.NotNil(t, repo)
+}
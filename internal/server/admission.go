@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/redis/go-redis/v9"
+
+	concertRepo "ticket-reservation/internal/infra/db/repository/concert"
+	waitingRoomRedisRepo "ticket-reservation/internal/infra/redis/repository/waiting_room"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+)
+
+// admissionController periodically lets the next batch of buyers in from the waiting room of every concert that has one.
+// Every instance runs its own controller; the waiting room lets in one batch per interval and concert so they never overlap.
+type admissionController struct {
+	appLogger          logger.Logger
+	waitingRoomUsecase waitingRoomUsecase.WaitingRoomUsecase
+}
+
+func (s *Server) setupAdmissionController(appLogger logger.Logger, dbConn *sqlx.DB, redisClient redis.UniversalClient) (*periodicWorker, error) {
+	jwtManager, err := newJWTManager(s.cfg.App)
+	if err != nil {
+		return nil, err
+	}
+	waitingRoomUsecase := waitingRoomUsecase.NewWaitingRoomUsecase(
+		s.cfg.App,
+		concertRepo.NewConcertRepository(dbConn),
+		waitingRoomRedisRepo.NewWaitingRoomRepository(redisClient),
+		jwtManager,
+	)
+
+	controller := &admissionController{
+		appLogger:          appLogger,
+		waitingRoomUsecase: waitingRoomUsecase,
+	}
+	return newPeriodicWorker("waiting room admission controller", s.cfg.App.WaitingRoomAdmitInterval, appLogger, controller.admit), nil
+}
+
+func (w *admissionController) admit(ctx context.Context) {
+	result, err := w.waitingRoomUsecase.AdmitWaitingRoomEntries(ctx)
+	if err != nil {
+		w.appLogger.Error(ctx, "failed to admit waiting room entries", err, nil)
+		return
+	}
+	if result.AdmittedEntries > 0 {
+		w.appLogger.Info(ctx, "waiting room entries admitted", logger.Fields{
+			"admitted_entries": result.AdmittedEntries,
+		})
+	}
+}
@@ -2,36 +2,83 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/kittipat1413/go-common/framework/logger"
 	"github.com/kittipat1413/go-common/framework/retry"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	redsyncLocker "github.com/kittipat1413/go-common/framework/lockmanager/redsync"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
 
 	redisHealthCheckRepo "ticket-reservation/internal/infra/redis/repository/healthcheck"
+	rateLimitRedisRepo "ticket-reservation/internal/infra/redis/repository/rate_limit"
 	seatRedisRepo "ticket-reservation/internal/infra/redis/repository/seat"
+	seatEventRedisRepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+	waitingRoomRedisRepo "ticket-reservation/internal/infra/redis/repository/waiting_room"
+
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	eventGateway "ticket-reservation/internal/infra/gateway/event"
+	paymentGateway "ticket-reservation/internal/infra/gateway/payment"
+	webhookGateway "ticket-reservation/internal/infra/gateway/webhook"
+	"ticket-reservation/pkg/promptpay"
+	"ticket-reservation/pkg/signedtoken"
 
 	infraDB "ticket-reservation/internal/infra/db"
+	apiKeyRepo "ticket-reservation/internal/infra/db/repository/api_key"
 	concertRepo "ticket-reservation/internal/infra/db/repository/concert"
 	dbHealthCheckRepo "ticket-reservation/internal/infra/db/repository/healthcheck"
+	paymentRepo "ticket-reservation/internal/infra/db/repository/payment"
+	paymentLineItemRepo "ticket-reservation/internal/infra/db/repository/payment_line_item"
+	paymentWebhookEventRepo "ticket-reservation/internal/infra/db/repository/payment_webhook_event"
+	promoCodeRepo "ticket-reservation/internal/infra/db/repository/promo_code"
+	promoCodeRedemptionRepo "ticket-reservation/internal/infra/db/repository/promo_code_redemption"
+	receiptRepo "ticket-reservation/internal/infra/db/repository/receipt"
+	refundRepo "ticket-reservation/internal/infra/db/repository/refund"
 	reservationRepo "ticket-reservation/internal/infra/db/repository/reservation"
 	seatRepo "ticket-reservation/internal/infra/db/repository/seat"
+	ticketRepo "ticket-reservation/internal/infra/db/repository/ticket"
+	ticketCheckinRepo "ticket-reservation/internal/infra/db/repository/ticket_checkin"
+	ticketScanRepo "ticket-reservation/internal/infra/db/repository/ticket_scan"
+	userRepo "ticket-reservation/internal/infra/db/repository/user"
+	webhookDeliveryRepo "ticket-reservation/internal/infra/db/repository/webhook_delivery"
+	webhookSubscriptionRepo "ticket-reservation/internal/infra/db/repository/webhook_subscription"
 	zonerepo "ticket-reservation/internal/infra/db/repository/zone"
 
+	apiKeyUsecase "ticket-reservation/internal/usecase/api_key"
+	authUsecase "ticket-reservation/internal/usecase/auth"
+	checkinUsecase "ticket-reservation/internal/usecase/checkin"
 	concertUsecase "ticket-reservation/internal/usecase/concert"
 	healthcheckUsecase "ticket-reservation/internal/usecase/healthcheck"
+	paymentUsecase "ticket-reservation/internal/usecase/payment"
+	promoCodeUsecase "ticket-reservation/internal/usecase/promo_code"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
 	seatUsecase "ticket-reservation/internal/usecase/seat"
+	waitingRoomUsecase "ticket-reservation/internal/usecase/waiting_room"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+	zoneUsecase "ticket-reservation/internal/usecase/zone"
 
 	"ticket-reservation/internal/api/http/middleware"
 	httproute "ticket-reservation/internal/api/http/route"
 
+	apiKeyHandler "ticket-reservation/internal/api/http/handler/api_key"
+	authHandler "ticket-reservation/internal/api/http/handler/auth"
+	checkinHandler "ticket-reservation/internal/api/http/handler/checkin"
 	concertHandler "ticket-reservation/internal/api/http/handler/concert"
 	healthcheckHandler "ticket-reservation/internal/api/http/handler/healthcheck"
+	paymentHandler "ticket-reservation/internal/api/http/handler/payment"
+	promoCodeHandler "ticket-reservation/internal/api/http/handler/promo_code"
+	reservationHandler "ticket-reservation/internal/api/http/handler/reservation"
 	seatHandler "ticket-reservation/internal/api/http/handler/seat"
+	waitingRoomHandler "ticket-reservation/internal/api/http/handler/waiting_room"
+	webhookHandler "ticket-reservation/internal/api/http/handler/webhook"
+	zoneHandler "ticket-reservation/internal/api/http/handler/zone"
 )
 
 //nolint:unparam
@@ -45,6 +92,9 @@ func (s *Server) setupRouteDependencies(ctx context.Context, tracerProvider *sdk
 	redisHealthRepo := redisHealthCheckRepo.NewHealthCheckRepository(redisClient)
 	seatLockerRepo := seatRedisRepo.NewSeatLockerRepository(lockmanager)
 	seatMapRepo := seatRedisRepo.NewSeatMapRepository(redisClient)
+	seatEventRepo := seatEventRedisRepo.NewSeatEventRepository(redisClient)
+	rateLimiterRepo := rateLimitRedisRepo.NewRateLimiterRepository(redisClient)
+	waitingRoomRepo := waitingRoomRedisRepo.NewWaitingRoomRepository(redisClient)
 
 	// DB Repositories
 	dbHealthRepo := dbHealthCheckRepo.NewHealthCheckRepository(dbConn)
@@ -52,6 +102,69 @@ func (s *Server) setupRouteDependencies(ctx context.Context, tracerProvider *sdk
 	zoneRepo := zonerepo.NewZoneRepository(dbConn)
 	seatRepo := seatRepo.NewSeatRepository(dbConn)
 	reservationRepo := reservationRepo.NewReservationRepository(dbConn)
+	paymentRepo := paymentRepo.NewPaymentRepository(dbConn)
+	paymentLineItemRepo := paymentLineItemRepo.NewPaymentLineItemRepository(dbConn)
+	paymentWebhookEventRepo := paymentWebhookEventRepo.NewPaymentWebhookEventRepository(dbConn)
+	refundRepo := refundRepo.NewRefundRepository(dbConn)
+	promoCodeRepo := promoCodeRepo.NewPromoCodeRepository(dbConn)
+	promoCodeRedemptionRepo := promoCodeRedemptionRepo.NewPromoCodeRedemptionRepository(dbConn)
+	receiptRepo := receiptRepo.NewReceiptRepository(dbConn)
+	ticketRepo := ticketRepo.NewTicketRepository(dbConn)
+	ticketCheckinRepo := ticketCheckinRepo.NewTicketCheckinRepository(dbConn)
+	ticketScanRepo := ticketScanRepo.NewTicketScanRepository(dbConn)
+	userRepo := userRepo.NewUserRepository(dbConn)
+	apiKeyRepo := apiKeyRepo.NewAPIKeyRepository(dbConn)
+	webhookSubscriptionRepo := webhookSubscriptionRepo.NewWebhookSubscriptionRepository(dbConn)
+	webhookDeliveryRepo := webhookDeliveryRepo.NewWebhookDeliveryRepository(dbConn)
+
+	// Event publisher
+	eventPublisher := eventGateway.NewEventPublisher(seatEventRepo, webhookDeliveryRepo)
+
+	// Payment gateway
+	var paymentGw gateway.PaymentGateway
+	switch s.cfg.App.PaymentGateway {
+	case paymentGateway.FakeProvider:
+		paymentGw = paymentGateway.NewFakePaymentGateway()
+	default:
+		return httproute.Dependency{}, fmt.Errorf("unsupported payment gateway %q", s.cfg.App.PaymentGateway)
+	}
+	// PromptPay is only offered with a biller ID
+	if s.cfg.App.PromptPayBillerID != "" {
+		if err := promptpay.ValidateBillerID(s.cfg.App.PromptPayBillerID); err != nil {
+			return httproute.Dependency{}, fmt.Errorf("invalid %s: %w", config.PromptPayBillerIDKey, err)
+		}
+	}
+
+	// Price calculator
+	priceCalculator, err := newPriceCalculator(s.cfg.App)
+	if err != nil {
+		return httproute.Dependency{}, err
+	}
+
+	// Ticket signer
+	ticketSigner, err := newTicketSigner(s.cfg.App)
+	if err != nil {
+		return httproute.Dependency{}, err
+	}
+
+	// JWT manager
+	jwtManager, err := newJWTManager(s.cfg.App)
+	if err != nil {
+		return httproute.Dependency{}, err
+	}
+
+	// Rate limits
+	rateLimits, err := newRateLimits(s.cfg.App)
+	if err != nil {
+		return httproute.Dependency{}, err
+	}
+
+	// Webhook backoff and sender
+	webhookBackoff, err := newWebhookBackoff(s.cfg.App)
+	if err != nil {
+		return httproute.Dependency{}, err
+	}
+	webhookSender := webhookGateway.NewHTTPWebhookSender(s.cfg.App.WebhookTimeout)
 
 	// Query retrier
 	queryBackoff, _ := retry.NewExponentialBackoffStrategy(500*time.Millisecond, 2.0, 5*time.Second)
@@ -62,8 +175,17 @@ func (s *Server) setupRouteDependencies(ctx context.Context, tracerProvider *sdk
 
 	// Usecases
 	healthcheckUsecase := healthcheckUsecase.NewHealthCheckUsecase(queryRetrier, dbHealthRepo, redisHealthRepo)
-	concertUsecase := concertUsecase.NewConcertUsecase(s.cfg.App, transactorFactory, concertRepo)
-	seatUsecase := seatUsecase.NewSeatUsecase(s.cfg.App, concertRepo, zoneRepo, seatRepo, reservationRepo, transactorFactory, seatLockerRepo, seatMapRepo)
+	concertUsecase := concertUsecase.NewConcertUsecase(s.cfg.App, transactorFactory, concertRepo, eventPublisher)
+	zoneUsecase := zoneUsecase.NewZoneUsecase(s.cfg.App, transactorFactory, concertRepo, zoneRepo)
+	seatUsecase := seatUsecase.NewSeatUsecase(s.cfg.App, concertRepo, zoneRepo, seatRepo, reservationRepo, transactorFactory, seatLockerRepo, seatMapRepo, seatEventRepo, eventPublisher)
+	reservationUsecase := reservationUsecase.NewReservationUsecase(s.cfg.App, transactorFactory, zoneRepo, seatRepo, reservationRepo, ticketRepo, seatLockerRepo, seatMapRepo, eventPublisher)
+	paymentUsecase := paymentUsecase.NewPaymentUsecase(s.cfg.App, transactorFactory, zoneRepo, seatRepo, reservationRepo, paymentRepo, paymentWebhookEventRepo, refundRepo, promoCodeRepo, promoCodeRedemptionRepo, paymentLineItemRepo, receiptRepo, ticketRepo, priceCalculator, ticketSigner, paymentGw, seatLockerRepo, seatMapRepo, eventPublisher)
+	promoCodeUsecase := promoCodeUsecase.NewPromoCodeUsecase(s.cfg.App, concertRepo, zoneRepo, promoCodeRepo, promoCodeRedemptionRepo)
+	checkinUsecase := checkinUsecase.NewCheckinUsecase(s.cfg.App, concertRepo, reservationRepo, ticketRepo, ticketCheckinRepo, ticketScanRepo, ticketSigner)
+	authUsecase := authUsecase.NewAuthUsecase(s.cfg.App, userRepo, jwtManager)
+	apiKeyUsecase := apiKeyUsecase.NewAPIKeyUsecase(s.cfg.App, apiKeyRepo)
+	waitingRoomUsecase := waitingRoomUsecase.NewWaitingRoomUsecase(s.cfg.App, concertRepo, waitingRoomRepo, jwtManager)
+	webhookUsecase := webhookUsecase.NewWebhookUsecase(s.cfg.App, webhookBackoff, webhookSubscriptionRepo, webhookDeliveryRepo, webhookSender)
 
 	// Application middleware
 	appMiddleware := middleware.New()
@@ -71,12 +193,113 @@ func (s *Server) setupRouteDependencies(ctx context.Context, tracerProvider *sdk
 	// Handlers
 	healthHandler := healthcheckHandler.NewHealthCheckHandler(healthcheckUsecase)
 	concertHandler := concertHandler.NewConcertHandler(s.cfg.App, concertUsecase)
+	zoneHandler := zoneHandler.NewZoneHandler(s.cfg.App, zoneUsecase)
 	seatHandler := seatHandler.NewSeatHandler(s.cfg.App, seatUsecase)
+	reservationHandler := reservationHandler.NewReservationHandler(s.cfg.App, reservationUsecase)
+	paymentHandler := paymentHandler.NewPaymentHandler(s.cfg.App, paymentUsecase)
+	promoCodeHandler := promoCodeHandler.NewPromoCodeHandler(s.cfg.App, promoCodeUsecase)
+	checkinHandler := checkinHandler.NewCheckinHandler(s.cfg.App, checkinUsecase)
+	authHandler := authHandler.NewAuthHandler(s.cfg.App, authUsecase)
+	apiKeyHandler := apiKeyHandler.NewAPIKeyHandler(s.cfg.App, apiKeyUsecase)
+	waitingRoomHandler := waitingRoomHandler.NewWaitingRoomHandler(s.cfg.App, waitingRoomUsecase)
+	webhookHandler := webhookHandler.NewWebhookHandler(s.cfg.App, webhookUsecase)
 
 	return httproute.Dependency{
 		Middleware:         appMiddleware,
+		JWTManager:         jwtManager,
+		APIKeyUsecase:      apiKeyUsecase,
+		WaitingRoomUsecase: waitingRoomUsecase,
+		RateLimiter:        rateLimiterRepo,
+		RateLimits:         rateLimits,
 		HealthCheckHandler: healthHandler,
 		ConcertHandler:     concertHandler,
+		ZoneHandler:        zoneHandler,
 		SeatHandler:        seatHandler,
+		ReservationHandler: reservationHandler,
+		PaymentHandler:     paymentHandler,
+		PromoCodeHandler:   promoCodeHandler,
+		CheckinHandler:     checkinHandler,
+		AuthHandler:        authHandler,
+		APIKeyHandler:      apiKeyHandler,
+		WaitingRoomHandler: waitingRoomHandler,
+		WebhookHandler:     webhookHandler,
 	}, nil
 }
+
+// newPriceCalculator builds the price calculator from the pricing settings, invalid rates or rounding stop the server from starting.
+func newPriceCalculator(appConfig config.AppConfig) (*entity.PriceCalculator, error) {
+	rates := make(map[string]decimal.Decimal)
+	for key, value := range map[string]string{
+		config.ServiceFeePercentKey: appConfig.ServiceFeePercent,
+		config.ServiceFeeFixedKey:   appConfig.ServiceFeeFixed,
+		config.PaymentFeePercentKey: appConfig.PaymentFeePercent,
+		config.VATPercentKey:        appConfig.VATPercent,
+	} {
+		rate, err := decimal.NewFromString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", key, value, err)
+		}
+		rates[key] = rate
+	}
+	return entity.NewPriceCalculator(entity.PriceCalculatorConfig{
+		ServiceFeePercent: rates[config.ServiceFeePercentKey],
+		ServiceFeeFixed:   rates[config.ServiceFeeFixedKey],
+		PaymentFeePercent: rates[config.PaymentFeePercentKey],
+		VATPercent:        rates[config.VATPercentKey],
+		Rounding:          entity.RoundingMode(appConfig.PriceRounding),
+	})
+}
+
+// newTicketSigner loads the Ed25519 key signing the QR codes of tickets, the server does not start without a valid key.
+func newTicketSigner(appConfig config.AppConfig) (*entity.TicketSigner, error) {
+	if appConfig.TicketSigningKey == "" {
+		return nil, fmt.Errorf("%s is required", config.TicketSigningKeyKey)
+	}
+	privateKey, err := signedtoken.ParsePrivateKey(appConfig.TicketSigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", config.TicketSigningKeyKey, err)
+	}
+	return entity.NewTicketSigner(privateKey), nil
+}
+
+// newJWTManager builds the manager signing the tokens of users with the JWT secret, the server does not start without a secret.
+func newJWTManager(appConfig config.AppConfig) (jwtutil.JWTManager, error) {
+	if appConfig.JWTSecret == "" {
+		return nil, fmt.Errorf("%s is required", config.JWTSecretKey)
+	}
+	jwtManager, err := jwtutil.NewJWTManager(jwtutil.HS256, []byte(appConfig.JWTSecret))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", config.JWTSecretKey, err)
+	}
+	return jwtManager, nil
+}
+
+// newRateLimits parses the limit of every rate limited route group, an invalid limit stops the server from starting.
+func newRateLimits(appConfig config.AppConfig) (map[entity.RateLimitGroup]entity.RateLimit, error) {
+	rateLimits := make(map[entity.RateLimitGroup]entity.RateLimit)
+	for group, setting := range map[entity.RateLimitGroup]struct{ key, value string }{
+		entity.RateLimitGroupAuth:     {config.RateLimitAuthKey, appConfig.RateLimitAuth},
+		entity.RateLimitGroupBrowse:   {config.RateLimitBrowseKey, appConfig.RateLimitBrowse},
+		entity.RateLimitGroupReserve:  {config.RateLimitReserveKey, appConfig.RateLimitReserve},
+		entity.RateLimitGroupCheckout: {config.RateLimitCheckoutKey, appConfig.RateLimitCheckout},
+	} {
+		rateLimit, err := entity.ParseRateLimit(setting.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", setting.key, err)
+		}
+		rateLimits[group] = rateLimit
+	}
+	return rateLimits, nil
+}
+
+// newWebhookBackoff builds the backoff spacing the attempts of a webhook delivery, doubling the wait after every attempt.
+func newWebhookBackoff(appConfig config.AppConfig) (retry.Strategy, error) {
+	if appConfig.WebhookMaxAttempts < 1 {
+		return nil, fmt.Errorf("invalid %s: must be at least 1", config.WebhookMaxAttemptsKey)
+	}
+	backoff, err := retry.NewExponentialBackoffStrategy(appConfig.WebhookBackoffBase, 2.0, appConfig.WebhookBackoffMax)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s or %s: %w", config.WebhookBackoffBaseKey, config.WebhookBackoffMaxKey, err)
+	}
+	return backoff, nil
+}
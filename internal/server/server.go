@@ -76,6 +76,15 @@ func (s *Server) Start() error {
 	// initialize gin
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.Default()
+	// The client IP keys the rate limits of anonymous callers, only trust the forwarded IP set by known proxies.
+	// Without any, the forwarded IP is ignored so that a caller cannot pick its own IP.
+	trustedProxies := s.cfg.Service.TrustedProxies
+	if len(trustedProxies) == 0 {
+		trustedProxies = nil
+	}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return fmt.Errorf("invalid %s: %w", config.TrustedProxiesKey, err)
+	}
 
 	// Setup middlewares
 	middlewares := s.setupMiddlewares(appLogger, tracerProvider)
@@ -96,6 +105,24 @@ func (s *Server) Start() error {
 	appRoutes := httproute.NewHTTPRoutes(s.cfg.App, deps)
 	appRoutes.RegisterRoutes(router)
 
+	// Start the background sweeper for expired reservations
+	expirySweeper := s.setupExpirySweeper(appLogger, db, redisClient)
+	expirySweeper.Start(ctx)
+
+	// Start the background admission controller of waiting rooms
+	admissionController, err := s.setupAdmissionController(appLogger, db, redisClient)
+	if err != nil {
+		return fmt.Errorf("failed to setup waiting room admission controller: %w", err)
+	}
+	admissionController.Start(ctx)
+
+	// Start the background dispatcher of webhook deliveries
+	webhookDispatcher, err := s.setupWebhookDispatcher(appLogger, db)
+	if err != nil {
+		return fmt.Errorf("failed to setup webhook dispatcher: %w", err)
+	}
+	webhookDispatcher.Start(ctx)
+
 	// Prometheus metrics
 	router.GET("/metrics", middlewareFramework.MetricsHandler())
 
@@ -133,6 +160,24 @@ func (s *Server) Start() error {
 					return httpServer.Shutdown(ctx)
 				},
 			},
+			{
+				Name: "Expiry Sweeper",
+				Op: func(ctx context.Context) error {
+					return expirySweeper.Stop(ctx)
+				},
+			},
+			{
+				Name: "Waiting Room Admission Controller",
+				Op: func(ctx context.Context) error {
+					return admissionController.Stop(ctx)
+				},
+			},
+			{
+				Name: "Webhook Dispatcher",
+				Op: func(ctx context.Context) error {
+					return webhookDispatcher.Stop(ctx)
+				},
+			},
 			{
 				Name: "Tracer Provider",
 				Op: func(ctx context.Context) error {
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	redsyncLocker "github.com/kittipat1413/go-common/framework/lockmanager/redsync"
+	"github.com/kittipat1413/go-common/framework/logger"
+	"github.com/redis/go-redis/v9"
+
+	infraDB "ticket-reservation/internal/infra/db"
+	reservationRepo "ticket-reservation/internal/infra/db/repository/reservation"
+	seatRepo "ticket-reservation/internal/infra/db/repository/seat"
+	ticketRepo "ticket-reservation/internal/infra/db/repository/ticket"
+	webhookDeliveryRepo "ticket-reservation/internal/infra/db/repository/webhook_delivery"
+	zonerepo "ticket-reservation/internal/infra/db/repository/zone"
+	eventGateway "ticket-reservation/internal/infra/gateway/event"
+	seatRedisRepo "ticket-reservation/internal/infra/redis/repository/seat"
+	seatEventRedisRepo "ticket-reservation/internal/infra/redis/repository/seat_event"
+	reservationUsecase "ticket-reservation/internal/usecase/reservation"
+)
+
+// expirySweeper periodically expires stale pending reservations and frees their seats.
+// Every instance runs its own sweeper; rows are claimed with FOR UPDATE SKIP LOCKED so they never overlap.
+type expirySweeper struct {
+	appLogger          logger.Logger
+	reservationUsecase reservationUsecase.ReservationUsecase
+}
+
+func (s *Server) setupExpirySweeper(appLogger logger.Logger, dbConn *sqlx.DB, redisClient redis.UniversalClient) *periodicWorker {
+	reservationUsecase := reservationUsecase.NewReservationUsecase(
+		s.cfg.App,
+		infraDB.NewSqlxTransactorFactory(dbConn),
+		zonerepo.NewZoneRepository(dbConn),
+		seatRepo.NewSeatRepository(dbConn),
+		reservationRepo.NewReservationRepository(dbConn),
+		ticketRepo.NewTicketRepository(dbConn),
+		seatRedisRepo.NewSeatLockerRepository(redsyncLocker.NewRedsyncLockManager(redisClient)),
+		seatRedisRepo.NewSeatMapRepository(redisClient),
+		eventGateway.NewEventPublisher(
+			seatEventRedisRepo.NewSeatEventRepository(redisClient),
+			webhookDeliveryRepo.NewWebhookDeliveryRepository(dbConn),
+		),
+	)
+
+	sweeper := &expirySweeper{
+		appLogger:          appLogger,
+		reservationUsecase: reservationUsecase,
+	}
+	return newPeriodicWorker("expiry sweeper", s.cfg.App.ExpirySweepInterval, appLogger, sweeper.sweep)
+}
+
+func (w *expirySweeper) sweep(ctx context.Context) {
+	result, err := w.reservationUsecase.CleanupExpiredReservations(ctx)
+	if err != nil {
+		w.appLogger.Error(ctx, "failed to cleanup expired reservations", err, nil)
+		return
+	}
+	if result.ExpiredReservations > 0 || result.ReleasedSeats > 0 {
+		w.appLogger.Info(ctx, "expired reservations cleaned up", logger.Fields{
+			"expired_reservations": result.ExpiredReservations,
+			"released_seats":       result.ReleasedSeats,
+		})
+	}
+}
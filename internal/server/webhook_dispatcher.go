@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/kittipat1413/go-common/framework/logger"
+
+	webhookDeliveryRepo "ticket-reservation/internal/infra/db/repository/webhook_delivery"
+	webhookSubscriptionRepo "ticket-reservation/internal/infra/db/repository/webhook_subscription"
+	webhookGateway "ticket-reservation/internal/infra/gateway/webhook"
+	webhookUsecase "ticket-reservation/internal/usecase/webhook"
+)
+
+// webhookDispatcher periodically sends the due webhook deliveries to their subscribers.
+// Every instance runs its own dispatcher; deliveries are claimed with FOR UPDATE SKIP LOCKED so they never overlap.
+// Stopping waits for the deliveries in progress to finish their attempt, the ones left pending are sent by the next
+// dispatcher that claims them once they are due.
+type webhookDispatcher struct {
+	appLogger      logger.Logger
+	webhookUsecase webhookUsecase.WebhookUsecase
+}
+
+func (s *Server) setupWebhookDispatcher(appLogger logger.Logger, dbConn *sqlx.DB) (*periodicWorker, error) {
+	backoff, err := newWebhookBackoff(s.cfg.App)
+	if err != nil {
+		return nil, err
+	}
+	webhookUsecase := webhookUsecase.NewWebhookUsecase(
+		s.cfg.App,
+		backoff,
+		webhookSubscriptionRepo.NewWebhookSubscriptionRepository(dbConn),
+		webhookDeliveryRepo.NewWebhookDeliveryRepository(dbConn),
+		webhookGateway.NewHTTPWebhookSender(s.cfg.App.WebhookTimeout),
+	)
+
+	dispatcher := &webhookDispatcher{
+		appLogger:      appLogger,
+		webhookUsecase: webhookUsecase,
+	}
+	return newPeriodicWorker("webhook dispatcher", s.cfg.App.WebhookDeliveryInterval, appLogger, dispatcher.dispatch), nil
+}
+
+func (w *webhookDispatcher) dispatch(ctx context.Context) {
+	result, err := w.webhookUsecase.DeliverWebhooks(ctx)
+	if err != nil {
+		w.appLogger.Error(ctx, "failed to deliver webhooks", err, nil)
+		return
+	}
+	if result.Delivered > 0 || result.Pending > 0 || result.DeadLettered > 0 {
+		w.appLogger.Info(ctx, "webhooks delivered", logger.Fields{
+			"delivered":     result.Delivered,
+			"pending":       result.Pending,
+			"dead_lettered": result.DeadLettered,
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+// periodicWorker runs a task in the background on every tick of its interval until it is stopped.
+type periodicWorker struct {
+	name      string
+	interval  time.Duration
+	appLogger logger.Logger
+	run       func(ctx context.Context)
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+func newPeriodicWorker(name string, interval time.Duration, appLogger logger.Logger, run func(ctx context.Context)) *periodicWorker {
+	return &periodicWorker{
+		name:      name,
+		interval:  interval,
+		appLogger: appLogger,
+		run:       run,
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start runs the worker in the background until Stop is called. A non-positive interval disables it.
+func (w *periodicWorker) Start(ctx context.Context) {
+	if w.interval <= 0 {
+		w.appLogger.Info(ctx, w.name+" disabled", nil)
+		close(w.doneCh)
+		return
+	}
+
+	go func() {
+		defer close(w.doneCh)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		w.appLogger.Info(ctx, w.name+" started", logger.Fields{
+			"interval": w.interval.String(),
+		})
+		for {
+			select {
+			case <-w.stopCh:
+				return
+			case <-ticker.C:
+				w.run(logger.NewContext(ctx, w.appLogger))
+			}
+		}
+	}()
+}
+
+// Stop signals the worker to stop and waits for the run in progress to finish.
+func (w *periodicWorker) Stop(ctx context.Context) error {
+	select {
+	case <-w.doneCh:
+		return nil
+	default:
+	}
+	close(w.stopCh)
+
+	select {
+	case <-w.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
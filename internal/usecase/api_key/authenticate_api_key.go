@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+)
+
+type AuthenticateAPIKeyInput struct {
+	Key   string
+	Scope entity.APIKeyScope // Scope the route requires
+}
+
+// AuthenticateAPIKey returns the API key a request was sent with, when the key is active and was granted the scope.
+// Unknown keys are unauthorized with the same message whether the prefix or the secret is wrong.
+func (u *apiKeyUsecase) AuthenticateAPIKey(ctx context.Context, input AuthenticateAPIKeyInput) (apiKey *entity.APIKey, err error) {
+	const errLocation = "[usecase api_key/authenticate_api_key AuthenticateAPIKey] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("api_key.usecase"), func(ctx context.Context) (*entity.APIKey, error) {
+		logger := commonLogger.FromContext(ctx)
+
+		prefix, ok := entity.ParseAPIKeyPrefix(input.Key)
+		if !ok {
+			return nil, errsFramework.NewUnauthorizedError("the api key is invalid", nil)
+		}
+
+		apiKey, err := u.apiKeyRepository.FindOneByPrefix(ctx, prefix)
+		if err != nil {
+			if errors.As(err, &errsFramework.NotFoundError{}) {
+				return nil, errsFramework.WrapError(err, errsFramework.NewUnauthorizedError("the api key is invalid", nil))
+			}
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find api key", nil))
+		}
+		if !apiKey.Matches(input.Key) {
+			return nil, errsFramework.NewUnauthorizedError("the api key is invalid", nil)
+		}
+
+		now := time.Now()
+		if !apiKey.IsActive(now) {
+			return nil, errsFramework.NewUnauthorizedError("the api key has been revoked or has expired", nil)
+		}
+		if !apiKey.HasScope(input.Scope) {
+			return nil, errsFramework.NewForbiddenError(fmt.Sprintf("the api key is not granted the %s scope", input.Scope.String()), nil)
+		}
+
+		// Recording the use is best effort, a failure does not reject a valid key
+		if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedInterval {
+			updated, updateErr := u.apiKeyRepository.UpdateOne(ctx, repository.UpdateAPIKeyInput{
+				ID:         apiKey.ID,
+				LastUsedAt: &now,
+			})
+			if updateErr != nil {
+				logger.Error(ctx, "failed to record the use of api key", updateErr, commonLogger.Fields{
+					"api_key_id": apiKey.ID.String(),
+				})
+			} else {
+				apiKey = updated
+			}
+		}
+
+		return apiKey, nil
+	})
+}
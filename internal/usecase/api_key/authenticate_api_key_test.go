@@ -0,0 +1,175 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	apikeyusecase "ticket-reservation/internal/usecase/api_key"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/framework/logger"
+)
+
+func TestAPIKeyUsecase_AuthenticateAPIKey(t *testing.T) {
+	key, prefix, keyHash, err := entity.GenerateAPIKey()
+	require.NoError(t, err)
+
+	apiKeyID := uuid.New()
+	recentlyUsedAt := time.Now().Add(-10 * time.Second)
+	expiredAt := time.Now().Add(-time.Hour)
+	newAPIKey := func() *entity.APIKey {
+		return &entity.APIKey{
+			ID:      apiKeyID,
+			Name:    "Partner",
+			Prefix:  prefix,
+			KeyHash: keyHash,
+			Scopes:  []entity.APIKeyScope{entity.APIKeyScopeReservationsWrite},
+		}
+	}
+
+	validInput := apikeyusecase.AuthenticateAPIKeyInput{Key: key, Scope: entity.APIKeyScopeReservationsWrite}
+
+	tests := []struct {
+		name          string
+		input         apikeyusecase.AuthenticateAPIKeyInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful authentication records the use",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(newAPIKey(), nil)
+				h.mockAPIKeyRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input repository.UpdateAPIKeyInput) (*entity.APIKey, error) {
+						assert.Equal(t, apiKeyID, input.ID)
+						require.NotNil(t, input.LastUsedAt)
+						updated := newAPIKey()
+						updated.LastUsedAt = input.LastUsedAt
+						return updated, nil
+					})
+			},
+		},
+		{
+			name:  "successful authentication of a recently used key",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				apiKey := newAPIKey()
+				apiKey.LastUsedAt = &recentlyUsedAt
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(apiKey, nil)
+			},
+		},
+		{
+			name:  "recording the use fails",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(newAPIKey(), nil)
+				h.mockAPIKeyRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+		},
+		{
+			name:          "malformed key",
+			input:         apikeyusecase.AuthenticateAPIKeyInput{Key: "not-a-key", Scope: entity.APIKeyScopeReservationsWrite},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the api key is invalid",
+		},
+		{
+			name:  "unknown prefix",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(nil, errsFramework.NewNotFoundError("api key not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the api key is invalid",
+		},
+		{
+			name:  "wrong secret",
+			input: apikeyusecase.AuthenticateAPIKeyInput{Key: "trk_" + prefix + "_wrong", Scope: entity.APIKeyScopeReservationsWrite},
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(newAPIKey(), nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the api key is invalid",
+		},
+		{
+			name:  "expired key",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				apiKey := newAPIKey()
+				apiKey.ExpiresAt = &expiredAt
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(apiKey, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the api key has been revoked or has expired",
+		},
+		{
+			name:  "scope not granted",
+			input: apikeyusecase.AuthenticateAPIKeyInput{Key: key, Scope: entity.APIKeyScopeAdmin},
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(newAPIKey(), nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the api key is not granted the admin scope",
+		},
+		{
+			name:  "api key repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOneByPrefix(gomock.Any(), prefix).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find api key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			ctx := logger.NewContext(context.Background(), logger.NewNoopLogger())
+			result, err := h.apiKeyUsecase.AuthenticateAPIKey(ctx, tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase api_key/authenticate_api_key AuthenticateAPIKey]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, apiKeyID, result.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type CreateAPIKeyInput struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,unique,dive,oneof=concerts:read reservations:write admin"`
+	ExpiresAt *time.Time `json:"expires_at" validate:"omitempty"`
+}
+
+// CreateAPIKey issues a key to a partner. Only the prefix and the hash of the key are stored,
+// the key itself is returned once and cannot be retrieved afterwards.
+func (u *apiKeyUsecase) CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (issued *IssuedAPIKey, err error) {
+	const errLocation = "[usecase api_key/create_api_key CreateAPIKey] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("api_key.usecase"), func(ctx context.Context) (*IssuedAPIKey, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		scopes := make([]entity.APIKeyScope, 0, len(input.Scopes))
+		for _, value := range input.Scopes {
+			scope, err := new(entity.APIKeyScope).Parse(value)
+			if err != nil {
+				return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid scope", nil))
+			}
+			scopes = append(scopes, scope)
+		}
+		if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+			return nil, errsFramework.NewBadRequestError("invalid expiry", map[string]string{"details": "expires_at must be in the future"})
+		}
+
+		key, prefix, keyHash, err := entity.GenerateAPIKey()
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to generate api key", nil))
+		}
+
+		apiKey, err := u.apiKeyRepository.CreateOne(ctx, &entity.APIKey{
+			Name:      input.Name,
+			Prefix:    prefix,
+			KeyHash:   keyHash,
+			Scopes:    scopes,
+			ExpiresAt: input.ExpiresAt,
+		})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create api key", nil))
+		}
+
+		return &IssuedAPIKey{APIKey: apiKey, Key: key}, nil
+	})
+}
@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	apikeyusecase "ticket-reservation/internal/usecase/api_key"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAPIKeyUsecase_CreateAPIKey(t *testing.T) {
+	apiKeyID := uuid.New()
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+	pastExpiresAt := time.Now().Add(-time.Hour)
+
+	validInput := apikeyusecase.CreateAPIKeyInput{
+		Name:      "Partner",
+		Scopes:    []string{"concerts:read", "reservations:write"},
+		ExpiresAt: &expiresAt,
+	}
+
+	tests := []struct {
+		name          string
+		input         apikeyusecase.CreateAPIKeyInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful creation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error) {
+						assert.Equal(t, "Partner", apiKey.Name)
+						assert.Equal(t, []entity.APIKeyScope{entity.APIKeyScopeConcertsRead, entity.APIKeyScopeReservationsWrite}, apiKey.Scopes)
+						assert.Equal(t, &expiresAt, apiKey.ExpiresAt)
+						assert.NotEmpty(t, apiKey.Prefix)
+						assert.NotEmpty(t, apiKey.KeyHash)
+						created := *apiKey
+						created.ID = apiKeyID
+						return &created, nil
+					})
+			},
+		},
+		{
+			name: "validation error - unknown scope",
+			input: apikeyusecase.CreateAPIKeyInput{
+				Name:   "Partner",
+				Scopes: []string{"concerts:write"},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - no scope",
+			input: apikeyusecase.CreateAPIKeyInput{
+				Name:   "Partner",
+				Scopes: []string{},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "expiry in the past",
+			input: apikeyusecase.CreateAPIKeyInput{
+				Name:      "Partner",
+				Scopes:    []string{"admin"},
+				ExpiresAt: &pastExpiresAt,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid expiry",
+		},
+		{
+			name:  "api key repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create api key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.apiKeyUsecase.CreateAPIKey(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase api_key/create_api_key CreateAPIKey]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, apiKeyID, result.APIKey.ID)
+
+				// The returned key is the one stored as prefix and hash
+				prefix, ok := entity.ParseAPIKeyPrefix(result.Key)
+				require.True(t, ok)
+				assert.Equal(t, result.APIKey.Prefix, prefix)
+				assert.True(t, result.APIKey.Matches(result.Key))
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+)
+
+// FindAllAPIKeys lists every key with its prefix, the keys themselves are never returned.
+func (u *apiKeyUsecase) FindAllAPIKeys(ctx context.Context) (apiKeys *entity.APIKeys, err error) {
+	const errLocation = "[usecase api_key/find_all_api_keys FindAllAPIKeys] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("api_key.usecase"), func(ctx context.Context) (*entity.APIKeys, error) {
+		apiKeys, err := u.apiKeyRepository.FindAll(ctx)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find api keys", nil))
+		}
+		return apiKeys, nil
+	})
+}
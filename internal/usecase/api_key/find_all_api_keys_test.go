@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAPIKeyUsecase_FindAllAPIKeys(t *testing.T) {
+	apiKeys := &entity.APIKeys{
+		{ID: uuid.New(), Name: "Partner", Prefix: "a1b2c3d4e5f6", Scopes: []entity.APIKeyScope{entity.APIKeyScopeConcertsRead}},
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.APIKeys
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "successful retrieval",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindAll(gomock.Any()).Return(apiKeys, nil)
+			},
+			expectedResult: apiKeys,
+		},
+		{
+			name: "api key repository error",
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find api keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.apiKeyUsecase.FindAllAPIKeys(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase api_key/find_all_api_keys FindAllAPIKeys]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+)
+
+// lastUsedInterval is how stale last_used_at may get before a request with the key records it again,
+// so that a busy partner does not write to the key on every request.
+const lastUsedInterval = time.Minute
+
+//go:generate mockgen -source=./main.go -destination=./mocks/api_key_usecase.go -package=api_key_usecasemocks
+type APIKeyUsecase interface {
+	CreateAPIKey(ctx context.Context, input CreateAPIKeyInput) (*IssuedAPIKey, error)
+	FindAllAPIKeys(ctx context.Context) (*entity.APIKeys, error)
+	RotateAPIKey(ctx context.Context, input RotateAPIKeyInput) (*IssuedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) (*entity.APIKey, error)
+	AuthenticateAPIKey(ctx context.Context, input AuthenticateAPIKeyInput) (*entity.APIKey, error)
+}
+
+type apiKeyUsecase struct {
+	appConfig        config.AppConfig
+	apiKeyRepository repository.APIKeyRepository
+}
+
+func NewAPIKeyUsecase(
+	appConfig config.AppConfig,
+	apiKeyRepository repository.APIKeyRepository,
+) APIKeyUsecase {
+	return &apiKeyUsecase{
+		appConfig:        appConfig,
+		apiKeyRepository: apiKeyRepository,
+	}
+}
+
+// IssuedAPIKey is a key together with its plain value, which is only returned when the key is created or rotated.
+type IssuedAPIKey struct {
+	APIKey *entity.APIKey
+	Key    string
+}
@@ -0,0 +1,60 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	apikeyusecase "ticket-reservation/internal/usecase/api_key"
+)
+
+type testHelper struct {
+	ctrl                 *gomock.Controller
+	appConfig            config.AppConfig
+	mockAPIKeyRepository *repository_mocks.MockAPIKeyRepository
+	apiKeyUsecase        apikeyusecase.APIKeyUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+
+	mockAPIKeyRepository := repository_mocks.NewMockAPIKeyRepository(ctrl)
+
+	usecase := apikeyusecase.NewAPIKeyUsecase(
+		appConfig,
+		mockAPIKeyRepository,
+	)
+
+	return &testHelper{
+		ctrl:                 ctrl,
+		appConfig:            appConfig,
+		mockAPIKeyRepository: mockAPIKeyRepository,
+		apiKeyUsecase:        usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewAPIKeyUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Execute
+	usecase := apikeyusecase.NewAPIKeyUsecase(
+		config.AppConfig{Timezone: "Asia/Bangkok"},
+		repository_mocks.NewMockAPIKeyRepository(ctrl),
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type RevokeAPIKeyInput struct {
+	ID string `json:"id" validate:"required,uuid4"`
+}
+
+// RevokeAPIKey stops an API key from authenticating for good, revoking a revoked key returns it unchanged.
+func (u *apiKeyUsecase) RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) (apiKey *entity.APIKey, err error) {
+	const errLocation = "[usecase api_key/revoke_api_key RevokeAPIKey] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("api_key.usecase"), func(ctx context.Context) (*entity.APIKey, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		apiKeyID, err := uuid.Parse(input.ID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid api key ID", nil))
+		}
+
+		apiKey, err := u.apiKeyRepository.FindOne(ctx, apiKeyID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find api key", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if apiKey.RevokedAt != nil {
+			return apiKey, nil
+		}
+
+		revokedAt := time.Now()
+		revoked, err := u.apiKeyRepository.UpdateOne(ctx, repository.UpdateAPIKeyInput{
+			ID:        apiKey.ID,
+			RevokedAt: &revokedAt,
+		})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to revoke api key", nil))
+		}
+
+		return revoked, nil
+	})
+}
@@ -0,0 +1,122 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	apikeyusecase "ticket-reservation/internal/usecase/api_key"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAPIKeyUsecase_RevokeAPIKey(t *testing.T) {
+	apiKeyID := uuid.New()
+	revokedAt := time.Now().Add(-time.Hour)
+	apiKey := &entity.APIKey{ID: apiKeyID, Name: "Partner", Prefix: "a1b2c3d4e5f6"}
+	revokedAPIKey := &entity.APIKey{ID: apiKeyID, Name: "Partner", Prefix: "a1b2c3d4e5f6", RevokedAt: &revokedAt}
+
+	validInput := apikeyusecase.RevokeAPIKeyInput{ID: apiKeyID.String()}
+
+	tests := []struct {
+		name           string
+		input          apikeyusecase.RevokeAPIKeyInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.APIKey
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful revocation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(apiKey, nil)
+				h.mockAPIKeyRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input repository.UpdateAPIKeyInput) (*entity.APIKey, error) {
+						assert.Equal(t, apiKeyID, input.ID)
+						require.NotNil(t, input.RevokedAt)
+						assert.Nil(t, input.Prefix)
+						return revokedAPIKey, nil
+					})
+			},
+			expectedResult: revokedAPIKey,
+		},
+		{
+			name:  "api key already revoked",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(revokedAPIKey, nil)
+			},
+			expectedResult: revokedAPIKey,
+		},
+		{
+			name:          "validation error - invalid api key ID",
+			input:         apikeyusecase.RevokeAPIKeyInput{ID: "not-a-uuid"},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "api key not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(nil, errsFramework.NewNotFoundError("api key not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "api key not found",
+		},
+		{
+			name:  "api key repository update error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(apiKey, nil)
+				h.mockAPIKeyRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to revoke api key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.apiKeyUsecase.RevokeAPIKey(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase api_key/revoke_api_key RevokeAPIKey]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
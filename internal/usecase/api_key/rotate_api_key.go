@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type RotateAPIKeyInput struct {
+	ID string `json:"id" validate:"required,uuid4"`
+}
+
+// RotateAPIKey replaces the key of an active API key with a new one, the previous key stops working at once.
+// The name, scopes and expiry are kept, and so is the ID, which owns the reservations made with the key.
+func (u *apiKeyUsecase) RotateAPIKey(ctx context.Context, input RotateAPIKeyInput) (issued *IssuedAPIKey, err error) {
+	const errLocation = "[usecase api_key/rotate_api_key RotateAPIKey] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("api_key.usecase"), func(ctx context.Context) (*IssuedAPIKey, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		apiKeyID, err := uuid.Parse(input.ID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid api key ID", nil))
+		}
+
+		apiKey, err := u.apiKeyRepository.FindOne(ctx, apiKeyID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find api key", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if !apiKey.IsActive(time.Now()) {
+			return nil, errsFramework.NewConflictError("the api key has been revoked or has expired", nil)
+		}
+
+		key, prefix, keyHash, err := entity.GenerateAPIKey()
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to generate api key", nil))
+		}
+
+		rotated, err := u.apiKeyRepository.UpdateOne(ctx, repository.UpdateAPIKeyInput{
+			ID:      apiKey.ID,
+			Prefix:  &prefix,
+			KeyHash: &keyHash,
+		})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to rotate api key", nil))
+		}
+
+		return &IssuedAPIKey{APIKey: rotated, Key: key}, nil
+	})
+}
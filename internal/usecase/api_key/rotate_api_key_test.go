@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	apikeyusecase "ticket-reservation/internal/usecase/api_key"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAPIKeyUsecase_RotateAPIKey(t *testing.T) {
+	apiKeyID := uuid.New()
+	revokedAt := time.Now().Add(-time.Hour)
+	apiKey := &entity.APIKey{ID: apiKeyID, Name: "Partner", Prefix: "a1b2c3d4e5f6", KeyHash: "old_hash", Scopes: []entity.APIKeyScope{entity.APIKeyScopeAdmin}}
+	revokedAPIKey := &entity.APIKey{ID: apiKeyID, Name: "Partner", Prefix: "a1b2c3d4e5f6", KeyHash: "old_hash", RevokedAt: &revokedAt}
+
+	validInput := apikeyusecase.RotateAPIKeyInput{ID: apiKeyID.String()}
+
+	tests := []struct {
+		name          string
+		input         apikeyusecase.RotateAPIKeyInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful rotation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(apiKey, nil)
+				h.mockAPIKeyRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input repository.UpdateAPIKeyInput) (*entity.APIKey, error) {
+						assert.Equal(t, apiKeyID, input.ID)
+						require.NotNil(t, input.Prefix)
+						require.NotNil(t, input.KeyHash)
+						assert.NotEqual(t, "a1b2c3d4e5f6", *input.Prefix)
+						assert.Nil(t, input.RevokedAt)
+						rotated := *apiKey
+						rotated.Prefix = *input.Prefix
+						rotated.KeyHash = *input.KeyHash
+						return &rotated, nil
+					})
+			},
+		},
+		{
+			name:          "validation error - invalid api key ID",
+			input:         apikeyusecase.RotateAPIKeyInput{ID: "not-a-uuid"},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "api key not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(nil, errsFramework.NewNotFoundError("api key not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "api key not found",
+		},
+		{
+			name:  "api key revoked",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(revokedAPIKey, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the api key has been revoked or has expired",
+		},
+		{
+			name:  "api key repository find error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find api key",
+		},
+		{
+			name:  "api key repository update error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockAPIKeyRepository.EXPECT().FindOne(gomock.Any(), apiKeyID).Return(apiKey, nil)
+				h.mockAPIKeyRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to rotate api key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.apiKeyUsecase.RotateAPIKey(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase api_key/rotate_api_key RotateAPIKey]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, apiKeyID, result.APIKey.ID)
+				assert.True(t, result.APIKey.Matches(result.Key))
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+// unknownUserPasswordHash is checked when no user has the email, so that a login takes as long whether the email is registered or not
+const unknownUserPasswordHash = "$2a$10$tRs8aFJv/DzRzpv8.1R9Ue/TLgxtKuJI7h5LjaVfdgaVtPcdpXtz6" // #nosec G101
+
+type LoginInput struct {
+	Email    string `json:"email" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type AuthResult struct {
+	User                  *entity.User
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// Login signs a user in with its email and password. Whether the email is unknown or the password is wrong is not told apart.
+func (u *authUsecase) Login(ctx context.Context, input LoginInput) (result *AuthResult, err error) {
+	const errLocation = "[usecase auth/login Login] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("auth.usecase"), func(ctx context.Context) (*AuthResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		user, err := u.userRepository.FindOneByEmail(ctx, entity.NormalizeEmail(input.Email))
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find user by email", nil))
+				return nil, err
+			}
+			user = &entity.User{PasswordHash: unknownUserPasswordHash}
+			user.CheckPassword(input.Password)
+			return nil, errsFramework.NewUnauthorizedError("invalid email or password", nil)
+		}
+		if !user.CheckPassword(input.Password) {
+			return nil, errsFramework.NewUnauthorizedError("invalid email or password", nil)
+		}
+
+		return u.issueTokens(ctx, user)
+	})
+}
+
+// issueTokens signs a new pair of access and refresh tokens for the user.
+func (u *authUsecase) issueTokens(ctx context.Context, user *entity.User) (*AuthResult, error) {
+	issuedAt := time.Now()
+	accessClaims := entity.NewAuthClaims(user, entity.AuthTokenTypeAccess, issuedAt, u.appConfig.JWTAccessTokenTTL)
+	accessToken, err := u.jwtManager.CreateToken(ctx, accessClaims)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create access token", nil))
+		return nil, err
+	}
+	refreshClaims := entity.NewAuthClaims(user, entity.AuthTokenTypeRefresh, issuedAt, u.appConfig.JWTRefreshTokenTTL)
+	refreshToken, err := u.jwtManager.CreateToken(ctx, refreshClaims)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create refresh token", nil))
+		return nil, err
+	}
+	return &AuthResult{
+		User:                  user,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessClaims.ExpiresAt.Time,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshClaims.ExpiresAt.Time,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	authusecase "ticket-reservation/internal/usecase/auth"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAuthUsecase_Login(t *testing.T) {
+	user, err := entity.NewUser("fan@example.com", "correct-horse")
+	require.NoError(t, err)
+
+	validInput := authusecase.LoginInput{
+		Email:    " FAN@example.com",
+		Password: "correct-horse",
+	}
+
+	tests := []struct {
+		name          string
+		input         authusecase.LoginInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful login",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().FindOneByEmail(gomock.Any(), "fan@example.com").Return(user, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("access-token", nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("refresh-token", nil)
+			},
+		},
+		{
+			name:          "validation error - missing password",
+			input:         authusecase.LoginInput{Email: "fan@example.com"},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "unknown email",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().FindOneByEmail(gomock.Any(), "fan@example.com").Return(nil, errsFramework.NewNotFoundError("user not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "invalid email or password",
+		},
+		{
+			name: "wrong password",
+			input: authusecase.LoginInput{
+				Email:    "fan@example.com",
+				Password: "wrong-horse",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().FindOneByEmail(gomock.Any(), "fan@example.com").Return(user, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "invalid email or password",
+		},
+		{
+			name:  "user repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().FindOneByEmail(gomock.Any(), "fan@example.com").Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find user by email",
+		},
+		{
+			name:  "token signing error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().FindOneByEmail(gomock.Any(), "fan@example.com").Return(user, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("access-token", nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("", errors.New("signing error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create refresh token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.authUsecase.Login(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase auth/login Login]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, user, result.User)
+				assert.Equal(t, "access-token", result.AccessToken)
+				assert.Equal(t, "refresh-token", result.RefreshToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/auth_usecase.go -package=auth_usecasemocks
+type AuthUsecase interface {
+	Register(ctx context.Context, input RegisterInput) (*AuthResult, error)
+	Login(ctx context.Context, input LoginInput) (*AuthResult, error)
+	Refresh(ctx context.Context, input RefreshInput) (*AuthResult, error)
+	UpdateUserRole(ctx context.Context, input UpdateUserRoleInput) (*entity.User, error)
+}
+
+type authUsecase struct {
+	appConfig      config.AppConfig
+	userRepository repository.UserRepository
+	jwtManager     jwtutil.JWTManager
+}
+
+func NewAuthUsecase(
+	appConfig config.AppConfig,
+	userRepository repository.UserRepository,
+	jwtManager jwtutil.JWTManager,
+) AuthUsecase {
+	return &authUsecase{
+		appConfig:      appConfig,
+		userRepository: userRepository,
+		jwtManager:     jwtManager,
+	}
+}
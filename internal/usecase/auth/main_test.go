@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	authusecase "ticket-reservation/internal/usecase/auth"
+
+	jwt_mocks "github.com/kittipat1413/go-common/util/jwt/mocks"
+)
+
+type testHelper struct {
+	ctrl               *gomock.Controller
+	appConfig          config.AppConfig
+	mockUserRepository *repository_mocks.MockUserRepository
+	mockJWTManager     *jwt_mocks.MockJWTManager
+	authUsecase        authusecase.AuthUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		Timezone:           "Asia/Bangkok",
+		JWTAccessTokenTTL:  15 * time.Minute,
+		JWTRefreshTokenTTL: 720 * time.Hour,
+	}
+
+	mockUserRepository := repository_mocks.NewMockUserRepository(ctrl)
+	mockJWTManager := jwt_mocks.NewMockJWTManager(ctrl)
+
+	usecase := authusecase.NewAuthUsecase(
+		appConfig,
+		mockUserRepository,
+		mockJWTManager,
+	)
+
+	return &testHelper{
+		ctrl:               ctrl,
+		appConfig:          appConfig,
+		mockUserRepository: mockUserRepository,
+		mockJWTManager:     mockJWTManager,
+		authUsecase:        usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewAuthUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Execute
+	usecase := authusecase.NewAuthUsecase(
+		config.AppConfig{Timezone: "Asia/Bangkok"},
+		repository_mocks.NewMockUserRepository(ctrl),
+		jwt_mocks.NewMockJWTManager(ctrl),
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
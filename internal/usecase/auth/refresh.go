@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type RefreshInput struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// Refresh issues a new pair of tokens in exchange of a refresh token, as long as the user it was issued to still exists.
+func (u *authUsecase) Refresh(ctx context.Context, input RefreshInput) (result *AuthResult, err error) {
+	const errLocation = "[usecase auth/refresh Refresh] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("auth.usecase"), func(ctx context.Context) (*AuthResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		// An access token is rejected here, so that a leaked access token cannot be turned into a long-lived session
+		var claims entity.AuthClaims
+		if err := u.jwtManager.ParseAndValidateToken(ctx, input.RefreshToken, &claims); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewUnauthorizedError("the refresh token is invalid", nil))
+			return nil, err
+		}
+		if claims.TokenType != entity.AuthTokenTypeRefresh {
+			return nil, errsFramework.NewUnauthorizedError("the refresh token is invalid", nil)
+		}
+		principal, err := claims.Principal()
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewUnauthorizedError("the refresh token is invalid", nil))
+			return nil, err
+		}
+
+		user, err := u.userRepository.FindOne(ctx, principal.UserID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find user by ID", nil))
+				return nil, err
+			}
+			err = errsFramework.WrapError(err, errsFramework.NewUnauthorizedError("the refresh token is invalid", nil))
+			return nil, err
+		}
+
+		return u.issueTokens(ctx, user)
+	})
+}
@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	authusecase "ticket-reservation/internal/usecase/auth"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAuthUsecase_Refresh(t *testing.T) {
+	user := &entity.User{ID: uuid.New(), Email: "fan@example.com", Role: entity.UserRoleCustomer}
+	promotedUser := &entity.User{ID: user.ID, Email: user.Email, Role: entity.UserRoleOrganizer}
+
+	// parseAs returns the claims the mocked JWT manager reads from the token
+	parseAs := func(claims *entity.AuthClaims) func(context.Context, string, jwt.Claims) error {
+		return func(_ context.Context, _ string, out jwt.Claims) error {
+			*out.(*entity.AuthClaims) = *claims
+			return nil
+		}
+	}
+	refreshClaims := entity.NewAuthClaims(user, entity.AuthTokenTypeRefresh, time.Now(), time.Hour)
+	accessClaims := entity.NewAuthClaims(user, entity.AuthTokenTypeAccess, time.Now(), time.Hour)
+
+	validInput := authusecase.RefreshInput{RefreshToken: "refresh-token"}
+
+	tests := []struct {
+		name          string
+		input         authusecase.RefreshInput
+		setupMocks    func(h *testHelper)
+		expectedUser  *entity.User // Defaults to user
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful refresh",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "refresh-token", gomock.Any()).DoAndReturn(parseAs(refreshClaims))
+				h.mockUserRepository.EXPECT().FindOne(gomock.Any(), user.ID).Return(user, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("new-access-token", nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("new-refresh-token", nil)
+			},
+		},
+		{
+			name:  "refreshed tokens carry the current role of the user",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "refresh-token", gomock.Any()).DoAndReturn(parseAs(refreshClaims))
+				h.mockUserRepository.EXPECT().FindOne(gomock.Any(), user.ID).Return(promotedUser, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims jwt.Claims) (string, error) {
+					assert.Equal(t, entity.UserRoleOrganizer, claims.(*entity.AuthClaims).Role)
+					return "new-access-token", nil
+				})
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims jwt.Claims) (string, error) {
+					assert.Equal(t, entity.UserRoleOrganizer, claims.(*entity.AuthClaims).Role)
+					return "new-refresh-token", nil
+				})
+			},
+			expectedUser: promotedUser,
+		},
+		{
+			name:          "validation error - missing refresh token",
+			input:         authusecase.RefreshInput{},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "invalid or expired token",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "refresh-token", gomock.Any()).Return(errors.New("token has expired"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the refresh token is invalid",
+		},
+		{
+			name:  "access token given",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "refresh-token", gomock.Any()).DoAndReturn(parseAs(accessClaims))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the refresh token is invalid",
+		},
+		{
+			name:  "user no longer exists",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "refresh-token", gomock.Any()).DoAndReturn(parseAs(refreshClaims))
+				h.mockUserRepository.EXPECT().FindOne(gomock.Any(), user.ID).Return(nil, errsFramework.NewNotFoundError("user not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "the refresh token is invalid",
+		},
+		{
+			name:  "user repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "refresh-token", gomock.Any()).DoAndReturn(parseAs(refreshClaims))
+				h.mockUserRepository.EXPECT().FindOne(gomock.Any(), user.ID).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find user by ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.authUsecase.Refresh(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase auth/refresh Refresh]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				expectedUser := user
+				if tt.expectedUser != nil {
+					expectedUser = tt.expectedUser
+				}
+				assert.Equal(t, expectedUser, result.User)
+				assert.Equal(t, "new-access-token", result.AccessToken)
+				assert.Equal(t, "new-refresh-token", result.RefreshToken)
+			}
+		})
+	}
+}
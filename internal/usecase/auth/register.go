@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type RegisterInput struct {
+	Email    string `json:"email" validate:"required,email,max=254"`
+	Password string `json:"password" validate:"required,min=8,max=72"` // bcrypt only reads the first 72 bytes
+}
+
+// Register creates a user and signs it in, an email is registered only once whatever its case.
+func (u *authUsecase) Register(ctx context.Context, input RegisterInput) (result *AuthResult, err error) {
+	const errLocation = "[usecase auth/register Register] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("auth.usecase"), func(ctx context.Context) (*AuthResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		user, err := entity.NewUser(input.Email, input.Password)
+		if err != nil {
+			if errors.Is(err, entity.ErrPasswordTooLong) {
+				err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+				return nil, err
+			}
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to hash password", nil))
+			return nil, err
+		}
+
+		user, err = u.userRepository.CreateOne(ctx, user)
+		if err != nil {
+			if !errors.As(err, &errsFramework.ConflictError{}) { // If the error is not a ConflictError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create user", nil))
+				return nil, err
+			}
+			return nil, err // Return the ConflictError directly
+		}
+
+		return u.issueTokens(ctx, user)
+	})
+}
@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	authusecase "ticket-reservation/internal/usecase/auth"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAuthUsecase_Register(t *testing.T) {
+	userID := uuid.New()
+
+	validInput := authusecase.RegisterInput{
+		Email:    "Fan@Example.com",
+		Password: "correct-horse",
+	}
+
+	createUser := func(_ context.Context, user *entity.User) (*entity.User, error) {
+		assert.Equal(t, "fan@example.com", user.Email)
+		assert.True(t, user.CheckPassword("correct-horse"))
+		user.ID = userID
+		return user, nil
+	}
+
+	tests := []struct {
+		name          string
+		input         authusecase.RegisterInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful registration",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(createUser)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, claims jwt.Claims) (string, error) {
+						authClaims := claims.(*entity.AuthClaims)
+						assert.Equal(t, userID.String(), authClaims.Subject)
+						assert.Equal(t, entity.AuthTokenTypeAccess, authClaims.TokenType)
+						assert.Equal(t, entity.UserRoleCustomer, authClaims.Role, "New accounts are customers")
+						return "access-token", nil
+					},
+				)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, claims jwt.Claims) (string, error) {
+						assert.Equal(t, entity.AuthTokenTypeRefresh, claims.(*entity.AuthClaims).TokenType)
+						return "refresh-token", nil
+					},
+				)
+			},
+		},
+		{
+			name: "validation error - invalid email",
+			input: authusecase.RegisterInput{
+				Email:    "not-an-email",
+				Password: "correct-horse",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - short password",
+			input: authusecase.RegisterInput{
+				Email:    "fan@example.com",
+				Password: "short",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "password longer than 72 bytes",
+			input: authusecase.RegisterInput{
+				Email:    "fan@example.com",
+				Password: strings.Repeat("ก", 30),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: entity.ErrPasswordTooLong.Error(),
+		},
+		{
+			name:  "email already registered",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewConflictError("email already registered", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "email already registered",
+		},
+		{
+			name:  "user repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create user",
+		},
+		{
+			name:  "token signing error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(createUser)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("", errors.New("signing error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create access token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.authUsecase.Register(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase auth/register Register]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, userID, result.User.ID)
+				assert.Equal(t, "access-token", result.AccessToken)
+				assert.Equal(t, "refresh-token", result.RefreshToken)
+				assert.WithinDuration(t, time.Now().Add(h.appConfig.JWTAccessTokenTTL), result.AccessTokenExpiresAt, time.Minute)
+				assert.WithinDuration(t, time.Now().Add(h.appConfig.JWTRefreshTokenTTL), result.RefreshTokenExpiresAt, time.Minute)
+			}
+		})
+	}
+}
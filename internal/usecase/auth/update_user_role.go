@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type UpdateUserRoleInput struct {
+	UserID string `json:"user_id" validate:"required,uuid4"`
+	Role   string `json:"role" validate:"required,oneof=admin organizer box_office customer"`
+}
+
+// UpdateUserRole changes the role of a user. Tokens already issued keep the previous role until they are refreshed.
+func (u *authUsecase) UpdateUserRole(ctx context.Context, input UpdateUserRoleInput) (user *entity.User, err error) {
+	const errLocation = "[usecase auth/update_user_role UpdateUserRole] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("auth.usecase"), func(ctx context.Context) (*entity.User, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		userID, err := uuid.Parse(input.UserID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid user ID", nil))
+			return nil, err
+		}
+		role, err := new(entity.UserRole).Parse(input.Role)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid role", nil))
+			return nil, err
+		}
+
+		user, err := u.userRepository.UpdateRole(ctx, userID, role)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update user role", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		return user, nil
+	})
+}
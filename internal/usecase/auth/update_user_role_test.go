@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	authusecase "ticket-reservation/internal/usecase/auth"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestAuthUsecase_UpdateUserRole(t *testing.T) {
+	userID := uuid.New()
+	user := &entity.User{ID: userID, Email: "organizer@example.com", Role: entity.UserRoleOrganizer}
+
+	validInput := authusecase.UpdateUserRoleInput{
+		UserID: userID.String(),
+		Role:   "organizer",
+	}
+
+	tests := []struct {
+		name           string
+		input          authusecase.UpdateUserRoleInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.User
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful role update",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().UpdateRole(gomock.Any(), userID, entity.UserRoleOrganizer).Return(user, nil)
+			},
+			expectedResult: user,
+		},
+		{
+			name: "validation error - unknown role",
+			input: authusecase.UpdateUserRoleInput{
+				UserID: userID.String(),
+				Role:   "superuser",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - invalid user ID",
+			input: authusecase.UpdateUserRoleInput{
+				UserID: "not-a-uuid",
+				Role:   "organizer",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "user not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().UpdateRole(gomock.Any(), userID, entity.UserRoleOrganizer).Return(nil, errsFramework.NewNotFoundError("user not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "user not found",
+		},
+		{
+			name:  "user repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockUserRepository.EXPECT().UpdateRole(gomock.Any(), userID, entity.UserRoleOrganizer).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update user role",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.authUsecase.UpdateUserRole(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase auth/update_user_role UpdateUserRole]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
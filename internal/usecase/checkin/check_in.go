@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type CheckInInput struct {
+	Payload   string `json:"payload" validate:"required"`
+	Gate      string `json:"gate" validate:"required,max=50"`
+	ScannerID string `json:"scanner_id" validate:"required"`
+}
+
+type CheckInResult struct {
+	Ticket  *entity.Ticket
+	Checkin *entity.TicketCheckin
+}
+
+// CheckIn admits the holder of a scanned ticket. The payload must be signed by the ticket signing key, the ticket must belong to
+// a concert taking place today and to a reservation that is still confirmed, and a ticket is admitted only once.
+func (u *checkinUsecase) CheckIn(ctx context.Context, input CheckInInput) (result *CheckInResult, err error) {
+	const errLocation = "[usecase checkin/check_in CheckIn] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("checkin.usecase"), func(ctx context.Context) (*CheckInResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		loc, err := time.LoadLocation(u.appConfig.Timezone)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to load timezone", nil))
+			return nil, err
+		}
+		scannedAt := time.Now()
+
+		// A forged or altered payload is rejected before touching the database
+		claims, err := u.ticketSigner.Verify(input.Payload)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewUnprocessableEntityError("the ticket is not valid", nil))
+			return nil, err
+		}
+
+		ticket, err := u.ticketRepository.FindOne(ctx, claims.TicketID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find ticket by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		concert, err := u.concertRepository.FindOne(ctx, ticket.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			return nil, err
+		}
+		if !concert.IsOnDayOf(scannedAt, loc) {
+			err = errsFramework.NewUnprocessableEntityError("the ticket is not for today's concert", map[string]string{
+				"concert_id":   concert.ID.String(),
+				"concert_date": concert.Date.In(loc).Format(time.RFC3339),
+			})
+			return nil, err
+		}
+
+		// A ticket of a reservation cancelled by a refund no longer admits anyone
+		reservation, err := u.reservationRepository.FindOne(ctx, ticket.ReservationID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+			return nil, err
+		}
+		if reservation.Status != entity.ReservationStatusConfirmed {
+			err = errsFramework.NewUnprocessableEntityError("the ticket has been cancelled", map[string]string{"status": reservation.Status.String()})
+			return nil, err
+		}
+
+		// Record the admission, the unique ticket ID lets only the first of concurrent scans through
+		checkin, err := u.checkinRepository.CreateOne(ctx, entity.NewTicketCheckin(ticket, input.Gate, input.ScannerID, scannedAt))
+		if err != nil {
+			if !errors.As(err, &errsFramework.ConflictError{}) {
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create ticket checkin", nil))
+				return nil, err
+			}
+			previous, findErr := u.checkinRepository.FindOneByTicketID(ctx, ticket.ID)
+			if findErr != nil {
+				err = errsFramework.WrapError(findErr, errsFramework.NewInternalServerError("failed to find ticket checkin by ticket ID", nil))
+				return nil, err
+			}
+			err = errsFramework.NewConflictError(
+				fmt.Sprintf("already admitted at %s at gate %s", previous.AdmittedAt.In(loc).Format("15:04"), previous.Gate),
+				map[string]string{
+					"admitted_at": previous.AdmittedAt.In(loc).Format(time.RFC3339),
+					"gate":        previous.Gate,
+				},
+			)
+			return nil, err
+		}
+
+		return &CheckInResult{Ticket: ticket, Checkin: checkin}, nil
+	})
+}
@@ -0,0 +1,222 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	checkinusecase "ticket-reservation/internal/usecase/checkin"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestCheckinUsecase_CheckIn(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Bangkok")
+	require.NoError(t, err)
+
+	concertID := uuid.New()
+	reservationID := uuid.New()
+	ticket := entity.NewTicket(
+		&entity.Reservation{ID: reservationID, SessionID: "session-123"},
+		&entity.Zone{ID: uuid.New(), ConcertID: concertID, Name: "VIP"},
+		&entity.Seat{ID: uuid.New(), SeatNumber: "A1"},
+		time.Now(),
+	)
+	require.NoError(t, testTicketSigner.Sign(ticket))
+
+	// A ticket signed with another key carries the same claims but not a valid signature
+	forged := *ticket
+	require.NoError(t, entity.NewTicketSigner(ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))).Sign(&forged))
+
+	todayConcert := &entity.Concert{ID: concertID, Name: "Concert", Date: time.Now()}
+	confirmedReservation := &entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}
+	previousAdmission := &entity.TicketCheckin{
+		ID:         uuid.New(),
+		TicketID:   ticket.ID,
+		ConcertID:  concertID,
+		Gate:       "B",
+		ScannerID:  "gate-b-1",
+		AdmittedAt: time.Date(2025, 1, 1, 11, 5, 0, 0, time.UTC),
+	}
+
+	validInput := checkinusecase.CheckInInput{
+		Payload:   ticket.Payload,
+		Gate:      "A",
+		ScannerID: "gate-a-1",
+	}
+
+	tests := []struct {
+		name          string
+		input         checkinusecase.CheckInInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful admission",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(ticket, nil)
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(todayConcert, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, checkin *entity.TicketCheckin) (*entity.TicketCheckin, error) {
+						assert.Equal(t, ticket.ID, checkin.TicketID)
+						assert.Equal(t, concertID, checkin.ConcertID)
+						assert.Equal(t, "A", checkin.Gate)
+						assert.Equal(t, "gate-a-1", checkin.ScannerID)
+						assert.WithinDuration(t, time.Now(), checkin.AdmittedAt, time.Minute)
+						return checkin, nil
+					},
+				)
+			},
+		},
+		{
+			name: "validation error - missing gate",
+			input: checkinusecase.CheckInInput{
+				Payload:   ticket.Payload,
+				ScannerID: "gate-a-1",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "payload signed with another key",
+			input: checkinusecase.CheckInInput{
+				Payload:   forged.Payload,
+				Gate:      "A",
+				ScannerID: "gate-a-1",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the ticket is not valid",
+		},
+		{
+			name: "payload is not a signed token",
+			input: checkinusecase.CheckInInput{
+				Payload:   "not-a-ticket",
+				Gate:      "A",
+				ScannerID: "gate-a-1",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the ticket is not valid",
+		},
+		{
+			name:  "ticket not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(nil, errsFramework.NewNotFoundError("ticket not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "ticket not found",
+		},
+		{
+			name:  "ticket repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find ticket by ID",
+		},
+		{
+			name:  "ticket of another day's concert",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(ticket, nil)
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(&entity.Concert{ID: concertID, Date: time.Now().In(loc).AddDate(0, 0, 1)}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the ticket is not for today's concert",
+		},
+		{
+			name:  "ticket of a cancelled reservation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(ticket, nil)
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(todayConcert, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusCancelled}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the ticket has been cancelled",
+		},
+		{
+			name:  "second scan tells when and where the ticket was admitted",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(ticket, nil)
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(todayConcert, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewConflictError("ticket already admitted", nil))
+				h.mockCheckinRepository.EXPECT().FindOneByTicketID(gomock.Any(), ticket.ID).Return(previousAdmission, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "already admitted at 18:05 at gate B",
+		},
+		{
+			name:  "checkin repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindOne(gomock.Any(), ticket.ID).Return(ticket, nil)
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(todayConcert, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create ticket checkin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.checkinUsecase.CheckIn(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase checkin/check_in CheckIn]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, ticket, result.Ticket)
+				assert.Equal(t, "A", result.Checkin.Gate)
+			}
+		})
+	}
+}
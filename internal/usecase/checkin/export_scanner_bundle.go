@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type ExportScannerBundleInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+}
+
+type ScannerBundleResult struct {
+	Bundle    *entity.ScannerBundle
+	Token     string            // Bundle signed with the ticket signing key
+	PublicKey ed25519.PublicKey // Key verifying the bundle and the ticket payloads
+}
+
+// ExportScannerBundle returns the signed list of the valid tickets of a concert, loaded onto gate scanners that may lose their connection.
+func (u *checkinUsecase) ExportScannerBundle(ctx context.Context, input ExportScannerBundleInput) (result *ScannerBundleResult, err error) {
+	const errLocation = "[usecase checkin/export_scanner_bundle ExportScannerBundle] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("checkin.usecase"), func(ctx context.Context) (*ScannerBundleResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+			return nil, err
+		}
+
+		concert, err := u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		// Tickets of refunded reservations are left out, so that offline scanners refuse them too
+		tickets, err := u.ticketRepository.FindAllConfirmedByConcertID(ctx, concert.ID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find tickets by concert ID", nil))
+			return nil, err
+		}
+
+		bundle := entity.NewScannerBundle(concert.ID, pointer.GetValue(tickets), time.Now())
+		token, err := u.ticketSigner.SignScannerBundle(bundle)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to sign scanner bundle", nil))
+			return nil, err
+		}
+
+		return &ScannerBundleResult{
+			Bundle:    bundle,
+			Token:     token,
+			PublicKey: u.ticketSigner.PublicKey(),
+		}, nil
+	})
+}
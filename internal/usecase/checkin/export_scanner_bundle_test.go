@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	checkinusecase "ticket-reservation/internal/usecase/checkin"
+	"ticket-reservation/pkg/signedtoken"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestCheckinUsecase_ExportScannerBundle(t *testing.T) {
+	concertID := uuid.New()
+	ticketID1 := uuid.New()
+	ticketID2 := uuid.New()
+	concert := &entity.Concert{ID: concertID, Name: "Concert", Date: time.Now()}
+
+	tests := []struct {
+		name              string
+		input             checkinusecase.ExportScannerBundleInput
+		setupMocks        func(h *testHelper)
+		expectedTicketIDs []uuid.UUID
+		expectedError     bool
+		errorType         error
+		errorContains     string
+	}{
+		{
+			name:  "successful export",
+			input: checkinusecase.ExportScannerBundleInput{ConcertID: concertID.String()},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockTicketRepository.EXPECT().FindAllConfirmedByConcertID(gomock.Any(), concertID).Return(&entity.Tickets{{ID: ticketID1}, {ID: ticketID2}}, nil)
+			},
+			expectedTicketIDs: []uuid.UUID{ticketID1, ticketID2},
+		},
+		{
+			name:  "concert without tickets",
+			input: checkinusecase.ExportScannerBundleInput{ConcertID: concertID.String()},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockTicketRepository.EXPECT().FindAllConfirmedByConcertID(gomock.Any(), concertID).Return(&entity.Tickets{}, nil)
+			},
+			expectedTicketIDs: []uuid.UUID{},
+		},
+		{
+			name:          "validation error - invalid concert ID",
+			input:         checkinusecase.ExportScannerBundleInput{ConcertID: "invalid"},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "concert not found",
+			input: checkinusecase.ExportScannerBundleInput{ConcertID: concertID.String()},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name:  "ticket repository error",
+			input: checkinusecase.ExportScannerBundleInput{ConcertID: concertID.String()},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockTicketRepository.EXPECT().FindAllConfirmedByConcertID(gomock.Any(), concertID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find tickets by concert ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.checkinUsecase.ExportScannerBundle(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase checkin/export_scanner_bundle ExportScannerBundle]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, concertID, result.Bundle.ConcertID)
+				assert.Equal(t, tt.expectedTicketIDs, result.Bundle.TicketIDs)
+				assert.Equal(t, testTicketSigner.PublicKey(), result.PublicKey)
+
+				// The token verifies with the public key and carries the bundle
+				decoded, err := signedtoken.Verify(result.PublicKey, result.Token)
+				require.NoError(t, err)
+				var bundle entity.ScannerBundle
+				require.NoError(t, json.Unmarshal(decoded, &bundle))
+				assert.Equal(t, *result.Bundle, bundle)
+			}
+		})
+	}
+}
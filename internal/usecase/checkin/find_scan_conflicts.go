@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindScanConflictsInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+}
+
+// FindScanConflicts reports the tickets of a concert scanned by more than one scanner, counting both the uploaded scan logs
+// and the online check-ins. Conflicts are listed in the order of their first scan.
+func (u *checkinUsecase) FindScanConflicts(ctx context.Context, input FindScanConflictsInput) (conflicts entity.ScanConflicts, err error) {
+	const errLocation = "[usecase checkin/find_scan_conflicts FindScanConflicts] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("checkin.usecase"), func(ctx context.Context) (entity.ScanConflicts, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+			return nil, err
+		}
+
+		_, err = u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		checkins, err := u.checkinRepository.FindAllByConcertID(ctx, concertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find ticket checkins by concert ID", nil))
+			return nil, err
+		}
+		scans, err := u.scanRepository.FindAllByConcertID(ctx, concertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find ticket scans by concert ID", nil))
+			return nil, err
+		}
+
+		// Only a ticket with uploaded scans can have been seen by two scanners, an online check-in alone is a single scanner
+		checkinsByTicketID := make(map[uuid.UUID]*entity.TicketCheckin)
+		for _, checkin := range pointer.GetValue(checkins) {
+			checkinsByTicketID[checkin.TicketID] = &checkin
+		}
+		var ticketIDs []uuid.UUID
+		candidates := make(map[uuid.UUID]*entity.ScanConflict)
+		for _, scan := range pointer.GetValue(scans) { // oldest first
+			candidate, ok := candidates[scan.TicketID]
+			if !ok {
+				candidate = &entity.ScanConflict{Checkin: checkinsByTicketID[scan.TicketID]}
+				candidates[scan.TicketID] = candidate
+				ticketIDs = append(ticketIDs, scan.TicketID)
+			}
+			candidate.Scans = append(candidate.Scans, scan)
+		}
+
+		var conflictTicketIDs []uuid.UUID
+		for _, ticketID := range ticketIDs {
+			if len(candidates[ticketID].ScannerIDs()) > 1 {
+				conflictTicketIDs = append(conflictTicketIDs, ticketID)
+			}
+		}
+		if len(conflictTicketIDs) == 0 {
+			return entity.ScanConflicts{}, nil
+		}
+
+		tickets, err := u.ticketRepository.FindMany(ctx, conflictTicketIDs)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find tickets by IDs", nil))
+			return nil, err
+		}
+		ticketsByID := make(map[uuid.UUID]entity.Ticket)
+		for _, ticket := range pointer.GetValue(tickets) {
+			ticketsByID[ticket.ID] = ticket
+		}
+
+		conflicts := make(entity.ScanConflicts, 0, len(conflictTicketIDs))
+		for _, ticketID := range conflictTicketIDs {
+			conflict := candidates[ticketID]
+			conflict.Ticket = ticketsByID[ticketID]
+			conflicts = append(conflicts, *conflict)
+		}
+		return conflicts, nil
+	})
+}
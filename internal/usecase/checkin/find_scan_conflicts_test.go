@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	checkinusecase "ticket-reservation/internal/usecase/checkin"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestCheckinUsecase_FindScanConflicts(t *testing.T) {
+	concertID := uuid.New()
+	concert := &entity.Concert{ID: concertID, Name: "Concert", Date: time.Now()}
+	scannedAt := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+
+	// Admitted online at gate A, then scanned offline at gate B
+	admittedTwice := entity.Ticket{ID: uuid.New(), ConcertID: concertID, ZoneName: "VIP", SeatNumber: "A1"}
+	// Scanned offline at gates B and C
+	scannedTwice := entity.Ticket{ID: uuid.New(), ConcertID: concertID, ZoneName: "VIP", SeatNumber: "A2"}
+	// Scanned twice by the same scanner, which is not a conflict
+	rescanned := entity.Ticket{ID: uuid.New(), ConcertID: concertID, ZoneName: "VIP", SeatNumber: "A3"}
+
+	checkins := entity.TicketCheckins{
+		{TicketID: admittedTwice.ID, ConcertID: concertID, Gate: "A", ScannerID: "gate-a-1", AdmittedAt: scannedAt},
+		{TicketID: scannedTwice.ID, ConcertID: concertID, Gate: "B", ScannerID: "gate-b-1", AdmittedAt: scannedAt.Add(time.Minute)},
+		{TicketID: rescanned.ID, ConcertID: concertID, Gate: "B", ScannerID: "gate-b-1", AdmittedAt: scannedAt.Add(2 * time.Minute)},
+	}
+	scans := entity.TicketScans{
+		{TicketID: scannedTwice.ID, ConcertID: concertID, Gate: "B", ScannerID: "gate-b-1", ScannedAt: scannedAt.Add(time.Minute)},
+		{TicketID: rescanned.ID, ConcertID: concertID, Gate: "B", ScannerID: "gate-b-1", ScannedAt: scannedAt.Add(2 * time.Minute)},
+		{TicketID: scannedTwice.ID, ConcertID: concertID, Gate: "C", ScannerID: "gate-c-1", ScannedAt: scannedAt.Add(3 * time.Minute)},
+		{TicketID: rescanned.ID, ConcertID: concertID, Gate: "B", ScannerID: "gate-b-1", ScannedAt: scannedAt.Add(4 * time.Minute)},
+		{TicketID: admittedTwice.ID, ConcertID: concertID, Gate: "B", ScannerID: "gate-b-1", ScannedAt: scannedAt.Add(5 * time.Minute)},
+	}
+	validInput := checkinusecase.FindScanConflictsInput{ConcertID: concertID.String()}
+
+	tests := []struct {
+		name              string
+		input             checkinusecase.FindScanConflictsInput
+		setupMocks        func(h *testHelper)
+		expectedConflicts entity.ScanConflicts
+		expectedError     bool
+		errorType         error
+		errorContains     string
+	}{
+		{
+			name:  "tickets seen by more than one scanner, in the order of their first scan",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockCheckinRepository.EXPECT().FindAllByConcertID(gomock.Any(), concertID).Return(&checkins, nil)
+				h.mockScanRepository.EXPECT().FindAllByConcertID(gomock.Any(), concertID).Return(&scans, nil)
+				h.mockTicketRepository.EXPECT().FindMany(gomock.Any(), []uuid.UUID{scannedTwice.ID, admittedTwice.ID}).Return(&entity.Tickets{admittedTwice, scannedTwice}, nil)
+			},
+			expectedConflicts: entity.ScanConflicts{
+				{Ticket: scannedTwice, Checkin: &checkins[1], Scans: entity.TicketScans{scans[0], scans[2]}},
+				{Ticket: admittedTwice, Checkin: &checkins[0], Scans: entity.TicketScans{scans[4]}},
+			},
+		},
+		{
+			name:  "no conflicts",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockCheckinRepository.EXPECT().FindAllByConcertID(gomock.Any(), concertID).Return(&checkins, nil)
+				h.mockScanRepository.EXPECT().FindAllByConcertID(gomock.Any(), concertID).Return(&entity.TicketScans{scans[1], scans[3]}, nil)
+			},
+			expectedConflicts: entity.ScanConflicts{},
+		},
+		{
+			name:          "validation error - invalid concert ID",
+			input:         checkinusecase.FindScanConflictsInput{ConcertID: "invalid"},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "concert not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name:  "scan repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockCheckinRepository.EXPECT().FindAllByConcertID(gomock.Any(), concertID).Return(&checkins, nil)
+				h.mockScanRepository.EXPECT().FindAllByConcertID(gomock.Any(), concertID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find ticket scans by concert ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			conflicts, err := h.checkinUsecase.FindScanConflicts(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase checkin/find_scan_conflicts FindScanConflicts]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, conflicts)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedConflicts, conflicts)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/checkin_usecase.go -package=checkin_usecasemocks
+type CheckinUsecase interface {
+	CheckIn(ctx context.Context, input CheckInInput) (*CheckInResult, error)
+	ExportScannerBundle(ctx context.Context, input ExportScannerBundleInput) (*ScannerBundleResult, error)
+	UploadScans(ctx context.Context, input UploadScansInput) (*UploadScansResult, error)
+	FindScanConflicts(ctx context.Context, input FindScanConflictsInput) (entity.ScanConflicts, error)
+}
+
+type checkinUsecase struct {
+	appConfig             config.AppConfig
+	concertRepository     repository.ConcertRepository
+	reservationRepository repository.ReservationRepository
+	ticketRepository      repository.TicketRepository
+	checkinRepository     repository.TicketCheckinRepository
+	scanRepository        repository.TicketScanRepository
+	ticketSigner          *entity.TicketSigner
+}
+
+func NewCheckinUsecase(
+	appConfig config.AppConfig,
+	concertRepository repository.ConcertRepository,
+	reservationRepository repository.ReservationRepository,
+	ticketRepository repository.TicketRepository,
+	checkinRepository repository.TicketCheckinRepository,
+	scanRepository repository.TicketScanRepository,
+	ticketSigner *entity.TicketSigner,
+) CheckinUsecase {
+	return &checkinUsecase{
+		appConfig:             appConfig,
+		concertRepository:     concertRepository,
+		reservationRepository: reservationRepository,
+		ticketRepository:      ticketRepository,
+		checkinRepository:     checkinRepository,
+		scanRepository:        scanRepository,
+		ticketSigner:          ticketSigner,
+	}
+}
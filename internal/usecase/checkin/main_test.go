@@ -0,0 +1,87 @@
+package usecase_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	checkinusecase "ticket-reservation/internal/usecase/checkin"
+)
+
+// testTicketSigner signs the tickets scanned in the tests
+var testTicketSigner = entity.NewTicketSigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+
+type testHelper struct {
+	ctrl                      *gomock.Controller
+	appConfig                 config.AppConfig
+	mockConcertRepository     *repository_mocks.MockConcertRepository
+	mockReservationRepository *repository_mocks.MockReservationRepository
+	mockTicketRepository      *repository_mocks.MockTicketRepository
+	mockCheckinRepository     *repository_mocks.MockTicketCheckinRepository
+	mockScanRepository        *repository_mocks.MockTicketScanRepository
+	checkinUsecase            checkinusecase.CheckinUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+
+	mockConcertRepository := repository_mocks.NewMockConcertRepository(ctrl)
+	mockReservationRepository := repository_mocks.NewMockReservationRepository(ctrl)
+	mockTicketRepository := repository_mocks.NewMockTicketRepository(ctrl)
+	mockCheckinRepository := repository_mocks.NewMockTicketCheckinRepository(ctrl)
+	mockScanRepository := repository_mocks.NewMockTicketScanRepository(ctrl)
+
+	usecase := checkinusecase.NewCheckinUsecase(
+		appConfig,
+		mockConcertRepository,
+		mockReservationRepository,
+		mockTicketRepository,
+		mockCheckinRepository,
+		mockScanRepository,
+		testTicketSigner,
+	)
+
+	return &testHelper{
+		ctrl:                      ctrl,
+		appConfig:                 appConfig,
+		mockConcertRepository:     mockConcertRepository,
+		mockReservationRepository: mockReservationRepository,
+		mockTicketRepository:      mockTicketRepository,
+		mockCheckinRepository:     mockCheckinRepository,
+		mockScanRepository:        mockScanRepository,
+		checkinUsecase:            usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewCheckinUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Execute
+	usecase := checkinusecase.NewCheckinUsecase(
+		config.AppConfig{Timezone: "Asia/Bangkok"},
+		repository_mocks.NewMockConcertRepository(ctrl),
+		repository_mocks.NewMockReservationRepository(ctrl),
+		repository_mocks.NewMockTicketRepository(ctrl),
+		repository_mocks.NewMockTicketCheckinRepository(ctrl),
+		repository_mocks.NewMockTicketScanRepository(ctrl),
+		testTicketSigner,
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
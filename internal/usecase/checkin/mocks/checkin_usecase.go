@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package checkin_usecasemocks is a generated GoMock package.
+package checkin_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/checkin"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCheckinUsecase is a mock of CheckinUsecase interface.
+type MockCheckinUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckinUsecaseMockRecorder
+}
+
+// MockCheckinUsecaseMockRecorder is the mock recorder for MockCheckinUsecase.
+type MockCheckinUsecaseMockRecorder struct {
+	mock *MockCheckinUsecase
+}
+
+// NewMockCheckinUsecase creates a new mock instance.
+func NewMockCheckinUsecase(ctrl *gomock.Controller) *MockCheckinUsecase {
+	mock := &MockCheckinUsecase{ctrl: ctrl}
+	mock.recorder = &MockCheckinUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckinUsecase) EXPECT() *MockCheckinUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockCheckinUsecase) CheckIn(ctx context.Context, input usecase.CheckInInput) (*usecase.CheckInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, input)
+	ret0, _ := ret[0].(*usecase.CheckInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockCheckinUsecaseMockRecorder) CheckIn(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockCheckinUsecase)(nil).CheckIn), ctx, input)
+}
+
+// ExportScannerBundle mocks base method.
+func (m *MockCheckinUsecase) ExportScannerBundle(ctx context.Context, input usecase.ExportScannerBundleInput) (*usecase.ScannerBundleResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportScannerBundle", ctx, input)
+	ret0, _ := ret[0].(*usecase.ScannerBundleResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportScannerBundle indicates an expected call of ExportScannerBundle.
+func (mr *MockCheckinUsecaseMockRecorder) ExportScannerBundle(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportScannerBundle", reflect.TypeOf((*MockCheckinUsecase)(nil).ExportScannerBundle), ctx, input)
+}
+
+// FindScanConflicts mocks base method.
+func (m *MockCheckinUsecase) FindScanConflicts(ctx context.Context, input usecase.FindScanConflictsInput) (entity.ScanConflicts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScanConflicts", ctx, input)
+	ret0, _ := ret[0].(entity.ScanConflicts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScanConflicts indicates an expected call of FindScanConflicts.
+func (mr *MockCheckinUsecaseMockRecorder) FindScanConflicts(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScanConflicts", reflect.TypeOf((*MockCheckinUsecase)(nil).FindScanConflicts), ctx, input)
+}
+
+// UploadScans mocks base method.
+func (m *MockCheckinUsecase) UploadScans(ctx context.Context, input usecase.UploadScansInput) (*usecase.UploadScansResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadScans", ctx, input)
+	ret0, _ := ret[0].(*usecase.UploadScansResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadScans indicates an expected call of UploadScans.
+func (mr *MockCheckinUsecaseMockRecorder) UploadScans(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadScans", reflect.TypeOf((*MockCheckinUsecase)(nil).UploadScans), ctx, input)
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type UploadScansInput struct {
+	ScannerID string      `json:"scanner_id" validate:"required"`
+	Scans     []ScanInput `json:"scans" validate:"required,min=1,max=1000,dive"` // Longer logs are uploaded in batches
+}
+
+type ScanInput struct {
+	TicketID  string    `json:"ticket_id" validate:"required,uuid4"`
+	Gate      string    `json:"gate" validate:"required,max=50"`
+	ScannedAt time.Time `json:"scanned_at" validate:"required"`
+}
+
+type UploadScansResult struct {
+	Received          int         // Scans in the upload
+	Recorded          int         // Scans that were not uploaded before
+	Admitted          int         // Tickets admitted by the upload
+	ConflictTicketIDs []uuid.UUID // Tickets already admitted by another scanner
+	UnknownTicketIDs  []uuid.UUID // Scanned IDs matching no ticket
+}
+
+// UploadScans merges the scan log of a scanner that validated tickets offline. Every scan is recorded once however often the log
+// is uploaded, the earliest scan of a ticket not admitted yet admits it, and tickets already admitted by another scanner are
+// reported as conflicts. Nothing is undone when a later step fails, uploading the same log again completes the merge.
+func (u *checkinUsecase) UploadScans(ctx context.Context, input UploadScansInput) (result *UploadScansResult, err error) {
+	const errLocation = "[usecase checkin/upload_scans UploadScans] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("checkin.usecase"), func(ctx context.Context) (*UploadScansResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		// Scanned ticket IDs in the order they first appear in the log
+		var ticketIDs []uuid.UUID
+		scannedTicketIDs := make([]uuid.UUID, 0, len(input.Scans))
+		seen := make(map[uuid.UUID]bool)
+		for _, scan := range input.Scans {
+			ticketID, err := uuid.Parse(scan.TicketID)
+			if err != nil {
+				err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid ticket ID", nil))
+				return nil, err
+			}
+			scannedTicketIDs = append(scannedTicketIDs, ticketID)
+			if !seen[ticketID] {
+				seen[ticketID] = true
+				ticketIDs = append(ticketIDs, ticketID)
+			}
+		}
+
+		tickets, err := u.ticketRepository.FindMany(ctx, ticketIDs)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find tickets by IDs", nil))
+			return nil, err
+		}
+		ticketsByID := make(map[uuid.UUID]*entity.Ticket)
+		for _, ticket := range pointer.GetValue(tickets) {
+			ticketsByID[ticket.ID] = &ticket
+		}
+
+		result := &UploadScansResult{Received: len(input.Scans)}
+		var scans entity.TicketScans
+		earliestScans := make(map[uuid.UUID]*entity.TicketScan)
+		for i, scan := range input.Scans {
+			ticket, ok := ticketsByID[scannedTicketIDs[i]]
+			if !ok {
+				continue
+			}
+			ticketScan := entity.NewTicketScan(ticket, scan.Gate, input.ScannerID, scan.ScannedAt)
+			scans = append(scans, *ticketScan)
+			if earliest, ok := earliestScans[ticket.ID]; !ok || ticketScan.ScannedAt.Before(earliest.ScannedAt) {
+				earliestScans[ticket.ID] = ticketScan
+			}
+		}
+		for _, ticketID := range ticketIDs {
+			if _, ok := ticketsByID[ticketID]; !ok {
+				result.UnknownTicketIDs = append(result.UnknownTicketIDs, ticketID)
+			}
+		}
+
+		recorded, err := u.scanRepository.CreateMany(ctx, scans)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create ticket scans", nil))
+			return nil, err
+		}
+		result.Recorded = len(pointer.GetValue(recorded))
+
+		// Admit each scanned ticket at its earliest scan, the unique ticket ID keeps an earlier admission in place
+		for _, ticketID := range ticketIDs {
+			scan, ok := earliestScans[ticketID]
+			if !ok {
+				continue
+			}
+			_, err := u.checkinRepository.CreateOne(ctx, entity.NewTicketCheckin(ticketsByID[ticketID], scan.Gate, scan.ScannerID, scan.ScannedAt))
+			if err == nil {
+				result.Admitted++
+				continue
+			}
+			if !errors.As(err, &errsFramework.ConflictError{}) {
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create ticket checkin", nil))
+				return nil, err
+			}
+			previous, err := u.checkinRepository.FindOneByTicketID(ctx, ticketID)
+			if err != nil {
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find ticket checkin by ticket ID", nil))
+				return nil, err
+			}
+			if previous.ScannerID != input.ScannerID {
+				result.ConflictTicketIDs = append(result.ConflictTicketIDs, ticketID)
+			}
+		}
+
+		return result, nil
+	})
+}
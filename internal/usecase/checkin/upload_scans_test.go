@@ -0,0 +1,218 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	checkinusecase "ticket-reservation/internal/usecase/checkin"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestCheckinUsecase_UploadScans(t *testing.T) {
+	concertID := uuid.New()
+	ticket1 := entity.Ticket{ID: uuid.New(), ConcertID: concertID, ZoneName: "VIP", SeatNumber: "A1"}
+	ticket2 := entity.Ticket{ID: uuid.New(), ConcertID: concertID, ZoneName: "VIP", SeatNumber: "A2"}
+	unknownTicketID := uuid.New()
+	firstScan := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+	secondScan := firstScan.Add(5 * time.Minute)
+
+	validInput := checkinusecase.UploadScansInput{
+		ScannerID: "gate-b-1",
+		Scans: []checkinusecase.ScanInput{
+			{TicketID: ticket1.ID.String(), Gate: "B", ScannedAt: secondScan},
+			{TicketID: ticket1.ID.String(), Gate: "B", ScannedAt: firstScan},
+			{TicketID: ticket2.ID.String(), Gate: "B", ScannedAt: firstScan},
+			{TicketID: unknownTicketID.String(), Gate: "B", ScannedAt: firstScan},
+		},
+	}
+	expectTickets := func(h *testHelper) {
+		h.mockTicketRepository.EXPECT().FindMany(gomock.Any(), []uuid.UUID{ticket1.ID, ticket2.ID, unknownTicketID}).Return(&entity.Tickets{ticket1, ticket2}, nil)
+	}
+	expectScans := func(h *testHelper, recorded int) {
+		h.mockScanRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, scans entity.TicketScans) (*entity.TicketScans, error) {
+				// Scans of unknown tickets are not recorded
+				require.Len(t, scans, 3)
+				for _, scan := range scans {
+					assert.Equal(t, concertID, scan.ConcertID)
+					assert.Equal(t, "gate-b-1", scan.ScannerID)
+				}
+				created := scans[:recorded]
+				return &created, nil
+			},
+		)
+	}
+
+	tests := []struct {
+		name           string
+		input          checkinusecase.UploadScansInput
+		setupMocks     func(h *testHelper)
+		expectedResult *checkinusecase.UploadScansResult
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "first upload admits each ticket at its earliest scan",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTickets(h)
+				expectScans(h, 3)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, checkin *entity.TicketCheckin) (*entity.TicketCheckin, error) {
+						assert.Equal(t, ticket1.ID, checkin.TicketID)
+						assert.Equal(t, firstScan, checkin.AdmittedAt)
+						assert.Equal(t, "B", checkin.Gate)
+						assert.Equal(t, "gate-b-1", checkin.ScannerID)
+						return checkin, nil
+					},
+				)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, checkin *entity.TicketCheckin) (*entity.TicketCheckin, error) {
+						assert.Equal(t, ticket2.ID, checkin.TicketID)
+						return checkin, nil
+					},
+				)
+			},
+			expectedResult: &checkinusecase.UploadScansResult{
+				Received:         4,
+				Recorded:         3,
+				Admitted:         2,
+				UnknownTicketIDs: []uuid.UUID{unknownTicketID},
+			},
+		},
+		{
+			name:  "second upload of the same log records nothing new",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTickets(h)
+				expectScans(h, 0)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewConflictError("ticket already admitted", nil)).Times(2)
+				h.mockCheckinRepository.EXPECT().FindOneByTicketID(gomock.Any(), ticket1.ID).Return(&entity.TicketCheckin{TicketID: ticket1.ID, ScannerID: "gate-b-1"}, nil)
+				h.mockCheckinRepository.EXPECT().FindOneByTicketID(gomock.Any(), ticket2.ID).Return(&entity.TicketCheckin{TicketID: ticket2.ID, ScannerID: "gate-b-1"}, nil)
+			},
+			expectedResult: &checkinusecase.UploadScansResult{
+				Received:         4,
+				Recorded:         0,
+				Admitted:         0,
+				UnknownTicketIDs: []uuid.UUID{unknownTicketID},
+			},
+		},
+		{
+			name:  "ticket admitted by another scanner is a conflict",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTickets(h)
+				expectScans(h, 3)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewConflictError("ticket already admitted", nil))
+				h.mockCheckinRepository.EXPECT().FindOneByTicketID(gomock.Any(), ticket1.ID).Return(&entity.TicketCheckin{TicketID: ticket1.ID, ScannerID: "gate-a-1"}, nil)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, checkin *entity.TicketCheckin) (*entity.TicketCheckin, error) {
+						return checkin, nil
+					},
+				)
+			},
+			expectedResult: &checkinusecase.UploadScansResult{
+				Received:          4,
+				Recorded:          3,
+				Admitted:          1,
+				ConflictTicketIDs: []uuid.UUID{ticket1.ID},
+				UnknownTicketIDs:  []uuid.UUID{unknownTicketID},
+			},
+		},
+		{
+			name: "validation error - empty log",
+			input: checkinusecase.UploadScansInput{
+				ScannerID: "gate-b-1",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - invalid ticket ID",
+			input: checkinusecase.UploadScansInput{
+				ScannerID: "gate-b-1",
+				Scans:     []checkinusecase.ScanInput{{TicketID: "invalid", Gate: "B", ScannedAt: firstScan}},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "ticket repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTicketRepository.EXPECT().FindMany(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find tickets by IDs",
+		},
+		{
+			name:  "scan repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTickets(h)
+				h.mockScanRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create ticket scans",
+		},
+		{
+			name:  "checkin repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTickets(h)
+				expectScans(h, 3)
+				h.mockCheckinRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create ticket checkin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.checkinUsecase.UploadScans(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase checkin/upload_scans UploadScans]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
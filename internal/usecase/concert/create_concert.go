@@ -12,9 +12,10 @@ import (
 )
 
 type CreateConcertInput struct {
-	Name  string    `json:"name" validate:"required,gt=0"`
-	Venue string    `json:"venue" validate:"required,gt=0"`
-	Date  time.Time `json:"date" validate:"required,thaitimezone"`
+	Name      string            `json:"name" validate:"required,gt=0"`
+	Venue     string            `json:"venue" validate:"required,gt=0"`
+	Date      time.Time         `json:"date" validate:"required,thaitimezone"`
+	Principal *entity.Principal `json:"-"` // User creating the concert, an organizer becomes its owner
 }
 
 func (u *concertUsecase) CreateConcert(ctx context.Context, input CreateConcertInput) (concert *entity.Concert, err error) {
@@ -42,10 +43,40 @@ func (u *concertUsecase) CreateConcert(ctx context.Context, input CreateConcertI
 			Venue: input.Venue,
 			Date:  input.Date,
 		}
+		switch {
+		case input.Principal.HasRole(entity.UserRoleAdmin):
+			// Concerts created by an admin have no organizer, only admins manage them
+		case input.Principal.HasRole(entity.UserRoleOrganizer):
+			concert.OrganizerID = &input.Principal.UserID
+		default:
+			return nil, errsFramework.NewForbiddenError("only admins and organizers can create concerts", nil)
+		}
+
+		// Start a transaction so that the concert is only created along with the notification of webhook subscribers
+		tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			} else {
+				_ = tx.Commit()
+			}
+		}()
+
+		created, err := u.concertRepository.WithTx(tx.DB()).CreateOne(ctx, concert)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create concert", nil))
+			return nil, err
+		}
 
-		created, err := u.concertRepository.CreateOne(ctx, concert)
+		event := entity.NewWebhookEventForConcert(entity.WebhookEventTypeConcertCreated, created, time.Now())
+		err = u.eventPublisher.EnqueueWebhookEvents(ctx, tx.DB(), entity.WebhookEvents{event})
 		if err != nil {
-			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create concert", nil))
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to enqueue webhook events", nil))
+			return nil, err
 		}
 
 		return created, nil
@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
 	concertusecase "ticket-reservation/internal/usecase/concert"
 
 	errsFramework "github.com/kittipat1413/go-common/framework/errors"
@@ -21,6 +23,9 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 	bangkokTime, _ := time.LoadLocation("Asia/Bangkok")
 	testTime := time.Date(2025, 12, 25, 20, 0, 0, 0, bangkokTime)
 	testID := uuid.New()
+	admin := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleAdmin}
+	organizer := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer}
+	customer := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleCustomer}
 
 	expectedConcert := &entity.Concert{
 		ID:        testID,
@@ -31,6 +36,18 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockConcertRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockConcertRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+
 	tests := []struct {
 		name           string
 		input          concertusecase.CreateConcertInput
@@ -43,11 +60,13 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 		{
 			name: "successful concert creation",
 			input: concertusecase.CreateConcertInput{
-				Name:  "Test Concert",
-				Venue: "Test Venue",
-				Date:  testTime,
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: admin,
 			},
 			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
 				h.mockConcertRepository.EXPECT().
 					CreateOne(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, concert *entity.Concert) (*entity.Concert, error) {
@@ -55,31 +74,92 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 						assert.Equal(h.ctrl.T, "Test Concert", concert.Name)
 						assert.Equal(h.ctrl.T, "Test Venue", concert.Venue)
 						assert.Equal(h.ctrl.T, testTime, concert.Date)
+						assert.Nil(h.ctrl.T, concert.OrganizerID, "A concert created by an admin has no organizer")
 						return expectedConcert, nil
 					})
+				h.mockEventPublisher.EXPECT().
+					EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ db.SqlExecer, events entity.WebhookEvents) error {
+						if assert.Len(h.ctrl.T, events, 1) {
+							assert.Equal(h.ctrl.T, entity.WebhookEventTypeConcertCreated, events[0].Type)
+							assert.Equal(h.ctrl.T, testID, events[0].Data["concert_id"])
+						}
+						return nil
+					})
 			},
 			expectedResult: expectedConcert,
 			expectedError:  false,
 		},
 		{
-			name: "validation error - empty name",
+			name: "successful concert creation by an organizer",
+			input: concertusecase.CreateConcertInput{
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				h.mockConcertRepository.EXPECT().
+					CreateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, concert *entity.Concert) (*entity.Concert, error) {
+						// Verify the organizer owns the concert
+						assert.Equal(h.ctrl.T, &organizer.UserID, concert.OrganizerID)
+						return expectedConcert, nil
+					})
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(1)).Return(nil)
+			},
+			expectedResult: expectedConcert,
+			expectedError:  false,
+		},
+		{
+			name: "forbidden - customer cannot create concerts",
+			input: concertusecase.CreateConcertInput{
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: customer,
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedResult: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.ForbiddenError{},
+			errorContains:  "only admins and organizers can create concerts",
+		},
+		{
+			name: "forbidden - missing principal",
 			input: concertusecase.CreateConcertInput{
-				Name:  "",
+				Name:  "Test Concert",
 				Venue: "Test Venue",
 				Date:  testTime,
 			},
 			setupMocks:     func(h *testHelper) {},
 			expectedResult: nil,
 			expectedError:  true,
+			errorType:      &errsFramework.ForbiddenError{},
+			errorContains:  "only admins and organizers can create concerts",
+		},
+		{
+			name: "validation error - empty name",
+			input: concertusecase.CreateConcertInput{
+				Name:      "",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: admin,
+			},
+			setupMocks:     func(h *testHelper) {},
+			expectedResult: nil,
+			expectedError:  true,
 			errorType:      &errsFramework.BadRequestError{},
 			errorContains:  "the request is invalid",
 		},
 		{
 			name: "validation error - empty venue",
 			input: concertusecase.CreateConcertInput{
-				Name:  "Test Concert",
-				Venue: "",
-				Date:  testTime,
+				Name:      "Test Concert",
+				Venue:     "",
+				Date:      testTime,
+				Principal: admin,
 			},
 			setupMocks:     func(h *testHelper) {},
 			expectedResult: nil,
@@ -90,9 +170,10 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 		{
 			name: "validation error - invalid timezone",
 			input: concertusecase.CreateConcertInput{
-				Name:  "Test Concert",
-				Venue: "Test Venue",
-				Date:  time.Date(2025, 12, 25, 20, 0, 0, 0, time.UTC), // UTC instead of Bangkok timezone
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      time.Date(2025, 12, 25, 20, 0, 0, 0, time.UTC), // UTC instead of Bangkok timezone
+				Principal: admin,
 			},
 			setupMocks:     func(h *testHelper) {},
 			expectedResult: nil,
@@ -103,11 +184,13 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 		{
 			name: "repository error - database failure",
 			input: concertusecase.CreateConcertInput{
-				Name:  "Test Concert",
-				Venue: "Test Venue",
-				Date:  testTime,
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: admin,
 			},
 			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
 				h.mockConcertRepository.EXPECT().
 					CreateOne(gomock.Any(), gomock.Any()).
 					Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
@@ -120,11 +203,13 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 		{
 			name: "repository error - conflict error",
 			input: concertusecase.CreateConcertInput{
-				Name:  "Test Concert",
-				Venue: "Test Venue",
-				Date:  testTime,
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: admin,
 			},
 			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
 				h.mockConcertRepository.EXPECT().
 					CreateOne(gomock.Any(), gomock.Any()).
 					Return(nil, errsFramework.NewConflictError("concert already exists", nil))
@@ -134,6 +219,42 @@ func TestConcertUsecase_CreateConcert(t *testing.T) {
 			errorType:      &errsFramework.InternalServerError{},
 			errorContains:  "failed to create concert",
 		},
+		{
+			name: "transaction creation error",
+			input: concertusecase.CreateConcertInput{
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: admin,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(nil, errors.New("tx failed"))
+			},
+			expectedResult: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.InternalServerError{},
+			errorContains:  "failed to create transaction",
+		},
+		{
+			name: "webhook enqueue error rolls back the concert",
+			input: concertusecase.CreateConcertInput{
+				Name:      "Test Concert",
+				Venue:     "Test Venue",
+				Date:      testTime,
+				Principal: admin,
+			},
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockConcertRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(expectedConcert, nil)
+				h.mockEventPublisher.EXPECT().
+					EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(1)).
+					Return(errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedResult: nil,
+			expectedError:  true,
+			errorType:      &errsFramework.InternalServerError{},
+			errorContains:  "failed to enqueue webhook events",
+		},
 	}
 
 	for _, tt := range tests {
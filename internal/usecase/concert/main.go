@@ -4,6 +4,7 @@ import (
 	"context"
 	"ticket-reservation/internal/config"
 	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
 	"ticket-reservation/internal/domain/repository"
 	"ticket-reservation/internal/infra/db"
 )
@@ -13,22 +14,26 @@ type ConcertUsecase interface {
 	CreateConcert(ctx context.Context, concert CreateConcertInput) (*entity.Concert, error)
 	FindOneConcert(ctx context.Context, id FindOneConcertInput) (*entity.Concert, error)
 	FindAllConcerts(ctx context.Context, input FindAllConcertsInput) (entity.Page[entity.Concert], error)
+	UpdateConcertWaitingRoom(ctx context.Context, input UpdateConcertWaitingRoomInput) (*entity.Concert, error)
 }
 
 type concertUsecase struct {
 	appConfig         config.AppConfig
 	transactorFactory db.SqlxTransactorFactory
 	concertRepository repository.ConcertRepository
+	eventPublisher    gateway.EventPublisher
 }
 
 func NewConcertUsecase(
 	appConfig config.AppConfig,
 	transactorFactory db.SqlxTransactorFactory,
 	concertRepository repository.ConcertRepository,
+	eventPublisher gateway.EventPublisher,
 ) ConcertUsecase {
 	return &concertUsecase{
 		appConfig:         appConfig,
 		transactorFactory: transactorFactory,
 		concertRepository: concertRepository,
+		eventPublisher:    eventPublisher,
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"ticket-reservation/internal/config"
+	gateway_mocks "ticket-reservation/internal/domain/gateway/mocks"
 	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
 	db_mocks "ticket-reservation/internal/infra/db/mocks"
 	concertusecase "ticket-reservation/internal/usecase/concert"
@@ -17,7 +18,9 @@ type testHelper struct {
 	ctrl                  *gomock.Controller
 	appConfig             config.AppConfig
 	mockTransactorFactory *db_mocks.MockSqlxTransactorFactory
+	mockTransactor        *db_mocks.MockSqlxTransactor
 	mockConcertRepository *repository_mocks.MockConcertRepository
+	mockEventPublisher    *gateway_mocks.MockEventPublisher
 	concertUsecase        concertusecase.ConcertUsecase
 }
 
@@ -33,19 +36,24 @@ func initTest(t *testing.T) *testHelper {
 	}
 
 	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
+	mockTransactor := db_mocks.NewMockSqlxTransactor(ctrl)
 	mockConcertRepository := repository_mocks.NewMockConcertRepository(ctrl)
+	mockEventPublisher := gateway_mocks.NewMockEventPublisher(ctrl)
 
 	usecase := concertusecase.NewConcertUsecase(
 		appConfig,
 		mockTransactorFactory,
 		mockConcertRepository,
+		mockEventPublisher,
 	)
 
 	return &testHelper{
 		ctrl:                  ctrl,
 		appConfig:             appConfig,
 		mockTransactorFactory: mockTransactorFactory,
+		mockTransactor:        mockTransactor,
 		mockConcertRepository: mockConcertRepository,
+		mockEventPublisher:    mockEventPublisher,
 		concertUsecase:        usecase,
 	}
 }
@@ -66,9 +74,10 @@ func TestNewConcertUsecase(t *testing.T) {
 	}
 	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
 	mockConcertRepo := repository_mocks.NewMockConcertRepository(ctrl)
+	mockEventPublisher := gateway_mocks.NewMockEventPublisher(ctrl)
 
 	// Execute
-	usecase := concertusecase.NewConcertUsecase(appConfig, mockTransactorFactory, mockConcertRepo)
+	usecase := concertusecase.NewConcertUsecase(appConfig, mockTransactorFactory, mockConcertRepo, mockEventPublisher)
 
 	// Assert
 	assert.NotNil(t, usecase)
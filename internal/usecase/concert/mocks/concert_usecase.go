@@ -80,3 +80,18 @@ func (mr *MockConcertUsecaseMockRecorder) FindOneConcert(ctx, id interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneConcert", reflect.TypeOf((*MockConcertUsecase)(nil).FindOneConcert), ctx, id)
 }
+
+// UpdateConcertWaitingRoom mocks base method.
+func (m *MockConcertUsecase) UpdateConcertWaitingRoom(ctx context.Context, input usecase.UpdateConcertWaitingRoomInput) (*entity.Concert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConcertWaitingRoom", ctx, input)
+	ret0, _ := ret[0].(*entity.Concert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConcertWaitingRoom indicates an expected call of UpdateConcertWaitingRoom.
+func (mr *MockConcertUsecaseMockRecorder) UpdateConcertWaitingRoom(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConcertWaitingRoom", reflect.TypeOf((*MockConcertUsecase)(nil).UpdateConcertWaitingRoom), ctx, input)
+}
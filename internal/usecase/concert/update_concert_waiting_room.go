@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type UpdateConcertWaitingRoomInput struct {
+	ID        string            `json:"id" validate:"required,uuid4"`
+	Enabled   *bool             `json:"enabled" validate:"required"`
+	Principal *entity.Principal `json:"-"` // User switching the waiting room, they must manage the concert
+}
+
+// UpdateConcertWaitingRoom switches the waiting room of a concert on or off.
+// Buyers already admitted keep their pass when it is switched off and on again.
+func (u *concertUsecase) UpdateConcertWaitingRoom(ctx context.Context, input UpdateConcertWaitingRoomInput) (concert *entity.Concert, err error) {
+	const errLocation = "[usecase concert/update_concert_waiting_room UpdateConcertWaitingRoom] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("concert.usecase"), func(ctx context.Context) (*entity.Concert, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+
+		concert, err := u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if !concert.IsManageableBy(input.Principal) {
+			return nil, errsFramework.NewForbiddenError("the concert is managed by another organizer", nil)
+		}
+
+		concert, err = u.concertRepository.UpdateOne(ctx, repository.UpdateConcertInput{
+			ID:                 concert.ID,
+			WaitingRoomEnabled: input.Enabled,
+		})
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update concert", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		return concert, nil
+	})
+}
@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	concertusecase "ticket-reservation/internal/usecase/concert"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestConcertUsecase_UpdateConcertWaitingRoom(t *testing.T) {
+	testID := uuid.New()
+	organizer := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer}
+	admin := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleAdmin}
+
+	existingConcert := &entity.Concert{
+		ID:          testID,
+		Name:        "Test Concert",
+		Venue:       "Test Venue",
+		Date:        time.Date(2025, 12, 25, 20, 0, 0, 0, time.UTC),
+		OrganizerID: &organizer.UserID,
+	}
+	updatedConcert := &entity.Concert{
+		ID:                 testID,
+		Name:               "Test Concert",
+		Venue:              "Test Venue",
+		Date:               time.Date(2025, 12, 25, 20, 0, 0, 0, time.UTC),
+		OrganizerID:        &organizer.UserID,
+		WaitingRoomEnabled: true,
+	}
+
+	tests := []struct {
+		name           string
+		input          concertusecase.UpdateConcertWaitingRoomInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Concert
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "organizer enables the waiting room of their concert",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Enabled:   pointer.ToPointer(true),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), testID).Return(existingConcert, nil)
+				h.mockConcertRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateConcertInput{
+					ID:                 testID,
+					WaitingRoomEnabled: pointer.ToPointer(true),
+				}).Return(updatedConcert, nil)
+			},
+			expectedResult: updatedConcert,
+		},
+		{
+			name: "admin disables the waiting room of any concert",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Enabled:   pointer.ToPointer(false),
+				Principal: admin,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), testID).Return(updatedConcert, nil)
+				h.mockConcertRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateConcertInput{
+					ID:                 testID,
+					WaitingRoomEnabled: pointer.ToPointer(false),
+				}).Return(existingConcert, nil)
+			},
+			expectedResult: existingConcert,
+		},
+		{
+			name: "validation error - missing enabled",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - invalid UUID format",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        "invalid-uuid",
+				Enabled:   pointer.ToPointer(true),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "concert not found",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Enabled:   pointer.ToPointer(true),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), testID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name: "concert managed by another organizer",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Enabled:   pointer.ToPointer(true),
+				Principal: &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), testID).Return(existingConcert, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the concert is managed by another organizer",
+		},
+		{
+			name: "repository error - find failure",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Enabled:   pointer.ToPointer(true),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), testID).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find concert by ID",
+		},
+		{
+			name: "repository error - update failure",
+			input: concertusecase.UpdateConcertWaitingRoomInput{
+				ID:        testID.String(),
+				Enabled:   pointer.ToPointer(true),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), testID).Return(existingConcert, nil)
+				h.mockConcertRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update concert",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			ctx := context.Background()
+			result, err := h.concertUsecase.UpdateConcertWaitingRoom(ctx, tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase concert/update_concert_waiting_room UpdateConcertWaitingRoom]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, pointer.GetValue(tt.expectedResult), pointer.GetValue(result))
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/infra/db"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindOnePaymentInput struct {
+	PaymentID string `json:"payment_id" validate:"required,uuid4"`
+	SessionID string `json:"session_id" validate:"required"`
+}
+
+// FindOnePayment returns a payment of a reservation held by the session, together with the line items of its amount.
+func (u *paymentUsecase) FindOnePayment(ctx context.Context, input FindOnePaymentInput) (payment *entity.Payment, err error) {
+	const errLocation = "[usecase payment/find_one_payment FindOnePayment] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("payment.usecase"), func(ctx context.Context) (*entity.Payment, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		payment, err := u.findPaymentOfSession(ctx, input.PaymentID, input.SessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		lineItems, err := u.lineItemRepository.FindAllByPaymentID(ctx, payment.ID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find payment line items", nil))
+			return nil, err
+		}
+		payment.LineItems = pointer.GetValue(lineItems)
+
+		return payment, nil
+	})
+}
+
+// findPaymentOfSession returns the payment when it belongs to a reservation held by the session.
+func (u *paymentUsecase) findPaymentOfSession(ctx context.Context, paymentIDStr string, sessionID string) (*entity.Payment, error) {
+	paymentID, err := uuid.Parse(paymentIDStr)
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid payment ID", nil))
+	}
+
+	payment, err := u.paymentRepository.FindOne(ctx, paymentID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find payment by ID", nil))
+		}
+		return nil, err // Return the NotFoundError directly
+	}
+
+	// Only the session holding the reservation may see its payments
+	reservation, err := u.reservationRepository.FindOne(ctx, payment.ReservationID)
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+	}
+	if reservation.SessionID != sessionID {
+		return nil, errsFramework.NewForbiddenError("the payment does not belong to this session", nil)
+	}
+
+	return payment, nil
+}
+
+// findPaymentForUpdate returns the payment together with its reservation locked by the transaction of the execer.
+// Every transition of a payment happens under the lock of its reservation, so the payment is read again once the lock
+// is held; it may have been settled by another request in the meantime.
+func (u *paymentUsecase) findPaymentForUpdate(ctx context.Context, execer db.SqlExecer, paymentID uuid.UUID) (payment *entity.Payment, reservation *entity.Reservation, err error) {
+	payment, err = u.paymentRepository.WithTx(execer).FindOne(ctx, paymentID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find payment by ID", nil))
+			return nil, nil, err
+		}
+		return nil, nil, err // Return the NotFoundError directly
+	}
+
+	// Get the reservation with explicit row locking
+	reservation, err = u.reservationRepository.WithTx(execer).FindOne(ctx, payment.ReservationID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+		return nil, nil, err
+	}
+
+	payment, err = u.paymentRepository.WithTx(execer).FindOne(ctx, paymentID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find payment by ID", nil))
+		return nil, nil, err
+	}
+
+	return payment, reservation, nil
+}
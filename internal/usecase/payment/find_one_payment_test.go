@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	paymentusecase "ticket-reservation/internal/usecase/payment"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentUsecase_FindOnePayment(t *testing.T) {
+	paymentID := uuid.New()
+	reservationID := uuid.New()
+	sessionID := "session-123"
+
+	payment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusPaid,
+	}
+	reservation := &entity.Reservation{
+		ID:        reservationID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusConfirmed,
+	}
+
+	lineItems := entity.PaymentLineItems{
+		{PaymentID: paymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1500.00")},
+		{PaymentID: paymentID, LineNumber: 2, Type: entity.LineItemTypeVAT, Rate: pointer.ToPointer(decimal.RequireFromString("7")), Amount: decimal.RequireFromString("105.00")},
+	}
+	paymentWithLineItems := *payment
+	paymentWithLineItems.LineItems = lineItems
+
+	validInput := paymentusecase.FindOnePaymentInput{
+		PaymentID: paymentID.String(),
+		SessionID: sessionID,
+	}
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.FindOnePaymentInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Payment
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful retrieval with line items",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(pointer.ToPointer(*payment), nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+				h.mockLineItemRepository.EXPECT().FindAllByPaymentID(gomock.Any(), paymentID).Return(&lineItems, nil)
+			},
+			expectedResult: &paymentWithLineItems,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid payment ID",
+			input: paymentusecase.FindOnePaymentInput{
+				PaymentID: "invalid-uuid",
+				SessionID: sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "payment not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(nil, errsFramework.NewNotFoundError("payment not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "payment not found",
+		},
+		{
+			name:  "payment repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find payment by ID",
+		},
+		{
+			name:  "reservation repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find reservation by ID",
+		},
+		{
+			name:  "line item repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(pointer.ToPointer(*payment), nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+				h.mockLineItemRepository.EXPECT().FindAllByPaymentID(gomock.Any(), paymentID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find payment line items",
+		},
+		{
+			name: "payment belongs to another session",
+			input: paymentusecase.FindOnePaymentInput{
+				PaymentID: paymentID.String(),
+				SessionID: "other-session",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the payment does not belong to this session",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.FindOnePayment(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/find_one_payment FindOnePayment]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
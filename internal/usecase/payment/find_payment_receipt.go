@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindPaymentReceiptInput struct {
+	PaymentID string `json:"payment_id" validate:"required,uuid4"`
+	SessionID string `json:"session_id" validate:"required"`
+}
+
+// FindPaymentReceipt returns the receipt issued for a paid payment of a reservation held by the session,
+// together with the line items of its amount. A payment that was never paid has no receipt.
+func (u *paymentUsecase) FindPaymentReceipt(ctx context.Context, input FindPaymentReceiptInput) (receipt *entity.Receipt, err error) {
+	const errLocation = "[usecase payment/find_payment_receipt FindPaymentReceipt] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("payment.usecase"), func(ctx context.Context) (*entity.Receipt, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		payment, err := u.findPaymentOfSession(ctx, input.PaymentID, input.SessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		receipt, err := u.receiptRepository.FindOneByPaymentID(ctx, payment.ID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find receipt by payment ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		lineItems, err := u.lineItemRepository.FindAllByPaymentID(ctx, payment.ID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find payment line items", nil))
+			return nil, err
+		}
+		receipt.LineItems = pointer.GetValue(lineItems)
+
+		return receipt, nil
+	})
+}
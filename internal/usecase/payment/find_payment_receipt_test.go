@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	paymentusecase "ticket-reservation/internal/usecase/payment"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentUsecase_FindPaymentReceipt(t *testing.T) {
+	paymentID := uuid.New()
+	reservationID := uuid.New()
+	receiptID := uuid.New()
+	sessionID := "session-123"
+	issuedAt := time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC)
+
+	payment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusPaid,
+	}
+	reservation := &entity.Reservation{
+		ID:        reservationID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusConfirmed,
+	}
+	receipt := &entity.Receipt{
+		ID:             receiptID,
+		PaymentID:      paymentID,
+		Seller:         entity.Seller{TaxID: testSellerTaxID, Name: "Ticket Co., Ltd."},
+		ReceiptNumber:  42,
+		DocumentNumber: "RC00000042",
+		Description:    "Ticket VIP, seat A1",
+		Currency:       "THB",
+		Amount:         decimal.RequireFromString("1605.00"),
+		IssuedAt:       issuedAt,
+	}
+	lineItems := entity.PaymentLineItems{
+		{PaymentID: paymentID, LineNumber: 1, Type: entity.LineItemTypeTicket, Amount: decimal.RequireFromString("1500.00")},
+		{PaymentID: paymentID, LineNumber: 2, Type: entity.LineItemTypeVAT, Amount: decimal.RequireFromString("105.00")},
+	}
+	receiptWithLineItems := *receipt
+	receiptWithLineItems.LineItems = lineItems
+
+	validInput := paymentusecase.FindPaymentReceiptInput{
+		PaymentID: paymentID.String(),
+		SessionID: sessionID,
+	}
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.FindPaymentReceiptInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Receipt
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful retrieval with line items",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+				h.mockReceiptRepository.EXPECT().FindOneByPaymentID(gomock.Any(), paymentID).Return(pointer.ToPointer(*receipt), nil)
+				h.mockLineItemRepository.EXPECT().FindAllByPaymentID(gomock.Any(), paymentID).Return(&lineItems, nil)
+			},
+			expectedResult: &receiptWithLineItems,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid payment ID",
+			input: paymentusecase.FindPaymentReceiptInput{
+				PaymentID: "invalid-uuid",
+				SessionID: sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "payment belongs to another session",
+			input: paymentusecase.FindPaymentReceiptInput{
+				PaymentID: paymentID.String(),
+				SessionID: "other-session",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the payment does not belong to this session",
+		},
+		{
+			name:  "payment without receipt",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+				h.mockReceiptRepository.EXPECT().FindOneByPaymentID(gomock.Any(), paymentID).Return(nil, errsFramework.NewNotFoundError("receipt not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "receipt not found",
+		},
+		{
+			name:  "receipt repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+				h.mockReceiptRepository.EXPECT().FindOneByPaymentID(gomock.Any(), paymentID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find receipt by payment ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.FindPaymentReceipt(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/find_payment_receipt FindPaymentReceipt]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
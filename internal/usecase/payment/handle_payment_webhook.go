@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/pkg/webhooksignature"
+	"time"
+
+	"github.com/google/uuid"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type HandlePaymentWebhookInput struct {
+	Provider  string `json:"provider" validate:"required"`
+	Timestamp string `json:"timestamp" validate:"required"`
+	Signature string `json:"signature" validate:"required"`
+	Payload   []byte `json:"payload" validate:"required"`
+}
+
+// PaymentWebhookPayload is the body a payment provider posts to the webhook.
+type PaymentWebhookPayload struct {
+	ID   string                    `json:"id" validate:"required,max=255"`
+	Type string                    `json:"type" validate:"required,max=100"`
+	Data PaymentWebhookPayloadData `json:"data"`
+}
+
+type PaymentWebhookPayloadData struct {
+	PaymentID         string `json:"payment_id" validate:"required,uuid4"`
+	ProviderReference string `json:"provider_reference" validate:"max=255"`
+}
+
+// PaymentWebhookResult describes what was done with a webhook event.
+type PaymentWebhookResult struct {
+	EventID   string
+	Duplicate bool            // The event was already processed, nothing was done
+	Payment   *entity.Payment // The payment after the event was applied, nil for a duplicate event
+}
+
+// HandlePaymentWebhook applies a payment event notified by a provider.
+// The signature is checked against the secret configured for the provider and the timestamp must be recent,
+// so that a captured delivery cannot be replayed. Each event is recorded in the same transaction as the transitions
+// it causes, a redelivered event changes nothing. Transitions are only applied when the payment is still in a state
+// that allows them, so that events arriving late or out of order never undo the outcome of the pay request.
+func (u *paymentUsecase) HandlePaymentWebhook(ctx context.Context, input HandlePaymentWebhookInput) (result *PaymentWebhookResult, err error) {
+	const errLocation = "[usecase payment/handle_payment_webhook HandlePaymentWebhook] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("payment.usecase"), func(ctx context.Context) (*PaymentWebhookResult, error) {
+		requestTime := time.Now()
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		// Only providers with a configured secret may send webhooks
+		provider := strings.ToLower(input.Provider)
+		secret := u.appConfig.PaymentWebhookSecrets[provider]
+		if secret == "" {
+			err = errsFramework.NewNotFoundError("unknown payment provider", map[string]string{"provider": input.Provider})
+			return nil, err
+		}
+
+		// Verify the signature before looking at the payload
+		err = webhooksignature.Verify(secret, input.Timestamp, input.Signature, input.Payload, requestTime, u.appConfig.PaymentWebhookTolerance)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewUnauthorizedError("invalid webhook signature", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		var payload PaymentWebhookPayload
+		if err := json.Unmarshal(input.Payload, &payload); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid webhook payload", nil))
+			return nil, err
+		}
+		if err := vInstance.Struct(payload); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid webhook payload", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+		paymentID, err := uuid.Parse(payload.Data.PaymentID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid payment ID", nil))
+			return nil, err
+		}
+
+		result, booked, err := u.applyPaymentWebhookEvent(ctx, provider, payload, paymentID, requestTime)
+		if err != nil {
+			return nil, err
+		}
+
+		// Release the Redis lock and publish the booked seat once the transaction is committed
+		if booked != nil {
+			u.publishBookedSeat(ctx, booked.zone, booked.seat, booked.sessionID, paymentID)
+		}
+
+		return result, nil
+	})
+}
+
+// bookedSeat is the seat booked by a webhook event, it is published to Redis after the transaction is committed.
+type bookedSeat struct {
+	zone      *entity.Zone
+	seat      *entity.Seat
+	sessionID string
+}
+
+// applyPaymentWebhookEvent records the event and applies the transition it asks for in a single transaction.
+func (u *paymentUsecase) applyPaymentWebhookEvent(ctx context.Context, provider string, payload PaymentWebhookPayload, paymentID uuid.UUID, requestTime time.Time) (result *PaymentWebhookResult, booked *bookedSeat, err error) {
+	logger := commonLogger.FromContext(ctx)
+	eventType := entity.PaymentWebhookEventType(payload.Type)
+	result = &PaymentWebhookResult{EventID: payload.ID}
+
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			// The caller publishes the changes once they are committed
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	payment, reservation, err := u.findPaymentForUpdate(ctx, tx.DB(), paymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// A provider may only settle the payments it processed
+	if pointer.GetValue(payment.Provider) != provider {
+		err = errsFramework.NewForbiddenError("the payment was not processed by this provider", map[string]string{"payment_id": payment.ID.String()})
+		return nil, nil, err
+	}
+
+	// Record the event, a redelivery of an event that was already processed stops here
+	_, err = u.webhookEventRepository.WithTx(tx.DB()).CreateOne(ctx, entity.NewPaymentWebhookEvent(provider, payload.ID, eventType, &payment.ID))
+	if err != nil {
+		if errors.As(err, &errsFramework.ConflictError{}) {
+			logger.Info(ctx, "payment webhook event was already processed", commonLogger.Fields{
+				"provider": provider,
+				"event_id": payload.ID,
+			})
+			result.Duplicate = true
+			return result, nil, nil
+		}
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to record payment webhook event", nil))
+		return nil, nil, err
+	}
+
+	switch eventType {
+	case entity.PaymentWebhookEventTypeSucceeded:
+		// Only a charge still waiting for its outcome or reported as declined can succeed, a paid or refunded payment is left alone
+		if payment.Status != entity.PaymentStatusInitiated && payment.Status != entity.PaymentStatusFailed {
+			break
+		}
+		var providerReference *string
+		if payload.Data.ProviderReference != "" {
+			providerReference = pointer.ToPointer(payload.Data.ProviderReference)
+		}
+		paidPayment, seat, zone, confirmErr := u.confirmPayment(ctx, tx.DB(), reservation, payment, providerReference, requestTime)
+		if confirmErr != nil {
+			if errors.As(confirmErr, &errsFramework.ConflictError{}) {
+				// The money is taken, record that it is owed back and keep the event recorded so that the provider stops retrying
+				payment, err = u.markRefundRequired(ctx, tx.DB(), payment, providerReference)
+				if err != nil {
+					return nil, nil, err
+				}
+				break
+			}
+			err = confirmErr
+			return nil, nil, err
+		}
+		payment = paidPayment
+		booked = &bookedSeat{zone: zone, seat: seat, sessionID: reservation.SessionID}
+
+	case entity.PaymentWebhookEventTypeFailed:
+		// Only a charge still waiting for its outcome can fail, a paid payment is never moved back
+		if payment.Status != entity.PaymentStatusInitiated {
+			break
+		}
+		payment, err = u.paymentRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdatePaymentInput{
+			ID:     payment.ID,
+			Status: pointer.ToPointer(entity.PaymentStatusFailed),
+		})
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update payment status", nil))
+			return nil, nil, err
+		}
+
+	default:
+		logger.Info(ctx, "ignoring payment webhook event of an unhandled type", commonLogger.Fields{
+			"provider":   provider,
+			"event_id":   payload.ID,
+			"event_type": payload.Type,
+		})
+	}
+
+	result.Payment = payment
+	return result, booked, nil
+}
@@ -0,0 +1,442 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	paymentusecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/webhooksignature"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+// signedWebhookFixture loads a payload from testdata and signs it like the provider would at the given time.
+func signedWebhookFixture(t *testing.T, name string, secret string, signedAt time.Time) paymentusecase.HandlePaymentWebhookInput {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return paymentusecase.HandlePaymentWebhookInput{
+		Provider:  "fake",
+		Timestamp: strconv.FormatInt(signedAt.Unix(), 10),
+		Signature: webhooksignature.Sign(secret, signedAt, payload),
+		Payload:   payload,
+	}
+}
+
+func TestPaymentUsecase_HandlePaymentWebhook(t *testing.T) {
+	// IDs match the fixtures in testdata
+	paymentID := uuid.MustParse("6f1c2b7e-3d4a-4b5c-8d9e-0a1b2c3d4e5f")
+	providerReference := "fake_ch_6f1c2b7e-3d4a-4b5c-8d9e-0a1b2c3d4e5f"
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	reservationID := uuid.New()
+	sessionID := "session-123"
+	lockedUntil := time.Now().Add(5 * time.Minute)
+	amount := decimal.RequireFromString("1500.00")
+	paidAt := time.Now()
+
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	pendingReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusPending,
+		ExpiresAt: lockedUntil,
+	}
+	expiredReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusExpired,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	lockedSeat := &entity.Seat{
+		ID:                seatID,
+		ZoneID:            zoneID,
+		SeatNumber:        "A1",
+		Status:            entity.SeatStatusPending,
+		LockedUntil:       &lockedUntil,
+		LockedBySessionID: pointer.ToPointer(sessionID),
+	}
+	availableSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusAvailable,
+	}
+	bookedSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusBooked,
+	}
+	initiatedPayment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &amount,
+		PaymentMethod: pointer.ToPointer("credit_card"),
+		Provider:      pointer.ToPointer("fake"),
+	}
+	paidPayment := &entity.Payment{
+		ID:                paymentID,
+		ReservationID:     reservationID,
+		Status:            entity.PaymentStatusPaid,
+		Amount:            &amount,
+		PaidAt:            &paidAt,
+		PaymentMethod:     pointer.ToPointer("credit_card"),
+		Provider:          pointer.ToPointer("fake"),
+		ProviderReference: pointer.ToPointer(providerReference),
+	}
+	failedPayment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusFailed,
+		Amount:        &amount,
+		PaymentMethod: pointer.ToPointer("credit_card"),
+		Provider:      pointer.ToPointer("fake"),
+	}
+	refundRequiredPayment := &entity.Payment{
+		ID:                paymentID,
+		ReservationID:     reservationID,
+		Status:            entity.PaymentStatusRefundRequired,
+		Amount:            &amount,
+		PaymentMethod:     pointer.ToPointer("credit_card"),
+		Provider:          pointer.ToPointer("fake"),
+		ProviderReference: pointer.ToPointer(providerReference),
+	}
+	otherProviderPayment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &amount,
+		PaymentMethod: pointer.ToPointer("credit_card"),
+		Provider:      pointer.ToPointer("other"),
+	}
+
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+		h.mockReceiptRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReceiptRepository).AnyTimes()
+		h.mockTicketRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockTicketRepository).AnyTimes()
+		h.mockWebhookEventRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockWebhookEventRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	// expectLoad sets up the payment lookup around the reservation lock
+	expectLoad := func(h *testHelper, payment *entity.Payment, reservation *entity.Reservation) {
+		h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil).Times(2)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(reservation, nil)
+	}
+	// expectRecord sets up the insert of the processed event
+	expectRecord := func(h *testHelper, eventID string, eventType entity.PaymentWebhookEventType, err error) {
+		h.mockWebhookEventRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, event *entity.PaymentWebhookEvent) (*entity.PaymentWebhookEvent, error) {
+				assert.Equal(t, "fake", event.Provider)
+				assert.Equal(t, eventID, event.EventID)
+				assert.Equal(t, eventType, event.EventType)
+				assert.Equal(t, paymentID, pointer.GetValue(event.PaymentID))
+				if err != nil {
+					return nil, err
+				}
+				return event, nil
+			},
+		)
+	}
+
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.HandlePaymentWebhookInput
+		setupMocks     func(h *testHelper)
+		expectedResult *paymentusecase.PaymentWebhookResult
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "succeeded event confirms the reservation and books the seat",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, initiatedPayment, pendingReservation)
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input repository.UpdatePaymentInput) (*entity.Payment, error) {
+						assert.Equal(t, paymentID, input.ID)
+						assert.Equal(t, entity.PaymentStatusPaid, pointer.GetValue(input.Status))
+						assert.NotNil(t, input.PaidAt)
+						assert.Equal(t, providerReference, pointer.GetValue(input.ProviderReference))
+						return paidPayment, nil
+					},
+				)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateReservationInput{
+					ID:     reservationID,
+					Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
+				}).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateSeatInput{
+					ID:        seatID,
+					Status:    pointer.ToPointer(entity.SeatStatusBooked),
+					ClearLock: true,
+				}).Return(bookedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockTicketRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Ticket{}, nil)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(2)).Return(nil)
+				h.mockReceiptRepository.EXPECT().NextReceiptNumber(gomock.Any(), testSellerTaxID).Return(int64(7), nil)
+				h.mockReceiptRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Receipt{}, nil)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, gomock.Any()).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0001", Payment: paidPayment},
+		},
+		{
+			name:  "redelivered event does nothing",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, paidPayment, &entity.Reservation{ID: reservationID, SeatID: seatID, Status: entity.ReservationStatusConfirmed})
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, errsFramework.NewConflictError("payment webhook event already processed", nil))
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0001", Duplicate: true},
+		},
+		{
+			name:  "succeeded event for a payment already paid by the pay request",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, paidPayment, &entity.Reservation{ID: reservationID, SeatID: seatID, Status: entity.ReservationStatusConfirmed})
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, nil)
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0001", Payment: paidPayment},
+		},
+		{
+			name:  "succeeded event for an expired reservation marks the payment as refund required",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, initiatedPayment, expiredReservation)
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(availableSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+					ID:                paymentID,
+					Status:            pointer.ToPointer(entity.PaymentStatusRefundRequired),
+					ProviderReference: pointer.ToPointer(providerReference),
+				}).Return(refundRequiredPayment, nil)
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0001", Payment: refundRequiredPayment},
+		},
+		{
+			name:  "marking the payment as refund required fails",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, initiatedPayment, expiredReservation)
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(availableSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update payment status",
+		},
+		{
+			name:  "failed event marks an initiated payment as failed",
+			input: signedWebhookFixture(t, "webhook_payment_failed.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, initiatedPayment, pendingReservation)
+				expectRecord(h, "evt_0002", entity.PaymentWebhookEventTypeFailed, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+					ID:     paymentID,
+					Status: pointer.ToPointer(entity.PaymentStatusFailed),
+				}).Return(failedPayment, nil)
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0002", Payment: failedPayment},
+		},
+		{
+			name:  "failed event never moves a paid payment back",
+			input: signedWebhookFixture(t, "webhook_payment_failed.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, paidPayment, &entity.Reservation{ID: reservationID, SeatID: seatID, Status: entity.ReservationStatusConfirmed})
+				expectRecord(h, "evt_0002", entity.PaymentWebhookEventTypeFailed, nil)
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0002", Payment: paidPayment},
+		},
+		{
+			name:  "event of an unhandled type is only recorded",
+			input: signedWebhookFixture(t, "webhook_unhandled_event.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, initiatedPayment, pendingReservation)
+				expectRecord(h, "evt_0003", entity.PaymentWebhookEventType("charge.dispute.created"), nil)
+			},
+			expectedResult: &paymentusecase.PaymentWebhookResult{EventID: "evt_0003", Payment: initiatedPayment},
+		},
+		{
+			name: "unknown provider",
+			input: func() paymentusecase.HandlePaymentWebhookInput {
+				input := signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now)
+				input.Provider = "unknown"
+				return input
+			}(),
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "unknown payment provider",
+		},
+		{
+			name:          "signed with another secret",
+			input:         signedWebhookFixture(t, "webhook_payment_succeeded.json", "whsec_other", now),
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "invalid webhook signature",
+		},
+		{
+			name:          "stale timestamp",
+			input:         signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now.Add(-10*time.Minute)),
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "outside the tolerance",
+		},
+		{
+			name: "payload tampered after signing",
+			input: func() paymentusecase.HandlePaymentWebhookInput {
+				input := signedWebhookFixture(t, "webhook_payment_failed.json", testWebhookSecret, now)
+				tampered := signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now)
+				input.Payload = tampered.Payload
+				return input
+			}(),
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnauthorizedError{},
+			errorContains: "invalid webhook signature",
+		},
+		{
+			name: "missing signature",
+			input: func() paymentusecase.HandlePaymentWebhookInput {
+				input := signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now)
+				input.Signature = ""
+				return input
+			}(),
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:          "signed payload with an invalid payment ID",
+			input:         signedWebhookFixture(t, "webhook_invalid_payload.json", testWebhookSecret, now),
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid webhook payload",
+		},
+		{
+			name:  "payment not found",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(nil, errsFramework.NewNotFoundError("payment not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "payment not found",
+		},
+		{
+			name:  "payment processed by another provider",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, otherProviderPayment, pendingReservation)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the payment was not processed by this provider",
+		},
+		{
+			name:  "recording the event fails",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, initiatedPayment, pendingReservation)
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, errors.New("database error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to record payment webhook event",
+		},
+		{
+			name:  "booking the seat fails",
+			input: signedWebhookFixture(t, "webhook_payment_succeeded.json", testWebhookSecret, now),
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, initiatedPayment, pendingReservation)
+				expectRecord(h, "evt_0001", entity.PaymentWebhookEventTypeSucceeded, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find seat by ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.HandlePaymentWebhook(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/handle_payment_webhook HandlePaymentWebhook]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/payment_usecase.go -package=payment_usecasemocks
+type PaymentUsecase interface {
+	PayReservation(ctx context.Context, input PayReservationInput) (*entity.Payment, error)
+	FindOnePayment(ctx context.Context, input FindOnePaymentInput) (*entity.Payment, error)
+	FindPaymentReceipt(ctx context.Context, input FindPaymentReceiptInput) (*entity.Receipt, error)
+	HandlePaymentWebhook(ctx context.Context, input HandlePaymentWebhookInput) (*PaymentWebhookResult, error)
+	RefundPayment(ctx context.Context, input RefundPaymentInput) (*RefundPaymentResult, error)
+}
+
+type paymentUsecase struct {
+	appConfig              config.AppConfig
+	transactorFactory      db.SqlxTransactorFactory
+	zoneRepository         repository.ZoneRepository
+	seatRepository         repository.SeatRepository
+	reservationRepository  repository.ReservationRepository
+	paymentRepository      repository.PaymentRepository
+	webhookEventRepository repository.PaymentWebhookEventRepository
+	refundRepository       repository.RefundRepository
+	promoCodeRepository    repository.PromoCodeRepository
+	redemptionRepository   repository.PromoCodeRedemptionRepository
+	lineItemRepository     repository.PaymentLineItemRepository
+	receiptRepository      repository.ReceiptRepository
+	ticketRepository       repository.TicketRepository
+	priceCalculator        *entity.PriceCalculator
+	ticketSigner           *entity.TicketSigner
+	paymentGateway         gateway.PaymentGateway
+	seatLockerRepository   cache.SeatLockerRepository
+	seatMapRepository      cache.SeatMapRepository
+	eventPublisher         gateway.EventPublisher
+}
+
+func NewPaymentUsecase(
+	appConfig config.AppConfig,
+	transactorFactory db.SqlxTransactorFactory,
+	zoneRepository repository.ZoneRepository,
+	seatRepository repository.SeatRepository,
+	reservationRepository repository.ReservationRepository,
+	paymentRepository repository.PaymentRepository,
+	webhookEventRepository repository.PaymentWebhookEventRepository,
+	refundRepository repository.RefundRepository,
+	promoCodeRepository repository.PromoCodeRepository,
+	redemptionRepository repository.PromoCodeRedemptionRepository,
+	lineItemRepository repository.PaymentLineItemRepository,
+	receiptRepository repository.ReceiptRepository,
+	ticketRepository repository.TicketRepository,
+	priceCalculator *entity.PriceCalculator,
+	ticketSigner *entity.TicketSigner,
+	paymentGateway gateway.PaymentGateway,
+	seatLockerRepository cache.SeatLockerRepository,
+	seatMapRepository cache.SeatMapRepository,
+	eventPublisher gateway.EventPublisher,
+) PaymentUsecase {
+	return &paymentUsecase{
+		appConfig:              appConfig,
+		transactorFactory:      transactorFactory,
+		zoneRepository:         zoneRepository,
+		seatRepository:         seatRepository,
+		reservationRepository:  reservationRepository,
+		paymentRepository:      paymentRepository,
+		webhookEventRepository: webhookEventRepository,
+		refundRepository:       refundRepository,
+		promoCodeRepository:    promoCodeRepository,
+		redemptionRepository:   redemptionRepository,
+		lineItemRepository:     lineItemRepository,
+		receiptRepository:      receiptRepository,
+		ticketRepository:       ticketRepository,
+		priceCalculator:        priceCalculator,
+		ticketSigner:           ticketSigner,
+		paymentGateway:         paymentGateway,
+		seatLockerRepository:   seatLockerRepository,
+		seatMapRepository:      seatMapRepository,
+		eventPublisher:         eventPublisher,
+	}
+}
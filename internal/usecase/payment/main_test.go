@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/config"
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	"ticket-reservation/internal/domain/entity"
+	gateway_mocks "ticket-reservation/internal/domain/gateway/mocks"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	db_mocks "ticket-reservation/internal/infra/db/mocks"
+	paymentusecase "ticket-reservation/internal/usecase/payment"
+)
+
+// testWebhookSecret signs the webhook fixtures of the "fake" provider
+const testWebhookSecret = "whsec_test_fake"
+
+// testSellerTaxID numbers the receipts issued in the tests
+const testSellerTaxID = "0105555000001"
+
+// testTicketSigner signs the tickets issued in the tests
+var testTicketSigner = entity.NewTicketSigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+
+// testPromptPayBillerID receives the PromptPay transfers made in the tests
+const testPromptPayBillerID = "010555500000101"
+
+type testHelper struct {
+	ctrl                       *gomock.Controller
+	appConfig                  config.AppConfig
+	mockTransactorFactory      *db_mocks.MockSqlxTransactorFactory
+	mockTransactor             *db_mocks.MockSqlxTransactor
+	mockZoneRepository         *repository_mocks.MockZoneRepository
+	mockSeatRepository         *repository_mocks.MockSeatRepository
+	mockReservationRepository  *repository_mocks.MockReservationRepository
+	mockPaymentRepository      *repository_mocks.MockPaymentRepository
+	mockWebhookEventRepository *repository_mocks.MockPaymentWebhookEventRepository
+	mockRefundRepository       *repository_mocks.MockRefundRepository
+	mockPromoCodeRepository    *repository_mocks.MockPromoCodeRepository
+	mockRedemptionRepository   *repository_mocks.MockPromoCodeRedemptionRepository
+	mockLineItemRepository     *repository_mocks.MockPaymentLineItemRepository
+	mockReceiptRepository      *repository_mocks.MockReceiptRepository
+	mockTicketRepository       *repository_mocks.MockTicketRepository
+	mockPaymentGateway         *gateway_mocks.MockPaymentGateway
+	mockSeatLockerRepository   *cache_mocks.MockSeatLockerRepository
+	mockSeatMapRepository      *cache_mocks.MockSeatMapRepository
+	mockEventPublisher         *gateway_mocks.MockEventPublisher
+	paymentUsecase             paymentusecase.PaymentUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	// Without fees and VAT the amount due is the ticket price
+	priceCalculator, err := entity.NewPriceCalculator(entity.PriceCalculatorConfig{Rounding: entity.RoundingModeHalfUp})
+	require.NoError(t, err)
+	return initTestWithPriceCalculator(t, priceCalculator)
+}
+
+func initTestWithPriceCalculator(t *testing.T, priceCalculator *entity.PriceCalculator) *testHelper {
+	return initTestWithConfig(t, priceCalculator, nil)
+}
+
+// initTestWithConfig lets the test change the app config before the usecase is created.
+func initTestWithConfig(t *testing.T, priceCalculator *entity.PriceCalculator, configure func(appConfig *config.AppConfig)) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+		PaymentGateway: "fake",
+		PaymentWebhookSecrets: map[string]string{
+			"fake": testWebhookSecret,
+		},
+		PaymentWebhookTolerance: 5 * time.Minute,
+		PromptPayBillerID:       testPromptPayBillerID,
+		ReceiptSellerTaxID:      testSellerTaxID,
+		ReceiptSellerName:       "Ticket Co., Ltd.",
+		ReceiptNumberPrefix:     "RC",
+	}
+	if configure != nil {
+		configure(&appConfig)
+	}
+
+	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
+	mockTransactor := db_mocks.NewMockSqlxTransactor(ctrl)
+	mockZoneRepository := repository_mocks.NewMockZoneRepository(ctrl)
+	mockSeatRepository := repository_mocks.NewMockSeatRepository(ctrl)
+	mockReservationRepository := repository_mocks.NewMockReservationRepository(ctrl)
+	mockPaymentRepository := repository_mocks.NewMockPaymentRepository(ctrl)
+	mockWebhookEventRepository := repository_mocks.NewMockPaymentWebhookEventRepository(ctrl)
+	mockRefundRepository := repository_mocks.NewMockRefundRepository(ctrl)
+	mockPromoCodeRepository := repository_mocks.NewMockPromoCodeRepository(ctrl)
+	mockRedemptionRepository := repository_mocks.NewMockPromoCodeRedemptionRepository(ctrl)
+	mockLineItemRepository := repository_mocks.NewMockPaymentLineItemRepository(ctrl)
+	mockReceiptRepository := repository_mocks.NewMockReceiptRepository(ctrl)
+	mockTicketRepository := repository_mocks.NewMockTicketRepository(ctrl)
+	mockPaymentGateway := gateway_mocks.NewMockPaymentGateway(ctrl)
+	mockSeatLockerRepository := cache_mocks.NewMockSeatLockerRepository(ctrl)
+	mockSeatMapRepository := cache_mocks.NewMockSeatMapRepository(ctrl)
+	mockEventPublisher := gateway_mocks.NewMockEventPublisher(ctrl)
+
+	usecase := paymentusecase.NewPaymentUsecase(
+		appConfig,
+		mockTransactorFactory,
+		mockZoneRepository,
+		mockSeatRepository,
+		mockReservationRepository,
+		mockPaymentRepository,
+		mockWebhookEventRepository,
+		mockRefundRepository,
+		mockPromoCodeRepository,
+		mockRedemptionRepository,
+		mockLineItemRepository,
+		mockReceiptRepository,
+		mockTicketRepository,
+		priceCalculator,
+		testTicketSigner,
+		mockPaymentGateway,
+		mockSeatLockerRepository,
+		mockSeatMapRepository,
+		mockEventPublisher,
+	)
+
+	return &testHelper{
+		ctrl:                       ctrl,
+		appConfig:                  appConfig,
+		mockTransactorFactory:      mockTransactorFactory,
+		mockTransactor:             mockTransactor,
+		mockZoneRepository:         mockZoneRepository,
+		mockSeatRepository:         mockSeatRepository,
+		mockReservationRepository:  mockReservationRepository,
+		mockPaymentRepository:      mockPaymentRepository,
+		mockWebhookEventRepository: mockWebhookEventRepository,
+		mockRefundRepository:       mockRefundRepository,
+		mockPromoCodeRepository:    mockPromoCodeRepository,
+		mockRedemptionRepository:   mockRedemptionRepository,
+		mockLineItemRepository:     mockLineItemRepository,
+		mockReceiptRepository:      mockReceiptRepository,
+		mockTicketRepository:       mockTicketRepository,
+		mockPaymentGateway:         mockPaymentGateway,
+		mockSeatLockerRepository:   mockSeatLockerRepository,
+		mockSeatMapRepository:      mockSeatMapRepository,
+		mockEventPublisher:         mockEventPublisher,
+		paymentUsecase:             usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewPaymentUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone:    "Asia/Bangkok",
+		SeatLockTTL: 5 * time.Minute,
+	}
+
+	// Execute
+	usecase := paymentusecase.NewPaymentUsecase(
+		appConfig,
+		db_mocks.NewMockSqlxTransactorFactory(ctrl),
+		repository_mocks.NewMockZoneRepository(ctrl),
+		repository_mocks.NewMockSeatRepository(ctrl),
+		repository_mocks.NewMockReservationRepository(ctrl),
+		repository_mocks.NewMockPaymentRepository(ctrl),
+		repository_mocks.NewMockPaymentWebhookEventRepository(ctrl),
+		repository_mocks.NewMockRefundRepository(ctrl),
+		repository_mocks.NewMockPromoCodeRepository(ctrl),
+		repository_mocks.NewMockPromoCodeRedemptionRepository(ctrl),
+		repository_mocks.NewMockPaymentLineItemRepository(ctrl),
+		repository_mocks.NewMockReceiptRepository(ctrl),
+		repository_mocks.NewMockTicketRepository(ctrl),
+		&entity.PriceCalculator{},
+		testTicketSigner,
+		gateway_mocks.NewMockPaymentGateway(ctrl),
+		cache_mocks.NewMockSeatLockerRepository(ctrl),
+		cache_mocks.NewMockSeatMapRepository(ctrl),
+		gateway_mocks.NewMockEventPublisher(ctrl),
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package payment_usecasemocks is a generated GoMock package.
+package payment_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/payment"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentUsecase is a mock of PaymentUsecase interface.
+type MockPaymentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentUsecaseMockRecorder
+}
+
+// MockPaymentUsecaseMockRecorder is the mock recorder for MockPaymentUsecase.
+type MockPaymentUsecaseMockRecorder struct {
+	mock *MockPaymentUsecase
+}
+
+// NewMockPaymentUsecase creates a new mock instance.
+func NewMockPaymentUsecase(ctrl *gomock.Controller) *MockPaymentUsecase {
+	mock := &MockPaymentUsecase{ctrl: ctrl}
+	mock.recorder = &MockPaymentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentUsecase) EXPECT() *MockPaymentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// FindOnePayment mocks base method.
+func (m *MockPaymentUsecase) FindOnePayment(ctx context.Context, input usecase.FindOnePaymentInput) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOnePayment", ctx, input)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOnePayment indicates an expected call of FindOnePayment.
+func (mr *MockPaymentUsecaseMockRecorder) FindOnePayment(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnePayment", reflect.TypeOf((*MockPaymentUsecase)(nil).FindOnePayment), ctx, input)
+}
+
+// FindPaymentReceipt mocks base method.
+func (m *MockPaymentUsecase) FindPaymentReceipt(ctx context.Context, input usecase.FindPaymentReceiptInput) (*entity.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaymentReceipt", ctx, input)
+	ret0, _ := ret[0].(*entity.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaymentReceipt indicates an expected call of FindPaymentReceipt.
+func (mr *MockPaymentUsecaseMockRecorder) FindPaymentReceipt(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaymentReceipt", reflect.TypeOf((*MockPaymentUsecase)(nil).FindPaymentReceipt), ctx, input)
+}
+
+// HandlePaymentWebhook mocks base method.
+func (m *MockPaymentUsecase) HandlePaymentWebhook(ctx context.Context, input usecase.HandlePaymentWebhookInput) (*usecase.PaymentWebhookResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandlePaymentWebhook", ctx, input)
+	ret0, _ := ret[0].(*usecase.PaymentWebhookResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandlePaymentWebhook indicates an expected call of HandlePaymentWebhook.
+func (mr *MockPaymentUsecaseMockRecorder) HandlePaymentWebhook(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePaymentWebhook", reflect.TypeOf((*MockPaymentUsecase)(nil).HandlePaymentWebhook), ctx, input)
+}
+
+// PayReservation mocks base method.
+func (m *MockPaymentUsecase) PayReservation(ctx context.Context, input usecase.PayReservationInput) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayReservation", ctx, input)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayReservation indicates an expected call of PayReservation.
+func (mr *MockPaymentUsecaseMockRecorder) PayReservation(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayReservation", reflect.TypeOf((*MockPaymentUsecase)(nil).PayReservation), ctx, input)
+}
+
+// RefundPayment mocks base method.
+func (m *MockPaymentUsecase) RefundPayment(ctx context.Context, input usecase.RefundPaymentInput) (*usecase.RefundPaymentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, input)
+	ret0, _ := ret[0].(*usecase.RefundPaymentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockPaymentUsecaseMockRecorder) RefundPayment(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockPaymentUsecase)(nil).RefundPayment), ctx, input)
+}
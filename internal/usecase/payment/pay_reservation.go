@@ -0,0 +1,586 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+	"ticket-reservation/pkg/promptpay"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+// promptPayCurrency is the only currency PromptPay transfers
+const promptPayCurrency = "THB"
+
+type PayReservationInput struct {
+	ReservationID string  `json:"reservation_id" validate:"required,uuid4"`
+	SessionID     string  `json:"session_id" validate:"required"`
+	PaymentMethod string  `json:"payment_method" validate:"required,max=50"`
+	Amount        string  `json:"amount" validate:"required"`
+	PromoCode     *string `json:"promo_code" validate:"omitempty,max=50"`
+}
+
+// PayReservation charges a pending reservation through the payment gateway.
+// The payment is recorded as initiated before the gateway is called, so that a charge is never taken without a trace.
+// The amount must equal the total of the price breakdown, which is stored as line items with the initiated payment.
+// A promo code is redeemed together with the initiated payment, its discount is taken off before the fees and VAT.
+// Once the charge is approved, the payment becomes paid, the reservation confirmed and the seat booked in a single transaction.
+// PromptPay payments are not charged, they are returned initiated with the QR code the customer scans to pay, and the
+// bank notifies the outcome through the payment webhook.
+func (u *paymentUsecase) PayReservation(ctx context.Context, input PayReservationInput) (payment *entity.Payment, err error) {
+	const errLocation = "[usecase payment/pay_reservation PayReservation] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("payment.usecase"), func(ctx context.Context) (*entity.Payment, error) {
+		requestTime := time.Now()
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		reservationID, err := uuid.Parse(input.ReservationID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid reservation ID", nil))
+			return nil, err
+		}
+		amount, err := decimal.NewFromString(input.Amount)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid amount", nil))
+			return nil, err
+		}
+		if !amount.IsPositive() || !amount.Equal(amount.Round(2)) {
+			err = errsFramework.NewBadRequestError("invalid amount", map[string]string{"details": "amount must be positive with at most 2 decimal places"})
+			return nil, err
+		}
+
+		// PromptPay needs the biller the transfers are made to
+		if input.PaymentMethod == entity.PaymentMethodPromptPay && u.appConfig.PromptPayBillerID == "" {
+			err = errsFramework.NewUnprocessableEntityError("the payment method is not available", map[string]string{"payment_method": input.PaymentMethod})
+			return nil, err
+		}
+
+		// Record the payment before charging
+		payment, err := u.initiatePayment(ctx, reservationID, input.SessionID, amount, input.PaymentMethod, input.PromoCode, requestTime)
+		if err != nil {
+			return nil, err
+		}
+
+		// The customer pays by scanning the QR code, there is nothing to charge
+		if payment.IsPaidByScanning() {
+			return u.attachPromptPayQR(ctx, payment)
+		}
+
+		// Charge outside of any transaction, the reservation row must not stay locked while waiting for the provider
+		chargeResult, err := u.paymentGateway.Charge(ctx, gateway.ChargeInput{
+			PaymentID:     payment.ID,
+			Amount:        amount,
+			PaymentMethod: input.PaymentMethod,
+		})
+		if err != nil {
+			if errors.Is(err, gateway.ErrPaymentDeclined) {
+				u.failPayment(ctx, payment.ID)
+				err = errsFramework.WrapError(err, errs.NewPaymentDeclinedError(map[string]string{"payment_id": payment.ID.String()}))
+				return nil, err
+			}
+			// The outcome of the charge is unknown, keep the payment initiated so that paying again retries the same charge
+			err = errsFramework.WrapError(err, errsFramework.NewThirdPartyError("failed to charge the payment", map[string]string{"payment_id": payment.ID.String()}))
+			return nil, err
+		}
+
+		// Apply the charge to the payment, the reservation and the seat
+		payment, seat, zone, err := u.completePayment(ctx, payment, chargeResult.ProviderReference, requestTime)
+		if err != nil {
+			return nil, err
+		}
+
+		// Release the Redis lock and publish the booked seat
+		u.publishBookedSeat(ctx, zone, seat, input.SessionID, payment.ID)
+
+		return payment, nil
+	})
+}
+
+// initiatePayment checks that the session may pay the reservation and records an initiated payment for it.
+// When an earlier attempt with the same amount and payment method is still initiated, that payment is returned
+// instead, so that the gateway receives the same payment ID and does not charge twice. An initiated PromptPay payment
+// with other details is marked failed so that the customer can switch method, other ones are a conflict.
+// The promo code row stays locked until the payment and its redemption are committed, so that concurrent checkouts
+// with the same code are counted one after the other and a capped code cannot be over-redeemed.
+func (u *paymentUsecase) initiatePayment(ctx context.Context, reservationID uuid.UUID, sessionID string, amount decimal.Decimal, paymentMethod string, code *string, requestTime time.Time) (payment *entity.Payment, err error) {
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			// The payment must be saved before it is charged, the gateway is only ever given a committed payment ID
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	// Get the reservation with explicit row locking
+	reservation, err := u.reservationRepository.WithTx(tx.DB()).FindOne(ctx, reservationID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+			return nil, err
+		}
+		return nil, err // Return the NotFoundError directly
+	}
+	// Only the session holding the reservation may pay for it
+	if reservation.SessionID != sessionID {
+		err = errsFramework.NewForbiddenError("the reservation does not belong to this session", nil)
+		return nil, err
+	}
+	if !reservation.CanPay(requestTime) {
+		err = errsFramework.NewConflictError("the reservation can no longer be paid", map[string]string{"status": reservation.Status.String()})
+		return nil, err
+	}
+	// PromptPay only transfers Thai baht, reservations made before pricing carry no currency and are priced in baht
+	if paymentMethod == entity.PaymentMethodPromptPay && pointer.GetValue(reservation.Currency) != "" && *reservation.Currency != promptPayCurrency {
+		err = errsFramework.NewUnprocessableEntityError("the payment method does not accept the reservation currency", map[string]string{"currency": *reservation.Currency})
+		return nil, err
+	}
+
+	// Resolve the promo code and the discount it gives on the reservation price
+	var promoCode *entity.PromoCode
+	discount := decimal.Zero
+	if code != nil {
+		promoCode, err = u.findApplicablePromoCode(ctx, tx.DB(), reservation, *code, requestTime)
+		if err != nil {
+			return nil, err
+		}
+		discount = promoCode.Discount(*reservation.Price)
+	}
+
+	// The amount must match the total of the price captured when the seat was held, with the fees and VAT charged on top of it;
+	// reservations made before pricing carry no price and are charged without a breakdown
+	var breakdown *entity.PriceBreakdown
+	if reservation.Price != nil {
+		breakdown = pointer.ToPointer(u.priceCalculator.Calculate(*reservation.Price, discount))
+		if !breakdown.Total.Equal(amount) {
+			details := map[string]string{
+				"price":      reservation.Price.StringFixed(2),
+				"currency":   pointer.GetValue(reservation.Currency),
+				"amount_due": breakdown.Total.StringFixed(2),
+			}
+			if promoCode != nil {
+				details["discount"] = discount.StringFixed(2)
+			}
+			err = errsFramework.NewUnprocessableEntityError("the amount does not match the reservation price", details)
+			return nil, err
+		}
+	}
+
+	// Resume a payment whose charge ended with an unknown outcome
+	initiatedPayments, err := u.paymentRepository.WithTx(tx.DB()).FindAll(ctx, repository.FindAllPaymentsFilter{
+		ReservationID: &reservation.ID,
+		Status:        pointer.ToPointer(entity.PaymentStatusInitiated),
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find initiated payments", nil))
+		return nil, err
+	}
+	for _, initiatedPayment := range pointer.GetValue(initiatedPayments) {
+		if initiatedPayment.Amount != nil && initiatedPayment.Amount.Equal(amount) && pointer.GetValue(initiatedPayment.PaymentMethod) == paymentMethod && initiatedPayment.UsesPromoCode(promoCode) {
+			return &initiatedPayment, nil
+		}
+		// A PromptPay QR the customer has not paid is given up for the new attempt, nothing was charged for it.
+		// Should the bank still notify a transfer, the webhook settles it or keeps it as refund required.
+		if initiatedPayment.IsPaidByScanning() {
+			_, err = u.paymentRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdatePaymentInput{
+				ID:     initiatedPayment.ID,
+				Status: pointer.ToPointer(entity.PaymentStatusFailed),
+			})
+			if err != nil {
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update payment status", nil))
+				return nil, err
+			}
+			continue
+		}
+		err = errsFramework.NewConflictError("another payment is in progress for the reservation", map[string]string{"payment_id": initiatedPayment.ID.String()})
+		return nil, err
+	}
+
+	// Only a new payment uses up the promo code, a resumed one has already been counted
+	if promoCode != nil {
+		if err = u.checkPromoCodeCaps(ctx, tx.DB(), promoCode, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	// PromptPay transfers are notified by the bank rather than charged through the gateway
+	provider := entity.PromptPayProvider
+	if paymentMethod != entity.PaymentMethodPromptPay {
+		provider = u.paymentGateway.Provider()
+	}
+	newPayment := entity.NewPayment(reservation.ID, amount, paymentMethod, provider)
+	if promoCode != nil {
+		newPayment.PromoCodeID = &promoCode.ID
+		newPayment.DiscountAmount = &discount
+	}
+	payment, err = u.paymentRepository.WithTx(tx.DB()).CreateOne(ctx, newPayment)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create payment", nil))
+		return nil, err
+	}
+
+	// Store the breakdown with the payment, so that receipts and reports show what was actually charged
+	if breakdown != nil {
+		_, err = u.lineItemRepository.WithTx(tx.DB()).CreateMany(ctx, entity.NewPaymentLineItems(payment.ID, *breakdown))
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create payment line items", nil))
+			return nil, err
+		}
+	}
+
+	// Record the redemption in the same transaction as the payment
+	if promoCode != nil {
+		_, err = u.redemptionRepository.WithTx(tx.DB()).CreateOne(ctx, entity.NewPromoCodeRedemption(promoCode.ID, payment.ID, sessionID, discount))
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create promo code redemption", nil))
+			return nil, err
+		}
+	}
+
+	return payment, nil
+}
+
+// attachPromptPayQR stores the PromptPay QR payload for the amount of the payment, a resumed payment keeps the payload it already has.
+// The first reference of the bill identifies the payment, the bank sends it back with the notification of the transfer.
+func (u *paymentUsecase) attachPromptPayQR(ctx context.Context, payment *entity.Payment) (*entity.Payment, error) {
+	if payment.QRPayload != nil {
+		return payment, nil
+	}
+
+	payload, err := promptpay.BillPayment(u.appConfig.PromptPayBillerID, payment.PromptPayReference(), "", pointer.GetValue(payment.Amount).StringFixed(2))
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to generate the PromptPay QR payload", nil))
+	}
+
+	payment, err = u.paymentRepository.UpdateOne(ctx, repository.UpdatePaymentInput{
+		ID:        payment.ID,
+		QRPayload: pointer.ToPointer(payload),
+	})
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to store the PromptPay QR payload", nil))
+	}
+	return payment, nil
+}
+
+// findApplicablePromoCode finds the promo code with row locking and checks that it can be used for the reservation at the request time.
+func (u *paymentUsecase) findApplicablePromoCode(ctx context.Context, execer db.SqlExecer, reservation *entity.Reservation, code string, requestTime time.Time) (*entity.PromoCode, error) {
+	// A discount needs a price to be taken off
+	if reservation.Price == nil {
+		return nil, errsFramework.NewUnprocessableEntityError("the reservation has no price to discount", nil)
+	}
+
+	promoCode, err := u.promoCodeRepository.WithTx(execer).FindOneByCode(ctx, entity.NormalizePromoCode(code))
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find promo code", nil))
+		}
+		return nil, err // Return the NotFoundError directly
+	}
+	if !promoCode.IsValidAt(requestTime) {
+		return nil, errsFramework.NewUnprocessableEntityError("the promo code is not valid at this time", map[string]string{"code": promoCode.Code})
+	}
+
+	// Resolve the concert and zone of the reserved seat for restricted codes
+	if promoCode.ConcertID != nil || promoCode.ZoneID != nil {
+		seat, err := u.seatRepository.WithTx(execer).FindOne(ctx, reservation.SeatID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seat by ID", nil))
+		}
+		zone, err := u.zoneRepository.FindOne(ctx, seat.ZoneID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+		}
+		if !promoCode.AppliesTo(zone) {
+			return nil, errsFramework.NewUnprocessableEntityError("the promo code does not apply to this reservation", map[string]string{"code": promoCode.Code})
+		}
+	}
+
+	return promoCode, nil
+}
+
+// checkPromoCodeCaps checks that the promo code has uses left, both overall and for the session.
+// The promo code must already be locked by the transaction of the given execer.
+func (u *paymentUsecase) checkPromoCodeCaps(ctx context.Context, execer db.SqlExecer, promoCode *entity.PromoCode, sessionID string) error {
+	if promoCode.MaxRedemptions != nil {
+		redemptions, err := u.redemptionRepository.WithTx(execer).Count(ctx, repository.CountPromoCodeRedemptionsFilter{
+			PromoCodeID: promoCode.ID,
+		})
+		if err != nil {
+			return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to count promo code redemptions", nil))
+		}
+		if redemptions >= *promoCode.MaxRedemptions {
+			return errsFramework.NewUnprocessableEntityError("the promo code has been fully redeemed", map[string]string{"code": promoCode.Code})
+		}
+	}
+
+	if promoCode.MaxRedemptionsPerSession != nil {
+		redemptions, err := u.redemptionRepository.WithTx(execer).Count(ctx, repository.CountPromoCodeRedemptionsFilter{
+			PromoCodeID: promoCode.ID,
+			SessionID:   &sessionID,
+		})
+		if err != nil {
+			return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to count promo code redemptions", nil))
+		}
+		if redemptions >= *promoCode.MaxRedemptionsPerSession {
+			return errsFramework.NewUnprocessableEntityError("the promo code has already been used by this session", map[string]string{"code": promoCode.Code})
+		}
+	}
+
+	return nil
+}
+
+// completePayment moves the payment to paid, the reservation to confirmed and the seat to booked in a single transaction.
+// When the reservation can no longer be confirmed, the payment is committed as refund_required and the conflict is returned.
+func (u *paymentUsecase) completePayment(ctx context.Context, payment *entity.Payment, providerReference string, requestTime time.Time) (paidPayment *entity.Payment, seat *entity.Seat, zone *entity.Zone, err error) {
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, nil, nil, err
+	}
+	var refundRequired bool
+	defer func() {
+		if err != nil && !refundRequired {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			// The caller publishes the changes once they are committed
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	// Lock the reservation again, it may have expired or been cancelled while the charge was in flight
+	reservation, err := u.reservationRepository.WithTx(tx.DB()).FindOne(ctx, payment.ReservationID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+		return nil, nil, nil, err
+	}
+
+	paidPayment, seat, zone, err = u.confirmPayment(ctx, tx.DB(), reservation, payment, pointer.ToPointer(providerReference), requestTime)
+	if errors.As(err, &errsFramework.ConflictError{}) {
+		// The money is taken, record that it is owed back along with the conflict
+		if _, markErr := u.markRefundRequired(ctx, tx.DB(), payment, pointer.ToPointer(providerReference)); markErr != nil {
+			err = markErr
+			return nil, nil, nil, err
+		}
+		refundRequired = true
+	}
+	return paidPayment, seat, zone, err
+}
+
+// markRefundRequired records that the charged payment could not be confirmed and its amount is owed back,
+// with the provider reference the refund is made against.
+func (u *paymentUsecase) markRefundRequired(ctx context.Context, execer db.SqlExecer, payment *entity.Payment, providerReference *string) (*entity.Payment, error) {
+	refundPayment, err := u.paymentRepository.WithTx(execer).UpdateOne(ctx, repository.UpdatePaymentInput{
+		ID:                payment.ID,
+		Status:            pointer.ToPointer(entity.PaymentStatusRefundRequired),
+		ProviderReference: providerReference,
+	})
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update payment status", nil))
+	}
+	return refundPayment, nil
+}
+
+// confirmPayment moves the payment to paid, the reservation to confirmed and the seat to booked, and issues the ticket and the receipt.
+// The reservation must already be locked by the transaction of the given execer. A ConflictError is returned when the
+// reservation can no longer be confirmed, the caller then keeps the charge with markRefundRequired.
+// A nil providerReference keeps the reference already stored on the payment.
+func (u *paymentUsecase) confirmPayment(ctx context.Context, execer db.SqlExecer, reservation *entity.Reservation, payment *entity.Payment, providerReference *string, paidAt time.Time) (paidPayment *entity.Payment, seat *entity.Seat, zone *entity.Zone, err error) {
+	logger := commonLogger.FromContext(ctx)
+
+	seat, err = u.seatRepository.WithTx(execer).FindOne(ctx, reservation.SeatID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seat by ID", nil))
+		return nil, nil, nil, err
+	}
+	if reservation.Status != entity.ReservationStatusPending || seat.Status != entity.SeatStatusPending || pointer.GetValue(seat.LockedBySessionID) != reservation.SessionID {
+		err = errsFramework.NewConflictError("the reservation can no longer be paid", map[string]string{"status": reservation.Status.String()})
+		// The provider has taken the money but the seat cannot be booked anymore, the charge has to be refunded
+		logger.Error(ctx, "payment was charged but the reservation can no longer be confirmed", err, commonLogger.Fields{
+			"payment_id":         payment.ID,
+			"provider_reference": pointer.GetValue(providerReference),
+			"reservation_id":     reservation.ID,
+			"reservation_status": reservation.Status,
+			"seat_id":            seat.ID,
+			"seat_status":        seat.Status,
+		})
+		return nil, nil, nil, err
+	}
+
+	// Mark the payment as paid
+	paidPayment, err = u.paymentRepository.WithTx(execer).UpdateOne(ctx, repository.UpdatePaymentInput{
+		ID:                payment.ID,
+		Status:            pointer.ToPointer(entity.PaymentStatusPaid),
+		PaidAt:            pointer.ToPointer(paidAt),
+		ProviderReference: providerReference,
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update payment status", nil))
+		return nil, nil, nil, err
+	}
+
+	// Confirm the reservation
+	confirmedReservation, err := u.reservationRepository.WithTx(execer).UpdateOne(ctx, repository.UpdateReservationInput{
+		ID:     reservation.ID,
+		Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update reservation status", nil))
+		return nil, nil, nil, err
+	}
+
+	// Book the seat and drop the temporary lock
+	seat, err = u.seatRepository.WithTx(execer).UpdateOne(ctx, repository.UpdateSeatInput{
+		ID:        seat.ID,
+		Status:    pointer.ToPointer(entity.SeatStatusBooked),
+		ClearLock: true,
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update seat status", nil))
+		return nil, nil, nil, err
+	}
+
+	// Find the zone to resolve the concert the seat belongs to
+	zone, err = u.zoneRepository.FindOne(ctx, seat.ZoneID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+		return nil, nil, nil, err
+	}
+
+	if err = u.issueTicket(ctx, execer, reservation, zone, seat, paidAt); err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Notify webhook subscribers along with the transaction
+	events := append(
+		entity.NewWebhookEventsForReservations(entity.WebhookEventTypeReservationConfirmed, entity.Reservations{*confirmedReservation}, paidAt),
+		entity.NewWebhookEventForPayment(entity.WebhookEventTypePaymentSucceeded, paidPayment, paidAt),
+	)
+	if enqueueErr := u.eventPublisher.EnqueueWebhookEvents(ctx, execer, events); enqueueErr != nil {
+		// Log the error but do not return it, the charge went through and must not be rolled back over a notification
+		logger.Error(ctx, "failed to enqueue webhook events", enqueueErr, commonLogger.Fields{
+			"payment_id":     payment.ID,
+			"reservation_id": reservation.ID,
+		})
+	}
+
+	// Issue the receipt last, the receipt number counter stays locked until the transaction ends
+	if err = u.issueReceipt(ctx, execer, paidPayment, reservation, zone, seat); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return paidPayment, seat, zone, nil
+}
+
+// issueReceipt takes the next receipt number of the seller and records the receipt of the paid payment.
+// It must run in the transaction that marks the payment paid, so that a rolled back payment gives its number back.
+func (u *paymentUsecase) issueReceipt(ctx context.Context, execer db.SqlExecer, payment *entity.Payment, reservation *entity.Reservation, zone *entity.Zone, seat *entity.Seat) error {
+	seller := entity.Seller{
+		TaxID:   u.appConfig.ReceiptSellerTaxID,
+		Name:    u.appConfig.ReceiptSellerName,
+		Address: u.appConfig.ReceiptSellerAddress,
+	}
+	receiptNumber, err := u.receiptRepository.WithTx(execer).NextReceiptNumber(ctx, seller.TaxID)
+	if err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to take the next receipt number", nil))
+	}
+
+	currency := zone.Currency
+	if reservation.Currency != nil {
+		currency = *reservation.Currency
+	}
+	description := fmt.Sprintf("Ticket %s, seat %s", zone.Name, seat.SeatNumber)
+	_, err = u.receiptRepository.WithTx(execer).CreateOne(ctx, entity.NewReceipt(payment, seller, receiptNumber, u.appConfig.ReceiptNumberPrefix, description, currency))
+	if err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create receipt", nil))
+	}
+	return nil
+}
+
+// issueTicket signs and records the ticket of the confirmed reservation.
+func (u *paymentUsecase) issueTicket(ctx context.Context, execer db.SqlExecer, reservation *entity.Reservation, zone *entity.Zone, seat *entity.Seat, issuedAt time.Time) error {
+	ticket := entity.NewTicket(reservation, zone, seat, issuedAt)
+	if err := u.ticketSigner.Sign(ticket); err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to sign ticket", nil))
+	}
+	if _, err := u.ticketRepository.WithTx(execer).CreateOne(ctx, ticket); err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create ticket", nil))
+	}
+	return nil
+}
+
+// publishBookedSeat releases the Redis lock held by the session and writes the booked seat to the seat map and its stream.
+// Errors are only logged, the database is the source of truth and the lock expires on its own after the TTL.
+func (u *paymentUsecase) publishBookedSeat(ctx context.Context, zone *entity.Zone, seat *entity.Seat, sessionID string, paymentID uuid.UUID) {
+	logger := commonLogger.FromContext(ctx)
+
+	unlockErr := u.seatLockerRepository.UnlockSeat(ctx, zone.ConcertID, zone.ID, seat.ID, sessionID)
+	if unlockErr != nil && !errors.Is(unlockErr, cache.ErrSeatUnlockDenied) {
+		logger.Error(ctx, "failed to unlock seat after payment", unlockErr, commonLogger.Fields{
+			"concert_id": zone.ConcertID,
+			"zone_id":    zone.ID,
+			"seat_id":    seat.ID,
+			"payment_id": paymentID,
+		})
+	}
+
+	setMapErr := u.seatMapRepository.SetSeat(ctx, zone.ConcertID, zone.ID, *seat, cache.SeatMapNoExpiration)
+	if setMapErr != nil {
+		logger.Error(ctx, "failed to update seat map in Redis", setMapErr, commonLogger.Fields{
+			"concert_id":  zone.ConcertID,
+			"zone_id":     zone.ID,
+			"seat_id":     seat.ID,
+			"seat_number": seat.SeatNumber,
+		})
+	}
+
+	u.eventPublisher.PublishSeatStatus(ctx, entity.NewSeatStatusEvents(zone.ConcertID, zone.ID, entity.Seats{*seat}, time.Now()))
+}
+
+// failPayment marks a declined payment as failed. Errors are only logged, the decline is what the caller needs to know.
+func (u *paymentUsecase) failPayment(ctx context.Context, paymentID uuid.UUID) {
+	_, err := u.paymentRepository.UpdateOne(ctx, repository.UpdatePaymentInput{
+		ID:     paymentID,
+		Status: pointer.ToPointer(entity.PaymentStatusFailed),
+	})
+	if err != nil {
+		commonLogger.FromContext(ctx).Error(ctx, "failed to mark payment as failed", err, commonLogger.Fields{
+			"payment_id": paymentID,
+		})
+	}
+}
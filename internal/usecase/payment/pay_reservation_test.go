@@ -0,0 +1,1400 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/config"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+	paymentusecase "ticket-reservation/internal/usecase/payment"
+	"ticket-reservation/pkg/promptpay"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentUsecase_PayReservation(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	sessionID := "session-123"
+	lockedUntil := time.Now().Add(5 * time.Minute)
+	amount := decimal.RequireFromString("1500.00")
+	paidAt := time.Now()
+	providerReference := "fake_ch_" + paymentID.String()
+
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	pendingReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusPending,
+		ExpiresAt: lockedUntil,
+		Price:     &amount,
+		Currency:  pointer.ToPointer("THB"),
+	}
+	lockedSeat := &entity.Seat{
+		ID:                seatID,
+		ZoneID:            zoneID,
+		SeatNumber:        "A1",
+		Status:            entity.SeatStatusPending,
+		LockedUntil:       &lockedUntil,
+		LockedBySessionID: pointer.ToPointer(sessionID),
+	}
+	bookedSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusBooked,
+	}
+	initiatedPayment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &amount,
+		PaymentMethod: pointer.ToPointer("credit_card"),
+		Provider:      pointer.ToPointer("fake"),
+	}
+	paidPayment := &entity.Payment{
+		ID:                paymentID,
+		ReservationID:     reservationID,
+		Status:            entity.PaymentStatusPaid,
+		Amount:            &amount,
+		PaidAt:            &paidAt,
+		PaymentMethod:     pointer.ToPointer("credit_card"),
+		Provider:          pointer.ToPointer("fake"),
+		ProviderReference: pointer.ToPointer(providerReference),
+	}
+
+	validInput := paymentusecase.PayReservationInput{
+		ReservationID: reservationID.String(),
+		SessionID:     sessionID,
+		PaymentMethod: "credit_card",
+		Amount:        "1500.00",
+	}
+
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+		h.mockReceiptRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReceiptRepository).AnyTimes()
+		h.mockTicketRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockTicketRepository).AnyTimes()
+		h.mockLineItemRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockLineItemRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	// expectInitiateOver sets up the first transaction recording a new initiated payment, with the given payments still initiated
+	expectInitiateOver := func(h *testHelper, initiatedPayments entity.Payments) {
+		expectTx(h, true)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllPaymentsFilter{
+			ReservationID: &reservationID,
+			Status:        pointer.ToPointer(entity.PaymentStatusInitiated),
+		}).Return(&initiatedPayments, nil)
+		h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+		h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, payment *entity.Payment) (*entity.Payment, error) {
+				assert.Equal(t, reservationID, payment.ReservationID)
+				assert.Equal(t, entity.PaymentStatusInitiated, payment.Status)
+				assert.True(t, amount.Equal(*payment.Amount))
+				assert.Equal(t, "credit_card", pointer.GetValue(payment.PaymentMethod))
+				assert.Equal(t, "fake", pointer.GetValue(payment.Provider))
+				return initiatedPayment, nil
+			},
+		)
+		h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, lineItems entity.PaymentLineItems) (*entity.PaymentLineItems, error) {
+				require.Len(t, lineItems, 1)
+				assert.Equal(t, paymentID, lineItems[0].PaymentID)
+				assert.Equal(t, entity.LineItemTypeTicket, lineItems[0].Type)
+				assert.True(t, amount.Equal(lineItems[0].Amount))
+				return &lineItems, nil
+			},
+		)
+	}
+	// expectInitiate sets up the first transaction recording a new initiated payment
+	expectInitiate := func(h *testHelper) {
+		expectInitiateOver(h, entity.Payments{})
+	}
+	expectCharge := func(h *testHelper) {
+		h.mockPaymentGateway.EXPECT().Charge(gomock.Any(), gateway.ChargeInput{
+			PaymentID:     paymentID,
+			Amount:        amount,
+			PaymentMethod: "credit_card",
+		}).Return(&gateway.ChargeResult{ProviderReference: providerReference}, nil)
+	}
+	// expectComplete sets up the second transaction booking the seat
+	expectComplete := func(h *testHelper) {
+		expectTx(h, true)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+		h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, input repository.UpdatePaymentInput) (*entity.Payment, error) {
+				assert.Equal(t, paymentID, input.ID)
+				assert.Equal(t, entity.PaymentStatusPaid, pointer.GetValue(input.Status))
+				assert.NotNil(t, input.PaidAt)
+				assert.Equal(t, providerReference, pointer.GetValue(input.ProviderReference))
+				return paidPayment, nil
+			},
+		)
+		h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateReservationInput{
+			ID:     reservationID,
+			Status: pointer.ToPointer(entity.ReservationStatusConfirmed),
+		}).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+		h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateSeatInput{
+			ID:        seatID,
+			Status:    pointer.ToPointer(entity.SeatStatusBooked),
+			ClearLock: true,
+		}).Return(bookedSeat, nil)
+		h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+		h.mockTicketRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, ticket *entity.Ticket) (*entity.Ticket, error) {
+				assert.Equal(t, reservationID, ticket.ReservationID)
+				assert.Equal(t, concertID, ticket.ConcertID)
+				assert.Equal(t, zoneID, ticket.ZoneID)
+				assert.Equal(t, seatID, ticket.SeatID)
+				assert.Equal(t, entity.TicketHolder(sessionID), ticket.Holder)
+				// The payload verifies with the public key and carries the ticket
+				claims, err := testTicketSigner.Verify(ticket.Payload)
+				require.NoError(t, err)
+				assert.Equal(t, entity.TicketClaims{
+					TicketID:   ticket.ID,
+					ConcertID:  concertID,
+					Zone:       "VIP",
+					SeatNumber: "A1",
+					Holder:     entity.TicketHolder(sessionID),
+					IssuedAt:   ticket.IssuedAt.Unix(),
+				}, *claims)
+				return ticket, nil
+			},
+		)
+		h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ db.SqlExecer, events entity.WebhookEvents) error {
+				require.Len(t, events, 2)
+				assert.Equal(t, entity.WebhookEventTypeReservationConfirmed, events[0].Type)
+				assert.Equal(t, reservationID, events[0].Data["reservation_id"])
+				assert.Equal(t, entity.WebhookEventTypePaymentSucceeded, events[1].Type)
+				assert.Equal(t, paymentID, events[1].Data["payment_id"])
+				return nil
+			},
+		)
+		h.mockReceiptRepository.EXPECT().NextReceiptNumber(gomock.Any(), testSellerTaxID).Return(int64(42), nil)
+		h.mockReceiptRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, receipt *entity.Receipt) (*entity.Receipt, error) {
+				assert.Equal(t, paymentID, receipt.PaymentID)
+				assert.Equal(t, testSellerTaxID, receipt.Seller.TaxID)
+				assert.Equal(t, int64(42), receipt.ReceiptNumber)
+				assert.Equal(t, "RC00000042", receipt.DocumentNumber)
+				assert.Equal(t, "Ticket VIP, seat A1", receipt.Description)
+				assert.Equal(t, "THB", receipt.Currency)
+				assert.True(t, amount.Equal(receipt.Amount))
+				assert.Equal(t, paidAt, receipt.IssuedAt)
+				return receipt, nil
+			},
+		)
+	}
+
+	// A PromptPay QR the customer walked away from
+	promptPayPaymentID := uuid.New()
+	stalePromptPayPayment := entity.Payment{
+		ID:            promptPayPaymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &amount,
+		PaymentMethod: pointer.ToPointer(entity.PaymentMethodPromptPay),
+		Provider:      pointer.ToPointer(entity.PromptPayProvider),
+	}
+	// The reservation expired while the charge was in flight
+	expiredReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusExpired,
+		ExpiresAt: lockedUntil,
+	}
+	availableSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusAvailable,
+	}
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.PayReservationInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Payment
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful payment books the seat and updates the seat map",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectComplete(h)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: paidPayment,
+			expectedError:  false,
+		},
+		{
+			name:  "initiated payment with the same details is charged again",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{*initiatedPayment}, nil)
+				expectCharge(h)
+				expectComplete(h)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: paidPayment,
+			expectedError:  false,
+		},
+		{
+			name:  "redis failures are logged and do not fail the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectComplete(h)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(errors.New("redis down"))
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, domaincache.SeatMapNoExpiration).Return(errors.New("redis down"))
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: paidPayment,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid reservation ID",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: "invalid-uuid",
+				SessionID:     sessionID,
+				PaymentMethod: "credit_card",
+				Amount:        "1500.00",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - missing payment method",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     sessionID,
+				Amount:        "1500.00",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - amount is not a number",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     sessionID,
+				PaymentMethod: "credit_card",
+				Amount:        "abc",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid amount",
+		},
+		{
+			name: "validation error - amount is not positive",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     sessionID,
+				PaymentMethod: "credit_card",
+				Amount:        "-10.00",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid amount",
+		},
+		{
+			name: "validation error - amount has more than 2 decimal places",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     sessionID,
+				PaymentMethod: "credit_card",
+				Amount:        "10.005",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid amount",
+		},
+		{
+			name:  "transaction creation error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(nil, errors.New("tx error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create transaction",
+		},
+		{
+			name:  "reservation not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(nil, errsFramework.NewNotFoundError("reservation not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "reservation not found",
+		},
+		{
+			name:  "reservation belongs to another session",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{
+					ID:        reservationID,
+					SeatID:    seatID,
+					SessionID: "other-session",
+					Status:    entity.ReservationStatusPending,
+					ExpiresAt: lockedUntil,
+				}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the reservation does not belong to this session",
+		},
+		{
+			name:  "expired reservation cannot be paid",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{
+					ID:        reservationID,
+					SeatID:    seatID,
+					SessionID: sessionID,
+					Status:    entity.ReservationStatusPending,
+					ExpiresAt: time.Now().Add(-time.Minute),
+				}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the reservation can no longer be paid",
+		},
+		{
+			name:  "amount does not match the reservation price",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{
+					ID:        reservationID,
+					SeatID:    seatID,
+					SessionID: sessionID,
+					Status:    entity.ReservationStatusPending,
+					ExpiresAt: lockedUntil,
+					Price:     pointer.ToPointer(decimal.RequireFromString("2500.00")),
+					Currency:  pointer.ToPointer("THB"),
+				}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the amount does not match the reservation price",
+		},
+		{
+			name:  "another payment with different details is in progress",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				otherAmount := decimal.RequireFromString("900.00")
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{{
+					ID:            paymentID,
+					ReservationID: reservationID,
+					Status:        entity.PaymentStatusInitiated,
+					Amount:        &otherAmount,
+					PaymentMethod: pointer.ToPointer("credit_card"),
+				}}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "another payment is in progress for the reservation",
+		},
+		{
+			name:  "unpaid promptpay payment is superseded by a new payment method",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				// Declared first so that the paid update of the completion is not matched by it
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+					ID:     promptPayPaymentID,
+					Status: pointer.ToPointer(entity.PaymentStatusFailed),
+				}).Return(&entity.Payment{ID: promptPayPaymentID, Status: entity.PaymentStatusFailed}, nil)
+				expectInitiateOver(h, entity.Payments{stalePromptPayPayment})
+				expectCharge(h)
+				expectComplete(h)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: paidPayment,
+		},
+		{
+			name:  "superseding a promptpay payment fails",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{stalePromptPayPayment}, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update payment status",
+		},
+		{
+			name:  "payment creation error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{}, nil)
+				h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+				h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create payment",
+		},
+		{
+			name:  "initiated payment is not charged when its commit fails",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+				h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+				h.mockLineItemRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockLineItemRepository).AnyTimes()
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{}, nil)
+				h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+				h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(initiatedPayment, nil)
+				h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, lineItems entity.PaymentLineItems) (*entity.PaymentLineItems, error) {
+						return &lineItems, nil
+					},
+				)
+				h.mockTransactor.EXPECT().Commit().Return(errors.New("connection reset"))
+				// No Charge expectation, the gateway must never see the unsaved payment
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to commit transaction",
+		},
+		{
+			name:  "declined charge marks the payment as failed",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				h.mockPaymentGateway.EXPECT().Charge(gomock.Any(), gomock.Any()).Return(nil, gateway.ErrPaymentDeclined)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+					ID:     paymentID,
+					Status: pointer.ToPointer(entity.PaymentStatusFailed),
+				}).Return(&entity.Payment{ID: paymentID, Status: entity.PaymentStatusFailed}, nil)
+			},
+			expectedError: true,
+			errorType:     &errs.PaymentDeclinedError{},
+			errorContains: "the payment was declined",
+		},
+		{
+			name:  "declined charge is reported even when the payment cannot be marked as failed",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				h.mockPaymentGateway.EXPECT().Charge(gomock.Any(), gomock.Any()).Return(nil, gateway.ErrPaymentDeclined)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errs.PaymentDeclinedError{},
+			errorContains: "the payment was declined",
+		},
+		{
+			name:  "gateway error keeps the payment initiated",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				h.mockPaymentGateway.EXPECT().Charge(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection reset"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ThirdPartyError{},
+			errorContains: "failed to charge the payment",
+		},
+		{
+			name:  "reservation expired while charging commits the payment as refund required",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(expiredReservation, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(availableSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+					ID:                paymentID,
+					Status:            pointer.ToPointer(entity.PaymentStatusRefundRequired),
+					ProviderReference: pointer.ToPointer(providerReference),
+				}).Return(&entity.Payment{ID: paymentID, ReservationID: reservationID, Status: entity.PaymentStatusRefundRequired}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the reservation can no longer be paid",
+		},
+		{
+			name:  "refund required update error rolls back",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(expiredReservation, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(availableSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update payment status",
+		},
+		{
+			name:  "seat update error rolls back the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(paidPayment, nil)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update seat status",
+		},
+		{
+			name:  "receipt number error rolls back the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(paidPayment, nil)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(bookedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockTicketRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Ticket{}, nil)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(2)).Return(nil)
+				h.mockReceiptRepository.EXPECT().NextReceiptNumber(gomock.Any(), testSellerTaxID).Return(int64(0), errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to take the next receipt number",
+		},
+		{
+			name:  "ticket creation error rolls back the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(paidPayment, nil)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(bookedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockTicketRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create ticket",
+		},
+		{
+			name:  "webhook enqueue error does not roll back the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				expectCharge(h)
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(paidPayment, nil)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(bookedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockTicketRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Ticket{}, nil)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(2)).Return(errsFramework.NewDatabaseError("database error", "error"))
+				h.mockReceiptRepository.EXPECT().NextReceiptNumber(gomock.Any(), testSellerTaxID).Return(int64(42), nil)
+				h.mockReceiptRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Receipt{}, nil)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: paidPayment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.PayReservation(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/pay_reservation PayReservation]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestPaymentUsecase_PayReservation_PromoCode(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	promoCodeID := uuid.New()
+	sessionID := "session-123"
+	lockedUntil := time.Now().Add(5 * time.Minute)
+	price := decimal.RequireFromString("1500.00")
+	discountedAmount := decimal.RequireFromString("1200.00")
+	discount := decimal.RequireFromString("300.00")
+	providerReference := "fake_ch_" + paymentID.String()
+
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	pendingReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusPending,
+		ExpiresAt: lockedUntil,
+		Price:     &price,
+		Currency:  pointer.ToPointer("THB"),
+	}
+	lockedSeat := &entity.Seat{
+		ID:                seatID,
+		ZoneID:            zoneID,
+		SeatNumber:        "A1",
+		Status:            entity.SeatStatusPending,
+		LockedUntil:       &lockedUntil,
+		LockedBySessionID: pointer.ToPointer(sessionID),
+	}
+	bookedSeat := &entity.Seat{ID: seatID, ZoneID: zoneID, SeatNumber: "A1", Status: entity.SeatStatusBooked}
+	promoCode := &entity.PromoCode{
+		ID:                       promoCodeID,
+		Code:                     "EARLYBIRD",
+		DiscountType:             entity.PromoCodeDiscountTypePercentage,
+		DiscountValue:            decimal.RequireFromString("20"),
+		MaxRedemptions:           pointer.ToPointer(int64(100)),
+		MaxRedemptionsPerSession: pointer.ToPointer(int64(1)),
+	}
+	initiatedPayment := &entity.Payment{
+		ID:             paymentID,
+		ReservationID:  reservationID,
+		Status:         entity.PaymentStatusInitiated,
+		Amount:         &discountedAmount,
+		PaymentMethod:  pointer.ToPointer("credit_card"),
+		Provider:       pointer.ToPointer("fake"),
+		PromoCodeID:    &promoCodeID,
+		DiscountAmount: &discount,
+	}
+	paidPayment := &entity.Payment{
+		ID:                paymentID,
+		ReservationID:     reservationID,
+		Status:            entity.PaymentStatusPaid,
+		Amount:            &discountedAmount,
+		PaymentMethod:     pointer.ToPointer("credit_card"),
+		Provider:          pointer.ToPointer("fake"),
+		ProviderReference: pointer.ToPointer(providerReference),
+		PromoCodeID:       &promoCodeID,
+		DiscountAmount:    &discount,
+	}
+
+	validInput := paymentusecase.PayReservationInput{
+		ReservationID: reservationID.String(),
+		SessionID:     sessionID,
+		PaymentMethod: "credit_card",
+		Amount:        "1200.00",
+		PromoCode:     pointer.ToPointer(" earlybird "),
+	}
+
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+		h.mockReceiptRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReceiptRepository).AnyTimes()
+		h.mockTicketRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockTicketRepository).AnyTimes()
+		h.mockLineItemRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockLineItemRepository).AnyTimes()
+		h.mockPromoCodeRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPromoCodeRepository).AnyTimes()
+		h.mockRedemptionRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockRedemptionRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	// expectPromoCode sets up the lookup of the locked promo code for the pending reservation
+	expectPromoCode := func(h *testHelper, commit bool, promoCode *entity.PromoCode) {
+		expectTx(h, commit)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockPromoCodeRepository.EXPECT().FindOneByCode(gomock.Any(), "EARLYBIRD").Return(promoCode, nil)
+	}
+	expectNoInitiatedPayment := func(h *testHelper) {
+		h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{}, nil)
+	}
+	expectCounts := func(h *testHelper, total int64, bySession int64) {
+		h.mockRedemptionRepository.EXPECT().Count(gomock.Any(), repository.CountPromoCodeRedemptionsFilter{
+			PromoCodeID: promoCodeID,
+		}).Return(total, nil)
+		h.mockRedemptionRepository.EXPECT().Count(gomock.Any(), repository.CountPromoCodeRedemptionsFilter{
+			PromoCodeID: promoCodeID,
+			SessionID:   pointer.ToPointer(sessionID),
+		}).Return(bySession, nil).MaxTimes(1)
+	}
+	// expectPaid sets up the charge of the discounted amount and the second transaction booking the seat
+	expectPaid := func(h *testHelper) {
+		h.mockPaymentGateway.EXPECT().Charge(gomock.Any(), gateway.ChargeInput{
+			PaymentID:     paymentID,
+			Amount:        discountedAmount,
+			PaymentMethod: "credit_card",
+		}).Return(&gateway.ChargeResult{ProviderReference: providerReference}, nil)
+		expectTx(h, true)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+		h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(paidPayment, nil)
+		h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusConfirmed}, nil)
+		h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(bookedSeat, nil)
+		h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+		h.mockTicketRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Ticket{}, nil)
+		h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(2)).Return(nil)
+		h.mockReceiptRepository.EXPECT().NextReceiptNumber(gomock.Any(), testSellerTaxID).Return(int64(1), nil)
+		h.mockReceiptRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&entity.Receipt{}, nil)
+		h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+		h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *bookedSeat, domaincache.SeatMapNoExpiration).Return(nil)
+		h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+	}
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.PayReservationInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Payment
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "discounted payment records the redemption with the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectPromoCode(h, true, promoCode)
+				expectNoInitiatedPayment(h)
+				expectCounts(h, 99, 0)
+				h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+				h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, payment *entity.Payment) (*entity.Payment, error) {
+						assert.True(t, discountedAmount.Equal(*payment.Amount))
+						assert.Equal(t, promoCodeID, pointer.GetValue(payment.PromoCodeID))
+						assert.True(t, discount.Equal(pointer.GetValue(payment.DiscountAmount)))
+						return initiatedPayment, nil
+					},
+				)
+				h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, lineItems entity.PaymentLineItems) (*entity.PaymentLineItems, error) {
+						require.Len(t, lineItems, 2)
+						assert.Equal(t, entity.LineItemTypeTicket, lineItems[0].Type)
+						assert.True(t, price.Equal(lineItems[0].Amount))
+						assert.Equal(t, entity.LineItemTypeDiscount, lineItems[1].Type)
+						assert.True(t, discount.Neg().Equal(lineItems[1].Amount))
+						return &lineItems, nil
+					},
+				)
+				h.mockRedemptionRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, redemption *entity.PromoCodeRedemption) (*entity.PromoCodeRedemption, error) {
+						assert.Equal(t, promoCodeID, redemption.PromoCodeID)
+						assert.Equal(t, paymentID, redemption.PaymentID)
+						assert.Equal(t, sessionID, redemption.SessionID)
+						assert.True(t, discount.Equal(redemption.DiscountAmount))
+						return redemption, nil
+					},
+				)
+				expectPaid(h)
+			},
+			expectedResult: paidPayment,
+			expectedError:  false,
+		},
+		{
+			name:  "initiated payment with the same promo code is charged again without a new redemption",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectPromoCode(h, true, promoCode)
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{*initiatedPayment}, nil)
+				expectPaid(h)
+			},
+			expectedResult: paidPayment,
+			expectedError:  false,
+		},
+		{
+			name:  "zone restricted promo code applies to a seat of the zone",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				restricted := *promoCode
+				restricted.ZoneID = &zoneID
+				restricted.MaxRedemptions = nil
+				restricted.MaxRedemptionsPerSession = nil
+				expectPromoCode(h, false, &restricted)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectNoInitiatedPayment(h)
+				h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+				h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create payment",
+		},
+		{
+			name:  "promo code not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockPromoCodeRepository.EXPECT().FindOneByCode(gomock.Any(), "EARLYBIRD").Return(nil, errsFramework.NewNotFoundError("promo code not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "promo code not found",
+		},
+		{
+			name:  "reservation without a price cannot be discounted",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{
+					ID:        reservationID,
+					SeatID:    seatID,
+					SessionID: sessionID,
+					Status:    entity.ReservationStatusPending,
+					ExpiresAt: lockedUntil,
+				}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the reservation has no price to discount",
+		},
+		{
+			name:  "expired promo code",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expired := *promoCode
+				expired.ValidUntil = pointer.ToPointer(time.Now().Add(-time.Hour))
+				expectPromoCode(h, false, &expired)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the promo code is not valid at this time",
+		},
+		{
+			name:  "promo code restricted to another concert",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				restricted := *promoCode
+				restricted.ConcertID = pointer.ToPointer(uuid.New())
+				expectPromoCode(h, false, &restricted)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the promo code does not apply to this reservation",
+		},
+		{
+			name: "amount does not match the discounted price",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     sessionID,
+				PaymentMethod: "credit_card",
+				Amount:        "1500.00",
+				PromoCode:     pointer.ToPointer("EARLYBIRD"),
+			},
+			setupMocks: func(h *testHelper) {
+				expectPromoCode(h, false, promoCode)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the amount does not match the reservation price",
+		},
+		{
+			name:  "promo code fully redeemed",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectPromoCode(h, false, promoCode)
+				expectNoInitiatedPayment(h)
+				expectCounts(h, 100, 0)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the promo code has been fully redeemed",
+		},
+		{
+			name:  "promo code already used by the session",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectPromoCode(h, false, promoCode)
+				expectNoInitiatedPayment(h)
+				expectCounts(h, 10, 1)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the promo code has already been used by this session",
+		},
+		{
+			name:  "redemption creation error rolls back the payment",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectPromoCode(h, false, promoCode)
+				expectNoInitiatedPayment(h)
+				expectCounts(h, 0, 0)
+				h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+				h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(initiatedPayment, nil)
+				h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(&entity.PaymentLineItems{}, nil)
+				h.mockRedemptionRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create promo code redemption",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.PayReservation(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/pay_reservation PayReservation]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestPaymentUsecase_PayReservation_LineItems(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	sessionID := "session-123"
+	price := decimal.RequireFromString("1500.00")
+	total := decimal.RequireFromString("1727.35")
+
+	// 2.5% + 20.00 service fee, 3.65% payment fee and 7% VAT
+	priceCalculator, err := entity.NewPriceCalculator(entity.PriceCalculatorConfig{
+		ServiceFeePercent: decimal.RequireFromString("2.5"),
+		ServiceFeeFixed:   decimal.RequireFromString("20"),
+		PaymentFeePercent: decimal.RequireFromString("3.65"),
+		VATPercent:        decimal.RequireFromString("7"),
+		Rounding:          entity.RoundingModeHalfUp,
+	})
+	require.NoError(t, err)
+
+	pendingReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    uuid.New(),
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusPending,
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+		Price:     &price,
+		Currency:  pointer.ToPointer("THB"),
+	}
+	initiatedPayment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &total,
+		PaymentMethod: pointer.ToPointer("credit_card"),
+		Provider:      pointer.ToPointer("fake"),
+	}
+
+	validInput := paymentusecase.PayReservationInput{
+		ReservationID: reservationID.String(),
+		SessionID:     sessionID,
+		PaymentMethod: "credit_card",
+		Amount:        "1727.35",
+	}
+
+	// expectInitiate sets up the first transaction up to the creation of the payment
+	expectInitiate := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+		h.mockReceiptRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReceiptRepository).AnyTimes()
+		h.mockTicketRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockTicketRepository).AnyTimes()
+		h.mockLineItemRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockLineItemRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{}, nil)
+		h.mockPaymentGateway.EXPECT().Provider().Return("fake")
+		h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(initiatedPayment, nil)
+	}
+
+	tests := []struct {
+		name          string
+		input         paymentusecase.PayReservationInput
+		setupMocks    func(h *testHelper)
+		errorType     error
+		errorContains string
+		assertError   func(t *testing.T, err error)
+	}{
+		{
+			name:  "line items of the fees and VAT add up to the charged amount",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, true)
+				h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, lineItems entity.PaymentLineItems) (*entity.PaymentLineItems, error) {
+						expected := []struct {
+							lineItemType entity.LineItemType
+							amount       string
+						}{
+							{entity.LineItemTypeTicket, "1500.00"},
+							{entity.LineItemTypeServiceFee, "57.50"},
+							{entity.LineItemTypePaymentFee, "56.85"},
+							{entity.LineItemTypeVAT, "113.00"},
+						}
+						require.Len(t, lineItems, len(expected))
+						for i, e := range expected {
+							assert.Equal(t, paymentID, lineItems[i].PaymentID)
+							assert.Equal(t, i+1, lineItems[i].LineNumber)
+							assert.Equal(t, e.lineItemType, lineItems[i].Type)
+							assert.Equal(t, e.amount, lineItems[i].Amount.StringFixed(2))
+						}
+						return &lineItems, nil
+					},
+				)
+				// The charge outcome is unknown, the payment stays initiated with its line items
+				h.mockPaymentGateway.EXPECT().Charge(gomock.Any(), gateway.ChargeInput{
+					PaymentID:     paymentID,
+					Amount:        total,
+					PaymentMethod: "credit_card",
+				}).Return(nil, errors.New("timeout"))
+			},
+			errorType:     &errsFramework.ThirdPartyError{},
+			errorContains: "failed to charge the payment",
+		},
+		{
+			name: "amount without the fees and VAT returns the amount due",
+			input: paymentusecase.PayReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     sessionID,
+				PaymentMethod: "credit_card",
+				Amount:        "1500.00",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+				h.mockTransactor.EXPECT().Rollback().Return(nil)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+			},
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the amount does not match the reservation price",
+			assertError: func(t *testing.T, err error) {
+				var unprocessableErr *errsFramework.UnprocessableEntityError
+				require.ErrorAs(t, err, &unprocessableErr)
+				assert.Equal(t, map[string]string{
+					"price":      "1500.00",
+					"currency":   "THB",
+					"amount_due": "1727.35",
+				}, unprocessableErr.GetData())
+			},
+		},
+		{
+			name:  "line item creation error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, false)
+				h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create payment line items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTestWithPriceCalculator(t, priceCalculator)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.PayReservation(context.Background(), tt.input)
+
+			// Assert
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "[usecase payment/pay_reservation PayReservation]")
+			assert.Contains(t, err.Error(), tt.errorContains)
+			assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+			if tt.assertError != nil {
+				tt.assertError(t, err)
+			}
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestPaymentUsecase_PayReservation_PromptPay(t *testing.T) {
+	reservationID := uuid.New()
+	paymentID := uuid.MustParse("123e4567-e89b-42d3-a456-426614174000")
+	sessionID := "session-123"
+	amount := decimal.RequireFromString("1500.00")
+
+	// The first reference of the bill is taken from the payment ID
+	qrPayload, err := promptpay.BillPayment(testPromptPayBillerID, "123E4567E89B42D3A456", "", "1500.00")
+	require.NoError(t, err)
+
+	pendingReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    uuid.New(),
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusPending,
+		ExpiresAt: time.Now().Add(5 * time.Minute),
+		Price:     &amount,
+		Currency:  pointer.ToPointer("THB"),
+	}
+	initiatedPayment := &entity.Payment{
+		ID:            paymentID,
+		ReservationID: reservationID,
+		Status:        entity.PaymentStatusInitiated,
+		Amount:        &amount,
+		PaymentMethod: pointer.ToPointer(entity.PaymentMethodPromptPay),
+		Provider:      pointer.ToPointer(entity.PromptPayProvider),
+	}
+	paymentWithQR := *initiatedPayment
+	paymentWithQR.QRPayload = pointer.ToPointer(qrPayload)
+
+	validInput := paymentusecase.PayReservationInput{
+		ReservationID: reservationID.String(),
+		SessionID:     sessionID,
+		PaymentMethod: entity.PaymentMethodPromptPay,
+		Amount:        "1500.00",
+	}
+
+	// expectTx sets up the transaction recording the payment
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+		h.mockLineItemRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockLineItemRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	// expectInitiate records a new initiated payment of the PromptPay provider, the gateway is never asked for its provider
+	expectInitiate := func(h *testHelper) {
+		expectTx(h, true)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{}, nil)
+		h.mockPaymentRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, payment *entity.Payment) (*entity.Payment, error) {
+				assert.Equal(t, entity.PaymentMethodPromptPay, pointer.GetValue(payment.PaymentMethod))
+				assert.Equal(t, entity.PromptPayProvider, pointer.GetValue(payment.Provider))
+				return pointer.ToPointer(*initiatedPayment), nil
+			},
+		)
+		h.mockLineItemRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, lineItems entity.PaymentLineItems) (*entity.PaymentLineItems, error) {
+				return &lineItems, nil
+			},
+		)
+	}
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.PayReservationInput
+		configure      func(appConfig *config.AppConfig)
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Payment
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "promptpay payment stores the QR payload without charging",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+					ID:        paymentID,
+					QRPayload: pointer.ToPointer(qrPayload),
+				}).Return(&paymentWithQR, nil)
+			},
+			expectedResult: &paymentWithQR,
+			expectedError:  false,
+		},
+		{
+			name:  "initiated promptpay payment is returned with its QR payload",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockPaymentRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Payments{paymentWithQR}, nil)
+			},
+			expectedResult: &paymentWithQR,
+			expectedError:  false,
+		},
+		{
+			name:  "promptpay is not available without a biller ID",
+			input: validInput,
+			configure: func(appConfig *config.AppConfig) {
+				appConfig.PromptPayBillerID = ""
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the payment method is not available",
+		},
+		{
+			name:  "promptpay does not accept another currency",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				usdReservation := *pendingReservation
+				usdReservation.Currency = pointer.ToPointer("USD")
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&usdReservation, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the payment method does not accept the reservation currency",
+		},
+		{
+			name:  "QR payload update error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to store the PromptPay QR payload",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priceCalculator, err := entity.NewPriceCalculator(entity.PriceCalculatorConfig{Rounding: entity.RoundingModeHalfUp})
+			require.NoError(t, err)
+			h := initTestWithConfig(t, priceCalculator, tt.configure)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.PayReservation(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/pay_reservation PayReservation]")
+				assert.Contains(t, err.Error(), tt.errorContains)
+				assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type RefundPaymentInput struct {
+	PaymentID   string `json:"payment_id" validate:"required,uuid4"`
+	Amount      string `json:"amount" validate:"required"`
+	Reason      string `json:"reason" validate:"max=255"`
+	ReleaseSeat bool   `json:"release_seat"` // Give the seat back to inventory, only allowed for a full refund
+}
+
+// RefundPaymentResult describes the refund that was given and the state of the payment afterwards.
+type RefundPaymentResult struct {
+	Refund       *entity.Refund
+	Payment      *entity.Payment
+	SeatReleased bool // The seat of the reservation was made available again
+}
+
+// RefundPayment gives back part or all of a paid payment through the payment gateway.
+// The refund is recorded as pending before the gateway is called and counts against the refundable amount straight
+// away, so that refunds in flight can never give back more than was paid. Once the provider approves it, the refund
+// succeeds and the payment moves to partially_refunded or refunded. A full refund may release the booked seat.
+func (u *paymentUsecase) RefundPayment(ctx context.Context, input RefundPaymentInput) (result *RefundPaymentResult, err error) {
+	const errLocation = "[usecase payment/refund_payment RefundPayment] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("payment.usecase"), func(ctx context.Context) (*RefundPaymentResult, error) {
+		logger := commonLogger.FromContext(ctx)
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		paymentID, err := uuid.Parse(input.PaymentID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid payment ID", nil))
+			return nil, err
+		}
+		amount, err := decimal.NewFromString(input.Amount)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid amount", nil))
+			return nil, err
+		}
+		if !amount.IsPositive() || !amount.Equal(amount.Round(2)) {
+			err = errsFramework.NewBadRequestError("invalid amount", map[string]string{"details": "amount must be positive with at most 2 decimal places"})
+			return nil, err
+		}
+		var reason *string
+		if input.Reason != "" {
+			reason = pointer.ToPointer(input.Reason)
+		}
+
+		// Record the refund before asking the provider for it
+		payment, refund, err := u.initiateRefund(ctx, paymentID, amount, reason, input.ReleaseSeat)
+		if err != nil {
+			return nil, err
+		}
+
+		// Refund outside of any transaction, the reservation row must not stay locked while waiting for the provider
+		refundResult, err := u.paymentGateway.Refund(ctx, gateway.RefundInput{
+			RefundID:          refund.ID,
+			ProviderReference: pointer.GetValue(payment.ProviderReference),
+			Amount:            refund.Amount,
+		})
+		if err != nil {
+			if errors.Is(err, gateway.ErrRefundDeclined) {
+				u.failRefund(ctx, refund.ID)
+				err = errsFramework.WrapError(err, errs.NewRefundDeclinedError(map[string]string{"refund_id": refund.ID.String()}))
+				return nil, err
+			}
+			// The outcome of the refund is unknown, keep the refund pending so that refunding again retries the same refund
+			err = errsFramework.WrapError(err, errsFramework.NewThirdPartyError("failed to refund the payment", map[string]string{"refund_id": refund.ID.String()}))
+			return nil, err
+		}
+
+		// Apply the refund to the payment, and to the reservation and the seat when they are released
+		result, releasedSeat, zone, err := u.completeRefund(ctx, refund, refundResult.ProviderReference, input.ReleaseSeat)
+		if err != nil {
+			return nil, err
+		}
+
+		// Publish the released seat once the transaction is committed
+		if releasedSeat != nil {
+			setMapErr := u.seatMapRepository.SetSeat(ctx, zone.ConcertID, zone.ID, *releasedSeat, cache.SeatMapNoExpiration)
+			if setMapErr != nil {
+				// Log the error but do not return it, the database is the source of truth
+				logger.Error(ctx, "failed to update seat map in Redis", setMapErr, commonLogger.Fields{
+					"concert_id":  zone.ConcertID,
+					"zone_id":     zone.ID,
+					"seat_id":     releasedSeat.ID,
+					"seat_number": releasedSeat.SeatNumber,
+				})
+			}
+
+			u.eventPublisher.PublishSeatStatus(ctx, entity.NewSeatStatusEvents(zone.ConcertID, zone.ID, entity.Seats{*releasedSeat}, time.Now()))
+		}
+
+		return result, nil
+	})
+}
+
+// initiateRefund checks that the amount may still be refunded and records a pending refund for it.
+// When an earlier refund of the same amount is still pending, that refund is returned instead,
+// so that the gateway receives the same refund ID and does not give the money back twice.
+func (u *paymentUsecase) initiateRefund(ctx context.Context, paymentID uuid.UUID, amount decimal.Decimal, reason *string, releaseSeat bool) (payment *entity.Payment, refund *entity.Refund, err error) {
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			// The refund must be saved before the gateway gives the money back, so that a retry resumes it by its ID
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	payment, _, err = u.findPaymentForUpdate(ctx, tx.DB(), paymentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !payment.CanRefund() {
+		err = errsFramework.NewConflictError("the payment cannot be refunded", map[string]string{"status": payment.Status.String()})
+		return nil, nil, err
+	}
+	// PromptPay transfers are not taken by the gateway, they can only be given back by a transfer made outside of the system
+	if payment.IsPaidByScanning() {
+		err = errsFramework.NewUnprocessableEntityError("the payment method cannot be refunded through the payment gateway", map[string]string{"payment_method": pointer.GetValue(payment.PaymentMethod)})
+		return nil, nil, err
+	}
+
+	refunds, err := u.findRefunds(ctx, tx.DB(), payment.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Resume a refund that ended with an unknown outcome
+	for _, pendingRefund := range refunds {
+		if pendingRefund.Status != entity.RefundStatusPending {
+			continue
+		}
+		if pendingRefund.Amount.Equal(amount) {
+			return payment, &pendingRefund, nil
+		}
+		err = errsFramework.NewConflictError("another refund is in progress for the payment", map[string]string{"refund_id": pendingRefund.ID.String()})
+		return nil, nil, err
+	}
+
+	refundableAmount := payment.RefundableAmount(refunds)
+	if amount.GreaterThan(refundableAmount) {
+		err = errsFramework.NewUnprocessableEntityError("the amount exceeds the refundable amount", map[string]string{"refundable_amount": refundableAmount.StringFixed(2)})
+		return nil, nil, err
+	}
+	// The seat stays booked for as long as part of the payment is kept
+	if releaseSeat && !amount.Equal(refundableAmount) {
+		err = errsFramework.NewBadRequestError("the seat can only be released by a full refund", map[string]string{"refundable_amount": refundableAmount.StringFixed(2)})
+		return nil, nil, err
+	}
+
+	refund, err = u.refundRepository.WithTx(tx.DB()).CreateOne(ctx, entity.NewRefund(payment.ID, amount, reason))
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create refund", nil))
+		return nil, nil, err
+	}
+
+	return payment, refund, nil
+}
+
+// completeRefund marks the refund as succeeded and moves the payment to the status matching the total refunded.
+// When the payment is fully refunded and the seat should be released, the reservation is cancelled and the seat made
+// available again in the same transaction. The released seat and its zone are returned to be published to Redis.
+func (u *paymentUsecase) completeRefund(ctx context.Context, refund *entity.Refund, providerReference string, releaseSeat bool) (result *RefundPaymentResult, releasedSeat *entity.Seat, zone *entity.Zone, err error) {
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			// The caller publishes the changes once they are committed
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	payment, reservation, err := u.findPaymentForUpdate(ctx, tx.DB(), refund.PaymentID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Mark the refund as succeeded
+	refund, err = u.refundRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateRefundInput{
+		ID:                refund.ID,
+		Status:            pointer.ToPointer(entity.RefundStatusSucceeded),
+		ProviderReference: pointer.ToPointer(providerReference),
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update refund status", nil))
+		return nil, nil, nil, err
+	}
+
+	// Move the payment to the status matching everything refunded so far
+	refunds, err := u.findRefunds(ctx, tx.DB(), payment.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	payment, err = u.paymentRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdatePaymentInput{
+		ID:     payment.ID,
+		Status: pointer.ToPointer(payment.StatusAfterRefund(refunds.SucceededAmount())),
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update payment status", nil))
+		return nil, nil, nil, err
+	}
+
+	result = &RefundPaymentResult{Refund: refund, Payment: payment}
+	if !releaseSeat || payment.Status != entity.PaymentStatusRefunded || reservation.Status != entity.ReservationStatusConfirmed {
+		return result, nil, nil, nil
+	}
+
+	// Cancel the reservation, the booking no longer holds the seat
+	_, err = u.reservationRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateReservationInput{
+		ID:     reservation.ID,
+		Status: pointer.ToPointer(entity.ReservationStatusCancelled),
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update reservation status", nil))
+		return nil, nil, nil, err
+	}
+
+	// Get the seat with explicit row locking
+	seat, err := u.seatRepository.WithTx(tx.DB()).FindOne(ctx, reservation.SeatID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seat by ID", nil))
+		return nil, nil, nil, err
+	}
+	if seat.Status != entity.SeatStatusBooked {
+		return result, nil, nil, nil
+	}
+
+	// Give the seat back to inventory
+	releasedSeat, err = u.seatRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateSeatInput{
+		ID:        seat.ID,
+		Status:    pointer.ToPointer(entity.SeatStatusAvailable),
+		ClearLock: true,
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update seat status", nil))
+		return nil, nil, nil, err
+	}
+
+	// Find the zone to resolve the concert the seat belongs to
+	zone, err = u.zoneRepository.FindOne(ctx, releasedSeat.ZoneID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+		return nil, nil, nil, err
+	}
+
+	result.SeatReleased = true
+	return result, releasedSeat, zone, nil
+}
+
+// findRefunds returns every refund of the payment.
+func (u *paymentUsecase) findRefunds(ctx context.Context, execer db.SqlExecer, paymentID uuid.UUID) (entity.Refunds, error) {
+	refunds, err := u.refundRepository.WithTx(execer).FindAll(ctx, repository.FindAllRefundsFilter{
+		PaymentID: &paymentID,
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find refunds", nil))
+		return nil, err
+	}
+	return pointer.GetValue(refunds), nil
+}
+
+// failRefund marks a declined refund as failed. Errors are only logged, the decline is what the caller needs to know.
+func (u *paymentUsecase) failRefund(ctx context.Context, refundID uuid.UUID) {
+	_, err := u.refundRepository.UpdateOne(ctx, repository.UpdateRefundInput{
+		ID:     refundID,
+		Status: pointer.ToPointer(entity.RefundStatusFailed),
+	})
+	if err != nil {
+		commonLogger.FromContext(ctx).Error(ctx, "failed to mark refund as failed", err, commonLogger.Fields{
+			"refund_id": refundID,
+		})
+	}
+}
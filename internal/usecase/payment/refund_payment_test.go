@@ -0,0 +1,489 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	paymentusecase "ticket-reservation/internal/usecase/payment"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPaymentUsecase_RefundPayment(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	reservationID := uuid.New()
+	paymentID := uuid.New()
+	refundID := uuid.New()
+	amount := decimal.RequireFromString("1500.00")
+	paidAt := time.Now()
+	chargeReference := "fake_ch_" + paymentID.String()
+	refundReference := "fake_re_" + refundID.String()
+
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	confirmedReservation := &entity.Reservation{
+		ID:     reservationID,
+		SeatID: seatID,
+		Status: entity.ReservationStatusConfirmed,
+	}
+	bookedSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusBooked,
+	}
+	availableSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusAvailable,
+	}
+	paymentWithStatus := func(status entity.PaymentStatus) *entity.Payment {
+		return &entity.Payment{
+			ID:                paymentID,
+			ReservationID:     reservationID,
+			Status:            status,
+			Amount:            &amount,
+			PaidAt:            &paidAt,
+			PaymentMethod:     pointer.ToPointer("credit_card"),
+			Provider:          pointer.ToPointer("fake"),
+			ProviderReference: pointer.ToPointer(chargeReference),
+		}
+	}
+	paidPayment := paymentWithStatus(entity.PaymentStatusPaid)
+	refundOf := func(value string, status entity.RefundStatus) entity.Refund {
+		return entity.Refund{
+			ID:        refundID,
+			PaymentID: paymentID,
+			Status:    status,
+			Amount:    decimal.RequireFromString(value),
+		}
+	}
+
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+		h.mockRefundRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockRefundRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	// expectLoad sets up reading the payment under the lock of its reservation
+	expectLoad := func(h *testHelper, payment *entity.Payment) {
+		h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(payment, nil).Times(2)
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+	}
+	expectRefunds := func(h *testHelper, refunds ...entity.Refund) {
+		h.mockRefundRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllRefundsFilter{
+			PaymentID: &paymentID,
+		}).Return(pointer.ToPointer(entity.Refunds(refunds)), nil)
+	}
+	// expectInitiate sets up the first transaction recording a new pending refund
+	expectInitiate := func(h *testHelper, value string, previous ...entity.Refund) {
+		expectTx(h, true)
+		expectLoad(h, paidPayment)
+		expectRefunds(h, previous...)
+		h.mockRefundRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, refund *entity.Refund) (*entity.Refund, error) {
+				assert.Equal(t, paymentID, refund.PaymentID)
+				assert.Equal(t, entity.RefundStatusPending, refund.Status)
+				assert.True(t, decimal.RequireFromString(value).Equal(refund.Amount))
+				assert.Equal(t, "changed plans", pointer.GetValue(refund.Reason))
+				created := refundOf(value, entity.RefundStatusPending)
+				return &created, nil
+			},
+		)
+	}
+	expectRefund := func(h *testHelper, value string) {
+		h.mockPaymentGateway.EXPECT().Refund(gomock.Any(), gateway.RefundInput{
+			RefundID:          refundID,
+			ProviderReference: chargeReference,
+			Amount:            decimal.RequireFromString(value),
+		}).Return(&gateway.RefundResult{ProviderReference: refundReference}, nil)
+	}
+	// expectComplete sets up the second transaction settling the refund and the payment
+	expectComplete := func(h *testHelper, value string, status entity.PaymentStatus, refunds ...entity.Refund) {
+		expectTx(h, true)
+		expectLoad(h, paidPayment)
+		succeeded := refundOf(value, entity.RefundStatusSucceeded)
+		h.mockRefundRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateRefundInput{
+			ID:                refundID,
+			Status:            pointer.ToPointer(entity.RefundStatusSucceeded),
+			ProviderReference: pointer.ToPointer(refundReference),
+		}).Return(&succeeded, nil)
+		expectRefunds(h, append(refunds, succeeded)...)
+		h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdatePaymentInput{
+			ID:     paymentID,
+			Status: pointer.ToPointer(status),
+		}).Return(paymentWithStatus(status), nil)
+	}
+	expectRelease := func(h *testHelper) {
+		h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateReservationInput{
+			ID:     reservationID,
+			Status: pointer.ToPointer(entity.ReservationStatusCancelled),
+		}).Return(&entity.Reservation{ID: reservationID, Status: entity.ReservationStatusCancelled}, nil)
+		h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(bookedSeat, nil)
+		h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateSeatInput{
+			ID:        seatID,
+			Status:    pointer.ToPointer(entity.SeatStatusAvailable),
+			ClearLock: true,
+		}).Return(availableSeat, nil)
+		h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+	}
+
+	partialInput := paymentusecase.RefundPaymentInput{
+		PaymentID: paymentID.String(),
+		Amount:    "500.00",
+		Reason:    "changed plans",
+	}
+	fullInput := paymentusecase.RefundPaymentInput{
+		PaymentID:   paymentID.String(),
+		Amount:      "1500.00",
+		Reason:      "changed plans",
+		ReleaseSeat: true,
+	}
+	succeededPartial := refundOf("500.00", entity.RefundStatusSucceeded)
+	succeededPartial.ID = uuid.New()
+
+	tests := []struct {
+		name           string
+		input          paymentusecase.RefundPaymentInput
+		setupMocks     func(h *testHelper)
+		expectedResult *paymentusecase.RefundPaymentResult
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "partial refund leaves the payment partially refunded",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "500.00")
+				expectRefund(h, "500.00")
+				expectComplete(h, "500.00", entity.PaymentStatusPartiallyRefunded)
+			},
+			expectedResult: &paymentusecase.RefundPaymentResult{
+				Refund:  pointer.ToPointer(refundOf("500.00", entity.RefundStatusSucceeded)),
+				Payment: paymentWithStatus(entity.PaymentStatusPartiallyRefunded),
+			},
+			expectedError: false,
+		},
+		{
+			name:  "full refund releases the seat and updates the seat map",
+			input: fullInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "1500.00")
+				expectRefund(h, "1500.00")
+				expectComplete(h, "1500.00", entity.PaymentStatusRefunded)
+				expectRelease(h)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *availableSeat, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: &paymentusecase.RefundPaymentResult{
+				Refund:       pointer.ToPointer(refundOf("1500.00", entity.RefundStatusSucceeded)),
+				Payment:      paymentWithStatus(entity.PaymentStatusRefunded),
+				SeatReleased: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "remaining amount after a partial refund completes the refund",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID:   paymentID.String(),
+				Amount:      "1000.00",
+				Reason:      "changed plans",
+				ReleaseSeat: true,
+			},
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "1000.00", succeededPartial)
+				expectRefund(h, "1000.00")
+				expectComplete(h, "1000.00", entity.PaymentStatusRefunded, succeededPartial)
+				expectRelease(h)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *availableSeat, domaincache.SeatMapNoExpiration).Return(errors.New("redis down"))
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: &paymentusecase.RefundPaymentResult{
+				Refund:       pointer.ToPointer(refundOf("1000.00", entity.RefundStatusSucceeded)),
+				Payment:      paymentWithStatus(entity.PaymentStatusRefunded),
+				SeatReleased: true,
+			},
+			expectedError: false,
+		},
+		{
+			name: "full refund keeps the seat booked unless asked to release it",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID: paymentID.String(),
+				Amount:    "1500.00",
+				Reason:    "changed plans",
+			},
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "1500.00")
+				expectRefund(h, "1500.00")
+				expectComplete(h, "1500.00", entity.PaymentStatusRefunded)
+			},
+			expectedResult: &paymentusecase.RefundPaymentResult{
+				Refund:  pointer.ToPointer(refundOf("1500.00", entity.RefundStatusSucceeded)),
+				Payment: paymentWithStatus(entity.PaymentStatusRefunded),
+			},
+			expectedError: false,
+		},
+		{
+			name:  "pending refund with the same amount is refunded again",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectLoad(h, paidPayment)
+				expectRefunds(h, refundOf("500.00", entity.RefundStatusPending))
+				expectRefund(h, "500.00")
+				expectComplete(h, "500.00", entity.PaymentStatusPartiallyRefunded)
+			},
+			expectedResult: &paymentusecase.RefundPaymentResult{
+				Refund:  pointer.ToPointer(refundOf("500.00", entity.RefundStatusSucceeded)),
+				Payment: paymentWithStatus(entity.PaymentStatusPartiallyRefunded),
+			},
+			expectedError: false,
+		},
+		{
+			name: "validation error - invalid payment ID",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID: "invalid-uuid",
+				Amount:    "500.00",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - amount is not a number",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID: paymentID.String(),
+				Amount:    "abc",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid amount",
+		},
+		{
+			name: "validation error - amount has more than 2 decimal places",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID: paymentID.String(),
+				Amount:    "10.005",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid amount",
+		},
+		{
+			name:  "payment not found",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockPaymentRepository.EXPECT().FindOne(gomock.Any(), paymentID).Return(nil, errsFramework.NewNotFoundError("payment not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "payment not found",
+		},
+		{
+			name:  "payment that was never paid cannot be refunded",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, paymentWithStatus(entity.PaymentStatusInitiated))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the payment cannot be refunded",
+		},
+		{
+			name:  "promptpay payment cannot be refunded through the gateway",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				promptPayPayment := *paidPayment
+				promptPayPayment.PaymentMethod = pointer.ToPointer(entity.PaymentMethodPromptPay)
+				promptPayPayment.Provider = pointer.ToPointer(entity.PromptPayProvider)
+				expectLoad(h, &promptPayPayment)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the payment method cannot be refunded through the payment gateway",
+		},
+		{
+			name:  "another refund with a different amount is in progress",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, paidPayment)
+				expectRefunds(h, refundOf("200.00", entity.RefundStatusPending))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "another refund is in progress for the payment",
+		},
+		{
+			name: "amount exceeds the refundable amount",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID: paymentID.String(),
+				Amount:    "1200.00",
+			},
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, paidPayment)
+				expectRefunds(h, succeededPartial, refundOf("300.00", entity.RefundStatusFailed))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.UnprocessableEntityError{},
+			errorContains: "the amount exceeds the refundable amount",
+		},
+		{
+			name: "seat cannot be released by a partial refund",
+			input: paymentusecase.RefundPaymentInput{
+				PaymentID:   paymentID.String(),
+				Amount:      "500.00",
+				ReleaseSeat: true,
+			},
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, paidPayment)
+				expectRefunds(h)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the seat can only be released by a full refund",
+		},
+		{
+			name:  "refund creation error",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectLoad(h, paidPayment)
+				expectRefunds(h)
+				h.mockRefundRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create refund",
+		},
+		{
+			name:  "pending refund is not sent to the gateway when its commit fails",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+				h.mockPaymentRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockPaymentRepository).AnyTimes()
+				h.mockRefundRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockRefundRepository).AnyTimes()
+				expectLoad(h, paidPayment)
+				expectRefunds(h)
+				pending := refundOf("500.00", entity.RefundStatusPending)
+				h.mockRefundRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(&pending, nil)
+				h.mockTransactor.EXPECT().Commit().Return(errors.New("connection reset"))
+				// No Refund expectation, the gateway must never see the unsaved refund
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to commit transaction",
+		},
+		{
+			name:  "declined refund marks the refund as failed",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "500.00")
+				h.mockPaymentGateway.EXPECT().Refund(gomock.Any(), gomock.Any()).Return(nil, gateway.ErrRefundDeclined)
+				failed := refundOf("500.00", entity.RefundStatusFailed)
+				h.mockRefundRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateRefundInput{
+					ID:     refundID,
+					Status: pointer.ToPointer(entity.RefundStatusFailed),
+				}).Return(&failed, nil)
+			},
+			expectedError: true,
+			errorType:     &errs.RefundDeclinedError{},
+			errorContains: "the refund was declined",
+		},
+		{
+			name:  "gateway error keeps the refund pending",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "500.00")
+				h.mockPaymentGateway.EXPECT().Refund(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection reset"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ThirdPartyError{},
+			errorContains: "failed to refund the payment",
+		},
+		{
+			name:  "payment update error rolls back the refund",
+			input: partialInput,
+			setupMocks: func(h *testHelper) {
+				expectInitiate(h, "500.00")
+				expectRefund(h, "500.00")
+				expectTx(h, false)
+				expectLoad(h, paidPayment)
+				succeeded := refundOf("500.00", entity.RefundStatusSucceeded)
+				h.mockRefundRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(&succeeded, nil)
+				expectRefunds(h, succeeded)
+				h.mockPaymentRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update payment status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.paymentUsecase.RefundPayment(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase payment/refund_payment RefundPayment]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
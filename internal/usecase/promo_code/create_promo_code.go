@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type CreatePromoCodeInput struct {
+	Code                     string     `json:"code" validate:"required,alphanum,max=50"`
+	DiscountType             string     `json:"discount_type" validate:"required,oneof=percentage fixed"`
+	DiscountValue            string     `json:"discount_value" validate:"required"`
+	MaxRedemptions           *int64     `json:"max_redemptions" validate:"omitempty,gt=0"`
+	MaxRedemptionsPerSession *int64     `json:"max_redemptions_per_session" validate:"omitempty,gt=0"`
+	ValidFrom                *time.Time `json:"valid_from" validate:"omitempty"`
+	ValidUntil               *time.Time `json:"valid_until" validate:"omitempty"`
+	ConcertID                *string    `json:"concert_id" validate:"omitempty,uuid4"`
+	ZoneID                   *string    `json:"zone_id" validate:"omitempty,uuid4"`
+}
+
+// CreatePromoCode creates a promo code, codes are stored in upper case so that customers can type them in any case.
+// A zone restriction also restricts the code to the concert of the zone.
+func (u *promoCodeUsecase) CreatePromoCode(ctx context.Context, input CreatePromoCodeInput) (promoCode *entity.PromoCode, err error) {
+	const errLocation = "[usecase promo_code/create_promo_code CreatePromoCode] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("promo_code.usecase"), func(ctx context.Context) (*entity.PromoCode, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		input.Code = entity.NormalizePromoCode(input.Code)
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		discountType, err := new(entity.PromoCodeDiscountType).Parse(input.DiscountType)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid discount type", nil))
+		}
+		discountValue, err := decimal.NewFromString(input.DiscountValue)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid discount value", nil))
+		}
+		if !discountValue.IsPositive() || !entity.IsValidPrice(discountValue) {
+			return nil, errsFramework.NewBadRequestError("invalid discount value", map[string]string{"details": "discount value must be positive with at most 2 decimal places"})
+		}
+		if discountType == entity.PromoCodeDiscountTypePercentage && discountValue.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return nil, errsFramework.NewBadRequestError("invalid discount value", map[string]string{"details": "a percentage discount must be below 100"})
+		}
+		if input.ValidFrom != nil && input.ValidUntil != nil && !input.ValidFrom.Before(*input.ValidUntil) {
+			return nil, errsFramework.NewBadRequestError("invalid validity window", map[string]string{"details": "valid_from must be before valid_until"})
+		}
+
+		concertID, zoneID, err := u.resolveRestriction(ctx, input.ConcertID, input.ZoneID)
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := u.promoCodeRepository.CreateOne(ctx, &entity.PromoCode{
+			Code:                     input.Code,
+			DiscountType:             discountType,
+			DiscountValue:            discountValue,
+			MaxRedemptions:           input.MaxRedemptions,
+			MaxRedemptionsPerSession: input.MaxRedemptionsPerSession,
+			ValidFrom:                input.ValidFrom,
+			ValidUntil:               input.ValidUntil,
+			ConcertID:                concertID,
+			ZoneID:                   zoneID,
+		})
+		if err != nil {
+			if !errors.As(err, &errsFramework.ConflictError{}) { // If the error is not a ConflictError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create promo code", nil))
+			}
+			return nil, err // Return the ConflictError directly
+		}
+
+		return created, nil
+	})
+}
+
+// resolveRestriction checks that the concert and zone a promo code is restricted to exist and belong together.
+func (u *promoCodeUsecase) resolveRestriction(ctx context.Context, concertIDInput *string, zoneIDInput *string) (concertID *uuid.UUID, zoneID *uuid.UUID, err error) {
+	if concertIDInput != nil {
+		id, err := uuid.Parse(*concertIDInput)
+		if err != nil {
+			return nil, nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+		_, err = u.concertRepository.FindOne(ctx, id)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			}
+			return nil, nil, err // Return the NotFoundError directly
+		}
+		concertID = &id
+	}
+
+	if zoneIDInput != nil {
+		id, err := uuid.Parse(*zoneIDInput)
+		if err != nil {
+			return nil, nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+		}
+		zone, err := u.zoneRepository.FindOne(ctx, id)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			}
+			return nil, nil, err // Return the NotFoundError directly
+		}
+		if concertID != nil && zone.ConcertID != *concertID {
+			return nil, nil, errsFramework.NewNotFoundError("zone not found", map[string]string{"zone_id": id.String()})
+		}
+		concertID = &zone.ConcertID
+		zoneID = &id
+	}
+
+	return concertID, zoneID, nil
+}
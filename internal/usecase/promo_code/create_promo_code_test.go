@@ -0,0 +1,244 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	promocodeusecase "ticket-reservation/internal/usecase/promo_code"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestPromoCodeUsecase_CreatePromoCode(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	promoCodeID := uuid.New()
+	validFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	concert := &entity.Concert{ID: concertID, Name: "Concert"}
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+
+	tests := []struct {
+		name           string
+		input          promocodeusecase.CreatePromoCodeInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.PromoCode
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "successful percentage promo code normalizes the code",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:                     " earlybird ",
+				DiscountType:             "percentage",
+				DiscountValue:            "20",
+				MaxRedemptions:           pointer.ToPointer(int64(100)),
+				MaxRedemptionsPerSession: pointer.ToPointer(int64(1)),
+				ValidFrom:                &validFrom,
+				ValidUntil:               &validUntil,
+				ConcertID:                pointer.ToPointer(concertID.String()),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockPromoCodeRepository.EXPECT().CreateOne(gomock.Any(), &entity.PromoCode{
+					Code:                     "EARLYBIRD",
+					DiscountType:             entity.PromoCodeDiscountTypePercentage,
+					DiscountValue:            decimal.RequireFromString("20"),
+					MaxRedemptions:           pointer.ToPointer(int64(100)),
+					MaxRedemptionsPerSession: pointer.ToPointer(int64(1)),
+					ValidFrom:                &validFrom,
+					ValidUntil:               &validUntil,
+					ConcertID:                &concertID,
+				}).Return(&entity.PromoCode{ID: promoCodeID, Code: "EARLYBIRD"}, nil)
+			},
+			expectedResult: &entity.PromoCode{ID: promoCodeID, Code: "EARLYBIRD"},
+			expectedError:  false,
+		},
+		{
+			name: "zone restriction also restricts the concert",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "VIP300",
+				DiscountType:  "fixed",
+				DiscountValue: "300.00",
+				ZoneID:        pointer.ToPointer(zoneID.String()),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockPromoCodeRepository.EXPECT().CreateOne(gomock.Any(), &entity.PromoCode{
+					Code:          "VIP300",
+					DiscountType:  entity.PromoCodeDiscountTypeFixed,
+					DiscountValue: decimal.RequireFromString("300.00"),
+					ConcertID:     &concertID,
+					ZoneID:        &zoneID,
+				}).Return(&entity.PromoCode{ID: promoCodeID, Code: "VIP300"}, nil)
+			},
+			expectedResult: &entity.PromoCode{ID: promoCodeID, Code: "VIP300"},
+			expectedError:  false,
+		},
+		{
+			name: "validation error - unknown discount type",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "EARLYBIRD",
+				DiscountType:  "bogo",
+				DiscountValue: "20",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - code with symbols",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "EARLY BIRD!",
+				DiscountType:  "percentage",
+				DiscountValue: "20",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "percentage of 100 or more",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "FREE",
+				DiscountType:  "percentage",
+				DiscountValue: "100",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid discount value",
+		},
+		{
+			name: "discount value with more than 2 decimal places",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "ODD",
+				DiscountType:  "fixed",
+				DiscountValue: "10.005",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid discount value",
+		},
+		{
+			name: "validity window ends before it starts",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "BACKWARDS",
+				DiscountType:  "fixed",
+				DiscountValue: "100",
+				ValidFrom:     &validUntil,
+				ValidUntil:    &validFrom,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid validity window",
+		},
+		{
+			name: "concert not found",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "EARLYBIRD",
+				DiscountType:  "percentage",
+				DiscountValue: "20",
+				ConcertID:     pointer.ToPointer(concertID.String()),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name: "zone belongs to another concert",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "VIP300",
+				DiscountType:  "fixed",
+				DiscountValue: "300",
+				ConcertID:     pointer.ToPointer(concertID.String()),
+				ZoneID:        pointer.ToPointer(zoneID.String()),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(&entity.Zone{ID: zoneID, ConcertID: uuid.New()}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "code already exists",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "EARLYBIRD",
+				DiscountType:  "percentage",
+				DiscountValue: "20",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewConflictError("promo code already exists", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "promo code already exists",
+		},
+		{
+			name: "repository error",
+			input: promocodeusecase.CreatePromoCodeInput{
+				Code:          "EARLYBIRD",
+				DiscountType:  "percentage",
+				DiscountValue: "20",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create promo code",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.promoCodeUsecase.CreatePromoCode(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase promo_code/create_promo_code CreatePromoCode]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
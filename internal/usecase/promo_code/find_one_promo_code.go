@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type FindOnePromoCodeInput struct {
+	Code string `json:"code" validate:"required,max=50"`
+}
+
+// PromoCodeUsage is a promo code together with the number of redemptions counted against its caps.
+type PromoCodeUsage struct {
+	PromoCode   *entity.PromoCode
+	Redemptions int64
+}
+
+func (u *promoCodeUsecase) FindOnePromoCode(ctx context.Context, input FindOnePromoCodeInput) (usage *PromoCodeUsage, err error) {
+	const errLocation = "[usecase promo_code/find_one_promo_code FindOnePromoCode] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("promo_code.usecase"), func(ctx context.Context) (*PromoCodeUsage, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		promoCode, err := u.promoCodeRepository.FindOneByCode(ctx, entity.NormalizePromoCode(input.Code))
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find promo code", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		redemptions, err := u.redemptionRepository.Count(ctx, repository.CountPromoCodeRedemptionsFilter{PromoCodeID: promoCode.ID})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to count promo code redemptions", nil))
+		}
+
+		return &PromoCodeUsage{PromoCode: promoCode, Redemptions: redemptions}, nil
+	})
+}
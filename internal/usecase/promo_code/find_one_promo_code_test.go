@@ -0,0 +1,104 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	promocodeusecase "ticket-reservation/internal/usecase/promo_code"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestPromoCodeUsecase_FindOnePromoCode(t *testing.T) {
+	promoCodeID := uuid.New()
+	promoCode := &entity.PromoCode{ID: promoCodeID, Code: "EARLYBIRD", DiscountType: entity.PromoCodeDiscountTypePercentage}
+
+	tests := []struct {
+		name           string
+		input          promocodeusecase.FindOnePromoCodeInput
+		setupMocks     func(h *testHelper)
+		expectedResult *promocodeusecase.PromoCodeUsage
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful retrieval with redemption count",
+			input: promocodeusecase.FindOnePromoCodeInput{Code: "earlybird"},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeRepository.EXPECT().FindOneByCode(gomock.Any(), "EARLYBIRD").Return(promoCode, nil)
+				h.mockRedemptionRepository.EXPECT().Count(gomock.Any(), repository.CountPromoCodeRedemptionsFilter{PromoCodeID: promoCodeID}).Return(int64(42), nil)
+			},
+			expectedResult: &promocodeusecase.PromoCodeUsage{PromoCode: promoCode, Redemptions: 42},
+			expectedError:  false,
+		},
+		{
+			name:          "validation error - missing code",
+			input:         promocodeusecase.FindOnePromoCodeInput{},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "promo code not found",
+			input: promocodeusecase.FindOnePromoCodeInput{Code: "UNKNOWN"},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeRepository.EXPECT().FindOneByCode(gomock.Any(), "UNKNOWN").Return(nil, errsFramework.NewNotFoundError("promo code not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "promo code not found",
+		},
+		{
+			name:  "redemption count error",
+			input: promocodeusecase.FindOnePromoCodeInput{Code: "EARLYBIRD"},
+			setupMocks: func(h *testHelper) {
+				h.mockPromoCodeRepository.EXPECT().FindOneByCode(gomock.Any(), "EARLYBIRD").Return(promoCode, nil)
+				h.mockRedemptionRepository.EXPECT().Count(gomock.Any(), gomock.Any()).Return(int64(0), errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to count promo code redemptions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.promoCodeUsecase.FindOnePromoCode(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase promo_code/find_one_promo_code FindOnePromoCode]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/promo_code_usecase.go -package=promo_code_usecasemocks
+type PromoCodeUsecase interface {
+	CreatePromoCode(ctx context.Context, input CreatePromoCodeInput) (*entity.PromoCode, error)
+	FindOnePromoCode(ctx context.Context, input FindOnePromoCodeInput) (*PromoCodeUsage, error)
+}
+
+type promoCodeUsecase struct {
+	appConfig            config.AppConfig
+	concertRepository    repository.ConcertRepository
+	zoneRepository       repository.ZoneRepository
+	promoCodeRepository  repository.PromoCodeRepository
+	redemptionRepository repository.PromoCodeRedemptionRepository
+}
+
+func NewPromoCodeUsecase(
+	appConfig config.AppConfig,
+	concertRepository repository.ConcertRepository,
+	zoneRepository repository.ZoneRepository,
+	promoCodeRepository repository.PromoCodeRepository,
+	redemptionRepository repository.PromoCodeRedemptionRepository,
+) PromoCodeUsecase {
+	return &promoCodeUsecase{
+		appConfig:            appConfig,
+		concertRepository:    concertRepository,
+		zoneRepository:       zoneRepository,
+		promoCodeRepository:  promoCodeRepository,
+		redemptionRepository: redemptionRepository,
+	}
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	promocodeusecase "ticket-reservation/internal/usecase/promo_code"
+)
+
+type testHelper struct {
+	ctrl                     *gomock.Controller
+	appConfig                config.AppConfig
+	mockConcertRepository    *repository_mocks.MockConcertRepository
+	mockZoneRepository       *repository_mocks.MockZoneRepository
+	mockPromoCodeRepository  *repository_mocks.MockPromoCodeRepository
+	mockRedemptionRepository *repository_mocks.MockPromoCodeRedemptionRepository
+	promoCodeUsecase         promocodeusecase.PromoCodeUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockConcertRepository := repository_mocks.NewMockConcertRepository(ctrl)
+	mockZoneRepository := repository_mocks.NewMockZoneRepository(ctrl)
+	mockPromoCodeRepository := repository_mocks.NewMockPromoCodeRepository(ctrl)
+	mockRedemptionRepository := repository_mocks.NewMockPromoCodeRedemptionRepository(ctrl)
+
+	usecase := promocodeusecase.NewPromoCodeUsecase(
+		appConfig,
+		mockConcertRepository,
+		mockZoneRepository,
+		mockPromoCodeRepository,
+		mockRedemptionRepository,
+	)
+
+	return &testHelper{
+		ctrl:                     ctrl,
+		appConfig:                appConfig,
+		mockConcertRepository:    mockConcertRepository,
+		mockZoneRepository:       mockZoneRepository,
+		mockPromoCodeRepository:  mockPromoCodeRepository,
+		mockRedemptionRepository: mockRedemptionRepository,
+		promoCodeUsecase:         usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewPromoCodeUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone:    "Asia/Bangkok",
+		SeatLockTTL: 5 * time.Minute,
+	}
+
+	// Execute
+	usecase := promocodeusecase.NewPromoCodeUsecase(
+		appConfig,
+		repository_mocks.NewMockConcertRepository(ctrl),
+		repository_mocks.NewMockZoneRepository(ctrl),
+		repository_mocks.NewMockPromoCodeRepository(ctrl),
+		repository_mocks.NewMockPromoCodeRedemptionRepository(ctrl),
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
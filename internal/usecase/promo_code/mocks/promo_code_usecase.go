@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package promo_code_usecasemocks is a generated GoMock package.
+package promo_code_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/promo_code"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromoCodeUsecase is a mock of PromoCodeUsecase interface.
+type MockPromoCodeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeUsecaseMockRecorder
+}
+
+// MockPromoCodeUsecaseMockRecorder is the mock recorder for MockPromoCodeUsecase.
+type MockPromoCodeUsecaseMockRecorder struct {
+	mock *MockPromoCodeUsecase
+}
+
+// NewMockPromoCodeUsecase creates a new mock instance.
+func NewMockPromoCodeUsecase(ctrl *gomock.Controller) *MockPromoCodeUsecase {
+	mock := &MockPromoCodeUsecase{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeUsecase) EXPECT() *MockPromoCodeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoCodeUsecase) CreatePromoCode(ctx context.Context, input usecase.CreatePromoCodeInput) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, input)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoCodeUsecaseMockRecorder) CreatePromoCode(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoCodeUsecase)(nil).CreatePromoCode), ctx, input)
+}
+
+// FindOnePromoCode mocks base method.
+func (m *MockPromoCodeUsecase) FindOnePromoCode(ctx context.Context, input usecase.FindOnePromoCodeInput) (*usecase.PromoCodeUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOnePromoCode", ctx, input)
+	ret0, _ := ret[0].(*usecase.PromoCodeUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOnePromoCode indicates an expected call of FindOnePromoCode.
+func (mr *MockPromoCodeUsecaseMockRecorder) FindOnePromoCode(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnePromoCode", reflect.TypeOf((*MockPromoCodeUsecase)(nil).FindOnePromoCode), ctx, input)
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type CancelReservationInput struct {
+	ReservationID string `json:"reservation_id" validate:"required,uuid4"`
+	SessionID     string `json:"session_id" validate:"required"`
+}
+
+func (u *reservationUsecase) CancelReservation(ctx context.Context, input CancelReservationInput) (reservation *entity.Reservation, err error) {
+	const errLocation = "[usecase reservation/cancel_reservation CancelReservation] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("reservation.usecase"), func(ctx context.Context) (*entity.Reservation, error) {
+		logger := commonLogger.FromContext(ctx)
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		reservationID, err := uuid.Parse(input.ReservationID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid reservation ID", nil))
+			return nil, err
+		}
+
+		// Start a transaction for database operations
+		tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+			return nil, err
+		}
+		var seatStatusEvents entity.SeatStatusEvents
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			} else if commitErr := tx.Commit(); commitErr == nil && len(seatStatusEvents) > 0 {
+				// Only tell the seat status stream about a committed cancellation that released the seat
+				u.eventPublisher.PublishSeatStatus(ctx, seatStatusEvents)
+			}
+		}()
+
+		// Get the reservation with explicit row locking
+		reservation, err := u.reservationRepository.WithTx(tx.DB()).FindOne(ctx, reservationID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		// Only the session holding the reservation may cancel it
+		if reservation.SessionID != input.SessionID {
+			err = errsFramework.NewForbiddenError("the reservation does not belong to this session", nil)
+			return nil, err
+		}
+		if !reservation.CanCancel() {
+			err = errsFramework.NewConflictError("the reservation can no longer be cancelled", map[string]string{"status": reservation.Status.String()})
+			return nil, err
+		}
+
+		// Mark the reservation as cancelled
+		reservation, err = u.reservationRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateReservationInput{
+			ID:     reservation.ID,
+			Status: pointer.ToPointer(entity.ReservationStatusCancelled),
+		})
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update reservation status", nil))
+			return nil, err
+		}
+
+		// Get the seat with explicit row locking
+		seat, err := u.seatRepository.WithTx(tx.DB()).FindOne(ctx, reservation.SeatID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seat by ID", nil))
+			return nil, err
+		}
+		// The lock may already have expired and been taken by another session; leave the seat untouched in that case
+		if seat.Status != entity.SeatStatusPending || pointer.GetValue(seat.LockedBySessionID) != input.SessionID {
+			return reservation, nil
+		}
+
+		// Release the seat in database
+		seat, err = u.seatRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateSeatInput{
+			ID:        seat.ID,
+			Status:    pointer.ToPointer(entity.SeatStatusAvailable),
+			ClearLock: true,
+		})
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update seat status", nil))
+			return nil, err
+		}
+
+		// Find the zone to resolve the concert the seat belongs to
+		zone, err := u.zoneRepository.FindOne(ctx, seat.ZoneID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			return nil, err
+		}
+
+		// Release the Redis lock held by the session
+		unlockErr := u.seatLockerRepository.UnlockSeat(ctx, zone.ConcertID, zone.ID, seat.ID, input.SessionID)
+		if unlockErr != nil && !errors.Is(unlockErr, cache.ErrSeatUnlockDenied) {
+			// Log the error but do not return it, the lock expires on its own after the TTL
+			logger.Error(ctx, "failed to unlock seat after cancellation", unlockErr, commonLogger.Fields{
+				"concert_id":     zone.ConcertID,
+				"zone_id":        zone.ID,
+				"seat_id":        seat.ID,
+				"reservation_id": reservation.ID,
+			})
+		}
+
+		// Update seat map in Redis
+		setMapErr := u.seatMapRepository.SetSeat(ctx, zone.ConcertID, zone.ID, *seat, cache.SeatMapNoExpiration)
+		if setMapErr != nil {
+			logger.Error(ctx, "failed to update seat map in Redis", setMapErr, commonLogger.Fields{
+				"concert_id":  zone.ConcertID,
+				"zone_id":     zone.ID,
+				"seat_id":     seat.ID,
+				"seat_number": seat.SeatNumber,
+			})
+		}
+
+		seatStatusEvents = entity.NewSeatStatusEvents(zone.ConcertID, zone.ID, entity.Seats{*seat}, time.Now())
+
+		return reservation, nil
+	})
+}
@@ -0,0 +1,304 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	reservationusecase "ticket-reservation/internal/usecase/reservation"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestReservationUsecase_CancelReservation(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	seatID := uuid.New()
+	reservationID := uuid.New()
+	sessionID := "session-123"
+	lockedUntil := time.Now().Add(5 * time.Minute)
+
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	pendingReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusPending,
+		ExpiresAt: lockedUntil,
+	}
+	cancelledReservation := &entity.Reservation{
+		ID:        reservationID,
+		SeatID:    seatID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusCancelled,
+		ExpiresAt: lockedUntil,
+	}
+	lockedSeat := &entity.Seat{
+		ID:                seatID,
+		ZoneID:            zoneID,
+		SeatNumber:        "A1",
+		Status:            entity.SeatStatusPending,
+		LockedUntil:       &lockedUntil,
+		LockedBySessionID: pointer.ToPointer(sessionID),
+	}
+	releasedSeat := &entity.Seat{
+		ID:         seatID,
+		ZoneID:     zoneID,
+		SeatNumber: "A1",
+		Status:     entity.SeatStatusAvailable,
+	}
+
+	validInput := reservationusecase.CancelReservationInput{
+		ReservationID: reservationID.String(),
+		SessionID:     sessionID,
+	}
+
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	expectCancel := func(h *testHelper) {
+		h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+		h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateReservationInput{
+			ID:     reservationID,
+			Status: pointer.ToPointer(entity.ReservationStatusCancelled),
+		}).Return(cancelledReservation, nil)
+	}
+
+	tests := []struct {
+		name           string
+		input          reservationusecase.CancelReservationInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Reservation
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful cancellation releases seat, lock and seat map entry",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectCancel(h)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateSeatInput{
+					ID:        seatID,
+					Status:    pointer.ToPointer(entity.SeatStatusAvailable),
+					ClearLock: true,
+				}).Return(releasedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *releasedSeat, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: cancelledReservation,
+			expectedError:  false,
+		},
+		{
+			name:  "redis failures are logged and do not fail the cancellation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectCancel(h)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(releasedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID).Return(errors.New("redis down"))
+				h.mockSeatMapRepository.EXPECT().SetSeat(gomock.Any(), concertID, zoneID, *releasedSeat, domaincache.SeatMapNoExpiration).Return(errors.New("redis down"))
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: cancelledReservation,
+			expectedError:  false,
+		},
+		{
+			name:  "seat already held by another session is left untouched",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectCancel(h)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(&entity.Seat{
+					ID:                seatID,
+					ZoneID:            zoneID,
+					SeatNumber:        "A1",
+					Status:            entity.SeatStatusPending,
+					LockedUntil:       &lockedUntil,
+					LockedBySessionID: pointer.ToPointer("another-session"),
+				}, nil)
+			},
+			expectedResult: cancelledReservation,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid reservation ID",
+			input: reservationusecase.CancelReservationInput{
+				ReservationID: "invalid-uuid",
+				SessionID:     sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - missing session ID",
+			input: reservationusecase.CancelReservationInput{
+				ReservationID: reservationID.String(),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "transaction creation error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(nil, errors.New("tx failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create transaction",
+		},
+		{
+			name:  "reservation not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(nil, errsFramework.NewNotFoundError("reservation not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "reservation not found",
+		},
+		{
+			name:  "reservation repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find reservation by ID",
+		},
+		{
+			name: "reservation belongs to another session",
+			input: reservationusecase.CancelReservationInput{
+				ReservationID: reservationID.String(),
+				SessionID:     "another-session",
+			},
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the reservation does not belong to this session",
+		},
+		{
+			name:  "confirmed reservation cannot be cancelled",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{
+					ID:        reservationID,
+					SeatID:    seatID,
+					SessionID: sessionID,
+					Status:    entity.ReservationStatusConfirmed,
+				}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the reservation can no longer be cancelled",
+		},
+		{
+			name:  "reservation update error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(pendingReservation, nil)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("update failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update reservation status",
+		},
+		{
+			name:  "seat update error rolls back the cancellation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectCancel(h)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("update failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update seat status",
+		},
+		{
+			name:  "zone lookup error rolls back the cancellation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				expectCancel(h)
+				h.mockSeatRepository.EXPECT().FindOne(gomock.Any(), seatID).Return(lockedSeat, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(releasedSeat, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find zone by ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.reservationUsecase.CancelReservation(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase reservation/cancel_reservation CancelReservation]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+// defaultExpirySweepBatchSize is used when no batch size is configured
+const defaultExpirySweepBatchSize = 100
+
+type CleanupExpiredReservationsResult struct {
+	ExpiredReservations int
+	ReleasedSeats       int
+}
+
+func (u *reservationUsecase) CleanupExpiredReservations(ctx context.Context) (result *CleanupExpiredReservationsResult, err error) {
+	const errLocation = "[usecase reservation/cleanup_expired_reservations CleanupExpiredReservations] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("reservation.usecase"), func(ctx context.Context) (*CleanupExpiredReservationsResult, error) {
+		// Use a fixed cut-off so that the sweep terminates even when new holds keep expiring
+		requestTime := time.Now()
+		batchSize := u.appConfig.ExpirySweepBatchSize
+		if batchSize <= 0 {
+			batchSize = defaultExpirySweepBatchSize
+		}
+
+		result := &CleanupExpiredReservationsResult{}
+		for {
+			expiredReservations, releasedSeats, err := u.cleanupExpiredBatch(ctx, requestTime, int64(batchSize))
+			if err != nil {
+				return nil, err
+			}
+			result.ExpiredReservations += len(pointer.GetValue(expiredReservations))
+			result.ReleasedSeats += len(pointer.GetValue(releasedSeats))
+
+			// Update seat map in Redis
+			u.updateReleasedSeatMap(ctx, pointer.GetValue(releasedSeats))
+
+			// A short batch means there is nothing left before the cut-off (rows locked by other sweepers are skipped)
+			if len(pointer.GetValue(expiredReservations)) < batchSize && len(pointer.GetValue(releasedSeats)) < batchSize {
+				break
+			}
+		}
+
+		return result, nil
+	})
+}
+
+// cleanupExpiredBatch expires a batch of pending reservations and frees a batch of pending seats in a single transaction.
+func (u *reservationUsecase) cleanupExpiredBatch(ctx context.Context, before time.Time, limit int64) (reservations *entity.Reservations, seats *entity.Seats, err error) {
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			// The caller publishes the changes once they are committed
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	reservations, err = u.reservationRepository.WithTx(tx.DB()).ExpireMany(ctx, repository.ExpireManyReservationsInput{
+		ExpiresBefore: before,
+		Limit:         limit,
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to expire reservations", nil))
+		return nil, nil, err
+	}
+
+	// Notify webhook subscribers along with the transaction
+	if len(pointer.GetValue(reservations)) > 0 {
+		events := entity.NewWebhookEventsForReservations(entity.WebhookEventTypeReservationExpired, *reservations, time.Now())
+		err = u.eventPublisher.EnqueueWebhookEvents(ctx, tx.DB(), events)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to enqueue webhook events", nil))
+			return nil, nil, err
+		}
+	}
+
+	seats, err = u.seatRepository.WithTx(tx.DB()).ReleaseManyExpired(ctx, repository.ReleaseManyExpiredSeatsInput{
+		LockedBefore: before,
+		Limit:        limit,
+	})
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to release expired seats", nil))
+		return nil, nil, err
+	}
+
+	return reservations, seats, nil
+}
+
+// updateReleasedSeatMap writes the released seats back to the seat map of their zone and publishes them to its stream.
+// Failures are only logged, the seat map falls back to Postgres once the pending entries expire.
+func (u *reservationUsecase) updateReleasedSeatMap(ctx context.Context, seats entity.Seats) {
+	logger := commonLogger.FromContext(ctx)
+
+	seatsByZone := make(map[uuid.UUID]entity.Seats)
+	zoneIDs := make([]uuid.UUID, 0)
+	for _, seat := range seats {
+		if _, ok := seatsByZone[seat.ZoneID]; !ok {
+			zoneIDs = append(zoneIDs, seat.ZoneID)
+		}
+		seatsByZone[seat.ZoneID] = append(seatsByZone[seat.ZoneID], seat)
+	}
+
+	for _, zoneID := range zoneIDs {
+		zone, err := u.zoneRepository.FindOne(ctx, zoneID)
+		if err != nil {
+			logger.Error(ctx, "failed to find zone of released seats", err, commonLogger.Fields{
+				"zone_id": zoneID,
+			})
+			continue
+		}
+		setMapErr := u.seatMapRepository.SetSeats(ctx, zone.ConcertID, zone.ID, seatsByZone[zoneID], cache.SeatMapNoExpiration)
+		if setMapErr != nil {
+			logger.Error(ctx, "failed to update seat map in Redis", setMapErr, commonLogger.Fields{
+				"concert_id": zone.ConcertID,
+				"zone_id":    zone.ID,
+			})
+		}
+
+		u.eventPublisher.PublishSeatStatus(ctx, entity.NewSeatStatusEvents(zone.ConcertID, zone.ID, seatsByZone[zoneID], time.Now()))
+	}
+}
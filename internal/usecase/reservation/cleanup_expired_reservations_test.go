@@ -0,0 +1,224 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/config"
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+	reservationusecase "ticket-reservation/internal/usecase/reservation"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestReservationUsecase_CleanupExpiredReservations(t *testing.T) {
+	concertID := uuid.New()
+	zoneID1 := uuid.New()
+	zoneID2 := uuid.New()
+
+	newReservations := func(n int) *entity.Reservations {
+		reservations := make(entity.Reservations, 0, n)
+		for i := 0; i < n; i++ {
+			reservations = append(reservations, entity.Reservation{ID: uuid.New(), SeatID: uuid.New(), Status: entity.ReservationStatusExpired})
+		}
+		return &reservations
+	}
+	seatA1 := entity.Seat{ID: uuid.New(), ZoneID: zoneID1, SeatNumber: "A1", Status: entity.SeatStatusAvailable}
+	seatA2 := entity.Seat{ID: uuid.New(), ZoneID: zoneID1, SeatNumber: "A2", Status: entity.SeatStatusAvailable}
+	seatB1 := entity.Seat{ID: uuid.New(), ZoneID: zoneID2, SeatNumber: "B1", Status: entity.SeatStatusAvailable}
+
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		batchSize      int
+		setupMocks     func(h *testHelper)
+		expectedResult *reservationusecase.CleanupExpiredReservationsResult
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:      "single batch expires reservations and releases seats per zone",
+			batchSize: 10,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input repository.ExpireManyReservationsInput) (*entity.Reservations, error) {
+						assert.Equal(t, int64(10), input.Limit)
+						return newReservations(3), nil
+					})
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ db.SqlExecer, events entity.WebhookEvents) error {
+						require.Len(t, events, 3)
+						for _, event := range events {
+							assert.Equal(t, entity.WebhookEventTypeReservationExpired, event.Type)
+						}
+						return nil
+					})
+				h.mockSeatRepository.EXPECT().ReleaseManyExpired(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input repository.ReleaseManyExpiredSeatsInput) (*entity.Seats, error) {
+						assert.Equal(t, int64(10), input.Limit)
+						return &entity.Seats{seatA1, seatB1, seatA2}, nil
+					})
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID1).Return(&entity.Zone{ID: zoneID1, ConcertID: concertID}, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID2).Return(&entity.Zone{ID: zoneID2, ConcertID: concertID}, nil)
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID1, entity.Seats{seatA1, seatA2}, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(2))
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID2, entity.Seats{seatB1}, domaincache.SeatMapNoExpiration).Return(errors.New("redis down"))
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			expectedResult: &reservationusecase.CleanupExpiredReservationsResult{ExpiredReservations: 3, ReleasedSeats: 3},
+			expectedError:  false,
+		},
+		{
+			name:      "full batch triggers another batch",
+			batchSize: 2,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				expectTx(h, true)
+				gomock.InOrder(
+					h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).Return(newReservations(2), nil),
+					h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).Return(newReservations(1), nil),
+				)
+				gomock.InOrder(
+					h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(2)).Return(nil),
+					h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(1)).Return(nil),
+				)
+				gomock.InOrder(
+					h.mockSeatRepository.EXPECT().ReleaseManyExpired(gomock.Any(), gomock.Any()).Return(&entity.Seats{}, nil),
+					h.mockSeatRepository.EXPECT().ReleaseManyExpired(gomock.Any(), gomock.Any()).Return(&entity.Seats{}, nil),
+				)
+			},
+			expectedResult: &reservationusecase.CleanupExpiredReservationsResult{ExpiredReservations: 3, ReleasedSeats: 0},
+			expectedError:  false,
+		},
+		{
+			name:      "zone lookup failure only skips the seat map update",
+			batchSize: 10,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).Return(&entity.Reservations{}, nil)
+				h.mockSeatRepository.EXPECT().ReleaseManyExpired(gomock.Any(), gomock.Any()).Return(&entity.Seats{seatA1}, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID1).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedResult: &reservationusecase.CleanupExpiredReservationsResult{ExpiredReservations: 0, ReleasedSeats: 1},
+			expectedError:  false,
+		},
+		{
+			name:      "default batch size is used when not configured",
+			batchSize: 0,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, true)
+				h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input repository.ExpireManyReservationsInput) (*entity.Reservations, error) {
+						assert.Equal(t, int64(100), input.Limit)
+						return &entity.Reservations{}, nil
+					})
+				h.mockSeatRepository.EXPECT().ReleaseManyExpired(gomock.Any(), gomock.Any()).Return(&entity.Seats{}, nil)
+			},
+			expectedResult: &reservationusecase.CleanupExpiredReservationsResult{},
+			expectedError:  false,
+		},
+		{
+			name:      "transaction creation error",
+			batchSize: 10,
+			setupMocks: func(h *testHelper) {
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(nil, errors.New("tx failed"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create transaction",
+		},
+		{
+			name:      "reservation repository error",
+			batchSize: 10,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("update failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to expire reservations",
+		},
+		{
+			name:      "seat repository error rolls back the batch",
+			batchSize: 10,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).Return(newReservations(1), nil)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(1)).Return(nil)
+				h.mockSeatRepository.EXPECT().ReleaseManyExpired(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("update failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to release expired seats",
+		},
+		{
+			name:      "webhook enqueue error rolls back the batch",
+			batchSize: 10,
+			setupMocks: func(h *testHelper) {
+				expectTx(h, false)
+				h.mockReservationRepository.EXPECT().ExpireMany(gomock.Any(), gomock.Any()).Return(newReservations(1), nil)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(1)).Return(errsFramework.NewDatabaseError("insert failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to enqueue webhook events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t, func(cfg *config.AppConfig) {
+				cfg.ExpirySweepBatchSize = tt.batchSize
+			})
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.reservationUsecase.CleanupExpiredReservations(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase reservation/cleanup_expired_reservations CleanupExpiredReservations]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindReservationTicketsInput struct {
+	ReservationID string `json:"reservation_id" validate:"required,uuid4"`
+	SessionID     string `json:"session_id" validate:"required"`
+}
+
+// FindReservationTickets returns the tickets issued for a confirmed reservation held by the session.
+// A reservation that is not confirmed has no valid tickets, including one cancelled after a refund.
+func (u *reservationUsecase) FindReservationTickets(ctx context.Context, input FindReservationTicketsInput) (tickets entity.Tickets, err error) {
+	const errLocation = "[usecase reservation/find_reservation_tickets FindReservationTickets] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("reservation.usecase"), func(ctx context.Context) (entity.Tickets, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		reservationID, err := uuid.Parse(input.ReservationID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid reservation ID", nil))
+			return nil, err
+		}
+
+		reservation, err := u.reservationRepository.FindOne(ctx, reservationID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find reservation by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		// Only the session holding the reservation may see its tickets
+		if reservation.SessionID != input.SessionID {
+			err = errsFramework.NewForbiddenError("the reservation does not belong to this session", nil)
+			return nil, err
+		}
+		if reservation.Status != entity.ReservationStatusConfirmed {
+			err = errsFramework.NewConflictError("tickets are only issued for confirmed reservations", map[string]string{"status": reservation.Status.String()})
+			return nil, err
+		}
+
+		found, err := u.ticketRepository.FindAllByReservationID(ctx, reservation.ID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find tickets by reservation ID", nil))
+			return nil, err
+		}
+
+		return pointer.GetValue(found), nil
+	})
+}
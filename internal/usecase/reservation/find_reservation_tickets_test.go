@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	reservationusecase "ticket-reservation/internal/usecase/reservation"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestReservationUsecase_FindReservationTickets(t *testing.T) {
+	reservationID := uuid.New()
+	sessionID := "session-123"
+	issuedAt := time.Date(2025, 1, 1, 3, 3, 0, 0, time.UTC)
+
+	confirmedReservation := &entity.Reservation{
+		ID:        reservationID,
+		SessionID: sessionID,
+		Status:    entity.ReservationStatusConfirmed,
+	}
+	tickets := entity.Tickets{
+		{
+			ID:            uuid.New(),
+			ReservationID: reservationID,
+			ZoneName:      "VIP",
+			SeatNumber:    "A1",
+			Holder:        entity.TicketHolder(sessionID),
+			Payload:       "eyJ0aWQiOiIxIn0.c2lnbmF0dXJl",
+			IssuedAt:      issuedAt,
+		},
+	}
+
+	validInput := reservationusecase.FindReservationTicketsInput{
+		ReservationID: reservationID.String(),
+		SessionID:     sessionID,
+	}
+
+	tests := []struct {
+		name           string
+		input          reservationusecase.FindReservationTicketsInput
+		setupMocks     func(h *testHelper)
+		expectedResult entity.Tickets
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "successful retrieval",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+				h.mockTicketRepository.EXPECT().FindAllByReservationID(gomock.Any(), reservationID).Return(&tickets, nil)
+			},
+			expectedResult: tickets,
+		},
+		{
+			name: "validation error - invalid reservation ID",
+			input: reservationusecase.FindReservationTicketsInput{
+				ReservationID: "invalid-uuid",
+				SessionID:     sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "reservation not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(nil, errsFramework.NewNotFoundError("reservation not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "reservation not found",
+		},
+		{
+			name:  "reservation repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find reservation by ID",
+		},
+		{
+			name: "reservation belongs to another session",
+			input: reservationusecase.FindReservationTicketsInput{
+				ReservationID: reservationID.String(),
+				SessionID:     "another-session",
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the reservation does not belong to this session",
+		},
+		{
+			name:  "reservation cancelled after a refund has no tickets",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(&entity.Reservation{
+					ID:        reservationID,
+					SessionID: sessionID,
+					Status:    entity.ReservationStatusCancelled,
+				}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "tickets are only issued for confirmed reservations",
+		},
+		{
+			name:  "ticket repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockReservationRepository.EXPECT().FindOne(gomock.Any(), reservationID).Return(confirmedReservation, nil)
+				h.mockTicketRepository.EXPECT().FindAllByReservationID(gomock.Any(), reservationID).Return(nil, errsFramework.NewDatabaseError("database error", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find tickets by reservation ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.reservationUsecase.FindReservationTickets(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase reservation/find_reservation_tickets FindReservationTickets]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
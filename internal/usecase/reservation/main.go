@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/reservation_usecase.go -package=reservation_usecasemocks
+type ReservationUsecase interface {
+	CancelReservation(ctx context.Context, input CancelReservationInput) (*entity.Reservation, error)
+	CleanupExpiredReservations(ctx context.Context) (*CleanupExpiredReservationsResult, error)
+	FindReservationTickets(ctx context.Context, input FindReservationTicketsInput) (entity.Tickets, error)
+}
+
+type reservationUsecase struct {
+	appConfig             config.AppConfig
+	transactorFactory     db.SqlxTransactorFactory
+	zoneRepository        repository.ZoneRepository
+	seatRepository        repository.SeatRepository
+	reservationRepository repository.ReservationRepository
+	ticketRepository      repository.TicketRepository
+	seatLockerRepository  cache.SeatLockerRepository
+	seatMapRepository     cache.SeatMapRepository
+	eventPublisher        gateway.EventPublisher
+}
+
+func NewReservationUsecase(
+	appConfig config.AppConfig,
+	transactorFactory db.SqlxTransactorFactory,
+	zoneRepository repository.ZoneRepository,
+	seatRepository repository.SeatRepository,
+	reservationRepository repository.ReservationRepository,
+	ticketRepository repository.TicketRepository,
+	seatLockerRepository cache.SeatLockerRepository,
+	seatMapRepository cache.SeatMapRepository,
+	eventPublisher gateway.EventPublisher,
+) ReservationUsecase {
+	return &reservationUsecase{
+		appConfig:             appConfig,
+		transactorFactory:     transactorFactory,
+		zoneRepository:        zoneRepository,
+		seatRepository:        seatRepository,
+		reservationRepository: reservationRepository,
+		ticketRepository:      ticketRepository,
+		seatLockerRepository:  seatLockerRepository,
+		seatMapRepository:     seatMapRepository,
+		eventPublisher:        eventPublisher,
+	}
+}
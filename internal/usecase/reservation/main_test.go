@@ -0,0 +1,113 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	gateway_mocks "ticket-reservation/internal/domain/gateway/mocks"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	db_mocks "ticket-reservation/internal/infra/db/mocks"
+	reservationusecase "ticket-reservation/internal/usecase/reservation"
+)
+
+type testHelper struct {
+	ctrl                      *gomock.Controller
+	appConfig                 config.AppConfig
+	mockTransactorFactory     *db_mocks.MockSqlxTransactorFactory
+	mockTransactor            *db_mocks.MockSqlxTransactor
+	mockZoneRepository        *repository_mocks.MockZoneRepository
+	mockSeatRepository        *repository_mocks.MockSeatRepository
+	mockReservationRepository *repository_mocks.MockReservationRepository
+	mockTicketRepository      *repository_mocks.MockTicketRepository
+	mockSeatLockerRepository  *cache_mocks.MockSeatLockerRepository
+	mockSeatMapRepository     *cache_mocks.MockSeatMapRepository
+	mockEventPublisher        *gateway_mocks.MockEventPublisher
+	reservationUsecase        reservationusecase.ReservationUsecase
+}
+
+func initTest(t *testing.T, configOverrides ...func(cfg *config.AppConfig)) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	for _, override := range configOverrides {
+		override(&appConfig)
+	}
+
+	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
+	mockTransactor := db_mocks.NewMockSqlxTransactor(ctrl)
+	mockZoneRepository := repository_mocks.NewMockZoneRepository(ctrl)
+	mockSeatRepository := repository_mocks.NewMockSeatRepository(ctrl)
+	mockReservationRepository := repository_mocks.NewMockReservationRepository(ctrl)
+	mockTicketRepository := repository_mocks.NewMockTicketRepository(ctrl)
+	mockSeatLockerRepository := cache_mocks.NewMockSeatLockerRepository(ctrl)
+	mockSeatMapRepository := cache_mocks.NewMockSeatMapRepository(ctrl)
+	mockEventPublisher := gateway_mocks.NewMockEventPublisher(ctrl)
+
+	usecase := reservationusecase.NewReservationUsecase(
+		appConfig,
+		mockTransactorFactory,
+		mockZoneRepository,
+		mockSeatRepository,
+		mockReservationRepository,
+		mockTicketRepository,
+		mockSeatLockerRepository,
+		mockSeatMapRepository,
+		mockEventPublisher,
+	)
+
+	return &testHelper{
+		ctrl:                      ctrl,
+		appConfig:                 appConfig,
+		mockTransactorFactory:     mockTransactorFactory,
+		mockTransactor:            mockTransactor,
+		mockZoneRepository:        mockZoneRepository,
+		mockSeatRepository:        mockSeatRepository,
+		mockReservationRepository: mockReservationRepository,
+		mockTicketRepository:      mockTicketRepository,
+		mockSeatLockerRepository:  mockSeatLockerRepository,
+		mockSeatMapRepository:     mockSeatMapRepository,
+		mockEventPublisher:        mockEventPublisher,
+		reservationUsecase:        usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewReservationUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone:    "Asia/Bangkok",
+		SeatLockTTL: 5 * time.Minute,
+	}
+
+	// Execute
+	usecase := reservationusecase.NewReservationUsecase(
+		appConfig,
+		db_mocks.NewMockSqlxTransactorFactory(ctrl),
+		repository_mocks.NewMockZoneRepository(ctrl),
+		repository_mocks.NewMockSeatRepository(ctrl),
+		repository_mocks.NewMockReservationRepository(ctrl),
+		repository_mocks.NewMockTicketRepository(ctrl),
+		cache_mocks.NewMockSeatLockerRepository(ctrl),
+		cache_mocks.NewMockSeatMapRepository(ctrl),
+		gateway_mocks.NewMockEventPublisher(ctrl),
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
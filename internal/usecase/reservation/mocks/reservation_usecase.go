@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package reservation_usecasemocks is a generated GoMock package.
+package reservation_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/reservation"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReservationUsecase is a mock of ReservationUsecase interface.
+type MockReservationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationUsecaseMockRecorder
+}
+
+// MockReservationUsecaseMockRecorder is the mock recorder for MockReservationUsecase.
+type MockReservationUsecaseMockRecorder struct {
+	mock *MockReservationUsecase
+}
+
+// NewMockReservationUsecase creates a new mock instance.
+func NewMockReservationUsecase(ctrl *gomock.Controller) *MockReservationUsecase {
+	mock := &MockReservationUsecase{ctrl: ctrl}
+	mock.recorder = &MockReservationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservationUsecase) EXPECT() *MockReservationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CancelReservation mocks base method.
+func (m *MockReservationUsecase) CancelReservation(ctx context.Context, input usecase.CancelReservationInput) (*entity.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReservation", ctx, input)
+	ret0, _ := ret[0].(*entity.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReservation indicates an expected call of CancelReservation.
+func (mr *MockReservationUsecaseMockRecorder) CancelReservation(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReservation", reflect.TypeOf((*MockReservationUsecase)(nil).CancelReservation), ctx, input)
+}
+
+// CleanupExpiredReservations mocks base method.
+func (m *MockReservationUsecase) CleanupExpiredReservations(ctx context.Context) (*usecase.CleanupExpiredReservationsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupExpiredReservations", ctx)
+	ret0, _ := ret[0].(*usecase.CleanupExpiredReservationsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupExpiredReservations indicates an expected call of CleanupExpiredReservations.
+func (mr *MockReservationUsecaseMockRecorder) CleanupExpiredReservations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupExpiredReservations", reflect.TypeOf((*MockReservationUsecase)(nil).CleanupExpiredReservations), ctx)
+}
+
+// FindReservationTickets mocks base method.
+func (m *MockReservationUsecase) FindReservationTickets(ctx context.Context, input usecase.FindReservationTicketsInput) (entity.Tickets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReservationTickets", ctx, input)
+	ret0, _ := ret[0].(entity.Tickets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReservationTickets indicates an expected call of FindReservationTickets.
+func (mr *MockReservationUsecaseMockRecorder) FindReservationTickets(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReservationTickets", reflect.TypeOf((*MockReservationUsecase)(nil).FindReservationTickets), ctx, input)
+}
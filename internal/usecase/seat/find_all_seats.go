@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindAllSeatsInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+	ZoneID    string `json:"zone_id" validate:"required,uuid4"`
+}
+
+// SeatMap is every seat of a zone, the zone carries the price and currency of the seats that do not override it.
+type SeatMap struct {
+	Zone  *entity.Zone
+	Seats entity.Seats
+}
+
+// FindAllSeats returns every seat in a zone with its effective status, along with the zone.
+// The Redis seat map is read first; only when some seats are missing from it (never cached or expired)
+// the seats are loaded from Postgres and the missing seats are written back to the seat map.
+func (u *seatUsecase) FindAllSeats(ctx context.Context, input FindAllSeatsInput) (seatMap *SeatMap, err error) {
+	const errLocation = "[usecase seat/find_all_seats FindAllSeats] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("seat.usecase"), func(ctx context.Context) (*SeatMap, error) {
+		logger := commonLogger.FromContext(ctx)
+		requestTime := time.Now()
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+		zoneID, err := uuid.Parse(input.ZoneID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+		}
+
+		logFields := commonLogger.Fields{
+			"concert_id": concertID,
+			"zone_id":    zoneID,
+		}
+
+		// The zone is always loaded, it carries the price of the seats and must belong to the concert
+		zone, err := u.zoneRepository.FindOne(ctx, zoneID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if zone.ConcertID != concertID {
+			return nil, errsFramework.NewNotFoundError("zone not found", nil)
+		}
+
+		// Read the seat map from Redis; cache failures fall back to Postgres instead of failing the request
+		cachedSeats := entity.Seats{}
+		if result, cacheErr := u.seatMapRepository.GetAllSeats(ctx, concertID, zoneID); cacheErr != nil {
+			logger.Error(ctx, "failed to get seat map from Redis", cacheErr, logFields)
+		} else {
+			cachedSeats = pointer.GetValue(result)
+		}
+
+		seatCount, cacheErr := u.seatMapRepository.GetSeatCount(ctx, concertID, zoneID)
+		if cacheErr != nil {
+			if !errors.As(cacheErr, &errsFramework.NotFoundError{}) {
+				logger.Error(ctx, "failed to get seat count from Redis", cacheErr, logFields)
+			}
+			seatCount = -1 // Unknown, force a Postgres read
+		}
+
+		// Serve the seat map straight from Redis when every seat is present
+		if seatCount >= 0 && int64(len(cachedSeats)) >= seatCount {
+			return &SeatMap{Zone: zone, Seats: u.toEffectiveSeats(cachedSeats, requestTime)}, nil
+		}
+
+		dbSeats, err := u.seatRepository.FindAllByZone(ctx, zoneID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seats by zone", nil))
+		}
+
+		// Fill the seats missing from the seat map with the Postgres rows
+		cachedSeatNumbers := make(map[string]struct{}, len(cachedSeats))
+		for _, seat := range cachedSeats {
+			cachedSeatNumbers[seat.SeatNumber] = struct{}{}
+		}
+		missingSeats := entity.Seats{}
+		for _, seat := range pointer.GetValue(dbSeats) {
+			if _, ok := cachedSeatNumbers[seat.SeatNumber]; !ok {
+				missingSeats = append(missingSeats, seat)
+			}
+		}
+		mergedSeats := append(cachedSeats, missingSeats...)
+
+		// Warm the seat map so that subsequent reads stay off Postgres
+		if setMapErr := u.seatMapRepository.SetSeats(ctx, concertID, zoneID, missingSeats, cache.SeatMapNoExpiration); setMapErr != nil {
+			logger.Error(ctx, "failed to update seat map in Redis", setMapErr, logFields)
+		}
+		if setCountErr := u.seatMapRepository.SetSeatCount(ctx, concertID, zoneID, int64(len(pointer.GetValue(dbSeats)))); setCountErr != nil {
+			logger.Error(ctx, "failed to update seat count in Redis", setCountErr, logFields)
+		}
+
+		return &SeatMap{Zone: zone, Seats: u.toEffectiveSeats(mergedSeats, requestTime)}, nil
+	})
+}
+
+// toEffectiveSeats returns a sorted copy of the seats with expired locks reported as available.
+func (u *seatUsecase) toEffectiveSeats(seats entity.Seats, now time.Time) entity.Seats {
+	result := make(entity.Seats, 0, len(seats))
+	for _, seat := range seats {
+		if status := seat.EffectiveStatus(now); status != seat.Status {
+			seat.Status = status
+			seat.LockedUntil = nil
+			seat.LockedBySessionID = nil
+		}
+		result = append(result, seat)
+	}
+	result.SortBySeatNumber()
+	return result
+}
@@ -0,0 +1,211 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	seatusecase "ticket-reservation/internal/usecase/seat"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatUsecase_FindAllSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	now := time.Now()
+	expiredLock := now.Add(-time.Minute)
+	activeLock := now.Add(time.Minute)
+
+	seatA1 := entity.Seat{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable}
+	seatA2 := entity.Seat{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A2", Status: entity.SeatStatusPending, LockedUntil: &activeLock, LockedBySessionID: pointer.ToPointer("session-1")}
+	seatA10 := entity.Seat{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "A10", Status: entity.SeatStatusBooked}
+	seatB1Expired := entity.Seat{ID: uuid.New(), ZoneID: zoneID, SeatNumber: "B1", Status: entity.SeatStatusPending, LockedUntil: &expiredLock, LockedBySessionID: pointer.ToPointer("session-2")}
+	seatB1Available := entity.Seat{ID: seatB1Expired.ID, ZoneID: zoneID, SeatNumber: "B1", Status: entity.SeatStatusAvailable}
+
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+
+	validInput := seatusecase.FindAllSeatsInput{
+		ConcertID: concertID.String(),
+		ZoneID:    zoneID.String(),
+	}
+
+	tests := []struct {
+		name           string
+		input          seatusecase.FindAllSeatsInput
+		setupMocks     func(h *testHelper)
+		expectedResult *seatusecase.SeatMap
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "serves complete seat map from cache",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(zone, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetAllSeats(gomock.Any(), concertID, zoneID).
+					Return(&entity.Seats{seatA10, seatB1Expired, seatA1, seatA2}, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetSeatCount(gomock.Any(), concertID, zoneID).
+					Return(int64(4), nil)
+			},
+			expectedResult: &seatusecase.SeatMap{Zone: zone, Seats: entity.Seats{seatA1, seatA2, seatA10, seatB1Available}},
+			expectedError:  false,
+		},
+		{
+			name:  "fills missing seats from Postgres and writes them back",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(zone, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetAllSeats(gomock.Any(), concertID, zoneID).
+					Return(&entity.Seats{seatA1}, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetSeatCount(gomock.Any(), concertID, zoneID).
+					Return(int64(4), nil)
+				h.mockSeatRepository.EXPECT().
+					FindAllByZone(gomock.Any(), zoneID).
+					Return(&entity.Seats{seatA1, seatA10, seatA2, seatB1Expired}, nil)
+				h.mockSeatMapRepository.EXPECT().
+					SetSeats(gomock.Any(), concertID, zoneID, entity.Seats{seatA10, seatA2, seatB1Expired}, domaincache.SeatMapNoExpiration).
+					Return(nil)
+				h.mockSeatMapRepository.EXPECT().
+					SetSeatCount(gomock.Any(), concertID, zoneID, int64(4)).
+					Return(nil)
+			},
+			expectedResult: &seatusecase.SeatMap{Zone: zone, Seats: entity.Seats{seatA1, seatA2, seatA10, seatB1Available}},
+			expectedError:  false,
+		},
+		{
+			name:  "falls back to Postgres when Redis is unavailable",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(zone, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetAllSeats(gomock.Any(), concertID, zoneID).
+					Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+				h.mockSeatMapRepository.EXPECT().
+					GetSeatCount(gomock.Any(), concertID, zoneID).
+					Return(int64(0), errsFramework.NewDatabaseError("connection failed", "error"))
+				h.mockSeatRepository.EXPECT().
+					FindAllByZone(gomock.Any(), zoneID).
+					Return(&entity.Seats{seatA1, seatA2}, nil)
+				h.mockSeatMapRepository.EXPECT().
+					SetSeats(gomock.Any(), concertID, zoneID, entity.Seats{seatA1, seatA2}, domaincache.SeatMapNoExpiration).
+					Return(errsFramework.NewDatabaseError("connection failed", "error"))
+				h.mockSeatMapRepository.EXPECT().
+					SetSeatCount(gomock.Any(), concertID, zoneID, int64(2)).
+					Return(errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedResult: &seatusecase.SeatMap{Zone: zone, Seats: entity.Seats{seatA1, seatA2}},
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid zone ID",
+			input: seatusecase.FindAllSeatsInput{
+				ConcertID: concertID.String(),
+				ZoneID:    "invalid-uuid",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "zone not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "zone belongs to another concert",
+			input: seatusecase.FindAllSeatsInput{
+				ConcertID: uuid.New().String(),
+				ZoneID:    zoneID.String(),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(zone, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name:  "seat repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(zone, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetAllSeats(gomock.Any(), concertID, zoneID).
+					Return(&entity.Seats{}, nil)
+				h.mockSeatMapRepository.EXPECT().
+					GetSeatCount(gomock.Any(), concertID, zoneID).
+					Return(int64(0), errsFramework.NewNotFoundError("seat count not found in cache", nil))
+				h.mockSeatRepository.EXPECT().
+					FindAllByZone(gomock.Any(), zoneID).
+					Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find seats by zone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.seatUsecase.FindAllSeats(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase seat/find_all_seats FindAllSeats]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
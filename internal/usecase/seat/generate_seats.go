@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+	"github.com/shopspring/decimal"
+)
+
+type GenerateSeatsInput struct {
+	ConcertID          string            `json:"concert_id" validate:"required,uuid4"`
+	ZoneID             string            `json:"zone_id" validate:"required,uuid4"`
+	RowLabels          []string          `json:"row_labels" validate:"required,min=1,unique,dive,required,alpha"`
+	SeatsPerRow        int               `json:"seats_per_row" validate:"required,gte=1,lte=1000"`
+	SkippedNumbers     []int             `json:"skipped_numbers" validate:"omitempty,unique,dive,gte=1"`
+	AislesAfter        []int             `json:"aisles_after" validate:"omitempty,unique,dive,gte=1"`
+	NumberingDirection string            `json:"numbering_direction" validate:"required,oneof=left_to_right right_to_left"`
+	Price              *string           `json:"price" validate:"omitempty"` // Overrides the zone price for every generated seat
+	Principal          *entity.Principal `json:"-"`                          // User generating the seats, they must manage the concert
+}
+
+// GenerateSeats inserts every seat of a zone layout in a single transaction.
+// If any generated seat number already exists in the zone nothing is inserted and
+// a SeatsAlreadyExistError listing each clashing seat is returned.
+func (u *seatUsecase) GenerateSeats(ctx context.Context, input GenerateSeatsInput) (seats *entity.Seats, err error) {
+	const errLocation = "[usecase seat/generate_seats GenerateSeats] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("seat.usecase"), func(ctx context.Context) (*entity.Seats, error) {
+		logger := commonLogger.FromContext(ctx)
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+			return nil, err
+		}
+		zoneID, err := uuid.Parse(input.ZoneID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+			return nil, err
+		}
+		numberingDirection, err := new(entity.SeatNumberingDirection).Parse(input.NumberingDirection)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid numbering direction", nil))
+			return nil, err
+		}
+
+		for _, seat := range input.AislesAfter {
+			if seat >= input.SeatsPerRow {
+				err = errsFramework.NewBadRequestError("invalid aisle", map[string]string{"details": "an aisle must run between two seats of the row"})
+				return nil, err
+			}
+		}
+
+		var price *decimal.Decimal
+		if input.Price != nil {
+			parsedPrice, err := decimal.NewFromString(*input.Price)
+			if err != nil {
+				err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid price", nil))
+				return nil, err
+			}
+			if !entity.IsValidPrice(parsedPrice) {
+				err = errsFramework.NewBadRequestError("invalid price", map[string]string{"details": "price must not be negative and have at most 2 decimal places"})
+				return nil, err
+			}
+			price = &parsedPrice
+		}
+
+		layout := entity.SeatLayout{
+			RowLabels:          input.RowLabels,
+			SeatsPerRow:        input.SeatsPerRow,
+			SkippedNumbers:     input.SkippedNumbers,
+			AislesAfter:        input.AislesAfter,
+			NumberingDirection: numberingDirection,
+		}
+		generatedSeats := layout.GenerateSeats(zoneID)
+		for i := range generatedSeats {
+			generatedSeats[i].Price = price
+		}
+
+		// Only the admins and the organizer of the concert lay out its seats
+		concert, err := u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if !concert.IsManageableBy(input.Principal) {
+			err = errsFramework.NewForbiddenError("the concert is managed by another organizer", nil)
+			return nil, err
+		}
+
+		// Insert the seats first, the seat map only ever shows committed seats
+		existingSeatCount, createdSeats, err := u.createLayoutSeats(ctx, concertID, zoneID, generatedSeats)
+		if err != nil {
+			return nil, err
+		}
+
+		// Add the new seats to the seat map and refresh the zone seat count
+		logFields := commonLogger.Fields{
+			"concert_id": concertID,
+			"zone_id":    zoneID,
+		}
+		if setMapErr := u.seatMapRepository.SetSeats(ctx, concertID, zoneID, pointer.GetValue(createdSeats), cache.SeatMapNoExpiration); setMapErr != nil {
+			logger.Error(ctx, "failed to update seat map in Redis", setMapErr, logFields)
+		}
+		seatCount := int64(existingSeatCount + len(pointer.GetValue(createdSeats)))
+		if setCountErr := u.seatMapRepository.SetSeatCount(ctx, concertID, zoneID, seatCount); setCountErr != nil {
+			logger.Error(ctx, "failed to update seat count in Redis", setCountErr, logFields)
+		}
+
+		return createdSeats, nil
+	})
+}
+
+// createLayoutSeats inserts the generated seats of a zone in a single transaction and returns them along with the
+// number of seats the zone already had. The zone row stays locked until the commit so that concurrent generations
+// for the same zone are serialized.
+func (u *seatUsecase) createLayoutSeats(ctx context.Context, concertID, zoneID uuid.UUID, generatedSeats entity.Seats) (existingSeatCount int, createdSeats *entity.Seats, err error) {
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return 0, nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+		}
+	}()
+
+	// Lock the zone row so that concurrent generations for the same zone are serialized
+	zone, err := u.zoneRepository.WithTx(tx.DB()).FindOne(ctx, zoneID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			return 0, nil, err
+		}
+		return 0, nil, err // Return the NotFoundError directly
+	}
+	if zone.ConcertID != concertID {
+		err = errsFramework.NewNotFoundError("zone not found", nil)
+		return 0, nil, err
+	}
+
+	// Check the generated seats against the UNIQUE(zone_id, seat_number) constraint up front
+	existingSeats, err := u.seatRepository.WithTx(tx.DB()).FindAllByZone(ctx, zoneID)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seats by zone", nil))
+		return 0, nil, err
+	}
+	existingSeatNumbers := make(map[string]struct{}, len(pointer.GetValue(existingSeats)))
+	for _, seat := range pointer.GetValue(existingSeats) {
+		existingSeatNumbers[seat.SeatNumber] = struct{}{}
+	}
+	conflicts := []errs.SeatConflict{}
+	for _, seat := range generatedSeats {
+		if _, ok := existingSeatNumbers[seat.SeatNumber]; ok {
+			conflicts = append(conflicts, errs.SeatConflict{
+				SeatNumber: seat.SeatNumber,
+				Reason:     "the seat already exists in the zone",
+			})
+		}
+	}
+	if len(conflicts) > 0 {
+		err = errs.NewSeatsAlreadyExistError(conflicts)
+		return 0, nil, err
+	}
+
+	createdSeats, err = u.seatRepository.WithTx(tx.DB()).CreateMany(ctx, generatedSeats)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create seats", nil))
+		return 0, nil, err
+	}
+	return len(pointer.GetValue(existingSeats)), createdSeats, nil
+}
@@ -0,0 +1,369 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	seatusecase "ticket-reservation/internal/usecase/seat"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatUsecase_GenerateSeats(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	organizer := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer}
+	concert := &entity.Concert{ID: concertID, Name: "Test Concert", OrganizerID: &organizer.UserID}
+
+	newSeat := func(seatNumber string, position int) entity.Seat {
+		return entity.Seat{ZoneID: zoneID, SeatNumber: seatNumber, Status: entity.SeatStatusAvailable, Position: pointer.ToPointer(position)}
+	}
+	withID := func(seats entity.Seats) *entity.Seats {
+		created := make(entity.Seats, 0, len(seats))
+		for _, seat := range seats {
+			seat.ID = uuid.New()
+			created = append(created, seat)
+		}
+		return &created
+	}
+
+	validInput := seatusecase.GenerateSeatsInput{
+		ConcertID:          concertID.String(),
+		ZoneID:             zoneID.String(),
+		RowLabels:          []string{"A", "B"},
+		SeatsPerRow:        4,
+		SkippedNumbers:     []int{3},
+		AislesAfter:        []int{2},
+		NumberingDirection: "left_to_right",
+		Principal:          organizer,
+	}
+	// The numbering jumps over 3 without leaving a gap, the aisle after the second seat does
+	expectedLeftToRight := entity.Seats{
+		newSeat("A1", 1), newSeat("A2", 2), newSeat("A4", 4), newSeat("A5", 5),
+		newSeat("B1", 1), newSeat("B2", 2), newSeat("B4", 4), newSeat("B5", 5),
+	}
+	createdLeftToRight := withID(expectedLeftToRight)
+
+	// expectConcert sets up the lookup of the concert whose organizer is checked
+	expectConcert := func(h *testHelper) {
+		h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), gomock.Any()).Return(concert, nil)
+	}
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		input          seatusecase.GenerateSeatsInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Seats
+		expectedError  bool
+		errorType      error
+		errorContains  string
+		assertError    func(t *testing.T, err error)
+	}{
+		{
+			name:  "successful generation left to right with skipped numbers and an aisle",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, true)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&entity.Seats{newSeat("C1", 1)}, nil)
+				h.mockSeatRepository.EXPECT().CreateMany(gomock.Any(), expectedLeftToRight).Return(createdLeftToRight, nil)
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID, *createdLeftToRight, domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeatCount(gomock.Any(), concertID, zoneID, int64(9)).Return(nil)
+			},
+			expectedResult: createdLeftToRight,
+			expectedError:  false,
+		},
+		{
+			name: "successful generation right to left",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        3,
+				NumberingDirection: "right_to_left",
+				Principal:          organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, true)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&entity.Seats{}, nil)
+				h.mockSeatRepository.EXPECT().
+					CreateMany(gomock.Any(), entity.Seats{newSeat("A3", 1), newSeat("A2", 2), newSeat("A1", 3)}).
+					Return(&entity.Seats{newSeat("A3", 1), newSeat("A2", 2), newSeat("A1", 3)}, nil)
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID, gomock.Any(), domaincache.SeatMapNoExpiration).Return(errors.New("redis down"))
+				h.mockSeatMapRepository.EXPECT().SetSeatCount(gomock.Any(), concertID, zoneID, int64(3)).Return(errors.New("redis down"))
+			},
+			expectedResult: &entity.Seats{newSeat("A3", 1), newSeat("A2", 2), newSeat("A1", 3)},
+			expectedError:  false,
+		},
+		{
+			name: "successful generation with price override",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        2,
+				NumberingDirection: "left_to_right",
+				Price:              pointer.ToPointer("4500.00"),
+				Principal:          organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, true)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&entity.Seats{}, nil)
+				h.mockSeatRepository.EXPECT().
+					CreateMany(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, seats entity.Seats) (*entity.Seats, error) {
+						require.Len(t, seats, 2)
+						for _, seat := range seats {
+							require.NotNil(t, seat.Price)
+							assert.Equal(t, "4500.00", seat.Price.StringFixed(2))
+						}
+						return &entity.Seats{newSeat("A1", 1), newSeat("A2", 2)}, nil
+					})
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID, gomock.Any(), domaincache.SeatMapNoExpiration).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeatCount(gomock.Any(), concertID, zoneID, int64(2)).Return(nil)
+			},
+			expectedResult: &entity.Seats{newSeat("A1", 1), newSeat("A2", 2)},
+			expectedError:  false,
+		},
+		{
+			name: "validation error - negative price",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        2,
+				NumberingDirection: "left_to_right",
+				Price:              pointer.ToPointer("-10"),
+				Principal:          organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid price",
+		},
+		{
+			name: "validation error - duplicated row labels",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A", "A"},
+				SeatsPerRow:        4,
+				NumberingDirection: "left_to_right",
+				Principal:          organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - invalid numbering direction",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        4,
+				NumberingDirection: "top_to_bottom",
+				Principal:          organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "aisle after the last seat of the row",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        4,
+				AislesAfter:        []int{4},
+				NumberingDirection: "left_to_right",
+				Principal:          organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid aisle",
+		},
+		{
+			name:  "concert not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name: "forbidden - concert managed by another organizer",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          concertID.String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        4,
+				NumberingDirection: "left_to_right",
+				Principal:          &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer},
+			},
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the concert is managed by another organizer",
+		},
+		{
+			name:  "zone not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, false)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "zone belongs to another concert",
+			input: seatusecase.GenerateSeatsInput{
+				ConcertID:          uuid.New().String(),
+				ZoneID:             zoneID.String(),
+				RowLabels:          []string{"A"},
+				SeatsPerRow:        4,
+				NumberingDirection: "left_to_right",
+				Principal:          organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, false)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name:  "seat map is not updated when the commit fails",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+				h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&entity.Seats{}, nil)
+				h.mockSeatRepository.EXPECT().CreateMany(gomock.Any(), expectedLeftToRight).Return(createdLeftToRight, nil)
+				h.mockTransactor.EXPECT().Commit().Return(errors.New("connection reset"))
+				// No SetSeats or SetSeatCount expectation, Redis must never show seats that were not saved
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to commit transaction",
+		},
+		{
+			name:  "clashes with existing seats are reported per seat",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, false)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&entity.Seats{newSeat("A2", 2), newSeat("B4", 4), newSeat("C1", 1)}, nil)
+			},
+			expectedError: true,
+			errorType:     &errs.SeatsAlreadyExistError{},
+			errorContains: "some seats already exist in the zone",
+			assertError: func(t *testing.T, err error) {
+				var conflictErr *errs.SeatsAlreadyExistError
+				require.ErrorAs(t, err, &conflictErr)
+				assert.Equal(t, map[string]interface{}{
+					"conflicts": []errs.SeatConflict{
+						{SeatNumber: "A2", Reason: "the seat already exists in the zone"},
+						{SeatNumber: "B4", Reason: "the seat already exists in the zone"},
+					},
+				}, conflictErr.GetData())
+			},
+		},
+		{
+			name:  "seat repository error on insert",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectConcert(h)
+				expectTx(h, false)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&entity.Seats{}, nil)
+				h.mockSeatRepository.EXPECT().CreateMany(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("insert failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create seats",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.seatUsecase.GenerateSeats(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase seat/generate_seats GenerateSeats]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				if tt.assertError != nil {
+					tt.assertError(t, err)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
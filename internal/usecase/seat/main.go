@@ -5,13 +5,28 @@ import (
 	"ticket-reservation/internal/config"
 	"ticket-reservation/internal/domain/cache"
 	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
 	"ticket-reservation/internal/domain/repository"
 	"ticket-reservation/internal/infra/db"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
 )
 
 //go:generate mockgen -source=./main.go -destination=./mocks/seat_usecase.go -package=seat_usecasemocks
 type SeatUsecase interface {
 	ReserveSeat(ctx context.Context, input ReserveSeatInput) (*entity.Reservation, error)
+	ReserveSeats(ctx context.Context, input ReserveSeatsInput) (*entity.Reservations, error)
+	ReserveBestAvailableSeats(ctx context.Context, input ReserveBestAvailableSeatsInput) (*BestAvailableSeats, error)
+	FindAllSeats(ctx context.Context, input FindAllSeatsInput) (*SeatMap, error)
+	GenerateSeats(ctx context.Context, input GenerateSeatsInput) (*entity.Seats, error)
+	StreamSeatStatus(ctx context.Context, input StreamSeatStatusInput) (SeatStatusStream, error)
+}
+
+// SeatStatusStream sends the status changes of the seats of a zone to one client, it must be closed once done with.
+type SeatStatusStream interface {
+	Next(ctx context.Context) (*SeatStatusUpdate, error)
+	Close()
 }
 
 type seatUsecase struct {
@@ -23,6 +38,8 @@ type seatUsecase struct {
 	transactorFactory     db.SqlxTransactorFactory
 	seatLockerRepository  cache.SeatLockerRepository
 	seatMapRepository     cache.SeatMapRepository
+	seatEventRepository   cache.SeatEventRepository
+	eventPublisher        gateway.EventPublisher
 }
 
 func NewSeatUsecase(
@@ -34,6 +51,8 @@ func NewSeatUsecase(
 	transactorFactory db.SqlxTransactorFactory,
 	seatLockerRepository cache.SeatLockerRepository,
 	seatMapRepository cache.SeatMapRepository,
+	seatEventRepository cache.SeatEventRepository,
+	eventPublisher gateway.EventPublisher,
 ) SeatUsecase {
 	return &seatUsecase{
 		appConfig:             appConfig,
@@ -44,5 +63,23 @@ func NewSeatUsecase(
 		transactorFactory:     transactorFactory,
 		seatLockerRepository:  seatLockerRepository,
 		seatMapRepository:     seatMapRepository,
+		seatEventRepository:   seatEventRepository,
+		eventPublisher:        eventPublisher,
+	}
+}
+
+// enqueueReservationEvents creates the webhook deliveries telling subscribers about the reservations created and
+// expired by a reservation, in its transaction.
+func (u *seatUsecase) enqueueReservationEvents(ctx context.Context, tx db.SqlExecer, created, expired entity.Reservations, occurredAt time.Time) error {
+	events := append(
+		entity.NewWebhookEventsForReservations(entity.WebhookEventTypeReservationExpired, expired, occurredAt),
+		entity.NewWebhookEventsForReservations(entity.WebhookEventTypeReservationCreated, created, occurredAt)...,
+	)
+	if len(events) == 0 {
+		return nil
+	}
+	if err := u.eventPublisher.EnqueueWebhookEvents(ctx, tx, events); err != nil {
+		return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to enqueue webhook events", nil))
 	}
+	return nil
 }
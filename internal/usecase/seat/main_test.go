@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	gateway_mocks "ticket-reservation/internal/domain/gateway/mocks"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	db_mocks "ticket-reservation/internal/infra/db/mocks"
+	seatusecase "ticket-reservation/internal/usecase/seat"
+)
+
+type testHelper struct {
+	ctrl                      *gomock.Controller
+	appConfig                 config.AppConfig
+	mockConcertRepository     *repository_mocks.MockConcertRepository
+	mockZoneRepository        *repository_mocks.MockZoneRepository
+	mockSeatRepository        *repository_mocks.MockSeatRepository
+	mockReservationRepository *repository_mocks.MockReservationRepository
+	mockTransactorFactory     *db_mocks.MockSqlxTransactorFactory
+	mockTransactor            *db_mocks.MockSqlxTransactor
+	mockSeatLockerRepository  *cache_mocks.MockSeatLockerRepository
+	mockSeatMapRepository     *cache_mocks.MockSeatMapRepository
+	mockSeatEventRepository   *cache_mocks.MockSeatEventRepository
+	mockEventPublisher        *gateway_mocks.MockEventPublisher
+	seatUsecase               seatusecase.SeatUsecase
+}
+
+func initTest(t *testing.T, configOverrides ...func(cfg *config.AppConfig)) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	for _, override := range configOverrides {
+		override(&appConfig)
+	}
+
+	mockConcertRepository := repository_mocks.NewMockConcertRepository(ctrl)
+	mockZoneRepository := repository_mocks.NewMockZoneRepository(ctrl)
+	mockSeatRepository := repository_mocks.NewMockSeatRepository(ctrl)
+	mockReservationRepository := repository_mocks.NewMockReservationRepository(ctrl)
+	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
+	mockTransactor := db_mocks.NewMockSqlxTransactor(ctrl)
+	mockSeatLockerRepository := cache_mocks.NewMockSeatLockerRepository(ctrl)
+	mockSeatMapRepository := cache_mocks.NewMockSeatMapRepository(ctrl)
+	mockSeatEventRepository := cache_mocks.NewMockSeatEventRepository(ctrl)
+	mockEventPublisher := gateway_mocks.NewMockEventPublisher(ctrl)
+
+	usecase := seatusecase.NewSeatUsecase(
+		appConfig,
+		mockConcertRepository,
+		mockZoneRepository,
+		mockSeatRepository,
+		mockReservationRepository,
+		mockTransactorFactory,
+		mockSeatLockerRepository,
+		mockSeatMapRepository,
+		mockSeatEventRepository,
+		mockEventPublisher,
+	)
+
+	return &testHelper{
+		ctrl:                      ctrl,
+		appConfig:                 appConfig,
+		mockConcertRepository:     mockConcertRepository,
+		mockZoneRepository:        mockZoneRepository,
+		mockSeatRepository:        mockSeatRepository,
+		mockReservationRepository: mockReservationRepository,
+		mockTransactorFactory:     mockTransactorFactory,
+		mockTransactor:            mockTransactor,
+		mockSeatLockerRepository:  mockSeatLockerRepository,
+		mockSeatMapRepository:     mockSeatMapRepository,
+		mockSeatEventRepository:   mockSeatEventRepository,
+		mockEventPublisher:        mockEventPublisher,
+		seatUsecase:               usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewSeatUsecase(t *testing.T) {
+	h := initTest(t)
+	defer h.Done()
+
+	// Assert
+	assert.NotNil(t, h.seatUsecase)
+}
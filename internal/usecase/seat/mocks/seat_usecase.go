@@ -36,6 +36,51 @@ func (m *MockSeatUsecase) EXPECT() *MockSeatUsecaseMockRecorder {
 	return m.recorder
 }
 
+// FindAllSeats mocks base method.
+func (m *MockSeatUsecase) FindAllSeats(ctx context.Context, input usecase.FindAllSeatsInput) (*usecase.SeatMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllSeats", ctx, input)
+	ret0, _ := ret[0].(*usecase.SeatMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllSeats indicates an expected call of FindAllSeats.
+func (mr *MockSeatUsecaseMockRecorder) FindAllSeats(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllSeats", reflect.TypeOf((*MockSeatUsecase)(nil).FindAllSeats), ctx, input)
+}
+
+// GenerateSeats mocks base method.
+func (m *MockSeatUsecase) GenerateSeats(ctx context.Context, input usecase.GenerateSeatsInput) (*entity.Seats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSeats", ctx, input)
+	ret0, _ := ret[0].(*entity.Seats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSeats indicates an expected call of GenerateSeats.
+func (mr *MockSeatUsecaseMockRecorder) GenerateSeats(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSeats", reflect.TypeOf((*MockSeatUsecase)(nil).GenerateSeats), ctx, input)
+}
+
+// ReserveBestAvailableSeats mocks base method.
+func (m *MockSeatUsecase) ReserveBestAvailableSeats(ctx context.Context, input usecase.ReserveBestAvailableSeatsInput) (*usecase.BestAvailableSeats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveBestAvailableSeats", ctx, input)
+	ret0, _ := ret[0].(*usecase.BestAvailableSeats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveBestAvailableSeats indicates an expected call of ReserveBestAvailableSeats.
+func (mr *MockSeatUsecaseMockRecorder) ReserveBestAvailableSeats(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveBestAvailableSeats", reflect.TypeOf((*MockSeatUsecase)(nil).ReserveBestAvailableSeats), ctx, input)
+}
+
 // ReserveSeat mocks base method.
 func (m *MockSeatUsecase) ReserveSeat(ctx context.Context, input usecase.ReserveSeatInput) (*entity.Reservation, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +95,83 @@ func (mr *MockSeatUsecaseMockRecorder) ReserveSeat(ctx, input interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveSeat", reflect.TypeOf((*MockSeatUsecase)(nil).ReserveSeat), ctx, input)
 }
+
+// ReserveSeats mocks base method.
+func (m *MockSeatUsecase) ReserveSeats(ctx context.Context, input usecase.ReserveSeatsInput) (*entity.Reservations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveSeats", ctx, input)
+	ret0, _ := ret[0].(*entity.Reservations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveSeats indicates an expected call of ReserveSeats.
+func (mr *MockSeatUsecaseMockRecorder) ReserveSeats(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveSeats", reflect.TypeOf((*MockSeatUsecase)(nil).ReserveSeats), ctx, input)
+}
+
+// StreamSeatStatus mocks base method.
+func (m *MockSeatUsecase) StreamSeatStatus(ctx context.Context, input usecase.StreamSeatStatusInput) (usecase.SeatStatusStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamSeatStatus", ctx, input)
+	ret0, _ := ret[0].(usecase.SeatStatusStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamSeatStatus indicates an expected call of StreamSeatStatus.
+func (mr *MockSeatUsecaseMockRecorder) StreamSeatStatus(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSeatStatus", reflect.TypeOf((*MockSeatUsecase)(nil).StreamSeatStatus), ctx, input)
+}
+
+// MockSeatStatusStream is a mock of SeatStatusStream interface.
+type MockSeatStatusStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeatStatusStreamMockRecorder
+}
+
+// MockSeatStatusStreamMockRecorder is the mock recorder for MockSeatStatusStream.
+type MockSeatStatusStreamMockRecorder struct {
+	mock *MockSeatStatusStream
+}
+
+// NewMockSeatStatusStream creates a new mock instance.
+func NewMockSeatStatusStream(ctrl *gomock.Controller) *MockSeatStatusStream {
+	mock := &MockSeatStatusStream{ctrl: ctrl}
+	mock.recorder = &MockSeatStatusStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeatStatusStream) EXPECT() *MockSeatStatusStreamMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSeatStatusStream) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSeatStatusStreamMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSeatStatusStream)(nil).Close))
+}
+
+// Next mocks base method.
+func (m *MockSeatStatusStream) Next(ctx context.Context) (*usecase.SeatStatusUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx)
+	ret0, _ := ret[0].(*usecase.SeatStatusUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockSeatStatusStreamMockRecorder) Next(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockSeatStatusStream)(nil).Next), ctx)
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+// maxBestAvailableAttempts bounds how many candidate blocks are tried when other buyers keep taking them first.
+const maxBestAvailableAttempts = 5
+
+type ReserveBestAvailableSeatsInput struct {
+	ConcertID  string `json:"concert_id" validate:"required,uuid4"`
+	ZoneID     string `json:"zone_id" validate:"required,uuid4"`
+	Quantity   int    `json:"quantity" validate:"required,min=1,max=10"`
+	Preference string `json:"preference" validate:"required,oneof=centre front"`
+	SessionID  string `json:"session_id" validate:"required"`
+}
+
+// BestAvailableSeats holds the seats picked for the customer and the reservations made for them.
+type BestAvailableSeats struct {
+	Seats        entity.Seats
+	Reservations entity.Reservations
+}
+
+// ReserveBestAvailableSeats picks Quantity adjacent seats in the same row of the zone, ranked by the preference,
+// and reserves them with the same flow as ReserveSeats. When a block is lost to a concurrent buyer,
+// the next best block that does not contain any of the lost seats is tried.
+func (u *seatUsecase) ReserveBestAvailableSeats(ctx context.Context, input ReserveBestAvailableSeatsInput) (result *BestAvailableSeats, err error) {
+	const errLocation = "[usecase seat/reserve_best_available_seats ReserveBestAvailableSeats] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("seat.usecase"), func(ctx context.Context) (*BestAvailableSeats, error) {
+		logger := commonLogger.FromContext(ctx)
+		requestTime := time.Now()
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		var (
+			concertID  uuid.UUID
+			zoneID     uuid.UUID
+			preference entity.SeatPreference
+		)
+		concertID, err = uuid.Parse(input.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+			return nil, err
+		}
+		zoneID, err = uuid.Parse(input.ZoneID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+			return nil, err
+		}
+		preference, err = preference.Parse(input.Preference)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid seat preference", nil))
+			return nil, err
+		}
+
+		// Check that the concert has not passed and that the zone belongs to it
+		zone, err := u.checkReservableZone(ctx, concertID, zoneID, requestTime)
+		if err != nil {
+			return nil, err
+		}
+
+		// Rank every block of adjacent available seats
+		seats, err := u.seatRepository.FindAllByZone(ctx, zoneID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seats by zone ID", nil))
+			return nil, err
+		}
+		blocks := pointer.GetValue(seats).FindAdjacentBlocks(input.Quantity, requestTime, preference)
+
+		lostSeatIDs := make(map[string]struct{})
+		attempts := 0
+		for _, block := range blocks {
+			if attempts >= maxBestAvailableAttempts {
+				break
+			}
+			if containsAnySeat(block, lostSeatIDs) {
+				continue
+			}
+			attempts++
+
+			seatIDs := make([]uuid.UUID, 0, len(block))
+			for _, seat := range block {
+				seatIDs = append(seatIDs, seat.ID)
+			}
+			// Lock in the same order as ReserveSeats so that both flows cannot deadlock each other
+			sort.Slice(seatIDs, func(i, j int) bool {
+				return seatIDs[i].String() < seatIDs[j].String()
+			})
+
+			reservations, err := u.reserveSeatGroup(ctx, concertID, zone, seatIDs, input.SessionID, requestTime)
+			if err == nil {
+				return &BestAvailableSeats{
+					Seats:        block,
+					Reservations: pointer.GetValue(reservations),
+				}, nil
+			}
+
+			var unavailableErr *errs.SeatsUnavailableError
+			if !errors.As(err, &unavailableErr) {
+				return nil, err
+			}
+			// Another buyer took some of the seats first, remember them and move on to the next block
+			for _, conflict := range unavailableErr.Conflicts {
+				lostSeatIDs[conflict.SeatID] = struct{}{}
+			}
+			logger.Info(ctx, "best available seats were taken by another buyer, trying the next block", commonLogger.Fields{
+				"concert_id": concertID,
+				"zone_id":    zoneID,
+				"attempt":    attempts,
+				"conflicts":  unavailableErr.Conflicts,
+			})
+		}
+
+		err = errsFramework.NewConflictError("no adjacent seats are available", map[string]interface{}{"quantity": input.Quantity})
+		return nil, err
+	})
+}
+
+// containsAnySeat reports whether one of the seats is part of the given set of seat IDs.
+func containsAnySeat(seats entity.Seats, seatIDs map[string]struct{}) bool {
+	for _, seat := range seats {
+		if _, ok := seatIDs[seat.ID.String()]; ok {
+			return true
+		}
+	}
+	return false
+}
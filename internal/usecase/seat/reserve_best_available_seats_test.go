@@ -0,0 +1,328 @@
+package usecase_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	seatusecase "ticket-reservation/internal/usecase/seat"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatUsecase_ReserveBestAvailableSeats(t *testing.T) {
+	sessionID := "session-123"
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	concert := &entity.Concert{ID: concertID, Name: "Concert", Date: time.Now().Add(24 * time.Hour)}
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+
+	newSeat := func(seatNumber string, position int, status entity.SeatStatus) entity.Seat {
+		return entity.Seat{ID: uuid.New(), ZoneID: zoneID, SeatNumber: seatNumber, Status: status, Position: pointer.ToPointer(position)}
+	}
+	// Row A: A1 [A2 booked] A3 A4 A5
+	// Row B: B1 B2 B3 B4 B5
+	// Row C: C1 C2 [C3 booked] C4 C5
+	seatsByNumber := map[string]entity.Seat{}
+	zoneSeats := entity.Seats{}
+	for _, row := range []string{"A", "B", "C"} {
+		for number := 1; number <= 5; number++ {
+			seatNumber := entity.FormatSeatNumber(row, number)
+			status := entity.SeatStatusAvailable
+			if seatNumber == "A2" || seatNumber == "C3" {
+				status = entity.SeatStatusBooked
+			}
+			seat := newSeat(seatNumber, number, status)
+			seatsByNumber[seatNumber] = seat
+			zoneSeats = append(zoneSeats, seat)
+		}
+	}
+	// Row D skips the number 13 and has an aisle after its second seat: [D10 booked] D11 | D12 D14
+	aisleSeats := entity.Seats{}
+	for _, seat := range []entity.Seat{
+		newSeat("D10", 1, entity.SeatStatusBooked),
+		newSeat("D11", 2, entity.SeatStatusAvailable),
+		newSeat("D12", 4, entity.SeatStatusAvailable),
+		newSeat("D14", 5, entity.SeatStatusAvailable),
+	} {
+		seatsByNumber[seat.SeatNumber] = seat
+		aisleSeats = append(aisleSeats, seat)
+	}
+	seatsOf := func(seatNumbers ...string) entity.Seats {
+		seats := entity.Seats{}
+		for _, seatNumber := range seatNumbers {
+			seats = append(seats, seatsByNumber[seatNumber])
+		}
+		return seats
+	}
+	sortedIDs := func(seats entity.Seats) []uuid.UUID {
+		ids := []uuid.UUID{}
+		for _, seat := range seats {
+			ids = append(ids, seat.ID)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+		return ids
+	}
+
+	validInput := seatusecase.ReserveBestAvailableSeatsInput{
+		ConcertID:  concertID.String(),
+		ZoneID:     zoneID.String(),
+		Quantity:   2,
+		Preference: "front",
+		SessionID:  sessionID,
+	}
+
+	expectZone := func(h *testHelper, seats entity.Seats) {
+		h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+		h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+		h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(&seats, nil)
+	}
+	// expectReserved sets up a successful reservation of the block
+	expectReserved := func(h *testHelper, block entity.Seats) {
+		for _, id := range sortedIDs(block) {
+			h.mockSeatLockerRepository.EXPECT().LockSeat(gomock.Any(), concertID, zoneID, id, sessionID, h.appConfig.SeatLockTTL).Return(nil)
+		}
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), sortedIDs(block)).Return(&block, nil)
+		h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input repository.UpdateSeatInput) (*entity.Seat, error) {
+				return &entity.Seat{ID: input.ID, ZoneID: zoneID, Status: pointer.GetValue(input.Status)}, nil
+			}).Times(len(block))
+		h.mockReservationRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Reservations{}, int64(0), nil).Times(len(block))
+		h.mockReservationRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, reservation *entity.Reservation) (*entity.Reservation, error) {
+				return reservation, nil
+			}).Times(len(block))
+		h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(len(block))).Return(nil)
+		h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID, gomock.Len(len(block)), h.appConfig.SeatLockTTL).Return(nil)
+		h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(len(block)))
+		h.mockTransactor.EXPECT().Commit().Return(nil)
+	}
+	assertBlock := func(seatNumbers ...string) func(t *testing.T, result *seatusecase.BestAvailableSeats) {
+		return func(t *testing.T, result *seatusecase.BestAvailableSeats) {
+			require.NotNil(t, result)
+			assert.Equal(t, seatsOf(seatNumbers...), result.Seats)
+			require.Len(t, result.Reservations, len(seatNumbers))
+			require.NotNil(t, result.Reservations[0].GroupID)
+			for _, reservation := range result.Reservations {
+				assert.Equal(t, result.Reservations[0].GroupID, reservation.GroupID)
+			}
+		}
+	}
+
+	tests := []struct {
+		name          string
+		input         seatusecase.ReserveBestAvailableSeatsInput
+		setupMocks    func(h *testHelper)
+		assertResult  func(t *testing.T, result *seatusecase.BestAvailableSeats)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "front preference picks the most central block of the first row",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectZone(h, zoneSeats)
+				expectReserved(h, seatsOf("A3", "A4"))
+			},
+			assertResult:  assertBlock("A3", "A4"),
+			expectedError: false,
+		},
+		{
+			name: "centre preference picks the middle of the middle row",
+			input: seatusecase.ReserveBestAvailableSeatsInput{
+				ConcertID:  concertID.String(),
+				ZoneID:     zoneID.String(),
+				Quantity:   3,
+				Preference: "centre",
+				SessionID:  sessionID,
+			},
+			setupMocks: func(h *testHelper) {
+				expectZone(h, zoneSeats)
+				expectReserved(h, seatsOf("B2", "B3", "B4"))
+			},
+			assertResult:  assertBlock("B2", "B3", "B4"),
+			expectedError: false,
+		},
+		{
+			name:  "block lost to a concurrent buyer moves on to the next block without the lost seat",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectZone(h, zoneSeats)
+				// A3-A4 is tried first but A4 has just been locked by someone else
+				lostBlock := sortedIDs(seatsOf("A3", "A4"))
+				lockResults := map[uuid.UUID]error{
+					seatsByNumber["A3"].ID: nil,
+					seatsByNumber["A4"].ID: domaincache.ErrSeatAlreadyLocked,
+				}
+				for _, id := range lostBlock {
+					h.mockSeatLockerRepository.EXPECT().LockSeat(gomock.Any(), concertID, zoneID, id, sessionID, h.appConfig.SeatLockTTL).Return(lockResults[id])
+				}
+				h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, seatsByNumber["A3"].ID, sessionID).Return(nil)
+				// A4-A5 contains the lost seat, so B2-B3 is the next block
+				expectReserved(h, seatsOf("B2", "B3"))
+			},
+			assertResult:  assertBlock("B2", "B3"),
+			expectedError: false,
+		},
+		{
+			name:  "aisle splits a row while a skipped seat number does not",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectZone(h, aisleSeats)
+				expectReserved(h, seatsOf("D12", "D14"))
+			},
+			assertResult:  assertBlock("D12", "D14"),
+			expectedError: false,
+		},
+		{
+			name: "gives up after the maximum number of attempts",
+			input: seatusecase.ReserveBestAvailableSeatsInput{
+				ConcertID:  concertID.String(),
+				ZoneID:     zoneID.String(),
+				Quantity:   1,
+				Preference: "front",
+				SessionID:  sessionID,
+			},
+			setupMocks: func(h *testHelper) {
+				expectZone(h, zoneSeats)
+				h.mockSeatLockerRepository.EXPECT().
+					LockSeat(gomock.Any(), concertID, zoneID, gomock.Any(), sessionID, h.appConfig.SeatLockTTL).
+					Return(domaincache.ErrSeatAlreadyLocked).
+					Times(5)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "no adjacent seats are available",
+		},
+		{
+			name: "no block is large enough",
+			input: seatusecase.ReserveBestAvailableSeatsInput{
+				ConcertID:  concertID.String(),
+				ZoneID:     zoneID.String(),
+				Quantity:   6,
+				Preference: "front",
+				SessionID:  sessionID,
+			},
+			setupMocks: func(h *testHelper) {
+				expectZone(h, zoneSeats)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "no adjacent seats are available",
+		},
+		{
+			name: "validation error - unknown preference",
+			input: seatusecase.ReserveBestAvailableSeatsInput{
+				ConcertID:  concertID.String(),
+				ZoneID:     zoneID.String(),
+				Quantity:   2,
+				Preference: "back",
+				SessionID:  sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - quantity too large",
+			input: seatusecase.ReserveBestAvailableSeatsInput{
+				ConcertID:  concertID.String(),
+				ZoneID:     zoneID.String(),
+				Quantity:   11,
+				Preference: "front",
+				SessionID:  sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "zone not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name:  "seat repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatRepository.EXPECT().FindAllByZone(gomock.Any(), zoneID).Return(nil, errsFramework.NewDatabaseError("query failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find seats by zone ID",
+		},
+		{
+			name:  "unexpected reservation error is not retried",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				expectZone(h, zoneSeats)
+				block := sortedIDs(seatsOf("A3", "A4"))
+				for _, id := range block {
+					h.mockSeatLockerRepository.EXPECT().LockSeat(gomock.Any(), concertID, zoneID, id, sessionID, h.appConfig.SeatLockTTL).Return(nil)
+					h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, id, sessionID).Return(nil)
+				}
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create transaction",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.seatUsecase.ReserveBestAvailableSeats(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase seat/reserve_best_available_seats ReserveBestAvailableSeats]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tt.assertResult(t, result)
+			}
+		})
+	}
+}
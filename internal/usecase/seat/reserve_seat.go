@@ -127,11 +127,13 @@ func (u *seatUsecase) ReserveSeat(ctx context.Context, input ReserveSeatInput) (
 			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
 			return nil, err
 		}
+		var seatStatusEvents entity.SeatStatusEvents
 		defer func() {
 			if err != nil {
 				_ = tx.Rollback()
-			} else {
-				_ = tx.Commit()
+			} else if commitErr := tx.Commit(); commitErr == nil {
+				// Only tell the seat status stream about a committed reservation
+				u.eventPublisher.PublishSeatStatus(ctx, seatStatusEvents)
 			}
 		}()
 
@@ -176,7 +178,9 @@ func (u *seatUsecase) ReserveSeat(ctx context.Context, input ReserveSeatInput) (
 		}
 
 		var (
-			reservation *entity.Reservation
+			reservation         *entity.Reservation
+			expiredReservations entity.Reservations
+			createdReservations entity.Reservations
 		)
 
 		// Find existing reservations for the seat
@@ -198,7 +202,8 @@ func (u *seatUsecase) ReserveSeat(ctx context.Context, input ReserveSeatInput) (
 				}
 			} else {
 				// Mark the existing reservation as expired
-				_, err = u.reservationRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateReservationInput{
+				var expiredReservation *entity.Reservation
+				expiredReservation, err = u.reservationRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateReservationInput{
 					ID:     existingReservation.ID,
 					Status: pointer.ToPointer(entity.ReservationStatusExpired),
 				})
@@ -206,16 +211,24 @@ func (u *seatUsecase) ReserveSeat(ctx context.Context, input ReserveSeatInput) (
 					err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update existing reservation", nil))
 					return nil, err
 				}
+				expiredReservations = append(expiredReservations, *expiredReservation)
 			}
 		}
 
 		// If no existing reservation found, create a new one
 		if reservation == nil {
-			reservation, err = u.reservationRepository.WithTx(tx.DB()).CreateOne(ctx, entity.NewReservation(seat.ID, input.SessionID, *seat.LockedUntil))
+			reservation, err = u.reservationRepository.WithTx(tx.DB()).CreateOne(ctx, entity.NewReservation(seat, zone, input.SessionID, *seat.LockedUntil))
 			if err != nil {
 				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create reservation", nil))
 				return nil, err
 			}
+			createdReservations = append(createdReservations, *reservation)
+		}
+
+		// Notify webhook subscribers along with the transaction
+		err = u.enqueueReservationEvents(ctx, tx.DB(), createdReservations, expiredReservations, requestTime)
+		if err != nil {
+			return nil, err
 		}
 
 		// Update seat map in Redis
@@ -229,6 +242,8 @@ func (u *seatUsecase) ReserveSeat(ctx context.Context, input ReserveSeatInput) (
 			})
 		}
 
+		seatStatusEvents = entity.NewSeatStatusEvents(concertID, zoneID, entity.Seats{*seat}, requestTime)
+
 		return reservation, nil
 	})
 }
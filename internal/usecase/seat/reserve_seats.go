@@ -0,0 +1,325 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	"ticket-reservation/internal/domain/errs"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type ReserveSeatsInput struct {
+	ConcertID string   `json:"concert_id" validate:"required,uuid4"`
+	ZoneID    string   `json:"zone_id" validate:"required,uuid4"`
+	SeatIDs   []string `json:"seat_ids" validate:"required,min=1,max=10,unique,dive,uuid4"`
+	SessionID string   `json:"session_id" validate:"required"`
+}
+
+// ReserveSeats reserves every requested seat or none of them.
+// Seat locks are taken in ascending seat ID order so that two carts sharing seats cannot deadlock,
+// and every lock already taken is released as soon as one seat turns out to be unavailable.
+// All reservations created by a single call share the same GroupID.
+func (u *seatUsecase) ReserveSeats(ctx context.Context, input ReserveSeatsInput) (reservations *entity.Reservations, err error) {
+	const errLocation = "[usecase seat/reserve_seats ReserveSeats] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("seat.usecase"), func(ctx context.Context) (*entity.Reservations, error) {
+		requestTime := time.Now()
+
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		var (
+			concertID uuid.UUID
+			zoneID    uuid.UUID
+			seatIDs   = make([]uuid.UUID, 0, len(input.SeatIDs))
+		)
+		concertID, err = uuid.Parse(input.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+			return nil, err
+		}
+		zoneID, err = uuid.Parse(input.ZoneID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+			return nil, err
+		}
+		for _, rawSeatID := range input.SeatIDs {
+			seatID, err := uuid.Parse(rawSeatID)
+			if err != nil {
+				err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid seat ID", nil))
+				return nil, err
+			}
+			seatIDs = append(seatIDs, seatID)
+		}
+		// Always lock in the same order, regardless of the order the client sent the seats in
+		sort.Slice(seatIDs, func(i, j int) bool {
+			return seatIDs[i].String() < seatIDs[j].String()
+		})
+
+		// Check that the concert has not passed and that the zone belongs to it
+		zone, err := u.checkReservableZone(ctx, concertID, zoneID, requestTime)
+		if err != nil {
+			return nil, err
+		}
+
+		return u.reserveSeatGroup(ctx, concertID, zone, seatIDs, input.SessionID, requestTime)
+	})
+}
+
+// checkReservableZone makes sure that the concert has not passed yet and that the zone belongs to it, and returns the zone.
+// NotFound errors are returned as is, other repository errors are wrapped as internal server errors.
+func (u *seatUsecase) checkReservableZone(ctx context.Context, concertID, zoneID uuid.UUID, requestTime time.Time) (*entity.Zone, error) {
+	// Find concert by ID and check if it has already passed
+	concert, err := u.concertRepository.FindOne(ctx, concertID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			return nil, err
+		}
+		return nil, err // Return the NotFoundError directly
+	}
+	if concert.Date.Before(requestTime) {
+		err = errsFramework.WrapError(err, errsFramework.NewConflictError("the concert has already passed", nil))
+		return nil, err
+	}
+
+	// Find zone by ID and check if it belongs to the concert
+	zone, err := u.zoneRepository.FindOne(ctx, zoneID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			return nil, err
+		}
+		return nil, err // Return the NotFoundError directly
+	}
+	if zone.ConcertID != concertID {
+		err = errsFramework.NewBadRequestError("the zone does not belong to the specified concert", nil)
+		return nil, err
+	}
+	return zone, nil
+}
+
+// reserveSeatGroup reserves every given seat or none of them, using one reservation group.
+// Seat locks are taken in the order of seatIDs, which must be sorted by the caller.
+// A SeatsUnavailableError listing every offending seat is returned when some seats cannot be reserved,
+// in which case every lock taken so far has already been released. The same goes for a lock that cannot be taken at all.
+func (u *seatUsecase) reserveSeatGroup(ctx context.Context, concertID uuid.UUID, zone *entity.Zone, seatIDs []uuid.UUID, sessionID string, requestTime time.Time) (reservations *entity.Reservations, err error) {
+	logger := commonLogger.FromContext(ctx)
+	zoneID := zone.ID
+
+	// Attempt to lock every seat, collecting the ones held by someone else
+	var (
+		lockedSeatIDs = make([]uuid.UUID, 0, len(seatIDs))
+		conflicts     []errs.SeatReservationConflict
+	)
+	defer func() {
+		if err != nil {
+			// If any error occurs, unlock every seat locked so far
+			// This ensures that a failed cart never keeps part of its seats
+			u.unlockSeats(ctx, concertID, zoneID, lockedSeatIDs, sessionID)
+		}
+	}()
+	for _, seatID := range seatIDs {
+		lockErr := u.seatLockerRepository.LockSeat(ctx, concertID, zoneID, seatID, sessionID, u.appConfig.SeatLockTTL)
+		if lockErr != nil && errors.Is(lockErr, cache.ErrSeatAlreadyLocked) {
+			conflicts = append(conflicts, errs.SeatReservationConflict{
+				SeatID: seatID.String(),
+				Reason: errs.SeatConflictReasonLocked,
+			})
+			continue
+		}
+		if lockErr != nil {
+			// The lock could not be taken, the seat is not held so the cart cannot go ahead
+			err = errsFramework.WrapError(lockErr, errsFramework.NewInternalServerError("failed to lock seat", nil))
+			return nil, err
+		}
+		lockedSeatIDs = append(lockedSeatIDs, seatID)
+	}
+	if len(conflicts) > 0 {
+		err = errs.NewSeatsUnavailableError(conflicts)
+		return nil, err
+	}
+
+	// Start a transaction for database operations
+	tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+		return nil, err
+	}
+	var seatStatusEvents entity.SeatStatusEvents
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+		if commitErr := tx.Commit(); commitErr != nil {
+			err = errsFramework.WrapError(commitErr, errsFramework.NewInternalServerError("failed to commit transaction", nil))
+			return
+		}
+		// Only tell the seat status stream about committed reservations
+		u.eventPublisher.PublishSeatStatus(ctx, seatStatusEvents)
+	}()
+
+	// Get all seats with explicit row locking
+	seats, err := u.seatRepository.WithTx(tx.DB()).FindMany(ctx, seatIDs)
+	if err != nil {
+		err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seats by ID", nil))
+		return nil, err
+	}
+	seatsByID := make(map[uuid.UUID]entity.Seat, len(pointer.GetValue(seats)))
+	for _, seat := range pointer.GetValue(seats) {
+		seatsByID[seat.ID] = seat
+	}
+	for _, seatID := range seatIDs {
+		seat, ok := seatsByID[seatID]
+		switch {
+		case !ok:
+			conflicts = append(conflicts, errs.SeatReservationConflict{
+				SeatID: seatID.String(),
+				Reason: errs.SeatConflictReasonNotFound,
+			})
+		case seat.ZoneID != zoneID:
+			conflicts = append(conflicts, errs.SeatReservationConflict{
+				SeatID:     seatID.String(),
+				SeatNumber: seat.SeatNumber,
+				Reason:     errs.SeatConflictReasonNotInZone,
+			})
+		case seat.IsBooked():
+			conflicts = append(conflicts, errs.SeatReservationConflict{
+				SeatID:     seatID.String(),
+				SeatNumber: seat.SeatNumber,
+				Reason:     errs.SeatConflictReasonBooked,
+			})
+		case seat.IsLocked(requestTime) && seat.LockedBySessionID != nil && pointer.GetValue(seat.LockedBySessionID) != sessionID:
+			conflicts = append(conflicts, errs.SeatReservationConflict{
+				SeatID:     seatID.String(),
+				SeatNumber: seat.SeatNumber,
+				Reason:     errs.SeatConflictReasonLocked,
+			})
+		}
+	}
+	if len(conflicts) > 0 {
+		err = errs.NewSeatsUnavailableError(conflicts)
+		return nil, err
+	}
+
+	var (
+		groupID             = uuid.New()
+		lockedUntil         = requestTime.Add(u.appConfig.SeatLockTTL)
+		reservedSeats       = make(entity.Seats, 0, len(seatIDs))
+		expiredReservations entity.Reservations
+	)
+	reservations = &entity.Reservations{}
+	for _, seatID := range seatIDs {
+		var (
+			seat                 *entity.Seat
+			existingReservations *entity.Reservations
+			reservation          *entity.Reservation
+		)
+
+		// Update seat status in database
+		seat, err = u.seatRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateSeatInput{
+			ID:                seatID,
+			Status:            pointer.ToPointer(entity.SeatStatusPending),
+			LockedBySessionID: pointer.ToPointer(sessionID),
+			LockedUntil:       pointer.ToPointer(lockedUntil),
+		})
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update seat status", nil))
+			return nil, err
+		}
+		reservedSeats = append(reservedSeats, *seat)
+
+		// Expire previous reservations of this session for the seat, they are superseded by the cart
+		existingReservations, _, err = u.reservationRepository.WithTx(tx.DB()).FindAll(ctx, repository.FindAllReservationsFilter{
+			SeatID:    pointer.ToPointer(seat.ID),
+			SessionID: pointer.ToPointer(sessionID),
+			Status:    pointer.ToPointer(entity.ReservationStatusPending),
+		})
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find existing reservations", nil))
+			return nil, err
+		}
+		for _, existingReservation := range pointer.GetValue(existingReservations) {
+			var expiredReservation *entity.Reservation
+			expiredReservation, err = u.reservationRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateReservationInput{
+				ID:     existingReservation.ID,
+				Status: pointer.ToPointer(entity.ReservationStatusExpired),
+			})
+			if err != nil {
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update existing reservation", nil))
+				return nil, err
+			}
+			expiredReservations = append(expiredReservations, *expiredReservation)
+		}
+
+		newReservation := entity.NewReservation(seat, zone, sessionID, lockedUntil)
+		newReservation.GroupID = pointer.ToPointer(groupID)
+		reservation, err = u.reservationRepository.WithTx(tx.DB()).CreateOne(ctx, newReservation)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create reservation", nil))
+			return nil, err
+		}
+		*reservations = append(*reservations, *reservation)
+	}
+
+	// Notify webhook subscribers along with the transaction
+	err = u.enqueueReservationEvents(ctx, tx.DB(), *reservations, expiredReservations, requestTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update seat map in Redis
+	setMapErr := u.seatMapRepository.SetSeats(ctx, concertID, zoneID, reservedSeats, u.appConfig.SeatLockTTL)
+	if setMapErr != nil {
+		logger.Error(ctx, "failed to update seat map in Redis", setMapErr, commonLogger.Fields{
+			"concert_id": concertID,
+			"zone_id":    zoneID,
+			"group_id":   groupID,
+		})
+	}
+
+	seatStatusEvents = entity.NewSeatStatusEvents(concertID, zoneID, reservedSeats, requestTime)
+
+	return reservations, nil
+}
+
+// unlockSeats releases the given seat locks, logging failures instead of returning them.
+func (u *seatUsecase) unlockSeats(ctx context.Context, concertID, zoneID uuid.UUID, seatIDs []uuid.UUID, sessionID string) {
+	for _, seatID := range seatIDs {
+		unlockErr := u.seatLockerRepository.UnlockSeat(ctx, concertID, zoneID, seatID, sessionID)
+		if unlockErr != nil {
+			// Log the error but do not return it, as the main error has already been handled
+			commonLogger.FromContext(ctx).Error(ctx, "failed to unlock seat after error", unlockErr, commonLogger.Fields{
+				"concert_id": concertID,
+				"zone_id":    zoneID,
+				"seat_id":    seatID,
+				"session_id": sessionID,
+			})
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaincache "ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/errs"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+	seatusecase "ticket-reservation/internal/usecase/seat"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatUsecase_ReserveSeats(t *testing.T) {
+	sessionID := "session-123"
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	concert := &entity.Concert{ID: concertID, Name: "Concert", Date: time.Now().Add(24 * time.Hour)}
+	pastConcert := &entity.Concert{ID: concertID, Name: "Concert", Date: time.Now().Add(-24 * time.Hour)}
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP", Price: pointer.ToPointer(decimal.RequireFromString("3500.00")), Currency: "THB"}
+
+	// Seat IDs in the order the locks must be taken
+	seatIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	sort.Slice(seatIDs, func(i, j int) bool { return seatIDs[i].String() < seatIDs[j].String() })
+	firstSeatID, secondSeatID := seatIDs[0], seatIDs[1]
+	firstSeat := entity.Seat{ID: firstSeatID, ZoneID: zoneID, SeatNumber: "A1", Status: entity.SeatStatusAvailable}
+	secondSeat := entity.Seat{ID: secondSeatID, ZoneID: zoneID, SeatNumber: "A2", Status: entity.SeatStatusAvailable, Price: pointer.ToPointer(decimal.RequireFromString("4500.00"))}
+
+	// The client sends the seats in reverse order on purpose
+	validInput := seatusecase.ReserveSeatsInput{
+		ConcertID: concertID.String(),
+		ZoneID:    zoneID.String(),
+		SeatIDs:   []string{secondSeatID.String(), firstSeatID.String()},
+		SessionID: sessionID,
+	}
+
+	// expectLocks sets up the seat locks in ascending seat ID order
+	expectLocks := func(h *testHelper, results ...error) {
+		calls := make([]*gomock.Call, 0, len(seatIDs))
+		for i, seatID := range seatIDs {
+			calls = append(calls, h.mockSeatLockerRepository.EXPECT().
+				LockSeat(gomock.Any(), concertID, zoneID, seatID, sessionID, h.appConfig.SeatLockTTL).
+				Return(results[i]))
+		}
+		gomock.InOrder(calls...)
+	}
+	// expectTx sets up a transaction that is expected to be committed or rolled back
+	expectTx := func(h *testHelper, commit bool) {
+		h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+		h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+		h.mockSeatRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockSeatRepository).AnyTimes()
+		h.mockReservationRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockReservationRepository).AnyTimes()
+		if commit {
+			h.mockTransactor.EXPECT().Commit().Return(nil)
+		} else {
+			h.mockTransactor.EXPECT().Rollback().Return(nil)
+		}
+	}
+	// expectUnlock expects the lock of every given seat to be released
+	expectUnlock := func(h *testHelper, ids ...uuid.UUID) {
+		for _, id := range ids {
+			h.mockSeatLockerRepository.EXPECT().UnlockSeat(gomock.Any(), concertID, zoneID, id, sessionID).Return(nil)
+		}
+	}
+	pendingSeat := func(seat entity.Seat) *entity.Seat {
+		seat.Status = entity.SeatStatusPending
+		seat.LockedBySessionID = pointer.ToPointer(sessionID)
+		seat.LockedUntil = pointer.ToPointer(time.Now().Add(5 * time.Minute))
+		return &seat
+	}
+	createdReservation := func(_ context.Context, reservation *entity.Reservation) (*entity.Reservation, error) {
+		return reservation, nil
+	}
+
+	tests := []struct {
+		name          string
+		input         seatusecase.ReserveSeatsInput
+		setupMocks    func(h *testHelper)
+		assertResult  func(t *testing.T, result *entity.Reservations)
+		expectedError bool
+		errorType     error
+		errorContains string
+		assertError   func(t *testing.T, err error)
+	}{
+		{
+			name:  "successful reservation of every seat in one group",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, nil)
+				expectTx(h, true)
+				h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), seatIDs).Return(&entity.Seats{firstSeat, secondSeat}, nil)
+
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input repository.UpdateSeatInput) (*entity.Seat, error) {
+						assert.Equal(t, entity.SeatStatusPending, pointer.GetValue(input.Status))
+						assert.Equal(t, sessionID, pointer.GetValue(input.LockedBySessionID))
+						if input.ID == firstSeatID {
+							return pendingSeat(firstSeat), nil
+						}
+						return pendingSeat(secondSeat), nil
+					}).Times(2)
+
+				// A previous single-seat reservation of the same session is superseded by the cart
+				previousReservation := entity.Reservation{ID: uuid.New(), SeatID: firstSeatID, SessionID: sessionID, Status: entity.ReservationStatusPending}
+				h.mockReservationRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllReservationsFilter{
+					SeatID:    pointer.ToPointer(firstSeatID),
+					SessionID: pointer.ToPointer(sessionID),
+					Status:    pointer.ToPointer(entity.ReservationStatusPending),
+				}).Return(&entity.Reservations{previousReservation}, int64(1), nil)
+				h.mockReservationRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateReservationInput{
+					ID:     previousReservation.ID,
+					Status: pointer.ToPointer(entity.ReservationStatusExpired),
+				}).Return(&previousReservation, nil)
+				h.mockReservationRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllReservationsFilter{
+					SeatID:    pointer.ToPointer(secondSeatID),
+					SessionID: pointer.ToPointer(sessionID),
+					Status:    pointer.ToPointer(entity.ReservationStatusPending),
+				}).Return(&entity.Reservations{}, int64(0), nil)
+
+				h.mockReservationRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(createdReservation).Times(2)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ db.SqlExecer, events entity.WebhookEvents) error {
+						require.Len(t, events, 3)
+						assert.Equal(t, entity.WebhookEventTypeReservationExpired, events[0].Type)
+						assert.Equal(t, previousReservation.ID, events[0].Data["reservation_id"])
+						assert.Equal(t, entity.WebhookEventTypeReservationCreated, events[1].Type)
+						assert.Equal(t, firstSeatID, events[1].Data["seat_id"])
+						assert.Equal(t, entity.WebhookEventTypeReservationCreated, events[2].Type)
+						assert.Equal(t, secondSeatID, events[2].Data["seat_id"])
+						return nil
+					})
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID, gomock.Len(2), h.appConfig.SeatLockTTL).Return(nil)
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(2))
+			},
+			assertResult: func(t *testing.T, result *entity.Reservations) {
+				require.NotNil(t, result)
+				require.Len(t, *result, 2)
+				reservations := *result
+				assert.Equal(t, firstSeatID, reservations[0].SeatID)
+				assert.Equal(t, secondSeatID, reservations[1].SeatID)
+				require.NotNil(t, reservations[0].GroupID)
+				assert.Equal(t, reservations[0].GroupID, reservations[1].GroupID)
+				// The zone price is snapshotted unless the seat overrides it
+				assert.Equal(t, "3500.00", reservations[0].Price.StringFixed(2))
+				assert.Equal(t, "4500.00", reservations[1].Price.StringFixed(2))
+				for _, reservation := range reservations {
+					assert.Equal(t, sessionID, reservation.SessionID)
+					assert.Equal(t, entity.ReservationStatusPending, reservation.Status)
+					assert.Equal(t, "THB", pointer.GetValue(reservation.Currency))
+				}
+			},
+			expectedError: false,
+		},
+		{
+			name: "seat map update failure does not fail the reservation",
+			input: seatusecase.ReserveSeatsInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				SeatIDs:   []string{firstSeatID.String()},
+				SessionID: sessionID,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatLockerRepository.EXPECT().LockSeat(gomock.Any(), concertID, zoneID, firstSeatID, sessionID, h.appConfig.SeatLockTTL).Return(nil)
+				expectTx(h, true)
+				h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), []uuid.UUID{firstSeatID}).Return(&entity.Seats{firstSeat}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(pendingSeat(firstSeat), nil)
+				h.mockReservationRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Reservations{}, int64(0), nil)
+				h.mockReservationRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(createdReservation)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(1)).Return(nil)
+				h.mockSeatMapRepository.EXPECT().SetSeats(gomock.Any(), concertID, zoneID, gomock.Any(), h.appConfig.SeatLockTTL).Return(errors.New("redis down"))
+				h.mockEventPublisher.EXPECT().PublishSeatStatus(gomock.Any(), gomock.Len(1))
+			},
+			assertResult: func(t *testing.T, result *entity.Reservations) {
+				require.NotNil(t, result)
+				assert.Len(t, *result, 1)
+			},
+			expectedError: false,
+		},
+		{
+			name: "validation error - no seats",
+			input: seatusecase.ReserveSeatsInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				SeatIDs:   []string{},
+				SessionID: sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - duplicated seats",
+			input: seatusecase.ReserveSeatsInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				SeatIDs:   []string{firstSeatID.String(), firstSeatID.String()},
+				SessionID: sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "concert has already passed",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(pastConcert, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the concert has already passed",
+		},
+		{
+			name:  "zone belongs to another concert",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(&entity.Zone{ID: zoneID, ConcertID: uuid.New()}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the zone does not belong to the specified concert",
+		},
+		{
+			name:  "seat locked by another session releases the locks already taken",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, domaincache.ErrSeatAlreadyLocked)
+				expectUnlock(h, firstSeatID)
+			},
+			expectedError: true,
+			errorType:     &errs.SeatsUnavailableError{},
+			errorContains: "some seats could not be reserved",
+			assertError: func(t *testing.T, err error) {
+				var conflictErr *errs.SeatsUnavailableError
+				require.ErrorAs(t, err, &conflictErr)
+				assert.Equal(t, []errs.SeatReservationConflict{
+					{SeatID: secondSeatID.String(), Reason: errs.SeatConflictReasonLocked},
+				}, conflictErr.Conflicts)
+			},
+		},
+		{
+			name:  "seat locker error releases the locks already taken",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, errors.New("redis connection refused"))
+				expectUnlock(h, firstSeatID)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to lock seat",
+		},
+		{
+			name:  "unavailable seats in the database are reported per seat",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, nil)
+				expectTx(h, false)
+				bookedSeat := firstSeat
+				bookedSeat.Status = entity.SeatStatusBooked
+				h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), seatIDs).Return(&entity.Seats{bookedSeat}, nil)
+				expectUnlock(h, firstSeatID, secondSeatID)
+			},
+			expectedError: true,
+			errorType:     &errs.SeatsUnavailableError{},
+			errorContains: "some seats could not be reserved",
+			assertError: func(t *testing.T, err error) {
+				var conflictErr *errs.SeatsUnavailableError
+				require.ErrorAs(t, err, &conflictErr)
+				assert.Equal(t, map[string]interface{}{
+					"conflicts": []errs.SeatReservationConflict{
+						{SeatID: firstSeatID.String(), SeatNumber: "A1", Reason: errs.SeatConflictReasonBooked},
+						{SeatID: secondSeatID.String(), Reason: errs.SeatConflictReasonNotFound},
+					},
+				}, conflictErr.GetData())
+			},
+		},
+		{
+			name:  "seat of another zone or held by another session in the database",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, nil)
+				expectTx(h, false)
+				otherZoneSeat := firstSeat
+				otherZoneSeat.ZoneID = uuid.New()
+				heldSeat := pendingSeat(secondSeat)
+				heldSeat.LockedBySessionID = pointer.ToPointer("another-session")
+				h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), seatIDs).Return(&entity.Seats{otherZoneSeat, *heldSeat}, nil)
+				expectUnlock(h, firstSeatID, secondSeatID)
+			},
+			expectedError: true,
+			errorType:     &errs.SeatsUnavailableError{},
+			assertError: func(t *testing.T, err error) {
+				var conflictErr *errs.SeatsUnavailableError
+				require.ErrorAs(t, err, &conflictErr)
+				assert.Equal(t, []errs.SeatReservationConflict{
+					{SeatID: firstSeatID.String(), SeatNumber: "A1", Reason: errs.SeatConflictReasonNotInZone},
+					{SeatID: secondSeatID.String(), SeatNumber: "A2", Reason: errs.SeatConflictReasonLocked},
+				}, conflictErr.Conflicts)
+			},
+		},
+		{
+			name:  "reservation creation error rolls back and releases every lock",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, nil)
+				expectTx(h, false)
+				h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), seatIDs).Return(&entity.Seats{firstSeat, secondSeat}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(pendingSeat(firstSeat), nil)
+				h.mockReservationRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Reservations{}, int64(0), nil)
+				h.mockReservationRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("insert failed", "error"))
+				expectUnlock(h, firstSeatID, secondSeatID)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create reservation",
+		},
+		{
+			name:  "webhook enqueue error rolls back and releases every lock",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				expectLocks(h, nil, nil)
+				expectTx(h, false)
+				h.mockSeatRepository.EXPECT().FindMany(gomock.Any(), seatIDs).Return(&entity.Seats{firstSeat, secondSeat}, nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(pendingSeat(firstSeat), nil)
+				h.mockSeatRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(pendingSeat(secondSeat), nil)
+				h.mockReservationRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Reservations{}, int64(0), nil).Times(2)
+				h.mockReservationRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).DoAndReturn(createdReservation).Times(2)
+				h.mockEventPublisher.EXPECT().EnqueueWebhookEvents(gomock.Any(), gomock.Any(), gomock.Len(2)).Return(errsFramework.NewDatabaseError("insert failed", "error"))
+				expectUnlock(h, firstSeatID, secondSeatID)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to enqueue webhook events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.seatUsecase.ReserveSeats(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase seat/reserve_seats ReserveSeats]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				if tt.assertError != nil {
+					tt.assertError(t, err)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				tt.assertResult(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type StreamSeatStatusInput struct {
+	ConcertID    string `json:"concert_id" validate:"required,uuid4"`
+	ZoneID       string `json:"zone_id" validate:"required,uuid4"`
+	SinceVersion *int64 `json:"since_version" validate:"omitempty,min=0"` // Last version seen by the client, nil to start from now
+}
+
+// SeatStatusUpdate is the next thing a seat status stream sends to the client.
+type SeatStatusUpdate struct {
+	Events    entity.SeatStatusEvents // New events in version order, never one sent before
+	Version   int64                   // Version the client is at once the update is applied, the cursor to resume from
+	Reset     bool                    // Events after the previous cursor are lost, the client must reload the seat map
+	Heartbeat bool                    // Nothing happened for a heartbeat interval
+}
+
+// StreamSeatStatus opens a stream of the status changes of the seats of a zone, resuming after the version the client
+// has seen when there is one. The stream is subscribed to before the missed events are read, so that no event
+// published in between is lost; the ones read twice are skipped by their version.
+func (u *seatUsecase) StreamSeatStatus(ctx context.Context, input StreamSeatStatusInput) (stream SeatStatusStream, err error) {
+	const errLocation = "[usecase seat/stream_seat_status StreamSeatStatus] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("seat.usecase"), func(ctx context.Context) (SeatStatusStream, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+		zoneID, err := uuid.Parse(input.ZoneID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+		}
+
+		zone, err := u.zoneRepository.FindOne(ctx, zoneID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if zone.ConcertID != concertID {
+			return nil, errsFramework.NewNotFoundError("zone not found", nil)
+		}
+
+		subscription, err := u.seatEventRepository.Subscribe(ctx, concertID, zoneID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to subscribe to seat status events", nil))
+		}
+
+		stream := &seatStatusStream{
+			seatEventRepository: u.seatEventRepository,
+			subscription:        subscription,
+			concertID:           concertID,
+			zoneID:              zoneID,
+			heartbeatInterval:   u.appConfig.SeatStreamHeartbeat,
+		}
+
+		// The first update brings the client up to date, then the stream goes live
+		if input.SinceVersion == nil {
+			version, err := u.seatEventRepository.FindVersion(ctx, concertID, zoneID)
+			if err != nil {
+				subscription.Close()
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seat status event version", nil))
+			}
+			stream.version = version
+			stream.pending = &SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: version}
+		} else {
+			stream.version = *input.SinceVersion
+			stream.pending, err = stream.catchUp(ctx)
+			if err != nil {
+				subscription.Close()
+				return nil, err
+			}
+		}
+
+		return stream, nil
+	})
+}
+
+type seatStatusStream struct {
+	seatEventRepository cache.SeatEventRepository
+	subscription        cache.SeatEventSubscription
+	concertID           uuid.UUID
+	zoneID              uuid.UUID
+	heartbeatInterval   time.Duration
+	version             int64             // Version of the last event sent
+	pending             *SeatStatusUpdate // Update to send before reading the subscription
+}
+
+// Next waits for the next update, a heartbeat when nothing happened for the heartbeat interval.
+// It returns entity.ErrSeatEventSubscriberTooSlow once the client fell too far behind, the client must then
+// open a new stream from the version of the last update.
+func (s *seatStatusStream) Next(ctx context.Context) (*SeatStatusUpdate, error) {
+	if s.pending != nil {
+		update := s.pending
+		s.pending = nil
+		return update, nil
+	}
+
+	var heartbeat <-chan time.Time
+	if s.heartbeatInterval > 0 {
+		timer := time.NewTimer(s.heartbeatInterval)
+		defer timer.Stop()
+		heartbeat = timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-heartbeat:
+			return &SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: s.version, Heartbeat: true}, nil
+		case event, ok := <-s.subscription.Events():
+			if !ok {
+				if err := s.subscription.Err(); err != nil {
+					return nil, err
+				}
+				return nil, errsFramework.NewInternalServerError("the seat status stream is closed", nil)
+			}
+			switch {
+			case event.Version <= s.version:
+				// Already sent with the missed events
+				continue
+			case event.Version == s.version+1:
+				s.version = event.Version
+				return &SeatStatusUpdate{Events: entity.SeatStatusEvents{event}, Version: s.version}, nil
+			default:
+				// Some events never reached this instance, e.g. while Redis was reconnecting
+				return s.catchUp(ctx)
+			}
+		}
+	}
+}
+
+// catchUp reads the events after the last version sent from the history of the zone.
+func (s *seatStatusStream) catchUp(ctx context.Context) (update *SeatStatusUpdate, err error) {
+	const errLocation = "[usecase seat/stream_seat_status catchUp] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	backlog, err := s.seatEventRepository.FindSince(ctx, s.concertID, s.zoneID, s.version)
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find seat status events", nil))
+	}
+	if backlog.Truncated {
+		s.version = backlog.Version
+		return &SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: s.version, Reset: true}, nil
+	}
+	s.version = backlog.Events.LastVersion(s.version)
+	return &SeatStatusUpdate{Events: backlog.Events, Version: s.version}, nil
+}
+
+func (s *seatStatusStream) Close() {
+	s.subscription.Close()
+}
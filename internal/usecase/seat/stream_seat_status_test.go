@@ -0,0 +1,315 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/config"
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	"ticket-reservation/internal/domain/entity"
+	seatusecase "ticket-reservation/internal/usecase/seat"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestSeatUsecase_StreamSeatStatus(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	seatEvent := func(version int64) entity.SeatStatusEvent {
+		return entity.SeatStatusEvent{Version: version, ConcertID: concertID, ZoneID: zoneID, SeatID: uuid.New(), SeatNumber: "A1", Status: entity.SeatStatusBooked}
+	}
+	event41, event42 := seatEvent(41), seatEvent(42)
+
+	tests := []struct {
+		name           string
+		input          seatusecase.StreamSeatStatusInput
+		setupMocks     func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription)
+		expectedUpdate *seatusecase.SeatStatusUpdate
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "stream without a cursor starts from the current version",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String()},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(subscription, nil)
+				h.mockSeatEventRepository.EXPECT().FindVersion(gomock.Any(), concertID, zoneID).Return(int64(40), nil)
+			},
+			expectedUpdate: &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 40},
+		},
+		{
+			name:  "stream with a cursor sends the missed events first",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String(), SinceVersion: pointer.ToPointer(int64(40))},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(subscription, nil)
+				h.mockSeatEventRepository.EXPECT().FindSince(gomock.Any(), concertID, zoneID, int64(40)).Return(&entity.SeatStatusEventBacklog{
+					Events:  entity.SeatStatusEvents{event41, event42},
+					Version: 42,
+				}, nil)
+			},
+			expectedUpdate: &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{event41, event42}, Version: 42},
+		},
+		{
+			name:  "stream with a cursor too old resets the client",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String(), SinceVersion: pointer.ToPointer(int64(1))},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(subscription, nil)
+				h.mockSeatEventRepository.EXPECT().FindSince(gomock.Any(), concertID, zoneID, int64(1)).Return(&entity.SeatStatusEventBacklog{
+					Events:    entity.SeatStatusEvents{},
+					Version:   2000,
+					Truncated: true,
+				}, nil)
+			},
+			expectedUpdate: &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 2000, Reset: true},
+		},
+		{
+			name:          "invalid input",
+			input:         seatusecase.StreamSeatStatusInput{ConcertID: "invalid", ZoneID: zoneID.String()},
+			setupMocks:    func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {},
+			expectedError: true,
+			errorType:     errsFramework.NewBadRequestError("", nil),
+			errorContains: "the request is invalid",
+		},
+		{
+			name:          "negative cursor",
+			input:         seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String(), SinceVersion: pointer.ToPointer(int64(-1))},
+			setupMocks:    func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {},
+			expectedError: true,
+			errorType:     errsFramework.NewBadRequestError("", nil),
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "zone not found",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String()},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedError: true,
+			errorType:     errsFramework.NewNotFoundError("", nil),
+			errorContains: "zone not found",
+		},
+		{
+			name:  "zone of another concert",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String()},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(&entity.Zone{ID: zoneID, ConcertID: uuid.New()}, nil)
+			},
+			expectedError: true,
+			errorType:     errsFramework.NewNotFoundError("", nil),
+			errorContains: "zone not found",
+		},
+		{
+			name:  "subscribe error",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String()},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(nil, errors.New("redis down"))
+			},
+			expectedError: true,
+			errorType:     errsFramework.NewInternalServerError("", nil),
+			errorContains: "failed to subscribe to seat status events",
+		},
+		{
+			name:  "find version error closes the subscription",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String()},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(subscription, nil)
+				h.mockSeatEventRepository.EXPECT().FindVersion(gomock.Any(), concertID, zoneID).Return(int64(0), errors.New("redis down"))
+				subscription.EXPECT().Close()
+			},
+			expectedError: true,
+			errorType:     errsFramework.NewInternalServerError("", nil),
+			errorContains: "failed to find seat status event version",
+		},
+		{
+			name:  "find since error closes the subscription",
+			input: seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String(), SinceVersion: pointer.ToPointer(int64(40))},
+			setupMocks: func(h *testHelper, subscription *cache_mocks.MockSeatEventSubscription) {
+				h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+				h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(subscription, nil)
+				h.mockSeatEventRepository.EXPECT().FindSince(gomock.Any(), concertID, zoneID, int64(40)).Return(nil, errors.New("redis down"))
+				subscription.EXPECT().Close()
+			},
+			expectedError: true,
+			errorType:     errsFramework.NewInternalServerError("", nil),
+			errorContains: "failed to find seat status events",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+			subscription := cache_mocks.NewMockSeatEventSubscription(h.ctrl)
+			tt.setupMocks(h, subscription)
+
+			// Execute
+			stream, err := h.seatUsecase.StreamSeatStatus(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, stream)
+				assert.Contains(t, err.Error(), "[usecase seat/stream_seat_status StreamSeatStatus]")
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType)
+				}
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, stream)
+
+				// The first update brings the client up to date
+				update, err := stream.Next(context.Background())
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedUpdate, update)
+			}
+		})
+	}
+}
+
+func TestSeatStatusStream_Next(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	zone := &entity.Zone{ID: zoneID, ConcertID: concertID, Name: "VIP"}
+	seatEvent := func(version int64) entity.SeatStatusEvent {
+		return entity.SeatStatusEvent{Version: version, ConcertID: concertID, ZoneID: zoneID, SeatID: uuid.New(), SeatNumber: "A1", Status: entity.SeatStatusPending}
+	}
+
+	// openStream opens a stream from version 40 on the given events and skips its first update
+	openStream := func(t *testing.T, h *testHelper, events chan entity.SeatStatusEvent) (seatusecase.SeatStatusStream, *cache_mocks.MockSeatEventSubscription) {
+		subscription := cache_mocks.NewMockSeatEventSubscription(h.ctrl)
+		subscription.EXPECT().Events().Return(events).AnyTimes()
+		h.mockZoneRepository.EXPECT().FindOne(gomock.Any(), zoneID).Return(zone, nil)
+		h.mockSeatEventRepository.EXPECT().Subscribe(gomock.Any(), concertID, zoneID).Return(subscription, nil)
+		h.mockSeatEventRepository.EXPECT().FindVersion(gomock.Any(), concertID, zoneID).Return(int64(40), nil)
+
+		stream, err := h.seatUsecase.StreamSeatStatus(context.Background(), seatusecase.StreamSeatStatusInput{ConcertID: concertID.String(), ZoneID: zoneID.String()})
+		require.NoError(t, err)
+		_, err = stream.Next(context.Background())
+		require.NoError(t, err)
+		return stream, subscription
+	}
+
+	t.Run("events already sent are skipped", func(t *testing.T) {
+		h := initTest(t)
+		defer h.Done()
+		events := make(chan entity.SeatStatusEvent, 2)
+		stream, _ := openStream(t, h, events)
+		event41 := seatEvent(41)
+		events <- seatEvent(40)
+		events <- event41
+
+		// Execute
+		update, err := stream.Next(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{event41}, Version: 41}, update)
+	})
+
+	t.Run("missed events are read from the history", func(t *testing.T) {
+		h := initTest(t)
+		defer h.Done()
+		events := make(chan entity.SeatStatusEvent, 1)
+		stream, _ := openStream(t, h, events)
+		event41, event42 := seatEvent(41), seatEvent(42)
+		events <- event42
+		h.mockSeatEventRepository.EXPECT().FindSince(gomock.Any(), concertID, zoneID, int64(40)).Return(&entity.SeatStatusEventBacklog{
+			Events:  entity.SeatStatusEvents{event41, event42},
+			Version: 42,
+		}, nil)
+
+		// Execute
+		update, err := stream.Next(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{event41, event42}, Version: 42}, update)
+	})
+
+	t.Run("missed events no longer kept reset the client", func(t *testing.T) {
+		h := initTest(t)
+		defer h.Done()
+		events := make(chan entity.SeatStatusEvent, 1)
+		stream, _ := openStream(t, h, events)
+		events <- seatEvent(2000)
+		h.mockSeatEventRepository.EXPECT().FindSince(gomock.Any(), concertID, zoneID, int64(40)).Return(&entity.SeatStatusEventBacklog{
+			Events:    entity.SeatStatusEvents{},
+			Version:   2000,
+			Truncated: true,
+		}, nil)
+
+		// Execute
+		update, err := stream.Next(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 2000, Reset: true}, update)
+	})
+
+	t.Run("heartbeat after the interval without events", func(t *testing.T) {
+		h := initTest(t, func(cfg *config.AppConfig) {
+			cfg.SeatStreamHeartbeat = 10 * time.Millisecond
+		})
+		defer h.Done()
+		stream, _ := openStream(t, h, make(chan entity.SeatStatusEvent))
+
+		// Execute
+		update, err := stream.Next(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &seatusecase.SeatStatusUpdate{Events: entity.SeatStatusEvents{}, Version: 40, Heartbeat: true}, update)
+	})
+
+	t.Run("slow client is dropped", func(t *testing.T) {
+		h := initTest(t)
+		defer h.Done()
+		events := make(chan entity.SeatStatusEvent)
+		stream, subscription := openStream(t, h, events)
+		subscription.EXPECT().Err().Return(entity.ErrSeatEventSubscriberTooSlow)
+		close(events)
+
+		// Execute
+		update, err := stream.Next(context.Background())
+
+		// Assert
+		assert.Nil(t, update)
+		assert.ErrorIs(t, err, entity.ErrSeatEventSubscriberTooSlow)
+	})
+
+	t.Run("client gone", func(t *testing.T) {
+		h := initTest(t)
+		defer h.Done()
+		stream, subscription := openStream(t, h, make(chan entity.SeatStatusEvent))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Execute
+		update, err := stream.Next(ctx)
+
+		// Assert
+		assert.Nil(t, update)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		subscription.EXPECT().Close()
+		stream.Close()
+	})
+}
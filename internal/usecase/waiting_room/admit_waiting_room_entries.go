@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+const (
+	// defaultWaitingRoomAdmitBatchSize is used when no batch size is configured
+	defaultWaitingRoomAdmitBatchSize = 100
+	// waitingRoomConcertsPageSize is how many concerts with a waiting room are read at a time
+	waitingRoomConcertsPageSize = 100
+)
+
+type AdmitWaitingRoomEntriesResult struct {
+	AdmittedEntries int64
+}
+
+// AdmitWaitingRoomEntries lets in the next batch of buyers of every concert with the waiting room enabled.
+// Every instance runs the admission controller, the waiting room repository lets in one batch per interval
+// and concert however many instances call it.
+func (u *waitingRoomUsecase) AdmitWaitingRoomEntries(ctx context.Context) (result *AdmitWaitingRoomEntriesResult, err error) {
+	const errLocation = "[usecase waiting_room/admit_waiting_room_entries AdmitWaitingRoomEntries] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("waiting_room.usecase"), func(ctx context.Context) (*AdmitWaitingRoomEntriesResult, error) {
+		logger := commonLogger.FromContext(ctx)
+		batchSize := u.appConfig.WaitingRoomAdmitBatchSize
+		if batchSize <= 0 {
+			batchSize = defaultWaitingRoomAdmitBatchSize
+		}
+
+		result := &AdmitWaitingRoomEntriesResult{}
+		for offset := int64(0); ; offset += waitingRoomConcertsPageSize {
+			concerts, total, err := u.concertRepository.FindAll(ctx, repository.FindAllConcertsFilter{
+				WaitingRoomEnabled: pointer.ToPointer(true),
+				Limit:              pointer.ToPointer(int64(waitingRoomConcertsPageSize)),
+				Offset:             pointer.ToPointer(offset),
+			})
+			if err != nil {
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concerts with a waiting room", nil))
+			}
+
+			// A failure on one concert must not hold up the queues of the others
+			for _, concert := range pointer.GetValue(concerts) {
+				admitted, err := u.waitingRoomRepository.Admit(ctx, concert.ID, int64(batchSize), u.appConfig.WaitingRoomAdmitInterval, u.appConfig.WaitingRoomPassTTL)
+				if err != nil {
+					logger.Error(ctx, "failed to admit waiting room entries", err, commonLogger.Fields{
+						"concert_id": concert.ID,
+					})
+					continue
+				}
+				result.AdmittedEntries += admitted
+			}
+
+			if offset+waitingRoomConcertsPageSize >= total {
+				break
+			}
+		}
+
+		return result, nil
+	})
+}
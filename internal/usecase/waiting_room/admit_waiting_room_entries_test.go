@@ -0,0 +1,122 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWaitingRoomUsecase_AdmitWaitingRoomEntries(t *testing.T) {
+	firstConcert := entity.Concert{ID: uuid.New(), WaitingRoomEnabled: true}
+	secondConcert := entity.Concert{ID: uuid.New(), WaitingRoomEnabled: true}
+
+	tests := []struct {
+		name             string
+		setupMocks       func(h *testHelper)
+		expectedAdmitted int64
+		expectedError    bool
+		errorType        error
+		errorContains    string
+	}{
+		{
+			name: "admits a batch for every concert with a waiting room",
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllConcertsFilter{
+					WaitingRoomEnabled: pointer.ToPointer(true),
+					Limit:              pointer.ToPointer(int64(100)),
+					Offset:             pointer.ToPointer(int64(0)),
+				}).Return(&entity.Concerts{firstConcert, secondConcert}, int64(2), nil)
+				h.mockWaitingRoomRepository.EXPECT().Admit(gomock.Any(), firstConcert.ID, int64(50), 5*time.Second, 10*time.Minute).Return(int64(50), nil)
+				h.mockWaitingRoomRepository.EXPECT().Admit(gomock.Any(), secondConcert.ID, int64(50), 5*time.Second, 10*time.Minute).Return(int64(7), nil)
+			},
+			expectedAdmitted: 57,
+		},
+		{
+			name: "reads every page of concerts",
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllConcertsFilter{
+					WaitingRoomEnabled: pointer.ToPointer(true),
+					Limit:              pointer.ToPointer(int64(100)),
+					Offset:             pointer.ToPointer(int64(0)),
+				}).Return(&entity.Concerts{firstConcert}, int64(101), nil)
+				h.mockConcertRepository.EXPECT().FindAll(gomock.Any(), repository.FindAllConcertsFilter{
+					WaitingRoomEnabled: pointer.ToPointer(true),
+					Limit:              pointer.ToPointer(int64(100)),
+					Offset:             pointer.ToPointer(int64(100)),
+				}).Return(&entity.Concerts{secondConcert}, int64(101), nil)
+				h.mockWaitingRoomRepository.EXPECT().Admit(gomock.Any(), firstConcert.ID, gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				h.mockWaitingRoomRepository.EXPECT().Admit(gomock.Any(), secondConcert.ID, gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(2), nil)
+			},
+			expectedAdmitted: 3,
+		},
+		{
+			name: "no concert with a waiting room",
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Concerts{}, int64(0), nil)
+			},
+			expectedAdmitted: 0,
+		},
+		{
+			name: "failure on one concert does not stop the others",
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.Concerts{firstConcert, secondConcert}, int64(2), nil)
+				h.mockWaitingRoomRepository.EXPECT().Admit(gomock.Any(), firstConcert.ID, gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), errsFramework.NewDatabaseError("redis down", "error"))
+				h.mockWaitingRoomRepository.EXPECT().Admit(gomock.Any(), secondConcert.ID, gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(4), nil)
+			},
+			expectedAdmitted: 4,
+		},
+		{
+			name: "repository error - concert lookup failure",
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, int64(0), errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find concerts with a waiting room",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.waitingRoomUsecase.AdmitWaitingRoomEntries(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase waiting_room/admit_waiting_room_entries AdmitWaitingRoomEntries]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedAdmitted, result.AdmittedEntries)
+			}
+		})
+	}
+}
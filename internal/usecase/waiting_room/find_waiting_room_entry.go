@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type FindWaitingRoomEntryInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+	SessionID string `json:"session_id" validate:"required"`
+}
+
+// FindWaitingRoomEntry returns the place of the buyer in the waiting room, buyers poll it until they are admitted
+// and get their pass.
+func (u *waitingRoomUsecase) FindWaitingRoomEntry(ctx context.Context, input FindWaitingRoomEntryInput) (result *WaitingRoomResult, err error) {
+	const errLocation = "[usecase waiting_room/find_waiting_room_entry FindWaitingRoomEntry] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("waiting_room.usecase"), func(ctx context.Context) (*WaitingRoomResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+
+		entry, err := u.waitingRoomRepository.FindEntry(ctx, concertID, input.SessionID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find waiting room entry", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		return u.newWaitingRoomResult(ctx, entry)
+	})
+}
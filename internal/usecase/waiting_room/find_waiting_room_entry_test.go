@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	waitingroomusecase "ticket-reservation/internal/usecase/waiting_room"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWaitingRoomUsecase_FindWaitingRoomEntry(t *testing.T) {
+	concertID := uuid.New()
+	sessionID := uuid.NewString()
+	admittedUntil := time.Now().Add(10 * time.Minute)
+	admissionEnded := time.Now().Add(-time.Minute)
+
+	waitingEntry := &entity.WaitingRoomEntry{
+		ConcertID:   concertID,
+		SessionID:   sessionID,
+		Status:      entity.WaitingRoomEntryStatusWaiting,
+		Position:    1,
+		QueueLength: 4,
+	}
+	admittedEntry := &entity.WaitingRoomEntry{
+		ConcertID:     concertID,
+		SessionID:     sessionID,
+		Status:        entity.WaitingRoomEntryStatusAdmitted,
+		AdmittedUntil: &admittedUntil,
+	}
+	endedEntry := &entity.WaitingRoomEntry{
+		ConcertID:     concertID,
+		SessionID:     sessionID,
+		Status:        entity.WaitingRoomEntryStatusAdmitted,
+		AdmittedUntil: &admissionEnded,
+	}
+	validInput := waitingroomusecase.FindWaitingRoomEntryInput{
+		ConcertID: concertID.String(),
+		SessionID: sessionID,
+	}
+
+	tests := []struct {
+		name          string
+		input         waitingroomusecase.FindWaitingRoomEntryInput
+		setupMocks    func(h *testHelper)
+		expectedEntry *entity.WaitingRoomEntry
+		expectedPass  *string
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "buyer still waiting",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomRepository.EXPECT().FindEntry(gomock.Any(), concertID, sessionID).Return(waitingEntry, nil)
+			},
+			expectedEntry: waitingEntry,
+		},
+		{
+			name:  "admitted buyer gets the pass",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomRepository.EXPECT().FindEntry(gomock.Any(), concertID, sessionID).Return(admittedEntry, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("waiting-room-pass", nil)
+			},
+			expectedEntry: admittedEntry,
+			expectedPass:  pointer.ToPointer("waiting-room-pass"),
+		},
+		{
+			name:  "no pass once the admission has ended",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomRepository.EXPECT().FindEntry(gomock.Any(), concertID, sessionID).Return(endedEntry, nil)
+			},
+			expectedEntry: endedEntry,
+		},
+		{
+			name: "validation error - invalid UUID format",
+			input: waitingroomusecase.FindWaitingRoomEntryInput{
+				ConcertID: "invalid-uuid",
+				SessionID: sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "entry not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomRepository.EXPECT().FindEntry(gomock.Any(), concertID, sessionID).Return(nil, errsFramework.NewNotFoundError("waiting room entry not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "waiting room entry not found",
+		},
+		{
+			name:  "repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWaitingRoomRepository.EXPECT().FindEntry(gomock.Any(), concertID, sessionID).Return(nil, errsFramework.NewDatabaseError("redis down", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find waiting room entry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.waitingRoomUsecase.FindWaitingRoomEntry(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase waiting_room/find_waiting_room_entry FindWaitingRoomEntry]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedEntry, result.Entry)
+				assert.Equal(t, tt.expectedPass, result.Pass)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type JoinWaitingRoomInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+	SessionID string `json:"session_id" validate:"required"`
+}
+
+// JoinWaitingRoom queues the buyer in the waiting room of the concert, joining again keeps the place in the queue.
+// The pass is returned at once when the buyer was already admitted.
+func (u *waitingRoomUsecase) JoinWaitingRoom(ctx context.Context, input JoinWaitingRoomInput) (result *WaitingRoomResult, err error) {
+	const errLocation = "[usecase waiting_room/join_waiting_room JoinWaitingRoom] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("waiting_room.usecase"), func(ctx context.Context) (*WaitingRoomResult, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+
+		concert, err := u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if !concert.WaitingRoomEnabled {
+			return nil, errsFramework.NewConflictError("the concert has no waiting room enabled", nil)
+		}
+
+		entry, err := u.waitingRoomRepository.Join(ctx, concert.ID, input.SessionID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to join waiting room", nil))
+		}
+
+		return u.newWaitingRoomResult(ctx, entry)
+	})
+}
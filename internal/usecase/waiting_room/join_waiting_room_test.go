@@ -0,0 +1,190 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	waitingroomusecase "ticket-reservation/internal/usecase/waiting_room"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWaitingRoomUsecase_JoinWaitingRoom(t *testing.T) {
+	concertID := uuid.New()
+	sessionID := uuid.NewString()
+	admittedUntil := time.Now().Add(10 * time.Minute)
+
+	concert := &entity.Concert{ID: concertID, Name: "Test Concert", WaitingRoomEnabled: true}
+	waitingEntry := &entity.WaitingRoomEntry{
+		ConcertID:   concertID,
+		SessionID:   sessionID,
+		Status:      entity.WaitingRoomEntryStatusWaiting,
+		Position:    3,
+		QueueLength: 10,
+	}
+	admittedEntry := &entity.WaitingRoomEntry{
+		ConcertID:     concertID,
+		SessionID:     sessionID,
+		Status:        entity.WaitingRoomEntryStatusAdmitted,
+		QueueLength:   9,
+		AdmittedUntil: &admittedUntil,
+	}
+	validInput := waitingroomusecase.JoinWaitingRoomInput{
+		ConcertID: concertID.String(),
+		SessionID: sessionID,
+	}
+
+	tests := []struct {
+		name          string
+		input         waitingroomusecase.JoinWaitingRoomInput
+		setupMocks    func(h *testHelper)
+		expectedEntry *entity.WaitingRoomEntry
+		expectedPass  *string
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "buyer is queued",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockWaitingRoomRepository.EXPECT().Join(gomock.Any(), concertID, sessionID).Return(waitingEntry, nil)
+			},
+			expectedEntry: waitingEntry,
+		},
+		{
+			name:  "buyer already admitted gets the pass",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockWaitingRoomRepository.EXPECT().Join(gomock.Any(), concertID, sessionID).Return(admittedEntry, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, claims jwt.Claims) (string, error) {
+					passClaims := claims.(*entity.WaitingRoomPassClaims)
+					assert.True(t, passClaims.Allows(concertID, sessionID))
+					assert.Equal(t, admittedUntil.Unix(), passClaims.ExpiresAt.Unix())
+					return "waiting-room-pass", nil
+				})
+			},
+			expectedEntry: admittedEntry,
+			expectedPass:  pointer.ToPointer("waiting-room-pass"),
+		},
+		{
+			name: "validation error - missing session ID",
+			input: waitingroomusecase.JoinWaitingRoomInput{
+				ConcertID: concertID.String(),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - invalid UUID format",
+			input: waitingroomusecase.JoinWaitingRoomInput{
+				ConcertID: "invalid-uuid",
+				SessionID: sessionID,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "concert not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name:  "concert without waiting room",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(&entity.Concert{ID: concertID}, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ConflictError{},
+			errorContains: "the concert has no waiting room enabled",
+		},
+		{
+			name:  "repository error - concert lookup failure",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find concert by ID",
+		},
+		{
+			name:  "repository error - join failure",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockWaitingRoomRepository.EXPECT().Join(gomock.Any(), concertID, sessionID).Return(nil, errsFramework.NewDatabaseError("redis down", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to join waiting room",
+		},
+		{
+			name:  "pass signing failure",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockWaitingRoomRepository.EXPECT().Join(gomock.Any(), concertID, sessionID).Return(admittedEntry, nil)
+				h.mockJWTManager.EXPECT().CreateToken(gomock.Any(), gomock.Any()).Return("", assert.AnError)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to sign waiting room pass",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.waitingRoomUsecase.JoinWaitingRoom(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase waiting_room/join_waiting_room JoinWaitingRoom]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expectedEntry, result.Entry)
+				assert.Equal(t, tt.expectedPass, result.Pass)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/cache"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"time"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	jwtutil "github.com/kittipat1413/go-common/util/jwt"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/waiting_room_usecase.go -package=waiting_room_usecasemocks
+type WaitingRoomUsecase interface {
+	JoinWaitingRoom(ctx context.Context, input JoinWaitingRoomInput) (*WaitingRoomResult, error)
+	FindWaitingRoomEntry(ctx context.Context, input FindWaitingRoomEntryInput) (*WaitingRoomResult, error)
+	AdmitWaitingRoomEntries(ctx context.Context) (*AdmitWaitingRoomEntriesResult, error)
+	VerifyWaitingRoomPass(ctx context.Context, input VerifyWaitingRoomPassInput) (*entity.WaitingRoomPassClaims, error)
+}
+
+type waitingRoomUsecase struct {
+	appConfig             config.AppConfig
+	concertRepository     repository.ConcertRepository
+	waitingRoomRepository cache.WaitingRoomRepository
+	jwtManager            jwtutil.JWTManager
+}
+
+func NewWaitingRoomUsecase(
+	appConfig config.AppConfig,
+	concertRepository repository.ConcertRepository,
+	waitingRoomRepository cache.WaitingRoomRepository,
+	jwtManager jwtutil.JWTManager,
+) WaitingRoomUsecase {
+	return &waitingRoomUsecase{
+		appConfig:             appConfig,
+		concertRepository:     concertRepository,
+		waitingRoomRepository: waitingRoomRepository,
+		jwtManager:            jwtManager,
+	}
+}
+
+// WaitingRoomResult is the entry of a buyer in the waiting room, together with the signed pass once the buyer is admitted.
+type WaitingRoomResult struct {
+	Entry *entity.WaitingRoomEntry
+	Pass  *string
+}
+
+// newWaitingRoomResult signs the pass of an admitted entry, the pass is left empty while the buyer is waiting.
+func (u *waitingRoomUsecase) newWaitingRoomResult(ctx context.Context, entry *entity.WaitingRoomEntry) (*WaitingRoomResult, error) {
+	requestTime := time.Now()
+	if !entry.IsAdmitted(requestTime) {
+		return &WaitingRoomResult{Entry: entry}, nil
+	}
+
+	pass, err := u.jwtManager.CreateToken(ctx, entity.NewWaitingRoomPassClaims(entry, requestTime))
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to sign waiting room pass", nil))
+	}
+	return &WaitingRoomResult{Entry: entry, Pass: &pass}, nil
+}
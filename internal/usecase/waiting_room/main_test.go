@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	cache_mocks "ticket-reservation/internal/domain/cache/mocks"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	waitingroomusecase "ticket-reservation/internal/usecase/waiting_room"
+
+	jwt_mocks "github.com/kittipat1413/go-common/util/jwt/mocks"
+)
+
+type testHelper struct {
+	ctrl                      *gomock.Controller
+	appConfig                 config.AppConfig
+	mockConcertRepository     *repository_mocks.MockConcertRepository
+	mockWaitingRoomRepository *cache_mocks.MockWaitingRoomRepository
+	mockJWTManager            *jwt_mocks.MockJWTManager
+	waitingRoomUsecase        waitingroomusecase.WaitingRoomUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		Timezone:                  "Asia/Bangkok",
+		WaitingRoomAdmitInterval:  5 * time.Second,
+		WaitingRoomAdmitBatchSize: 50,
+		WaitingRoomPassTTL:        10 * time.Minute,
+	}
+
+	mockConcertRepository := repository_mocks.NewMockConcertRepository(ctrl)
+	mockWaitingRoomRepository := cache_mocks.NewMockWaitingRoomRepository(ctrl)
+	mockJWTManager := jwt_mocks.NewMockJWTManager(ctrl)
+
+	usecase := waitingroomusecase.NewWaitingRoomUsecase(
+		appConfig,
+		mockConcertRepository,
+		mockWaitingRoomRepository,
+		mockJWTManager,
+	)
+
+	return &testHelper{
+		ctrl:                      ctrl,
+		appConfig:                 appConfig,
+		mockConcertRepository:     mockConcertRepository,
+		mockWaitingRoomRepository: mockWaitingRoomRepository,
+		mockJWTManager:            mockJWTManager,
+		waitingRoomUsecase:        usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewWaitingRoomUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		Timezone: "Asia/Bangkok",
+	}
+	mockConcertRepo := repository_mocks.NewMockConcertRepository(ctrl)
+	mockWaitingRoomRepo := cache_mocks.NewMockWaitingRoomRepository(ctrl)
+	mockJWTManager := jwt_mocks.NewMockJWTManager(ctrl)
+
+	// Execute
+	usecase := waitingroomusecase.NewWaitingRoomUsecase(appConfig, mockConcertRepo, mockWaitingRoomRepo, mockJWTManager)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package waiting_room_usecasemocks is a generated GoMock package.
+package waiting_room_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/waiting_room"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWaitingRoomUsecase is a mock of WaitingRoomUsecase interface.
+type MockWaitingRoomUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitingRoomUsecaseMockRecorder
+}
+
+// MockWaitingRoomUsecaseMockRecorder is the mock recorder for MockWaitingRoomUsecase.
+type MockWaitingRoomUsecaseMockRecorder struct {
+	mock *MockWaitingRoomUsecase
+}
+
+// NewMockWaitingRoomUsecase creates a new mock instance.
+func NewMockWaitingRoomUsecase(ctrl *gomock.Controller) *MockWaitingRoomUsecase {
+	mock := &MockWaitingRoomUsecase{ctrl: ctrl}
+	mock.recorder = &MockWaitingRoomUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitingRoomUsecase) EXPECT() *MockWaitingRoomUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AdmitWaitingRoomEntries mocks base method.
+func (m *MockWaitingRoomUsecase) AdmitWaitingRoomEntries(ctx context.Context) (*usecase.AdmitWaitingRoomEntriesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdmitWaitingRoomEntries", ctx)
+	ret0, _ := ret[0].(*usecase.AdmitWaitingRoomEntriesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdmitWaitingRoomEntries indicates an expected call of AdmitWaitingRoomEntries.
+func (mr *MockWaitingRoomUsecaseMockRecorder) AdmitWaitingRoomEntries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdmitWaitingRoomEntries", reflect.TypeOf((*MockWaitingRoomUsecase)(nil).AdmitWaitingRoomEntries), ctx)
+}
+
+// FindWaitingRoomEntry mocks base method.
+func (m *MockWaitingRoomUsecase) FindWaitingRoomEntry(ctx context.Context, input usecase.FindWaitingRoomEntryInput) (*usecase.WaitingRoomResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWaitingRoomEntry", ctx, input)
+	ret0, _ := ret[0].(*usecase.WaitingRoomResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWaitingRoomEntry indicates an expected call of FindWaitingRoomEntry.
+func (mr *MockWaitingRoomUsecaseMockRecorder) FindWaitingRoomEntry(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWaitingRoomEntry", reflect.TypeOf((*MockWaitingRoomUsecase)(nil).FindWaitingRoomEntry), ctx, input)
+}
+
+// JoinWaitingRoom mocks base method.
+func (m *MockWaitingRoomUsecase) JoinWaitingRoom(ctx context.Context, input usecase.JoinWaitingRoomInput) (*usecase.WaitingRoomResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitingRoom", ctx, input)
+	ret0, _ := ret[0].(*usecase.WaitingRoomResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitingRoom indicates an expected call of JoinWaitingRoom.
+func (mr *MockWaitingRoomUsecaseMockRecorder) JoinWaitingRoom(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitingRoom", reflect.TypeOf((*MockWaitingRoomUsecase)(nil).JoinWaitingRoom), ctx, input)
+}
+
+// VerifyWaitingRoomPass mocks base method.
+func (m *MockWaitingRoomUsecase) VerifyWaitingRoomPass(ctx context.Context, input usecase.VerifyWaitingRoomPassInput) (*entity.WaitingRoomPassClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyWaitingRoomPass", ctx, input)
+	ret0, _ := ret[0].(*entity.WaitingRoomPassClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyWaitingRoomPass indicates an expected call of VerifyWaitingRoomPass.
+func (mr *MockWaitingRoomUsecaseMockRecorder) VerifyWaitingRoomPass(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyWaitingRoomPass", reflect.TypeOf((*MockWaitingRoomUsecase)(nil).VerifyWaitingRoomPass), ctx, input)
+}
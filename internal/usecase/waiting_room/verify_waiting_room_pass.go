@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type VerifyWaitingRoomPassInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+	SessionID string `json:"session_id" validate:"required"`
+	Pass      string `json:"pass"` // Empty when the buyer sent no pass
+}
+
+// VerifyWaitingRoomPass checks that the buyer may reserve seats of the concert and returns the claims of the pass.
+// Concerts without the waiting room need no pass and nil claims are returned, for the others the pass must have been
+// issued to the same session for the same concert and must not have expired.
+func (u *waitingRoomUsecase) VerifyWaitingRoomPass(ctx context.Context, input VerifyWaitingRoomPassInput) (claims *entity.WaitingRoomPassClaims, err error) {
+	const errLocation = "[usecase waiting_room/verify_waiting_room_pass VerifyWaitingRoomPass] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("waiting_room.usecase"), func(ctx context.Context) (*entity.WaitingRoomPassClaims, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+
+		concert, err := u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if !concert.WaitingRoomEnabled {
+			return nil, nil
+		}
+
+		if input.Pass == "" {
+			return nil, errsFramework.NewForbiddenError("a waiting room pass is required to reserve seats of this concert", nil)
+		}
+		var claims entity.WaitingRoomPassClaims
+		if err := u.jwtManager.ParseAndValidateToken(ctx, input.Pass, &claims); err != nil { // Also rejects an expired pass
+			return nil, errsFramework.WrapError(err, errsFramework.NewForbiddenError("the waiting room pass is invalid", nil))
+		}
+		if !claims.Allows(concert.ID, input.SessionID) {
+			return nil, errsFramework.NewForbiddenError("the waiting room pass is invalid", nil)
+		}
+
+		return &claims, nil
+	})
+}
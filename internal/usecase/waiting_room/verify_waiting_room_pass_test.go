@@ -0,0 +1,196 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	waitingroomusecase "ticket-reservation/internal/usecase/waiting_room"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWaitingRoomUsecase_VerifyWaitingRoomPass(t *testing.T) {
+	concertID := uuid.New()
+	sessionID := uuid.NewString()
+	admittedUntil := time.Now().Add(10 * time.Minute)
+
+	// parseAs returns the claims the mocked JWT manager reads from the pass
+	parseAs := func(claims *entity.WaitingRoomPassClaims) func(context.Context, string, jwt.Claims) error {
+		return func(_ context.Context, _ string, out jwt.Claims) error {
+			*out.(*entity.WaitingRoomPassClaims) = *claims
+			return nil
+		}
+	}
+	passClaims := entity.NewWaitingRoomPassClaims(&entity.WaitingRoomEntry{
+		ConcertID:     concertID,
+		SessionID:     sessionID,
+		Status:        entity.WaitingRoomEntryStatusAdmitted,
+		AdmittedUntil: &admittedUntil,
+	}, time.Now())
+	otherConcertClaims := entity.NewWaitingRoomPassClaims(&entity.WaitingRoomEntry{
+		ConcertID:     uuid.New(),
+		SessionID:     sessionID,
+		Status:        entity.WaitingRoomEntryStatusAdmitted,
+		AdmittedUntil: &admittedUntil,
+	}, time.Now())
+	otherSessionClaims := entity.NewWaitingRoomPassClaims(&entity.WaitingRoomEntry{
+		ConcertID:     concertID,
+		SessionID:     uuid.NewString(),
+		Status:        entity.WaitingRoomEntryStatusAdmitted,
+		AdmittedUntil: &admittedUntil,
+	}, time.Now())
+
+	concert := &entity.Concert{ID: concertID, WaitingRoomEnabled: true}
+	validInput := waitingroomusecase.VerifyWaitingRoomPassInput{
+		ConcertID: concertID.String(),
+		SessionID: sessionID,
+		Pass:      "waiting-room-pass",
+	}
+
+	tests := []struct {
+		name           string
+		input          waitingroomusecase.VerifyWaitingRoomPassInput
+		setupMocks     func(h *testHelper)
+		expectedClaims *entity.WaitingRoomPassClaims
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name:  "valid pass",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "waiting-room-pass", gomock.Any()).DoAndReturn(parseAs(passClaims))
+			},
+			expectedClaims: passClaims,
+		},
+		{
+			name: "no pass needed without waiting room",
+			input: waitingroomusecase.VerifyWaitingRoomPassInput{
+				ConcertID: concertID.String(),
+				SessionID: sessionID,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(&entity.Concert{ID: concertID}, nil)
+			},
+			expectedClaims: nil,
+		},
+		{
+			name: "missing pass",
+			input: waitingroomusecase.VerifyWaitingRoomPassInput{
+				ConcertID: concertID.String(),
+				SessionID: sessionID,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "a waiting room pass is required",
+		},
+		{
+			name:  "expired or tampered pass",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "waiting-room-pass", gomock.Any()).Return(assert.AnError)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the waiting room pass is invalid",
+		},
+		{
+			name:  "pass of another concert",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "waiting-room-pass", gomock.Any()).DoAndReturn(parseAs(otherConcertClaims))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the waiting room pass is invalid",
+		},
+		{
+			name:  "pass of another session",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(concert, nil)
+				h.mockJWTManager.EXPECT().ParseAndValidateToken(gomock.Any(), "waiting-room-pass", gomock.Any()).DoAndReturn(parseAs(otherSessionClaims))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the waiting room pass is invalid",
+		},
+		{
+			name: "validation error - missing session ID",
+			input: waitingroomusecase.VerifyWaitingRoomPassInput{
+				ConcertID: concertID.String(),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "concert not found",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name:  "repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().FindOne(gomock.Any(), concertID).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find concert by ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			claims, err := h.waitingRoomUsecase.VerifyWaitingRoomPass(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase waiting_room/verify_waiting_room_pass VerifyWaitingRoomPass]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, claims)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedClaims, claims)
+			}
+		})
+	}
+}
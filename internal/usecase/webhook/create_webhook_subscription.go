@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type CreateWebhookSubscriptionInput struct {
+	Name       string   `json:"name" validate:"required,max=100"`
+	URL        string   `json:"url" validate:"required,http_url,max=2048"`
+	Secret     string   `json:"secret" validate:"required,min=16,max=256"`
+	EventTypes []string `json:"event_types" validate:"required,min=1,unique,dive,oneof=concert.created reservation.created reservation.expired reservation.confirmed payment.succeeded"`
+}
+
+// CreateWebhookSubscription subscribes an endpoint to the events of the given types, starting with the next event.
+// The secret is chosen by the subscriber, who uses it to verify the signature of every delivery.
+func (u *webhookUsecase) CreateWebhookSubscription(ctx context.Context, input CreateWebhookSubscriptionInput) (subscription *entity.WebhookSubscription, err error) {
+	const errLocation = "[usecase webhook/create_webhook_subscription CreateWebhookSubscription] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("webhook.usecase"), func(ctx context.Context) (*entity.WebhookSubscription, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		eventTypes, err := parseWebhookEventTypes(input.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+
+		subscription, err := u.webhookSubscriptionRepository.CreateOne(ctx, &entity.WebhookSubscription{
+			Name:       input.Name,
+			URL:        input.URL,
+			Secret:     input.Secret,
+			EventTypes: eventTypes,
+		})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create webhook subscription", nil))
+		}
+
+		return subscription, nil
+	})
+}
+
+func parseWebhookEventTypes(values []string) ([]entity.WebhookEventType, error) {
+	eventTypes := make([]entity.WebhookEventType, 0, len(values))
+	for _, value := range values {
+		eventType, err := new(entity.WebhookEventType).Parse(value)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid event type", nil))
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes, nil
+}
@@ -0,0 +1,137 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	webhookusecase "ticket-reservation/internal/usecase/webhook"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWebhookUsecase_CreateWebhookSubscription(t *testing.T) {
+	subscriptionID := uuid.New()
+
+	validInput := webhookusecase.CreateWebhookSubscriptionInput{
+		Name:       "CRM",
+		URL:        "https://crm.example.com/webhooks",
+		Secret:     "whsec_0123456789abcdef",
+		EventTypes: []string{"reservation.created", "payment.succeeded"},
+	}
+
+	tests := []struct {
+		name          string
+		input         webhookusecase.CreateWebhookSubscriptionInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name:  "successful creation",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+						assert.Equal(t, "CRM", subscription.Name)
+						assert.Equal(t, "https://crm.example.com/webhooks", subscription.URL)
+						assert.Equal(t, "whsec_0123456789abcdef", subscription.Secret)
+						assert.Equal(t, []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated, entity.WebhookEventTypePaymentSucceeded}, subscription.EventTypes)
+						created := *subscription
+						created.ID = subscriptionID
+						created.IsActive = true
+						return &created, nil
+					})
+			},
+		},
+		{
+			name: "validation error - unknown event type",
+			input: webhookusecase.CreateWebhookSubscriptionInput{
+				Name:       "CRM",
+				URL:        "https://crm.example.com/webhooks",
+				Secret:     "whsec_0123456789abcdef",
+				EventTypes: []string{"seat.released"},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - not an http url",
+			input: webhookusecase.CreateWebhookSubscriptionInput{
+				Name:       "CRM",
+				URL:        "ftp://crm.example.com/webhooks",
+				Secret:     "whsec_0123456789abcdef",
+				EventTypes: []string{"reservation.created"},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - short secret",
+			input: webhookusecase.CreateWebhookSubscriptionInput{
+				Name:       "CRM",
+				URL:        "https://crm.example.com/webhooks",
+				Secret:     "secret",
+				EventTypes: []string{"reservation.created"},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name:  "webhook subscription repository error",
+			input: validInput,
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().CreateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create webhook subscription",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.webhookUsecase.CreateWebhookSubscription(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase webhook/create_webhook_subscription CreateWebhookSubscription]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				assert.Equal(t, subscriptionID, result.ID)
+				assert.True(t, result.IsActive)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/pkg/webhooksignature"
+	"time"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	commonLogger "github.com/kittipat1413/go-common/framework/logger"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+const (
+	// defaultWebhookDeliveryBatchSize is used when no batch size is configured
+	defaultWebhookDeliveryBatchSize = 20
+	// defaultWebhookMaxAttempts is used when no maximum is configured
+	defaultWebhookMaxAttempts = 8
+	// defaultWebhookDeliveryLease is used when no lease is configured
+	defaultWebhookDeliveryLease = 15 * time.Minute
+)
+
+type DeliverWebhooksResult struct {
+	Delivered    int // Answered with a 2xx status
+	Pending      int // Left for a later run, failed with attempts left or not recorded
+	DeadLettered int // Out of attempts, or their subscription was deactivated
+}
+
+// DeliverWebhooks claims a batch of due deliveries and attempts each of them once, concurrently. A delivery is done
+// once its subscriber answers with a 2xx status; a failed attempt is recorded with the time the delivery is due again,
+// further away after every attempt, so that a later run claims it again. It is dead-lettered once it has been
+// attempted WebhookMaxAttempts times. A delivery whose attempt was never recorded is claimed again once its lease has passed.
+func (u *webhookUsecase) DeliverWebhooks(ctx context.Context) (result *DeliverWebhooksResult, err error) {
+	const errLocation = "[usecase webhook/deliver_webhooks DeliverWebhooks] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("webhook.usecase"), func(ctx context.Context) (*DeliverWebhooksResult, error) {
+		batchSize := u.appConfig.WebhookDeliveryBatchSize
+		if batchSize <= 0 {
+			batchSize = defaultWebhookDeliveryBatchSize
+		}
+		lease := u.appConfig.WebhookDeliveryLease
+		if lease <= 0 {
+			lease = defaultWebhookDeliveryLease
+		}
+
+		now := time.Now()
+		deliveries, err := u.webhookDeliveryRepository.ClaimMany(ctx, repository.ClaimManyWebhookDeliveriesInput{
+			DueBefore:  now,
+			LeaseUntil: now.Add(lease),
+			Limit:      int64(batchSize),
+		})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to claim webhook deliveries", nil))
+		}
+		if len(pointer.GetValue(deliveries)) == 0 {
+			return &DeliverWebhooksResult{}, nil
+		}
+
+		subscriptions, err := u.webhookSubscriptionRepository.FindAll(ctx)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find webhook subscriptions", nil))
+		}
+		subscriptionsByID := make(map[uuid.UUID]entity.WebhookSubscription, len(pointer.GetValue(subscriptions)))
+		for _, subscription := range pointer.GetValue(subscriptions) {
+			subscriptionsByID[subscription.ID] = subscription
+		}
+
+		// One slow subscriber must not hold back the others, every delivery is sent on its own
+		statuses := make([]entity.WebhookDeliveryStatus, len(*deliveries))
+		var wg sync.WaitGroup
+		for i, delivery := range *deliveries {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				statuses[i] = u.deliverWebhook(ctx, delivery, subscriptionsByID[delivery.SubscriptionID])
+			}()
+		}
+		wg.Wait()
+
+		result := &DeliverWebhooksResult{}
+		for _, status := range statuses {
+			switch status {
+			case entity.WebhookDeliveryStatusDelivered:
+				result.Delivered++
+			case entity.WebhookDeliveryStatusDeadLettered:
+				result.DeadLettered++
+			default:
+				result.Pending++
+			}
+		}
+		return result, nil
+	})
+}
+
+// deliverWebhook attempts the delivery once and returns its status. Failures to record the attempt are only logged,
+// the delivery is then left pending and claimed again once its lease has passed.
+func (u *webhookUsecase) deliverWebhook(ctx context.Context, delivery entity.WebhookDelivery, subscription entity.WebhookSubscription) entity.WebhookDeliveryStatus {
+	logger := commonLogger.FromContext(ctx)
+	maxAttempts := u.appConfig.WebhookMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultWebhookMaxAttempts
+	}
+
+	// A deactivated subscription is not sent anything anymore
+	if !subscription.IsActive {
+		attemptedAt := time.Now()
+		recorded, err := u.webhookDeliveryRepository.RecordAttempt(ctx, repository.RecordWebhookDeliveryAttemptInput{
+			ID:            delivery.ID,
+			Status:        entity.WebhookDeliveryStatusDeadLettered,
+			AttemptedAt:   attemptedAt,
+			Error:         pointer.ToPointer("the webhook subscription is inactive"),
+			NextAttemptAt: attemptedAt,
+		})
+		if err != nil {
+			logger.Error(ctx, "failed to dead-letter webhook delivery", err, commonLogger.Fields{"delivery_id": delivery.ID})
+			return entity.WebhookDeliveryStatusPending
+		}
+		return recorded.Status
+	}
+
+	input := u.attemptWebhookDelivery(ctx, delivery, subscription)
+	if input.Status == entity.WebhookDeliveryStatusPending {
+		attempts := delivery.Attempts + 1
+		if attempts >= maxAttempts {
+			input.Status = entity.WebhookDeliveryStatusDeadLettered
+		} else {
+			// Wait longer after every failed attempt, the first retry waits the base delay
+			input.NextAttemptAt = input.AttemptedAt.Add(u.backoff.Next(attempts - 1))
+		}
+	}
+
+	recorded, err := u.webhookDeliveryRepository.RecordAttempt(ctx, input)
+	if err != nil {
+		logger.Error(ctx, "failed to record webhook delivery attempt", err, commonLogger.Fields{"delivery_id": delivery.ID})
+		return entity.WebhookDeliveryStatusPending
+	}
+	if recorded.Status == entity.WebhookDeliveryStatusDeadLettered {
+		logger.Warn(ctx, "webhook delivery dead-lettered", commonLogger.Fields{
+			"delivery_id":     delivery.ID,
+			"subscription_id": subscription.ID,
+			"event_id":        delivery.EventID,
+		})
+	}
+	return recorded.Status
+}
+
+// attemptWebhookDelivery signs and sends the delivery once, and returns the outcome to record.
+func (u *webhookUsecase) attemptWebhookDelivery(ctx context.Context, delivery entity.WebhookDelivery, subscription entity.WebhookSubscription) repository.RecordWebhookDeliveryAttemptInput {
+	attemptedAt := time.Now()
+	payload := []byte(delivery.Payload)
+	input := repository.RecordWebhookDeliveryAttemptInput{
+		ID:            delivery.ID,
+		Status:        entity.WebhookDeliveryStatusPending,
+		AttemptedAt:   attemptedAt,
+		NextAttemptAt: attemptedAt,
+	}
+
+	result, err := u.webhookSender.Send(ctx, gateway.SendWebhookInput{
+		URL: subscription.URL,
+		Headers: map[string]string{
+			WebhookTimestampHeader:  strconv.FormatInt(attemptedAt.Unix(), 10),
+			WebhookSignatureHeader:  webhooksignature.Sign(subscription.Secret, attemptedAt, payload),
+			WebhookEventIDHeader:    delivery.EventID.String(),
+			WebhookEventTypeHeader:  delivery.EventType.String(),
+			WebhookDeliveryIDHeader: delivery.ID.String(),
+		},
+		Payload: payload,
+	})
+	if err != nil {
+		input.Error = pointer.ToPointer(err.Error())
+		return input
+	}
+
+	input.ResponseStatus = pointer.ToPointer(result.StatusCode)
+	if result.StatusCode < 200 || result.StatusCode > 299 {
+		input.Error = pointer.ToPointer(fmt.Sprintf("unexpected response status %d", result.StatusCode))
+		return input
+	}
+	input.Status = entity.WebhookDeliveryStatusDelivered
+	input.DeliveredAt = &attemptedAt
+	return input
+}
@@ -0,0 +1,272 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+	webhookusecase "ticket-reservation/internal/usecase/webhook"
+	"ticket-reservation/pkg/webhooksignature"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWebhookUsecase_DeliverWebhooks(t *testing.T) {
+	subscriptionID := uuid.New()
+	subscription := entity.WebhookSubscription{
+		ID:         subscriptionID,
+		Name:       "CRM",
+		URL:        "https://crm.example.com/webhooks",
+		Secret:     "whsec_0123456789abcdef",
+		EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated},
+		IsActive:   true,
+	}
+	inactiveSubscription := subscription
+	inactiveSubscription.IsActive = false
+
+	newDelivery := func(attempts int) entity.WebhookDelivery {
+		return entity.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscriptionID,
+			EventID:        uuid.New(),
+			EventType:      entity.WebhookEventTypeReservationCreated,
+			Payload:        `{"id":"1","type":"reservation.created"}`,
+			Status:         entity.WebhookDeliveryStatusPending,
+			Attempts:       attempts,
+		}
+	}
+	// expectClaim claims the deliveries and finds the subscriptions
+	expectClaim := func(h *testHelper, subscriptions entity.WebhookSubscriptions, deliveries ...entity.WebhookDelivery) {
+		claimed := entity.WebhookDeliveries(deliveries)
+		h.mockWebhookDeliveryRepository.EXPECT().ClaimMany(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, input repository.ClaimManyWebhookDeliveriesInput) (*entity.WebhookDeliveries, error) {
+				assert.Equal(t, int64(20), input.Limit)
+				assert.Equal(t, 15*time.Minute, input.LeaseUntil.Sub(input.DueBefore))
+				return &claimed, nil
+			})
+		h.mockWebhookSubscriptionRepository.EXPECT().FindAll(gomock.Any()).Return(&subscriptions, nil)
+	}
+	// expectAttempt records the attempt of the delivery with the expected status and delay until it is due again,
+	// counting it as the repository does
+	expectAttempt := func(h *testHelper, delivery entity.WebhookDelivery, status entity.WebhookDeliveryStatus, nextAttemptDelay time.Duration) {
+		h.mockWebhookDeliveryRepository.EXPECT().RecordAttempt(gomock.Any(), recordingDelivery(delivery.ID)).
+			DoAndReturn(func(ctx context.Context, input repository.RecordWebhookDeliveryAttemptInput) (*entity.WebhookDelivery, error) {
+				assert.Equal(t, delivery.ID, input.ID)
+				assert.Equal(t, status, input.Status)
+				assert.Equal(t, nextAttemptDelay, input.NextAttemptAt.Sub(input.AttemptedAt))
+				recorded := delivery
+				recorded.Status = input.Status
+				recorded.Attempts = delivery.Attempts + 1
+				recorded.NextAttemptAt = input.NextAttemptAt
+				recorded.LastResponseStatus = input.ResponseStatus
+				recorded.LastError = input.Error
+				recorded.DeliveredAt = input.DeliveredAt
+				return &recorded, nil
+			})
+	}
+	// respond answers the attempt with the status, 0 standing for no response
+	respond := func(h *testHelper, statusCode int) {
+		call := h.mockWebhookSender.EXPECT().Send(gomock.Any(), gomock.Any())
+		if statusCode == 0 {
+			call.Return(nil, errors.New("connection refused"))
+		} else {
+			call.Return(&gateway.SendWebhookResult{StatusCode: statusCode}, nil)
+		}
+	}
+
+	delivered := entity.WebhookDeliveryStatusDelivered
+	pending := entity.WebhookDeliveryStatusPending
+	deadLettered := entity.WebhookDeliveryStatusDeadLettered
+
+	tests := []struct {
+		name           string
+		setupMocks     func(h *testHelper)
+		expectedResult *webhookusecase.DeliverWebhooksResult
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "no due deliveries",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookDeliveryRepository.EXPECT().ClaimMany(gomock.Any(), gomock.Any()).Return(&entity.WebhookDeliveries{}, nil)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{},
+		},
+		{
+			name: "delivered on the first attempt with a signed payload",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(0)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, delivery)
+				h.mockWebhookSender.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input gateway.SendWebhookInput) (*gateway.SendWebhookResult, error) {
+						assert.Equal(t, subscription.URL, input.URL)
+						assert.Equal(t, delivery.Payload, string(input.Payload))
+						assert.Equal(t, delivery.EventID.String(), input.Headers[webhookusecase.WebhookEventIDHeader])
+						assert.Equal(t, "reservation.created", input.Headers[webhookusecase.WebhookEventTypeHeader])
+						assert.Equal(t, delivery.ID.String(), input.Headers[webhookusecase.WebhookDeliveryIDHeader])
+						assert.NoError(t, webhooksignature.Verify(
+							subscription.Secret,
+							input.Headers[webhookusecase.WebhookTimestampHeader],
+							input.Headers[webhookusecase.WebhookSignatureHeader],
+							input.Payload,
+							time.Now(),
+							time.Minute,
+						))
+						return &gateway.SendWebhookResult{StatusCode: http.StatusNoContent}, nil
+					})
+				expectAttempt(h, delivery, delivered, 0)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{Delivered: 1},
+		},
+		{
+			name: "failed attempt is due again after the base delay",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(0)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, delivery)
+				respond(h, http.StatusServiceUnavailable)
+				expectAttempt(h, delivery, pending, time.Second)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{Pending: 1},
+		},
+		{
+			name: "the delay doubles after every failed attempt",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(1)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, delivery)
+				respond(h, 0)
+				expectAttempt(h, delivery, pending, 2*time.Second)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{Pending: 1},
+		},
+		{
+			name: "delivered on a later attempt",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(2)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, delivery)
+				respond(h, http.StatusOK)
+				expectAttempt(h, delivery, delivered, 0)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{Delivered: 1},
+		},
+		{
+			name: "dead-lettered after the last attempt",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(2)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, delivery)
+				respond(h, http.StatusBadRequest)
+				expectAttempt(h, delivery, deadLettered, 0)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{DeadLettered: 1},
+		},
+		{
+			name: "deliveries of an inactive subscription are dead-lettered without being sent",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(0)
+				expectClaim(h, entity.WebhookSubscriptions{inactiveSubscription}, delivery)
+				expectAttempt(h, delivery, deadLettered, 0)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{DeadLettered: 1},
+		},
+		{
+			name: "deliveries are sent independently",
+			setupMocks: func(h *testHelper) {
+				first := newDelivery(0)
+				second := newDelivery(2)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, first, second)
+				h.mockWebhookSender.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, input gateway.SendWebhookInput) (*gateway.SendWebhookResult, error) {
+						if input.Headers[webhookusecase.WebhookDeliveryIDHeader] == first.ID.String() {
+							return &gateway.SendWebhookResult{StatusCode: http.StatusOK}, nil
+						}
+						return &gateway.SendWebhookResult{StatusCode: http.StatusGone}, nil
+					}).Times(2)
+				expectAttempt(h, first, delivered, 0)
+				expectAttempt(h, second, deadLettered, 0)
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{Delivered: 1, DeadLettered: 1},
+		},
+		{
+			name: "delivery is left pending when its attempt cannot be recorded",
+			setupMocks: func(h *testHelper) {
+				delivery := newDelivery(0)
+				expectClaim(h, entity.WebhookSubscriptions{subscription}, delivery)
+				respond(h, http.StatusOK)
+				h.mockWebhookDeliveryRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedResult: &webhookusecase.DeliverWebhooksResult{Pending: 1},
+		},
+		{
+			name: "claim error",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookDeliveryRepository.EXPECT().ClaimMany(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to claim webhook deliveries",
+		},
+		{
+			name: "webhook subscription repository error",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookDeliveryRepository.EXPECT().ClaimMany(gomock.Any(), gomock.Any()).Return(&entity.WebhookDeliveries{newDelivery(0)}, nil)
+				h.mockWebhookSubscriptionRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find webhook subscriptions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.webhookUsecase.DeliverWebhooks(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase webhook/deliver_webhooks DeliverWebhooks]")
+				assert.Contains(t, err.Error(), tt.errorContains)
+				assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+// recordDeliveryMatcher matches the attempts recorded for one delivery, deliveries being sent concurrently
+type recordDeliveryMatcher struct {
+	id uuid.UUID
+}
+
+func recordingDelivery(id uuid.UUID) gomock.Matcher {
+	return recordDeliveryMatcher{id: id}
+}
+
+func (m recordDeliveryMatcher) Matches(x interface{}) bool {
+	input, ok := x.(repository.RecordWebhookDeliveryAttemptInput)
+	return ok && input.ID == m.id
+}
+
+func (m recordDeliveryMatcher) String() string {
+	return "records an attempt of delivery " + m.id.String()
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindAllWebhookDeliveriesInput struct {
+	SubscriptionID string  `json:"subscription_id" validate:"required,uuid4"`
+	Status         *string `json:"status" validate:"omitempty,oneof=pending delivered dead_lettered"`
+	EventType      *string `json:"event_type" validate:"omitempty,oneof=concert.created reservation.created reservation.expired reservation.confirmed payment.succeeded"`
+	Limit          *int64  `json:"limit" validate:"required,gte=1,lte=100"`
+	Offset         *int64  `json:"offset" validate:"required,gte=0"`
+}
+
+// FindAllWebhookDeliveries returns the delivery log of a subscription, the most recent first, with the outcome
+// of the last attempt of every delivery.
+func (u *webhookUsecase) FindAllWebhookDeliveries(ctx context.Context, input FindAllWebhookDeliveriesInput) (deliveries entity.Page[entity.WebhookDelivery], err error) {
+	const errLocation = "[usecase webhook/find_all_webhook_deliveries FindAllWebhookDeliveries] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("webhook.usecase"), func(ctx context.Context) (entity.Page[entity.WebhookDelivery], error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		subscriptionID, err := uuid.Parse(input.SubscriptionID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid webhook subscription ID", nil))
+		}
+
+		filter := repository.FindAllWebhookDeliveriesFilter{SubscriptionID: &subscriptionID}
+		if input.Status != nil {
+			status, err := new(entity.WebhookDeliveryStatus).Parse(*input.Status)
+			if err != nil {
+				return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid delivery status", nil))
+			}
+			filter.Status = &status
+		}
+		if input.EventType != nil {
+			eventType, err := new(entity.WebhookEventType).Parse(*input.EventType)
+			if err != nil {
+				return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid event type", nil))
+			}
+			filter.EventType = &eventType
+		}
+
+		// Make sure the subscription exists, so an unknown subscription is a 404 rather than an empty list
+		_, err = u.webhookSubscriptionRepository.FindOne(ctx, subscriptionID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find webhook subscription by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		return entity.NewPage(u.findAllWebhookDeliveries(ctx, filter, input))
+	})
+}
+
+func (u *webhookUsecase) findAllWebhookDeliveries(ctx context.Context, filter repository.FindAllWebhookDeliveriesFilter, input FindAllWebhookDeliveriesInput) entity.PageProvider[entity.WebhookDelivery] {
+	return func() ([]entity.WebhookDelivery, entity.PageProvider[entity.WebhookDelivery], entity.Pagination, error) {
+		filter.Limit = input.Limit
+		filter.Offset = input.Offset
+		deliveries, count, err := u.webhookDeliveryRepository.FindAll(ctx, filter)
+		if err != nil {
+			return entity.WebhookDeliveries{}, nil, entity.Pagination{}, errsFramework.NewInternalServerError("failed to fetch webhook deliveries", nil)
+		}
+		if deliveries == nil || len(pointer.GetValue(deliveries)) == 0 {
+			return entity.WebhookDeliveries{}, nil, entity.Pagination{}, nil
+		}
+
+		// Create pagination and next search criteria
+		pagination := entity.NewPagination(count, pointer.GetValue(input.Limit), pointer.GetValue(input.Offset))
+		nextSearchCriteria := input
+		nextSearchCriteria.Offset = pointer.ToPointer((*input.Limit) + (*input.Offset))
+		return pointer.GetValue(deliveries), u.findAllWebhookDeliveries(ctx, filter, nextSearchCriteria), pagination, nil
+	}
+}
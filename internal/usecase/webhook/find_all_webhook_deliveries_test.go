@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	webhookusecase "ticket-reservation/internal/usecase/webhook"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookUsecase_FindAllWebhookDeliveries(t *testing.T) {
+	subscriptionID := uuid.New()
+	now := time.Now()
+
+	subscription := &entity.WebhookSubscription{ID: subscriptionID, Name: "CRM", IsActive: true}
+	testDeliveries := entity.WebhookDeliveries{
+		{ID: uuid.New(), SubscriptionID: subscriptionID, EventType: entity.WebhookEventTypeReservationCreated, Status: entity.WebhookDeliveryStatusDeadLettered, Attempts: 8, CreatedAt: now},
+		{ID: uuid.New(), SubscriptionID: subscriptionID, EventType: entity.WebhookEventTypeReservationCreated, Status: entity.WebhookDeliveryStatusDeadLettered, Attempts: 8, CreatedAt: now},
+	}
+
+	tests := []struct {
+		name          string
+		input         webhookusecase.FindAllWebhookDeliveriesInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+		expectedCount int
+	}{
+		{
+			name: "successful find all webhook deliveries",
+			input: webhookusecase.FindAllWebhookDeliveriesInput{
+				SubscriptionID: subscriptionID.String(),
+				Status:         pointer.ToPointer("dead_lettered"),
+				EventType:      pointer.ToPointer("reservation.created"),
+				Limit:          pointer.ToPointer(int64(10)),
+				Offset:         pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().FindOne(gomock.Any(), subscriptionID).Return(subscription, nil)
+				h.mockWebhookDeliveryRepository.EXPECT().
+					FindAll(gomock.Any(), gomock.Eq(repository.FindAllWebhookDeliveriesFilter{
+						SubscriptionID: pointer.ToPointer(subscriptionID),
+						Status:         pointer.ToPointer(entity.WebhookDeliveryStatusDeadLettered),
+						EventType:      pointer.ToPointer(entity.WebhookEventTypeReservationCreated),
+						Limit:          pointer.ToPointer(int64(10)),
+						Offset:         pointer.ToPointer(int64(0)),
+					})).
+					Return(&testDeliveries, int64(2), nil)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "no webhook deliveries",
+			input: webhookusecase.FindAllWebhookDeliveriesInput{
+				SubscriptionID: subscriptionID.String(),
+				Limit:          pointer.ToPointer(int64(10)),
+				Offset:         pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().FindOne(gomock.Any(), subscriptionID).Return(subscription, nil)
+				h.mockWebhookDeliveryRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(&entity.WebhookDeliveries{}, int64(0), nil)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "validation error - unknown status",
+			input: webhookusecase.FindAllWebhookDeliveriesInput{
+				SubscriptionID: subscriptionID.String(),
+				Status:         pointer.ToPointer("failed"),
+				Limit:          pointer.ToPointer(int64(10)),
+				Offset:         pointer.ToPointer(int64(0)),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "webhook subscription not found",
+			input: webhookusecase.FindAllWebhookDeliveriesInput{
+				SubscriptionID: subscriptionID.String(),
+				Limit:          pointer.ToPointer(int64(10)),
+				Offset:         pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().FindOne(gomock.Any(), subscriptionID).Return(nil, errsFramework.NewNotFoundError("webhook subscription not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "webhook subscription not found",
+		},
+		{
+			name: "webhook delivery repository error",
+			input: webhookusecase.FindAllWebhookDeliveriesInput{
+				SubscriptionID: subscriptionID.String(),
+				Limit:          pointer.ToPointer(int64(10)),
+				Offset:         pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().FindOne(gomock.Any(), subscriptionID).Return(subscription, nil)
+				h.mockWebhookDeliveryRepository.EXPECT().FindAll(gomock.Any(), gomock.Any()).Return(nil, int64(0), errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to fetch webhook deliveries",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.webhookUsecase.FindAllWebhookDeliveries(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase webhook/find_all_webhook_deliveries FindAllWebhookDeliveries]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+
+				data := result.GetData()
+				assert.Equal(t, tt.expectedCount, len(data))
+				if tt.expectedCount > 0 {
+					assert.Equal(t, []entity.WebhookDelivery(testDeliveries), data)
+					assert.Equal(t, int64(2), result.GetPagination().Total)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+)
+
+func (u *webhookUsecase) FindAllWebhookSubscriptions(ctx context.Context) (subscriptions *entity.WebhookSubscriptions, err error) {
+	const errLocation = "[usecase webhook/find_all_webhook_subscriptions FindAllWebhookSubscriptions] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("webhook.usecase"), func(ctx context.Context) (*entity.WebhookSubscriptions, error) {
+		subscriptions, err := u.webhookSubscriptionRepository.FindAll(ctx)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find webhook subscriptions", nil))
+		}
+		return subscriptions, nil
+	})
+}
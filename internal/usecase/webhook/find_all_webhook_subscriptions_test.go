@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestWebhookUsecase_FindAllWebhookSubscriptions(t *testing.T) {
+	subscriptions := &entity.WebhookSubscriptions{
+		{ID: uuid.New(), Name: "CRM", EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationCreated}, IsActive: true},
+	}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name: "successful retrieval",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().FindAll(gomock.Any()).Return(subscriptions, nil)
+			},
+		},
+		{
+			name: "webhook subscription repository error",
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().FindAll(gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find webhook subscriptions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.webhookUsecase.FindAllWebhookSubscriptions(context.Background())
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase webhook/find_all_webhook_subscriptions FindAllWebhookSubscriptions]")
+				assert.Contains(t, err.Error(), tt.errorContains)
+				assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, subscriptions, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/gateway"
+	"ticket-reservation/internal/domain/repository"
+
+	"github.com/kittipat1413/go-common/framework/retry"
+)
+
+const (
+	// WebhookTimestampHeader carries the unix time at which a delivery attempt was signed
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of "<timestamp>.<raw body>", keyed by the subscription secret
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookEventIDHeader carries the ID of the event, the same on every delivery of the event so that subscribers can deduplicate
+	WebhookEventIDHeader = "X-Webhook-Event-ID"
+	// WebhookEventTypeHeader carries the type of the event
+	WebhookEventTypeHeader = "X-Webhook-Event-Type"
+	// WebhookDeliveryIDHeader carries the ID of the delivery, to be matched against the delivery log
+	WebhookDeliveryIDHeader = "X-Webhook-Delivery-ID"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/webhook_usecase.go -package=webhook_usecasemocks
+type WebhookUsecase interface {
+	CreateWebhookSubscription(ctx context.Context, input CreateWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+	FindAllWebhookSubscriptions(ctx context.Context) (*entity.WebhookSubscriptions, error)
+	UpdateWebhookSubscription(ctx context.Context, input UpdateWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+	FindAllWebhookDeliveries(ctx context.Context, input FindAllWebhookDeliveriesInput) (entity.Page[entity.WebhookDelivery], error)
+	DeliverWebhooks(ctx context.Context) (*DeliverWebhooksResult, error)
+}
+
+type webhookUsecase struct {
+	appConfig                     config.AppConfig
+	backoff                       retry.Strategy
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository
+	webhookDeliveryRepository     repository.WebhookDeliveryRepository
+	webhookSender                 gateway.WebhookSender
+}
+
+// NewWebhookUsecase returns the usecase managing webhook subscriptions and sending their deliveries.
+// The backoff spaces the attempts of a delivery, a failed attempt is due again once its delay has passed.
+func NewWebhookUsecase(
+	appConfig config.AppConfig,
+	backoff retry.Strategy,
+	webhookSubscriptionRepository repository.WebhookSubscriptionRepository,
+	webhookDeliveryRepository repository.WebhookDeliveryRepository,
+	webhookSender gateway.WebhookSender,
+) WebhookUsecase {
+	return &webhookUsecase{
+		appConfig:                     appConfig,
+		backoff:                       backoff,
+		webhookSubscriptionRepository: webhookSubscriptionRepository,
+		webhookDeliveryRepository:     webhookDeliveryRepository,
+		webhookSender:                 webhookSender,
+	}
+}
@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kittipat1413/go-common/framework/retry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/config"
+	gateway_mocks "ticket-reservation/internal/domain/gateway/mocks"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	webhookusecase "ticket-reservation/internal/usecase/webhook"
+)
+
+type testHelper struct {
+	ctrl                              *gomock.Controller
+	appConfig                         config.AppConfig
+	mockWebhookSubscriptionRepository *repository_mocks.MockWebhookSubscriptionRepository
+	mockWebhookDeliveryRepository     *repository_mocks.MockWebhookDeliveryRepository
+	mockWebhookSender                 *gateway_mocks.MockWebhookSender
+	webhookUsecase                    webhookusecase.WebhookUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		Timezone:                 "Asia/Bangkok",
+		WebhookDeliveryBatchSize: 20,
+		WebhookDeliveryLease:     15 * time.Minute,
+		WebhookMaxAttempts:       3,
+	}
+
+	// Use real backoff with the default configuration (similar to dependency.go)
+	backoff, err := retry.NewExponentialBackoffStrategy(time.Second, 2.0, time.Minute)
+	require.NoError(t, err)
+
+	mockWebhookSubscriptionRepository := repository_mocks.NewMockWebhookSubscriptionRepository(ctrl)
+	mockWebhookDeliveryRepository := repository_mocks.NewMockWebhookDeliveryRepository(ctrl)
+	mockWebhookSender := gateway_mocks.NewMockWebhookSender(ctrl)
+
+	usecase := webhookusecase.NewWebhookUsecase(
+		appConfig,
+		backoff,
+		mockWebhookSubscriptionRepository,
+		mockWebhookDeliveryRepository,
+		mockWebhookSender,
+	)
+
+	return &testHelper{
+		ctrl:                              ctrl,
+		appConfig:                         appConfig,
+		mockWebhookSubscriptionRepository: mockWebhookSubscriptionRepository,
+		mockWebhookDeliveryRepository:     mockWebhookDeliveryRepository,
+		mockWebhookSender:                 mockWebhookSender,
+		webhookUsecase:                    usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewWebhookUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Execute
+	usecase := webhookusecase.NewWebhookUsecase(
+		config.AppConfig{Timezone: "Asia/Bangkok"},
+		nil,
+		repository_mocks.NewMockWebhookSubscriptionRepository(ctrl),
+		repository_mocks.NewMockWebhookDeliveryRepository(ctrl),
+		gateway_mocks.NewMockWebhookSender(ctrl),
+	)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package webhook_usecasemocks is a generated GoMock package.
+package webhook_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/webhook"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebhookUsecase is a mock of WebhookUsecase interface.
+type MockWebhookUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookUsecaseMockRecorder
+}
+
+// MockWebhookUsecaseMockRecorder is the mock recorder for MockWebhookUsecase.
+type MockWebhookUsecaseMockRecorder struct {
+	mock *MockWebhookUsecase
+}
+
+// NewMockWebhookUsecase creates a new mock instance.
+func NewMockWebhookUsecase(ctrl *gomock.Controller) *MockWebhookUsecase {
+	mock := &MockWebhookUsecase{ctrl: ctrl}
+	mock.recorder = &MockWebhookUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookUsecase) EXPECT() *MockWebhookUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockWebhookUsecase) CreateWebhookSubscription(ctx context.Context, input usecase.CreateWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockWebhookUsecaseMockRecorder) CreateWebhookSubscription(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockWebhookUsecase)(nil).CreateWebhookSubscription), ctx, input)
+}
+
+// DeliverWebhooks mocks base method.
+func (m *MockWebhookUsecase) DeliverWebhooks(ctx context.Context) (*usecase.DeliverWebhooksResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverWebhooks", ctx)
+	ret0, _ := ret[0].(*usecase.DeliverWebhooksResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverWebhooks indicates an expected call of DeliverWebhooks.
+func (mr *MockWebhookUsecaseMockRecorder) DeliverWebhooks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverWebhooks", reflect.TypeOf((*MockWebhookUsecase)(nil).DeliverWebhooks), ctx)
+}
+
+// FindAllWebhookDeliveries mocks base method.
+func (m *MockWebhookUsecase) FindAllWebhookDeliveries(ctx context.Context, input usecase.FindAllWebhookDeliveriesInput) (entity.Page[entity.WebhookDelivery], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllWebhookDeliveries", ctx, input)
+	ret0, _ := ret[0].(entity.Page[entity.WebhookDelivery])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllWebhookDeliveries indicates an expected call of FindAllWebhookDeliveries.
+func (mr *MockWebhookUsecaseMockRecorder) FindAllWebhookDeliveries(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllWebhookDeliveries", reflect.TypeOf((*MockWebhookUsecase)(nil).FindAllWebhookDeliveries), ctx, input)
+}
+
+// FindAllWebhookSubscriptions mocks base method.
+func (m *MockWebhookUsecase) FindAllWebhookSubscriptions(ctx context.Context) (*entity.WebhookSubscriptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllWebhookSubscriptions", ctx)
+	ret0, _ := ret[0].(*entity.WebhookSubscriptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllWebhookSubscriptions indicates an expected call of FindAllWebhookSubscriptions.
+func (mr *MockWebhookUsecaseMockRecorder) FindAllWebhookSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllWebhookSubscriptions", reflect.TypeOf((*MockWebhookUsecase)(nil).FindAllWebhookSubscriptions), ctx)
+}
+
+// UpdateWebhookSubscription mocks base method.
+func (m *MockWebhookUsecase) UpdateWebhookSubscription(ctx context.Context, input usecase.UpdateWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookSubscription", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookSubscription indicates an expected call of UpdateWebhookSubscription.
+func (mr *MockWebhookUsecaseMockRecorder) UpdateWebhookSubscription(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookSubscription", reflect.TypeOf((*MockWebhookUsecase)(nil).UpdateWebhookSubscription), ctx, input)
+}
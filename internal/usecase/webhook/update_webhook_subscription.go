@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type UpdateWebhookSubscriptionInput struct {
+	ID         string   `json:"id" validate:"required,uuid4"`
+	Name       *string  `json:"name" validate:"omitempty,min=1,max=100"`
+	URL        *string  `json:"url" validate:"omitempty,http_url,max=2048"`
+	Secret     *string  `json:"secret" validate:"omitempty,min=16,max=256"`
+	EventTypes []string `json:"event_types" validate:"omitempty,min=1,unique,dive,oneof=concert.created reservation.created reservation.expired reservation.confirmed payment.succeeded"`
+	IsActive   *bool    `json:"is_active"`
+}
+
+// UpdateWebhookSubscription changes the fields given. A new URL or secret applies to the next attempt of every
+// pending delivery; deactivating a subscription stops new deliveries and dead-letters the pending ones.
+func (u *webhookUsecase) UpdateWebhookSubscription(ctx context.Context, input UpdateWebhookSubscriptionInput) (subscription *entity.WebhookSubscription, err error) {
+	const errLocation = "[usecase webhook/update_webhook_subscription UpdateWebhookSubscription] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("webhook.usecase"), func(ctx context.Context) (*entity.WebhookSubscription, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		if input.Name == nil && input.URL == nil && input.Secret == nil && input.EventTypes == nil && input.IsActive == nil {
+			return nil, errsFramework.NewBadRequestError("no fields provided to update", nil)
+		}
+
+		subscriptionID, err := uuid.Parse(input.ID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid webhook subscription ID", nil))
+		}
+
+		var eventTypes []entity.WebhookEventType
+		if input.EventTypes != nil {
+			eventTypes, err = parseWebhookEventTypes(input.EventTypes)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		subscription, err := u.webhookSubscriptionRepository.UpdateOne(ctx, repository.UpdateWebhookSubscriptionInput{
+			ID:         subscriptionID,
+			Name:       input.Name,
+			URL:        input.URL,
+			Secret:     input.Secret,
+			EventTypes: eventTypes,
+			IsActive:   input.IsActive,
+		})
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update webhook subscription", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		return subscription, nil
+	})
+}
@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	webhookusecase "ticket-reservation/internal/usecase/webhook"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestWebhookUsecase_UpdateWebhookSubscription(t *testing.T) {
+	subscriptionID := uuid.New()
+	updated := &entity.WebhookSubscription{
+		ID:         subscriptionID,
+		Name:       "CRM",
+		URL:        "https://crm.example.com/v2/webhooks",
+		EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationExpired},
+		IsActive:   false,
+	}
+
+	tests := []struct {
+		name          string
+		input         webhookusecase.UpdateWebhookSubscriptionInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+	}{
+		{
+			name: "successful update",
+			input: webhookusecase.UpdateWebhookSubscriptionInput{
+				ID:         subscriptionID.String(),
+				URL:        pointer.ToPointer("https://crm.example.com/v2/webhooks"),
+				EventTypes: []string{"reservation.expired"},
+				IsActive:   pointer.ToPointer(false),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().UpdateOne(gomock.Any(), repository.UpdateWebhookSubscriptionInput{
+					ID:         subscriptionID,
+					URL:        pointer.ToPointer("https://crm.example.com/v2/webhooks"),
+					EventTypes: []entity.WebhookEventType{entity.WebhookEventTypeReservationExpired},
+					IsActive:   pointer.ToPointer(false),
+				}).Return(updated, nil)
+			},
+		},
+		{
+			name: "validation error - invalid ID",
+			input: webhookusecase.UpdateWebhookSubscriptionInput{
+				ID:       "not-a-uuid",
+				IsActive: pointer.ToPointer(false),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - empty event types",
+			input: webhookusecase.UpdateWebhookSubscriptionInput{
+				ID:         subscriptionID.String(),
+				EventTypes: []string{},
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "no fields provided to update",
+			input: webhookusecase.UpdateWebhookSubscriptionInput{
+				ID: subscriptionID.String(),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "no fields provided to update",
+		},
+		{
+			name: "webhook subscription not found",
+			input: webhookusecase.UpdateWebhookSubscriptionInput{
+				ID:       subscriptionID.String(),
+				IsActive: pointer.ToPointer(false),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewNotFoundError("webhook subscription not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "webhook subscription not found",
+		},
+		{
+			name: "webhook subscription repository error",
+			input: webhookusecase.UpdateWebhookSubscriptionInput{
+				ID:       subscriptionID.String(),
+				IsActive: pointer.ToPointer(false),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockWebhookSubscriptionRepository.EXPECT().UpdateOne(gomock.Any(), gomock.Any()).Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update webhook subscription",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.webhookUsecase.UpdateWebhookSubscription(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase webhook/update_webhook_subscription UpdateWebhookSubscription]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, updated, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/shopspring/decimal"
+)
+
+type CreateZoneInput struct {
+	ConcertID   string            `json:"concert_id" validate:"required,uuid4"`
+	Name        string            `json:"name" validate:"required,gt=0"`
+	Description *string           `json:"description" validate:"omitempty"`
+	Price       *string           `json:"price" validate:"omitempty"`
+	Currency    *string           `json:"currency" validate:"omitempty,len=3,alpha,uppercase"`
+	Principal   *entity.Principal `json:"-"` // User creating the zone, they must manage the concert
+}
+
+func (u *zoneUsecase) CreateZone(ctx context.Context, input CreateZoneInput) (zone *entity.Zone, err error) {
+	const errLocation = "[usecase zone/create_zone CreateZone] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("zone.usecase"), func(ctx context.Context) (*entity.Zone, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+
+		price, err := parsePrice(input.Price)
+		if err != nil {
+			return nil, err
+		}
+		currency := entity.DefaultCurrency
+		if input.Currency != nil {
+			currency = *input.Currency
+		}
+
+		// Make sure the concert exists and is managed by the user before attaching a zone to it
+		if err := u.checkConcertIsManageable(ctx, concertID, input.Principal); err != nil {
+			return nil, err
+		}
+
+		created, err := u.zoneRepository.CreateOne(ctx, &entity.Zone{
+			ConcertID:   concertID,
+			Name:        input.Name,
+			Description: input.Description,
+			Price:       price,
+			Currency:    currency,
+		})
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create zone", nil))
+		}
+
+		return created, nil
+	})
+}
+
+// parsePrice parses an optional price given as a decimal string, nil is returned when no price is given.
+func parsePrice(value *string) (*decimal.Decimal, error) {
+	if value == nil {
+		return nil, nil
+	}
+	price, err := decimal.NewFromString(*value)
+	if err != nil {
+		return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid price", nil))
+	}
+	if !entity.IsValidPrice(price) {
+		return nil, errsFramework.NewBadRequestError("invalid price", map[string]string{"details": "price must not be negative and have at most 2 decimal places"})
+	}
+	return &price, nil
+}
+
+// checkConcertIsManageable returns a NotFoundError when the concert does not exist,
+// and a ForbiddenError when the principal does not manage it, see entity.Concert.IsManageableBy.
+func (u *zoneUsecase) checkConcertIsManageable(ctx context.Context, concertID uuid.UUID, principal *entity.Principal) error {
+	concert, err := u.concertRepository.FindOne(ctx, concertID)
+	if err != nil {
+		if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+			return errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+		}
+		return err // Return the NotFoundError directly
+	}
+	if !concert.IsManageableBy(principal) {
+		return errsFramework.NewForbiddenError("the concert is managed by another organizer", nil)
+	}
+	return nil
+}
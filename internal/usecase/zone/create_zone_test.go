@@ -0,0 +1,282 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	zoneusecase "ticket-reservation/internal/usecase/zone"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneUsecase_CreateZone(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	now := time.Now()
+	organizer := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer}
+
+	concert := &entity.Concert{
+		ID:          concertID,
+		Name:        "Test Concert",
+		Venue:       "Test Venue",
+		Date:        now.Add(24 * time.Hour),
+		OrganizerID: &organizer.UserID,
+	}
+	expectedZone := &entity.Zone{
+		ID:          zoneID,
+		ConcertID:   concertID,
+		Name:        "VIP",
+		Description: pointer.ToPointer("Front row seats"),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	tests := []struct {
+		name           string
+		input          zoneusecase.CreateZoneInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Zone
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "successful zone creation",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID:   concertID.String(),
+				Name:        "VIP",
+				Description: pointer.ToPointer("Front row seats"),
+				Principal:   organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					CreateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, zone *entity.Zone) (*entity.Zone, error) {
+						assert.Equal(t, concertID, zone.ConcertID)
+						assert.Equal(t, "VIP", zone.Name)
+						assert.Equal(t, "Front row seats", pointer.GetValue(zone.Description))
+						assert.Nil(t, zone.Price)
+						assert.Equal(t, entity.DefaultCurrency, zone.Currency)
+						return expectedZone, nil
+					})
+			},
+			expectedResult: expectedZone,
+			expectedError:  false,
+		},
+		{
+			name: "successful zone creation with price",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Price:     pointer.ToPointer("3500.50"),
+				Currency:  pointer.ToPointer("USD"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					CreateOne(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, zone *entity.Zone) (*entity.Zone, error) {
+						require.NotNil(t, zone.Price)
+						assert.Equal(t, "3500.50", zone.Price.StringFixed(2))
+						assert.Equal(t, "USD", zone.Currency)
+						return expectedZone, nil
+					})
+			},
+			expectedResult: expectedZone,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - negative price",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Price:     pointer.ToPointer("-1.00"),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid price",
+		},
+		{
+			name: "validation error - price with fractional cents",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Price:     pointer.ToPointer("10.005"),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid price",
+		},
+		{
+			name: "validation error - lowercase currency",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Currency:  pointer.ToPointer("thb"),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - missing name",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - invalid concert ID",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: "invalid-uuid",
+				Name:      "VIP",
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "concert not found",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name: "successful zone creation by an admin",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Principal: &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleAdmin},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					CreateOne(gomock.Any(), gomock.Any()).
+					Return(expectedZone, nil)
+			},
+			expectedResult: expectedZone,
+			expectedError:  false,
+		},
+		{
+			name: "forbidden - concert managed by another organizer",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Principal: &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the concert is managed by another organizer",
+		},
+		{
+			name: "concert repository error",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find concert by ID",
+		},
+		{
+			name: "zone repository error",
+			input: zoneusecase.CreateZoneInput{
+				ConcertID: concertID.String(),
+				Name:      "VIP",
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					CreateOne(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewDatabaseError("insert failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create zone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.zoneUsecase.CreateZone(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase zone/create_zone CreateZone]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+type FindAllZonesInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+	Limit     *int64 `json:"limit" validate:"required,gte=1,lte=100"`
+	Offset    *int64 `json:"offset" validate:"required,gte=0"`
+}
+
+func (u *zoneUsecase) FindAllZones(ctx context.Context, input FindAllZonesInput) (zones entity.Page[entity.Zone], err error) {
+	const errLocation = "[usecase zone/find_all_zones FindAllZones] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("zone.usecase"), func(ctx context.Context) (entity.Page[entity.Zone], error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+
+		// Make sure the concert exists, so an unknown concert is a 404 rather than an empty list
+		_, err = u.concertRepository.FindOne(ctx, concertID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find concert by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+
+		return entity.NewPage(u.findAllZones(ctx, concertID, input))
+	})
+}
+
+func (u *zoneUsecase) findAllZones(ctx context.Context, concertID uuid.UUID, input FindAllZonesInput) entity.PageProvider[entity.Zone] {
+	return func() ([]entity.Zone, entity.PageProvider[entity.Zone], entity.Pagination, error) {
+		zones, count, err := u.zoneRepository.FindAll(ctx, repository.FindAllZonesFilter{
+			ConcertID: pointer.ToPointer(concertID),
+			Limit:     input.Limit,
+			Offset:    input.Offset,
+		})
+		if err != nil {
+			return entity.Zones{}, nil, entity.Pagination{}, errsFramework.NewInternalServerError("failed to fetch zones", nil)
+		}
+		if zones == nil || len(pointer.GetValue(zones)) == 0 {
+			return entity.Zones{}, nil, entity.Pagination{}, nil
+		}
+
+		// Create pagination and next search criteria
+		pagination := entity.NewPagination(count, pointer.GetValue(input.Limit), pointer.GetValue(input.Offset))
+		nextSearchCriteria := FindAllZonesInput{
+			ConcertID: input.ConcertID,
+			Limit:     input.Limit,
+			Offset:    pointer.ToPointer((*input.Limit) + (*input.Offset)),
+		}
+		return pointer.GetValue(zones), u.findAllZones(ctx, concertID, nextSearchCriteria), pagination, nil
+	}
+}
@@ -0,0 +1,184 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	zoneusecase "ticket-reservation/internal/usecase/zone"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneUsecase_FindAllZones(t *testing.T) {
+	concertID := uuid.New()
+	now := time.Now()
+
+	concert := &entity.Concert{ID: concertID, Name: "Test Concert", Venue: "Test Venue", Date: now}
+	testZones := entity.Zones{
+		{ID: uuid.New(), ConcertID: concertID, Name: "A", CreatedAt: now, UpdatedAt: now},
+		{ID: uuid.New(), ConcertID: concertID, Name: "B", CreatedAt: now, UpdatedAt: now},
+	}
+
+	tests := []struct {
+		name          string
+		input         zoneusecase.FindAllZonesInput
+		setupMocks    func(h *testHelper)
+		expectedError bool
+		errorType     error
+		errorContains string
+		expectedCount int
+	}{
+		{
+			name: "successful find all zones",
+			input: zoneusecase.FindAllZonesInput{
+				ConcertID: concertID.String(),
+				Limit:     pointer.ToPointer(int64(10)),
+				Offset:    pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					FindAll(gomock.Any(), gomock.Eq(repository.FindAllZonesFilter{
+						ConcertID: pointer.ToPointer(concertID),
+						Limit:     pointer.ToPointer(int64(10)),
+						Offset:    pointer.ToPointer(int64(0)),
+					})).
+					Return(&testZones, int64(2), nil)
+			},
+			expectedError: false,
+			expectedCount: 2,
+		},
+		{
+			name: "successful find all zones with nil results",
+			input: zoneusecase.FindAllZonesInput{
+				ConcertID: concertID.String(),
+				Limit:     pointer.ToPointer(int64(10)),
+				Offset:    pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					FindAll(gomock.Any(), gomock.Any()).
+					Return(nil, int64(0), nil)
+			},
+			expectedError: false,
+			expectedCount: 0,
+		},
+		{
+			name: "validation error - missing limit",
+			input: zoneusecase.FindAllZonesInput{
+				ConcertID: concertID.String(),
+				Offset:    pointer.ToPointer(int64(0)),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - limit too high",
+			input: zoneusecase.FindAllZonesInput{
+				ConcertID: concertID.String(),
+				Limit:     pointer.ToPointer(int64(101)),
+				Offset:    pointer.ToPointer(int64(0)),
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "concert not found",
+			input: zoneusecase.FindAllZonesInput{
+				ConcertID: concertID.String(),
+				Limit:     pointer.ToPointer(int64(10)),
+				Offset:    pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name: "repository error - database failure",
+			input: zoneusecase.FindAllZonesInput{
+				ConcertID: concertID.String(),
+				Limit:     pointer.ToPointer(int64(10)),
+				Offset:    pointer.ToPointer(int64(0)),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockZoneRepository.EXPECT().
+					FindAll(gomock.Any(), gomock.Any()).
+					Return(nil, int64(0), errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to fetch zones",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.zoneUsecase.FindAllZones(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase zone/find_all_zones FindAllZones]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, result)
+
+				data := result.GetData()
+				assert.Equal(t, tt.expectedCount, len(data))
+				if tt.expectedCount > 0 {
+					assert.Equal(t, []entity.Zone(testZones), data)
+
+					pagination := result.GetPagination()
+					assert.Equal(t, int64(tt.expectedCount), pagination.Total)
+					assert.Equal(t, *tt.input.Limit, pagination.Limit)
+					assert.Equal(t, *tt.input.Offset, pagination.Offset)
+				} else {
+					assert.Equal(t, entity.Pagination{}, result.GetPagination())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type FindOneZoneInput struct {
+	ConcertID string `json:"concert_id" validate:"required,uuid4"`
+	ZoneID    string `json:"zone_id" validate:"required,uuid4"`
+}
+
+func (u *zoneUsecase) FindOneZone(ctx context.Context, input FindOneZoneInput) (zone *entity.Zone, err error) {
+	const errLocation = "[usecase zone/find_one_zone FindOneZone] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("zone.usecase"), func(ctx context.Context) (*entity.Zone, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+		}
+
+		// Validate Input
+		err = vInstance.Struct(input)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+		}
+		zoneID, err := uuid.Parse(input.ZoneID)
+		if err != nil {
+			return nil, errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+		}
+
+		// Find zone by ID
+		zone, err := u.zoneRepository.FindOne(ctx, zoneID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				return nil, errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		// A zone of another concert is treated as not found
+		if zone.ConcertID != concertID {
+			return nil, errsFramework.NewNotFoundError("zone not found", nil)
+		}
+
+		return zone, nil
+	})
+}
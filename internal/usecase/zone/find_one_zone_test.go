@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	zoneusecase "ticket-reservation/internal/usecase/zone"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+)
+
+func TestZoneUsecase_FindOneZone(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	now := time.Now()
+
+	expectedZone := &entity.Zone{
+		ID:        zoneID,
+		ConcertID: concertID,
+		Name:      "VIP",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	tests := []struct {
+		name           string
+		input          zoneusecase.FindOneZoneInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Zone
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "successful zone retrieval",
+			input: zoneusecase.FindOneZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(expectedZone, nil)
+			},
+			expectedResult: expectedZone,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid zone ID",
+			input: zoneusecase.FindOneZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    "invalid-uuid",
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "zone not found",
+			input: zoneusecase.FindOneZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "zone belongs to another concert",
+			input: zoneusecase.FindOneZoneInput{
+				ConcertID: uuid.New().String(),
+				ZoneID:    zoneID.String(),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(expectedZone, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "repository error - database failure",
+			input: zoneusecase.FindOneZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(nil, errsFramework.NewDatabaseError("connection failed", "error"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to find zone by ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.zoneUsecase.FindOneZone(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase zone/find_one_zone FindOneZone]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
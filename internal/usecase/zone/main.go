@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"ticket-reservation/internal/config"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	"ticket-reservation/internal/infra/db"
+)
+
+//go:generate mockgen -source=./main.go -destination=./mocks/zone_usecase.go -package=zone_usecasemocks
+type ZoneUsecase interface {
+	CreateZone(ctx context.Context, input CreateZoneInput) (*entity.Zone, error)
+	FindOneZone(ctx context.Context, input FindOneZoneInput) (*entity.Zone, error)
+	FindAllZones(ctx context.Context, input FindAllZonesInput) (entity.Page[entity.Zone], error)
+	UpdateZone(ctx context.Context, input UpdateZoneInput) (*entity.Zone, error)
+}
+
+type zoneUsecase struct {
+	appConfig         config.AppConfig
+	transactorFactory db.SqlxTransactorFactory
+	concertRepository repository.ConcertRepository
+	zoneRepository    repository.ZoneRepository
+}
+
+func NewZoneUsecase(
+	appConfig config.AppConfig,
+	transactorFactory db.SqlxTransactorFactory,
+	concertRepository repository.ConcertRepository,
+	zoneRepository repository.ZoneRepository,
+) ZoneUsecase {
+	return &zoneUsecase{
+		appConfig:         appConfig,
+		transactorFactory: transactorFactory,
+		concertRepository: concertRepository,
+		zoneRepository:    zoneRepository,
+	}
+}
@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"ticket-reservation/internal/config"
+	repository_mocks "ticket-reservation/internal/domain/repository/mocks"
+	db_mocks "ticket-reservation/internal/infra/db/mocks"
+	zoneusecase "ticket-reservation/internal/usecase/zone"
+)
+
+type testHelper struct {
+	ctrl                  *gomock.Controller
+	appConfig             config.AppConfig
+	mockTransactorFactory *db_mocks.MockSqlxTransactorFactory
+	mockTransactor        *db_mocks.MockSqlxTransactor
+	mockConcertRepository *repository_mocks.MockConcertRepository
+	mockZoneRepository    *repository_mocks.MockZoneRepository
+	zoneUsecase           zoneusecase.ZoneUsecase
+}
+
+func initTest(t *testing.T) *testHelper {
+	ctrl := gomock.NewController(t)
+
+	// Create test app config
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+
+	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
+	mockTransactor := db_mocks.NewMockSqlxTransactor(ctrl)
+	mockConcertRepository := repository_mocks.NewMockConcertRepository(ctrl)
+	mockZoneRepository := repository_mocks.NewMockZoneRepository(ctrl)
+
+	usecase := zoneusecase.NewZoneUsecase(
+		appConfig,
+		mockTransactorFactory,
+		mockConcertRepository,
+		mockZoneRepository,
+	)
+
+	return &testHelper{
+		ctrl:                  ctrl,
+		appConfig:             appConfig,
+		mockTransactorFactory: mockTransactorFactory,
+		mockTransactor:        mockTransactor,
+		mockConcertRepository: mockConcertRepository,
+		mockZoneRepository:    mockZoneRepository,
+		zoneUsecase:           usecase,
+	}
+}
+
+func (h *testHelper) Done() {
+	h.ctrl.Finish()
+}
+
+func TestNewZoneUsecase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	appConfig := config.AppConfig{
+		AdminAPIKey:    "test-api-key",
+		AdminAPISecret: "test-api-secret",
+		Timezone:       "Asia/Bangkok",
+		SeatLockTTL:    5 * time.Minute,
+	}
+	mockTransactorFactory := db_mocks.NewMockSqlxTransactorFactory(ctrl)
+	mockConcertRepo := repository_mocks.NewMockConcertRepository(ctrl)
+	mockZoneRepo := repository_mocks.NewMockZoneRepository(ctrl)
+
+	// Execute
+	usecase := zoneusecase.NewZoneUsecase(appConfig, mockTransactorFactory, mockConcertRepo, mockZoneRepo)
+
+	// Assert
+	assert.NotNil(t, usecase)
+}
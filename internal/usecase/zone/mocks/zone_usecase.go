@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./main.go
+
+// Package zone_usecasemocks is a generated GoMock package.
+package zone_usecasemocks
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "ticket-reservation/internal/domain/entity"
+	usecase "ticket-reservation/internal/usecase/zone"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockZoneUsecase is a mock of ZoneUsecase interface.
+type MockZoneUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockZoneUsecaseMockRecorder
+}
+
+// MockZoneUsecaseMockRecorder is the mock recorder for MockZoneUsecase.
+type MockZoneUsecaseMockRecorder struct {
+	mock *MockZoneUsecase
+}
+
+// NewMockZoneUsecase creates a new mock instance.
+func NewMockZoneUsecase(ctrl *gomock.Controller) *MockZoneUsecase {
+	mock := &MockZoneUsecase{ctrl: ctrl}
+	mock.recorder = &MockZoneUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockZoneUsecase) EXPECT() *MockZoneUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateZone mocks base method.
+func (m *MockZoneUsecase) CreateZone(ctx context.Context, input usecase.CreateZoneInput) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", ctx, input)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockZoneUsecaseMockRecorder) CreateZone(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockZoneUsecase)(nil).CreateZone), ctx, input)
+}
+
+// FindAllZones mocks base method.
+func (m *MockZoneUsecase) FindAllZones(ctx context.Context, input usecase.FindAllZonesInput) (entity.Page[entity.Zone], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllZones", ctx, input)
+	ret0, _ := ret[0].(entity.Page[entity.Zone])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllZones indicates an expected call of FindAllZones.
+func (mr *MockZoneUsecaseMockRecorder) FindAllZones(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllZones", reflect.TypeOf((*MockZoneUsecase)(nil).FindAllZones), ctx, input)
+}
+
+// FindOneZone mocks base method.
+func (m *MockZoneUsecase) FindOneZone(ctx context.Context, input usecase.FindOneZoneInput) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneZone", ctx, input)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneZone indicates an expected call of FindOneZone.
+func (mr *MockZoneUsecaseMockRecorder) FindOneZone(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneZone", reflect.TypeOf((*MockZoneUsecase)(nil).FindOneZone), ctx, input)
+}
+
+// UpdateZone mocks base method.
+func (m *MockZoneUsecase) UpdateZone(ctx context.Context, input usecase.UpdateZoneInput) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZone", ctx, input)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateZone indicates an expected call of UpdateZone.
+func (mr *MockZoneUsecaseMockRecorder) UpdateZone(ctx, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockZoneUsecase)(nil).UpdateZone), ctx, input)
+}
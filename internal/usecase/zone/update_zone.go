@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+
+	"github.com/google/uuid"
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	traceFramework "github.com/kittipat1413/go-common/framework/trace"
+	"github.com/kittipat1413/go-common/framework/validator"
+)
+
+type UpdateZoneInput struct {
+	ConcertID   string            `json:"concert_id" validate:"required,uuid4"`
+	ZoneID      string            `json:"zone_id" validate:"required,uuid4"`
+	Name        *string           `json:"name" validate:"required_without_all=Description Price Currency,omitempty,gt=0"`
+	Description *string           `json:"description" validate:"required_without_all=Name Price Currency,omitempty"`
+	Price       *string           `json:"price" validate:"required_without_all=Name Description Currency,omitempty"`
+	Currency    *string           `json:"currency" validate:"required_without_all=Name Description Price,omitempty,len=3,alpha,uppercase"`
+	Principal   *entity.Principal `json:"-"` // User updating the zone, they must manage the concert
+}
+
+func (u *zoneUsecase) UpdateZone(ctx context.Context, input UpdateZoneInput) (zone *entity.Zone, err error) {
+	const errLocation = "[usecase zone/update_zone UpdateZone] "
+	defer errsFramework.WrapErrorWithPrefix(errLocation, &err)
+
+	return traceFramework.TraceFunc(ctx, traceFramework.GetTracer("zone.usecase"), func(ctx context.Context) (*entity.Zone, error) {
+		// Create a new validator instance
+		vInstance, err := validator.NewValidator(
+			validator.WithTagNameFunc(validator.JSONTagNameFunc),
+		)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create validator", nil))
+			return nil, err
+		}
+
+		// Validate Input
+		if err := vInstance.Struct(input); err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("the request is invalid", map[string]string{"details": err.Error()}))
+			return nil, err
+		}
+
+		concertID, err := uuid.Parse(input.ConcertID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid concert ID", nil))
+			return nil, err
+		}
+		zoneID, err := uuid.Parse(input.ZoneID)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewBadRequestError("invalid zone ID", nil))
+			return nil, err
+		}
+		price, err := parsePrice(input.Price)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.checkConcertIsManageable(ctx, concertID, input.Principal); err != nil {
+			return nil, err
+		}
+
+		// Start a transaction so that the ownership check and the update see the same row
+		tx, err := u.transactorFactory.CreateSqlxTransactor(ctx)
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to create transaction", nil))
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				_ = tx.Rollback()
+			} else {
+				_ = tx.Commit()
+			}
+		}()
+
+		// Get the zone with explicit row locking
+		zone, err := u.zoneRepository.WithTx(tx.DB()).FindOne(ctx, zoneID)
+		if err != nil {
+			if !errors.As(err, &errsFramework.NotFoundError{}) { // If the error is not a NotFoundError, wrap it as an internal server error
+				err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to find zone by ID", nil))
+				return nil, err
+			}
+			return nil, err // Return the NotFoundError directly
+		}
+		if zone.ConcertID != concertID {
+			err = errsFramework.NewNotFoundError("zone not found", nil)
+			return nil, err
+		}
+
+		zone, err = u.zoneRepository.WithTx(tx.DB()).UpdateOne(ctx, repository.UpdateZoneInput{
+			ID:          zone.ID,
+			Name:        input.Name,
+			Description: input.Description,
+			Price:       price,
+			Currency:    input.Currency,
+		})
+		if err != nil {
+			err = errsFramework.WrapError(err, errsFramework.NewInternalServerError("failed to update zone", nil))
+			return nil, err
+		}
+
+		return zone, nil
+	})
+}
@@ -0,0 +1,321 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/internal/domain/entity"
+	"ticket-reservation/internal/domain/repository"
+	zoneusecase "ticket-reservation/internal/usecase/zone"
+
+	errsFramework "github.com/kittipat1413/go-common/framework/errors"
+	"github.com/kittipat1413/go-common/util/pointer"
+)
+
+func TestZoneUsecase_UpdateZone(t *testing.T) {
+	concertID := uuid.New()
+	zoneID := uuid.New()
+	now := time.Now()
+	organizer := &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer}
+
+	concert := &entity.Concert{
+		ID:          concertID,
+		Name:        "Test Concert",
+		Venue:       "Test Venue",
+		Date:        now.Add(24 * time.Hour),
+		OrganizerID: &organizer.UserID,
+	}
+	existingZone := &entity.Zone{
+		ID:        zoneID,
+		ConcertID: concertID,
+		Name:      "VIP",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	updatedZone := &entity.Zone{
+		ID:          zoneID,
+		ConcertID:   concertID,
+		Name:        "VVIP",
+		Description: pointer.ToPointer("Closest to the stage"),
+		CreatedAt:   now,
+		UpdatedAt:   now.Add(time.Minute),
+	}
+
+	tests := []struct {
+		name           string
+		input          zoneusecase.UpdateZoneInput
+		setupMocks     func(h *testHelper)
+		expectedResult *entity.Zone
+		expectedError  bool
+		errorType      error
+		errorContains  string
+	}{
+		{
+			name: "successful zone update",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID:   concertID.String(),
+				ZoneID:      zoneID.String(),
+				Name:        pointer.ToPointer("VVIP"),
+				Description: pointer.ToPointer("Closest to the stage"),
+				Principal:   organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(existingZone, nil)
+				h.mockZoneRepository.EXPECT().
+					UpdateOne(gomock.Any(), repository.UpdateZoneInput{
+						ID:          zoneID,
+						Name:        pointer.ToPointer("VVIP"),
+						Description: pointer.ToPointer("Closest to the stage"),
+					}).
+					Return(updatedZone, nil)
+				h.mockTransactor.EXPECT().Commit().Return(nil)
+			},
+			expectedResult: updatedZone,
+			expectedError:  false,
+		},
+		{
+			name: "successful price update",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Price:     pointer.ToPointer("4200.50"),
+				Currency:  pointer.ToPointer("USD"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(existingZone, nil)
+				h.mockZoneRepository.EXPECT().
+					UpdateOne(gomock.Any(), repository.UpdateZoneInput{
+						ID:       zoneID,
+						Price:    pointer.ToPointer(decimal.RequireFromString("4200.50")),
+						Currency: pointer.ToPointer("USD"),
+					}).
+					Return(updatedZone, nil)
+				h.mockTransactor.EXPECT().Commit().Return(nil)
+			},
+			expectedResult: updatedZone,
+			expectedError:  false,
+		},
+		{
+			name: "validation error - invalid price",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Price:     pointer.ToPointer("abc"),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "invalid price",
+		},
+		{
+			name: "validation error - nothing to update",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "validation error - empty name",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer(""),
+				Principal: organizer,
+			},
+			setupMocks:    func(h *testHelper) {},
+			expectedError: true,
+			errorType:     &errsFramework.BadRequestError{},
+			errorContains: "the request is invalid",
+		},
+		{
+			name: "transaction creation error",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer("VVIP"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(nil, errors.New("connection refused"))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to create transaction",
+		},
+		{
+			name: "concert not found",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer("VVIP"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(nil, errsFramework.NewNotFoundError("concert not found", nil))
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "concert not found",
+		},
+		{
+			name: "forbidden - concert managed by another organizer",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer("VVIP"),
+				Principal: &entity.Principal{UserID: uuid.New(), Role: entity.UserRoleOrganizer},
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.ForbiddenError{},
+			errorContains: "the concert is managed by another organizer",
+		},
+		{
+			name: "zone not found",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer("VVIP"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(nil, errsFramework.NewNotFoundError("zone not found", nil))
+				h.mockTransactor.EXPECT().Rollback().Return(nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "zone belongs to another concert",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: uuid.New().String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer("VVIP"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), gomock.Any()).
+					Return(concert, nil)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(existingZone, nil)
+				h.mockTransactor.EXPECT().Rollback().Return(nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.NotFoundError{},
+			errorContains: "zone not found",
+		},
+		{
+			name: "repository error - update failure",
+			input: zoneusecase.UpdateZoneInput{
+				ConcertID: concertID.String(),
+				ZoneID:    zoneID.String(),
+				Name:      pointer.ToPointer("VVIP"),
+				Principal: organizer,
+			},
+			setupMocks: func(h *testHelper) {
+				h.mockConcertRepository.EXPECT().
+					FindOne(gomock.Any(), concertID).
+					Return(concert, nil)
+				h.mockTransactorFactory.EXPECT().CreateSqlxTransactor(gomock.Any()).Return(h.mockTransactor, nil)
+				h.mockTransactor.EXPECT().DB().Return(nil).AnyTimes()
+				h.mockZoneRepository.EXPECT().WithTx(gomock.Any()).Return(h.mockZoneRepository).AnyTimes()
+				h.mockZoneRepository.EXPECT().
+					FindOne(gomock.Any(), zoneID).
+					Return(existingZone, nil)
+				h.mockZoneRepository.EXPECT().
+					UpdateOne(gomock.Any(), gomock.Any()).
+					Return(nil, errsFramework.NewDatabaseError("update failed", "error"))
+				h.mockTransactor.EXPECT().Rollback().Return(nil)
+			},
+			expectedError: true,
+			errorType:     &errsFramework.InternalServerError{},
+			errorContains: "failed to update zone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := initTest(t)
+			defer h.Done()
+
+			// Setup mocks
+			tt.setupMocks(h)
+
+			// Execute
+			result, err := h.zoneUsecase.UpdateZone(context.Background(), tt.input)
+
+			// Assert
+			if tt.expectedError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "[usecase zone/update_zone UpdateZone]")
+
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+
+				if tt.errorType != nil {
+					assert.ErrorAs(t, err, &tt.errorType, "Expected error to be of type %T", tt.errorType)
+				}
+
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
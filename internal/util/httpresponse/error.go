@@ -1,8 +1,11 @@
 package httpresponse
 
 import (
+	"errors"
 	"net/http"
 
+	"ticket-reservation/internal/domain/errs"
+
 	"github.com/gin-gonic/gin"
 	errsFramework "github.com/kittipat1413/go-common/framework/errors"
 	"github.com/kittipat1413/go-common/framework/logger"
@@ -43,5 +46,11 @@ func unwrapError(err error) (httpCode int, errResp ErrorResponse) {
 		errResp.Data = domainErr.GetData()
 	}
 
+	// The error categories have no 429 status, rate limit errors are client errors sent with it.
+	var tooManyRequestsErr *errs.TooManyRequestsError
+	if errors.As(err, &tooManyRequestsErr) {
+		httpCode = http.StatusTooManyRequests
+	}
+
 	return httpCode, errResp
 }
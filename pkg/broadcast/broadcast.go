@@ -0,0 +1,114 @@
+package broadcast
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrSlowSubscriber = errors.New("subscriber did not keep up with the messages")
+)
+
+// Hub fans out the messages published on a topic to every subscription of the topic.
+// Publishing never blocks: a subscription whose buffer is full is ended with ErrSlowSubscriber,
+// so that one slow subscriber does not hold up the others.
+type Hub[T any] struct {
+	mu         sync.Mutex
+	bufferSize int
+	topics     map[string]map[*Subscription[T]]struct{}
+}
+
+// NewHub returns a hub buffering up to bufferSize messages for every subscription.
+func NewHub[T any](bufferSize int) *Hub[T] {
+	return &Hub[T]{
+		bufferSize: bufferSize,
+		topics:     make(map[string]map[*Subscription[T]]struct{}),
+	}
+}
+
+// Subscription receives the messages published on a topic after it was made.
+type Subscription[T any] struct {
+	hub      *Hub[T]
+	topic    string
+	messages chan T
+	err      error
+	closed   bool
+}
+
+// Subscribe starts receiving the messages published on the topic.
+func (h *Hub[T]) Subscribe(topic string) *Subscription[T] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subscription := &Subscription[T]{
+		hub:      h,
+		topic:    topic,
+		messages: make(chan T, h.bufferSize),
+	}
+	subscriptions, ok := h.topics[topic]
+	if !ok {
+		subscriptions = make(map[*Subscription[T]]struct{})
+		h.topics[topic] = subscriptions
+	}
+	subscriptions[subscription] = struct{}{}
+	return subscription
+}
+
+// Publish sends the message to every subscription of the topic.
+func (h *Hub[T]) Publish(topic string, message T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for subscription := range h.topics[topic] {
+		select {
+		case subscription.messages <- message:
+		default:
+			h.end(subscription, ErrSlowSubscriber)
+		}
+	}
+}
+
+// Len returns the number of subscriptions of the topic.
+func (h *Hub[T]) Len(topic string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.topics[topic])
+}
+
+// end removes the subscription and closes its channel, h.mu must be held.
+func (h *Hub[T]) end(subscription *Subscription[T], err error) {
+	if subscription.closed {
+		return
+	}
+	subscription.closed = true
+	subscription.err = err
+	close(subscription.messages)
+
+	subscriptions := h.topics[subscription.topic]
+	delete(subscriptions, subscription)
+	if len(subscriptions) == 0 {
+		delete(h.topics, subscription.topic)
+	}
+}
+
+// Messages returns the channel of the messages, closed when the subscription ends.
+func (s *Subscription[T]) Messages() <-chan T {
+	return s.messages
+}
+
+// Err returns why the subscription ended, ErrSlowSubscriber when it fell behind and nil when it was closed.
+func (s *Subscription[T]) Err() error {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	return s.err
+}
+
+// Close ends the subscription, closing it again does nothing.
+func (s *Subscription[T]) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.end(s, nil)
+}
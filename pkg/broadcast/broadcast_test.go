@@ -0,0 +1,69 @@
+package broadcast_test
+
+import (
+	"testing"
+
+	"ticket-reservation/pkg/broadcast"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_Publish(t *testing.T) {
+	hub := broadcast.NewHub[int](4)
+	first := hub.Subscribe("zone-a")
+	second := hub.Subscribe("zone-a")
+	other := hub.Subscribe("zone-b")
+	defer first.Close()
+	defer second.Close()
+	defer other.Close()
+
+	hub.Publish("zone-a", 1)
+	hub.Publish("zone-a", 2)
+
+	for _, subscription := range []*broadcast.Subscription[int]{first, second} {
+		assert.Equal(t, 1, <-subscription.Messages())
+		assert.Equal(t, 2, <-subscription.Messages())
+	}
+	assert.Empty(t, other.Messages())
+	assert.Equal(t, 2, hub.Len("zone-a"))
+	assert.Equal(t, 1, hub.Len("zone-b"))
+}
+
+func TestHub_PublishToSlowSubscriber(t *testing.T) {
+	hub := broadcast.NewHub[int](2)
+	slow := hub.Subscribe("zone-a")
+	fast := hub.Subscribe("zone-a")
+	defer fast.Close()
+
+	for i := 1; i <= 3; i++ {
+		hub.Publish("zone-a", i)
+		require.Equal(t, i, <-fast.Messages())
+	}
+
+	// The slow subscriber gets what fitted in its buffer, then its channel is closed
+	assert.Equal(t, 1, <-slow.Messages())
+	assert.Equal(t, 2, <-slow.Messages())
+	_, ok := <-slow.Messages()
+	assert.False(t, ok)
+	assert.ErrorIs(t, slow.Err(), broadcast.ErrSlowSubscriber)
+	assert.Equal(t, 1, hub.Len("zone-a"))
+
+	// Closing an ended subscription does nothing
+	slow.Close()
+	assert.ErrorIs(t, slow.Err(), broadcast.ErrSlowSubscriber)
+}
+
+func TestSubscription_Close(t *testing.T) {
+	hub := broadcast.NewHub[int](2)
+	subscription := hub.Subscribe("zone-a")
+
+	subscription.Close()
+	subscription.Close()
+	hub.Publish("zone-a", 1)
+
+	_, ok := <-subscription.Messages()
+	assert.False(t, ok)
+	assert.NoError(t, subscription.Err())
+	assert.Equal(t, 0, hub.Len("zone-a"))
+}
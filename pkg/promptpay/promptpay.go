@@ -0,0 +1,164 @@
+// Package promptpay generates PromptPay QR code payloads following the EMVCo merchant-presented QR specification
+// as adopted by the Bank of Thailand (Thai QR Payment).
+package promptpay
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrInvalidProxyID   = errors.New("invalid PromptPay proxy ID")
+	ErrInvalidBillerID  = errors.New("invalid PromptPay biller ID")
+	ErrInvalidReference = errors.New("invalid PromptPay reference")
+	ErrInvalidAmount    = errors.New("invalid PromptPay amount")
+)
+
+// Tags of the top level data objects.
+const (
+	tagPayloadFormatIndicator = "00"
+	tagPointOfInitiation      = "01"
+	tagCreditTransfer         = "29"
+	tagBillPayment            = "30"
+	tagTransactionCurrency    = "53"
+	tagTransactionAmount      = "54"
+	tagCountryCode            = "58"
+	tagCRC                    = "63"
+)
+
+// Tags of the merchant account information templates.
+const (
+	tagApplicationID = "00"
+	tagMobileNumber  = "01"
+	tagNationalID    = "02"
+	tagEWalletID     = "03"
+	tagBillerID      = "01"
+	tagReference1    = "02"
+	tagReference2    = "03"
+)
+
+const (
+	payloadFormatIndicator = "01"
+	// A static code may be paid many times with any amount, a dynamic one is made for a single payment
+	pointOfInitiationStatic  = "11"
+	pointOfInitiationDynamic = "12"
+
+	creditTransferApplicationID = "A000000677010111"
+	billPaymentApplicationID    = "A000000677010112"
+
+	currencyTHB = "764" // ISO 4217 numeric code of the Thai baht
+	countryTH   = "TH"
+)
+
+var (
+	digitsPattern    = regexp.MustCompile(`^[0-9]+$`)
+	referencePattern = regexp.MustCompile(`^[0-9A-Z]{1,20}$`)
+	amountPattern    = regexp.MustCompile(`^[0-9]+\.[0-9]{2}$`)
+)
+
+// CreditTransfer returns the payload of a QR code that transfers money to a PromptPay proxy ID:
+// a 10 digit mobile number, a 13 digit national or tax ID, or a 15 digit e-wallet ID.
+// The amount is a decimal with two places, e.g. "1605.00", or empty to let the payer enter it.
+func CreditTransfer(proxyID string, amount string) (string, error) {
+	proxyID = strings.NewReplacer("-", "", " ", "").Replace(proxyID)
+	if !digitsPattern.MatchString(proxyID) {
+		return "", fmt.Errorf("%w: must contain digits only", ErrInvalidProxyID)
+	}
+
+	var account string
+	switch len(proxyID) {
+	case 10:
+		// Mobile numbers are sent in the international format, 0066 followed by the number without its leading zero
+		account = tlv(tagMobileNumber, "0066"+proxyID[1:])
+	case 13:
+		account = tlv(tagNationalID, proxyID)
+	case 15:
+		account = tlv(tagEWalletID, proxyID)
+	default:
+		return "", fmt.Errorf("%w: must have 10, 13 or 15 digits", ErrInvalidProxyID)
+	}
+
+	return build(tlv(tagCreditTransfer, tlv(tagApplicationID, creditTransferApplicationID)+account), amount)
+}
+
+// BillPayment returns the payload of a QR code that pays a bill to a biller registered with PromptPay.
+// The biller ID is the 15 digit ID given by the bank, usually the 13 digit tax ID followed by a 2 digit suffix.
+// The references identify the bill on the biller side and are sent back with the payment notification;
+// they have up to 20 upper case letters or digits, the second one is optional.
+// The amount is a decimal with two places, e.g. "1605.00", or empty to let the payer enter it.
+func BillPayment(billerID string, reference1 string, reference2 string, amount string) (string, error) {
+	if err := ValidateBillerID(billerID); err != nil {
+		return "", err
+	}
+	if !referencePattern.MatchString(reference1) {
+		return "", fmt.Errorf("%w: reference 1 must have 1 to 20 upper case letters or digits", ErrInvalidReference)
+	}
+	if reference2 != "" && !referencePattern.MatchString(reference2) {
+		return "", fmt.Errorf("%w: reference 2 must have up to 20 upper case letters or digits", ErrInvalidReference)
+	}
+
+	account := tlv(tagApplicationID, billPaymentApplicationID) + tlv(tagBillerID, billerID) + tlv(tagReference1, reference1)
+	if reference2 != "" {
+		account += tlv(tagReference2, reference2)
+	}
+
+	return build(tlv(tagBillPayment, account), amount)
+}
+
+// ValidateBillerID checks that the biller ID has the 15 digits given by the bank.
+func ValidateBillerID(billerID string) error {
+	if len(billerID) != 15 || !digitsPattern.MatchString(billerID) {
+		return fmt.Errorf("%w: must have 15 digits", ErrInvalidBillerID)
+	}
+	return nil
+}
+
+// build wraps the merchant account information into a complete payload ending with its checksum.
+func build(merchantAccount string, amount string) (string, error) {
+	pointOfInitiation := pointOfInitiationStatic
+	if amount != "" {
+		if !amountPattern.MatchString(amount) || len(amount) > 13 {
+			return "", fmt.Errorf("%w: must be a decimal with two places of at most 13 characters", ErrInvalidAmount)
+		}
+		pointOfInitiation = pointOfInitiationDynamic
+	}
+
+	var b strings.Builder
+	b.WriteString(tlv(tagPayloadFormatIndicator, payloadFormatIndicator))
+	b.WriteString(tlv(tagPointOfInitiation, pointOfInitiation))
+	b.WriteString(merchantAccount)
+	b.WriteString(tlv(tagCountryCode, countryTH))
+	b.WriteString(tlv(tagTransactionCurrency, currencyTHB))
+	if amount != "" {
+		b.WriteString(tlv(tagTransactionAmount, amount))
+	}
+
+	// The checksum covers the whole payload up to and including the tag and length of the checksum itself
+	b.WriteString(tagCRC + "04")
+	b.WriteString(fmt.Sprintf("%04X", CRC16([]byte(b.String()))))
+	return b.String(), nil
+}
+
+// tlv encodes a data object as its tag, its two digit length and its value.
+func tlv(tag string, value string) string {
+	return fmt.Sprintf("%s%02d%s", tag, len(value), value)
+}
+
+// CRC16 returns the CRC-16/CCITT-FALSE checksum of the data (polynomial 0x1021, initial value 0xFFFF),
+// the checksum required by EMVCo QR codes.
+func CRC16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
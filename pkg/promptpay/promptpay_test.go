@@ -0,0 +1,163 @@
+package promptpay_test
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"ticket-reservation/pkg/promptpay"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRC16(t *testing.T) {
+	// Check value of CRC-16/CCITT-FALSE
+	assert.Equal(t, uint16(0x29B1), promptpay.CRC16([]byte("123456789")))
+}
+
+func TestCreditTransfer(t *testing.T) {
+	tests := []struct {
+		name            string
+		proxyID         string
+		amount          string
+		expectedPayload string
+		expectedErr     error
+	}{
+		{
+			// Known-good payloads, as generated by the reference promptpay-qr implementation and accepted by Thai banking apps
+			name:            "national ID without amount",
+			proxyID:         "1111111111111",
+			expectedPayload: "00020101021129370016A000000677010111021311111111111115802TH530376463047B5A",
+		},
+		{
+			name:            "e-wallet ID without amount",
+			proxyID:         "004999000288505",
+			expectedPayload: "00020101021129390016A00000067701011103150049990002885055802TH530376463041521",
+		},
+		{
+			name:            "mobile number without amount",
+			proxyID:         "0801234567",
+			expectedPayload: "00020101021129370016A000000677010111011300668012345675802TH530376463046197",
+		},
+		{
+			name:            "mobile number with dashes and an amount",
+			proxyID:         "080-123-4567",
+			amount:          "4.22",
+			expectedPayload: "00020101021229370016A000000677010111011300668012345675802TH530376454044.22630444FE",
+		},
+		{
+			name:        "proxy ID with letters",
+			proxyID:     "08O1234567",
+			expectedErr: promptpay.ErrInvalidProxyID,
+		},
+		{
+			name:        "proxy ID of unknown length",
+			proxyID:     "123456",
+			expectedErr: promptpay.ErrInvalidProxyID,
+		},
+		{
+			name:        "amount without two decimal places",
+			proxyID:     "0801234567",
+			amount:      "4.2",
+			expectedErr: promptpay.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := promptpay.CreditTransfer(tt.proxyID, tt.amount)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, payload)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPayload, payload)
+			}
+		})
+	}
+}
+
+func TestBillPayment(t *testing.T) {
+	tests := []struct {
+		name            string
+		billerID        string
+		reference1      string
+		reference2      string
+		amount          string
+		expectedPayload string
+		expectedErr     error
+	}{
+		{
+			// 00 02 01 | 01 02 12 | 30 49 [00 16 A000000677010112 | 01 15 010555500000101 | 02 06 ABC123] | 58 02 TH | 53 03 764 | 54 07 1605.00 | 63 04 E8B8
+			name:            "biller ID with one reference and an amount",
+			billerID:        "010555500000101",
+			reference1:      "ABC123",
+			amount:          "1605.00",
+			expectedPayload: "00020101021230490016A00000067701011201150105555000001010206ABC1235802TH530376454071605.006304E8B8",
+		},
+		{
+			name:            "biller ID with two references without amount",
+			billerID:        "010555500000101",
+			reference1:      "ABC123",
+			reference2:      "REF2",
+			expectedPayload: "00020101021130570016A00000067701011201150105555000001010206ABC1230304REF25802TH530376463041C0E",
+		},
+		{
+			name:        "biller ID too short",
+			billerID:    "0105555000001",
+			reference1:  "ABC123",
+			expectedErr: promptpay.ErrInvalidBillerID,
+		},
+		{
+			name:        "missing reference 1",
+			billerID:    "010555500000101",
+			expectedErr: promptpay.ErrInvalidReference,
+		},
+		{
+			name:        "lower case reference",
+			billerID:    "010555500000101",
+			reference1:  "abc123",
+			expectedErr: promptpay.ErrInvalidReference,
+		},
+		{
+			name:        "reference 2 too long",
+			billerID:    "010555500000101",
+			reference1:  "ABC123",
+			reference2:  "ABCDEFGHIJKLMNOPQRSTU",
+			expectedErr: promptpay.ErrInvalidReference,
+		},
+		{
+			name:        "negative amount",
+			billerID:    "010555500000101",
+			reference1:  "ABC123",
+			amount:      "-1.00",
+			expectedErr: promptpay.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := promptpay.BillPayment(tt.billerID, tt.reference1, tt.reference2, tt.amount)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, payload)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPayload, payload)
+		})
+	}
+}
+
+func TestPNG(t *testing.T) {
+	payload := "00020101021129370016A000000677010111021311111111111115802TH530376463047B5A"
+
+	image, err := promptpay.PNG(payload, 256)
+	require.NoError(t, err)
+
+	decoded, err := png.Decode(bytes.NewReader(image))
+	require.NoError(t, err)
+	assert.Equal(t, 256, decoded.Bounds().Dx())
+	assert.Equal(t, 256, decoded.Bounds().Dy())
+}
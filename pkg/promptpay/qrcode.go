@@ -0,0 +1,11 @@
+package promptpay
+
+import (
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// PNG renders the payload as a square QR code image of size pixels, with the medium error correction level
+// that banking apps expect.
+func PNG(payload string, size int) ([]byte, error) {
+	return qrcode.Encode(payload, qrcode.Medium, size)
+}
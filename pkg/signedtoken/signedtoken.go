@@ -0,0 +1,78 @@
+package signedtoken
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidKey       = errors.New("invalid ed25519 key")
+	ErrMalformedToken   = errors.New("malformed signed token")
+	ErrInvalidSignature = errors.New("invalid token signature")
+)
+
+// encoding is the URL-safe base64 without padding, so that tokens fit in URLs and QR codes as they are
+var encoding = base64.RawURLEncoding
+
+// ParsePrivateKey decodes a standard base64 Ed25519 key, either the 32-byte seed or the 64-byte private key.
+func ParsePrivateKey(encoded string) (ed25519.PrivateKey, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	switch len(decoded) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(decoded), nil
+	case ed25519.PrivateKeySize:
+		// The second half of a private key is its public key, reject keys where it does not match the seed
+		privateKey := ed25519.NewKeyFromSeed(decoded[:ed25519.SeedSize])
+		if !bytes.Equal(privateKey, decoded) {
+			return nil, ErrInvalidKey
+		}
+		return privateKey, nil
+	default:
+		return nil, ErrInvalidKey
+	}
+}
+
+// EncodePublicKey returns the standard base64 of the public key, the form handed to verifiers.
+func EncodePublicKey(publicKey ed25519.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(publicKey)
+}
+
+// ParsePublicKey decodes a public key encoded by EncodePublicKey.
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(decoded) != ed25519.PublicKeySize {
+		return nil, ErrInvalidKey
+	}
+	return ed25519.PublicKey(decoded), nil
+}
+
+// Sign returns "<payload>.<signature>", both URL-safe base64 without padding, where the signature is the Ed25519 signature of the raw payload.
+func Sign(privateKey ed25519.PrivateKey, payload []byte) string {
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(ed25519.Sign(privateKey, payload))
+}
+
+// Verify checks that the token was produced by Sign with the private key of publicKey and returns the payload.
+func Verify(publicKey ed25519.PublicKey, token string) ([]byte, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrMalformedToken
+	}
+	payload, err := encoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	signature, err := encoding.DecodeString(encodedSignature)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return nil, ErrMalformedToken
+	}
+	if len(publicKey) != ed25519.PublicKeySize || !ed25519.Verify(publicKey, payload, signature) {
+		return nil, ErrInvalidSignature
+	}
+	return payload, nil
+}
@@ -0,0 +1,124 @@
+package signedtoken_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+
+	"ticket-reservation/pkg/signedtoken"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc8032Seed is the secret key of test 1 in RFC 8032 section 7.1
+const rfc8032Seed = "nWGxne/9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A="
+
+func TestParsePrivateKey(t *testing.T) {
+	seedKey, err := signedtoken.ParsePrivateKey(rfc8032Seed)
+	require.NoError(t, err)
+	assert.Equal(t, "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=", signedtoken.EncodePublicKey(seedKey.Public().(ed25519.PublicKey)))
+
+	fullKey, err := signedtoken.ParsePrivateKey(base64.StdEncoding.EncodeToString(seedKey))
+	require.NoError(t, err)
+	assert.Equal(t, seedKey, fullKey)
+
+	tampered := append([]byte{}, seedKey...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	for name, encoded := range map[string]string{
+		"not base64":                 "not a key!",
+		"wrong length":               base64.StdEncoding.EncodeToString([]byte("short")),
+		"public half does not match": base64.StdEncoding.EncodeToString(tampered),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := signedtoken.ParsePrivateKey(encoded)
+			assert.ErrorIs(t, err, signedtoken.ErrInvalidKey)
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	publicKey, err := signedtoken.ParsePublicKey("11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=")
+	require.NoError(t, err)
+	assert.Len(t, publicKey, ed25519.PublicKeySize)
+
+	_, err = signedtoken.ParsePublicKey(rfc8032Seed + "AAAA")
+	assert.ErrorIs(t, err, signedtoken.ErrInvalidKey)
+}
+
+func TestSign(t *testing.T) {
+	privateKey, err := signedtoken.ParsePrivateKey(rfc8032Seed)
+	require.NoError(t, err)
+
+	// Signature of the empty message from RFC 8032 test 1
+	assert.Equal(t, ".5VZDAMNgrHKQhuLMgG6CioSHfx645dl02HPgZSJJAVVfuIIVkKM7rMYeOXAc-bRr0lv18FlbviRlUUFDjnoQCw", signedtoken.Sign(privateKey, []byte{}))
+	assert.Equal(t, "eyJ0aWQiOiIxIn0.WMTwNSrPIXqmgUiP02h7rbIjDSWMAelC0K6iWhJL6hTZPVUVNyEWVIu2dpklKmhSEJzDts2iTXzYuvJgjZbQBQ", signedtoken.Sign(privateKey, []byte(`{"tid":"1"}`)))
+}
+
+func TestVerify(t *testing.T) {
+	privateKey, err := signedtoken.ParsePrivateKey(rfc8032Seed)
+	require.NoError(t, err)
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	_, otherPrivateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	payload := []byte(`{"tid":"1"}`)
+	token := signedtoken.Sign(privateKey, payload)
+
+	tests := []struct {
+		name        string
+		publicKey   ed25519.PublicKey
+		token       string
+		expectedErr error
+	}{
+		{
+			name:      "valid token",
+			publicKey: publicKey,
+			token:     token,
+		},
+		{
+			name:        "missing signature",
+			publicKey:   publicKey,
+			token:       "eyJ0aWQiOiIxIn0",
+			expectedErr: signedtoken.ErrMalformedToken,
+		},
+		{
+			name:        "payload is not base64",
+			publicKey:   publicKey,
+			token:       "!!!." + token[len("eyJ0aWQiOiIxIn0."):],
+			expectedErr: signedtoken.ErrMalformedToken,
+		},
+		{
+			name:        "truncated signature",
+			publicKey:   publicKey,
+			token:       token[:len(token)-4],
+			expectedErr: signedtoken.ErrMalformedToken,
+		},
+		{
+			name:        "payload changed after signing",
+			publicKey:   publicKey,
+			token:       "eyJ0aWQiOiIyIn0." + token[len("eyJ0aWQiOiIxIn0."):],
+			expectedErr: signedtoken.ErrInvalidSignature,
+		},
+		{
+			name:        "signed with another key",
+			publicKey:   publicKey,
+			token:       signedtoken.Sign(otherPrivateKey, payload),
+			expectedErr: signedtoken.ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := signedtoken.Verify(tt.publicKey, tt.token)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, payload, result)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package textpdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"ticket-reservation/pkg/textpdf"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name          string
+		lines         []string
+		expectedPages int
+		contains      []string
+	}{
+		{
+			name:          "single page with escaped characters",
+			lines:         []string{"Receipt RC00000042", "Service fee (2.50%)", `C:\path`, "Café", "ใบเสร็จ"},
+			expectedPages: 1,
+			contains:      []string{"(Receipt RC00000042) Tj", `(Service fee \(2.50%\)) Tj`, `(C:\\path) Tj`, `(Caf\351) Tj`, "(???????) Tj"},
+		},
+		{
+			name:          "long documents continue on the next page",
+			lines:         strings.Split(strings.Repeat("line\n", 100), "\n"),
+			expectedPages: 3,
+		},
+		{
+			name:          "empty document has one blank page",
+			lines:         nil,
+			expectedPages: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			document := textpdf.Render(tt.lines)
+
+			// Assert
+			assert.True(t, bytes.HasPrefix(document, []byte("%PDF-1.4\n")))
+			assert.True(t, bytes.HasSuffix(document, []byte("%%EOF\n")))
+			assert.Contains(t, string(document), fmt.Sprintf("/Count %d", tt.expectedPages))
+			for _, expected := range tt.contains {
+				assert.Contains(t, string(document), expected)
+			}
+
+			// Every xref entry points at the start of its object
+			startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(document)
+			require.NotNil(t, startxref)
+			xrefOffset, err := strconv.Atoi(string(startxref[1]))
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(document[xrefOffset:], []byte("xref\n")))
+			entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(document[xrefOffset:], -1)
+			require.Len(t, entries, 3+2*tt.expectedPages)
+			for i, entry := range entries {
+				offset, err := strconv.Atoi(string(entry[1]))
+				require.NoError(t, err)
+				assert.True(t, bytes.HasPrefix(document[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d is not at its xref offset", i+1)
+			}
+		})
+	}
+}
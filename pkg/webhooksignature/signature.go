@@ -0,0 +1,52 @@
+package webhooksignature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrInvalidTimestamp = errors.New("invalid webhook timestamp")
+	ErrStaleTimestamp   = errors.New("webhook timestamp is outside the tolerance")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Sign returns the hex encoded HMAC-SHA256 of "<unix timestamp>.<payload>" keyed with the secret.
+// Binding the timestamp into the signature prevents a captured delivery from being replayed later with a fresh timestamp.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	return hex.EncodeToString(compute(secret, strconv.FormatInt(timestamp.Unix(), 10), payload))
+}
+
+// Verify checks that the signature was produced by Sign with the same secret, timestamp and payload,
+// and that the timestamp is not further than tolerance away from now.
+func Verify(secret string, timestamp string, signature string, payload []byte, now time.Time, tolerance time.Duration) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	age := now.Sub(time.Unix(unix, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrStaleTimestamp
+	}
+
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal(decoded, compute(secret, timestamp, payload)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func compute(secret string, timestamp string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
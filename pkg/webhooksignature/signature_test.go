@@ -0,0 +1,124 @@
+package webhooksignature_test
+
+import (
+	"testing"
+	"time"
+
+	"ticket-reservation/pkg/webhooksignature"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	// Known answer computed independently: HMAC-SHA256("whsec_test", `1760600000.{"id":"evt_1"}`)
+	signature := webhooksignature.Sign("whsec_test", time.Unix(1760600000, 0), []byte(`{"id":"evt_1"}`))
+	assert.Equal(t, "abbd014707ed4ffc2ef1e194f7c25945c9e064a2cc22de6dc617a24ac16d26e7", signature)
+}
+
+func TestVerify(t *testing.T) {
+	secret := "whsec_test"
+	payload := []byte(`{"id":"evt_1"}`)
+	signedAt := time.Unix(1760600000, 0)
+	signature := webhooksignature.Sign(secret, signedAt, payload)
+
+	tests := []struct {
+		name        string
+		secret      string
+		timestamp   string
+		signature   string
+		payload     []byte
+		now         time.Time
+		expectedErr error
+	}{
+		{
+			name:      "valid signature",
+			secret:    secret,
+			timestamp: "1760600000",
+			signature: signature,
+			payload:   payload,
+			now:       signedAt.Add(time.Minute),
+		},
+		{
+			name:      "valid signature with the sender clock slightly ahead",
+			secret:    secret,
+			timestamp: "1760600000",
+			signature: signature,
+			payload:   payload,
+			now:       signedAt.Add(-time.Minute),
+		},
+		{
+			name:        "malformed timestamp",
+			secret:      secret,
+			timestamp:   "yesterday",
+			signature:   signature,
+			payload:     payload,
+			now:         signedAt,
+			expectedErr: webhooksignature.ErrInvalidTimestamp,
+		},
+		{
+			name:        "stale timestamp",
+			secret:      secret,
+			timestamp:   "1760600000",
+			signature:   signature,
+			payload:     payload,
+			now:         signedAt.Add(6 * time.Minute),
+			expectedErr: webhooksignature.ErrStaleTimestamp,
+		},
+		{
+			name:        "timestamp too far in the future",
+			secret:      secret,
+			timestamp:   "1760600000",
+			signature:   signature,
+			payload:     payload,
+			now:         signedAt.Add(-6 * time.Minute),
+			expectedErr: webhooksignature.ErrStaleTimestamp,
+		},
+		{
+			name:        "tampered payload",
+			secret:      secret,
+			timestamp:   "1760600000",
+			signature:   signature,
+			payload:     []byte(`{"id":"evt_2"}`),
+			now:         signedAt,
+			expectedErr: webhooksignature.ErrInvalidSignature,
+		},
+		{
+			name:        "timestamp replaced after signing",
+			secret:      secret,
+			timestamp:   "1760600060",
+			signature:   signature,
+			payload:     payload,
+			now:         signedAt,
+			expectedErr: webhooksignature.ErrInvalidSignature,
+		},
+		{
+			name:        "wrong secret",
+			secret:      "whsec_other",
+			timestamp:   "1760600000",
+			signature:   signature,
+			payload:     payload,
+			now:         signedAt,
+			expectedErr: webhooksignature.ErrInvalidSignature,
+		},
+		{
+			name:        "signature is not hex",
+			secret:      secret,
+			timestamp:   "1760600000",
+			signature:   "not-a-signature",
+			payload:     payload,
+			now:         signedAt,
+			expectedErr: webhooksignature.ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := webhooksignature.Verify(tt.secret, tt.timestamp, tt.signature, tt.payload, tt.now, 5*time.Minute)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}